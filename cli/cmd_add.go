@@ -97,6 +97,7 @@ there instead of prompting the user:
 There are various driver-specific options available. For example:
 
   $ sq add actor.csv --ingest.header=false --driver.csv.delim=colon
+  $ sq add --driver=fixedwidth export.txt --driver.fixedwidth.cols=1-10,11-25,26-
 
 If flag --driver is omitted, sq will attempt to determine the
 type from LOCATION via file suffix, content type, etc. If the result
@@ -115,10 +116,18 @@ minimum, the following drivers are bundled:
   oracle     Oracle Database (experimental)
   csv        Comma-Separated Values
   tsv        Tab-Separated Values
+  fixedwidth Fixed-width text
   json       JSON
   jsona      JSON Array: LF-delimited JSON arrays
   jsonl      JSON Lines: LF-delimited JSON objects
+  yaml       YAML
+  toml       TOML
   xlsx       Microsoft Excel XLSX
+  ods        OpenDocument Spreadsheet
+  parquet    Apache Parquet
+  arrow      Apache Arrow IPC / Feather
+  avro       Apache Avro
+  xml        XML
 
 DRIVER NOTES:
 
@@ -197,6 +206,7 @@ More examples:
 	addOptionFlag(cmd.Flags(), csv.OptEmptyAsNull)
 	addOptionFlag(cmd.Flags(), csv.OptDelim)
 	panicOn(cmd.RegisterFlagCompletionFunc(csv.OptDelim.Flag().Name, completeStrings(csv.NamedDelims()...)))
+	addOptionFlag(cmd.Flags(), csv.OptFixedWidthCols)
 
 	return cmd
 }
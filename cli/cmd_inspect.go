@@ -51,7 +51,11 @@ is supplied, the default is to show the source metadata and schema.
 Use --verbose with --text format to see more detail. The --json and --yaml
 formats both show extensive detail. The --markdown and --html formats each
 render a schema document that includes a Mermaid entity-relationship diagram;
---html produces a standalone page (use --output to save it to a file).`,
+--html produces a standalone page (use --output to save it to a file). The
+--format latex and --format rst formats render the same document as LaTeX
+(without the diagram) and reStructuredText. The --format plantuml-erd and
+--format dbml formats emit just the schema, as PlantUML diagram source and
+as DBML (for dbdiagram.io) respectively.`,
 		Example: `  # Inspect active data source.
   $ sq inspect
 
@@ -70,6 +74,12 @@ render a schema document that includes a Mermaid entity-relationship diagram;
   # Show output as a Markdown schema doc with a Mermaid ER diagram.
   $ sq inspect --markdown @pg1
 
+  # Show output as a reStructuredText schema doc, e.g. for Sphinx.
+  $ sq inspect -f rst @pg1
+
+  # Write the schema as DBML, e.g. for dbdiagram.io.
+  $ sq inspect -f dbml @pg1 -o pg1.dbml
+
   # Show output as a standalone HTML schema doc with a Mermaid ER diagram.
   $ sq inspect --html @pg1
 
@@ -112,8 +122,12 @@ render a schema document that includes a Mermaid entity-relationship diagram;
 			format.JSON.String(),
 			format.YAML.String(),
 			format.Markdown.String(),
+			format.LaTeX.String(),
+			format.RST.String(),
 			format.HTML.String(),
 			format.MermaidERD.String(),
+			format.PlantUMLERD.String(),
+			format.DBML.String(),
 			format.SVGERD.String(),
 			format.PNGERD.String(),
 		),
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"image/png"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/neilotoole/sq/libsq/core/lg"
 	"github.com/neilotoole/sq/libsq/core/lg/lgt"
 	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/files"
 	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/libsq/source/drivertype"
 	"github.com/neilotoole/sq/libsq/source/location"
@@ -564,6 +567,45 @@ func TestCmdInspect_mermaidERD(t *testing.T) { //nolint:tparallel
 	})
 }
 
+// TestCmdInspect_plantumlERD_dbml exercises the "plantuml-erd" and "dbml"
+// output formats for whole-source and single-table inspection, and verifies
+// that they error for operations (such as overview) that they don't support.
+func TestCmdInspect_plantumlERD_dbml(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		fm        format.Format
+		wantStart string
+		wantTable string
+	}{
+		{fm: format.PlantUMLERD, wantStart: "@startuml\n", wantTable: "entity data {"},
+		{fm: format.DBML, wantStart: "Table data {\n", wantTable: "Table data {"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fm.String(), func(t *testing.T) {
+			t.Parallel()
+
+			th := testh.New(t)
+			src := th.Source(sakila.CSVActor)
+
+			for _, arg := range []string{src.Handle, src.Handle + "." + source.MonotableName} {
+				tr := testrun.New(th.Context, t, nil).Hush().Add(*src)
+				require.NoError(t, tr.Exec("inspect", arg, "-f", tc.fm.String()))
+				out := tr.Out.String()
+				require.True(t, strings.HasPrefix(out, tc.wantStart), out)
+				require.Contains(t, out, tc.wantTable)
+				require.Contains(t, out, "first_name")
+			}
+
+			tr := testrun.New(th.Context, t, nil).Hush().Add(*src)
+			err := tr.Exec("inspect", src.Handle, "--"+flag.InspectOverview, "-f", tc.fm.String())
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.fm.String())
+		})
+	}
+}
+
 // TestCmdInspect_svgERD exercises the "svg-erd" output format against the
 // sakila SQLite source (no Docker required). It renders the schema ERD to an
 // SVG image — valid SVG markup carrying the table names — for whole-source
@@ -629,6 +671,33 @@ func TestCmdInspect_pngERD(t *testing.T) {
 	require.Positive(t, img.Bounds().Dy())
 }
 
+// TestCmdInspect_compress verifies that inspect output is compressed when
+// the --output file has a compression extension.
+func TestCmdInspect_compress(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Source(sakila.CSVActor)
+	tr := testrun.New(th.Context, t, nil).Hush().Add(*src)
+
+	outPath := filepath.Join(tu.TempDir(t), "actor.json.gz")
+	require.NoError(t, tr.Exec("inspect", "--json", src.Handle+".data", "-o", outPath))
+
+	got, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	require.Equal(t, files.CompressionGzip, files.DetectCompression(got))
+
+	r, err := files.NewDecompressReader(io.NopCloser(bytes.NewReader(got)))
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	var tblMeta map[string]any
+	require.NoError(t, json.Unmarshal(b, &tblMeta))
+	require.Equal(t, "data", tblMeta["name"])
+}
+
 // TestErrBinaryFormatToTerminal pins the guard that refuses to write the
 // binary png-erd format to a terminal: it errors only for png-erd, only when
 // no file target is set, and only when stdout is a terminal. svg-erd (text)
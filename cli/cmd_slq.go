@@ -232,11 +232,12 @@ func execSLQPrint(ctx context.Context, ru *run.Run, mArgs map[string]string) err
 //     is syntax-highlighted via chroma using sq's palette.
 //   - json, jsonl, yaml: structured payload (see [output.SQLPayload]).
 //
-// Any other format (csv, tsv, html, markdown, xml, xlsx, jsona) falls
-// back to the text writer. The fallback is deliberate — those formats
-// don't have a natural representation for a single rendered statement —
-// but a log.Warn is emitted so the substitution is discoverable to
-// anyone running with verbose / debug logging.
+// Any other format (csv, tsv, html, markdown, latex, rst, xml, xlsx,
+// parquet, arrow, avro, sql-insert, template, jsona) falls back to the
+// text writer. The fallback is deliberate — those formats don't have a
+// natural representation for a single rendered statement — but a log.Warn
+// is emitted so the substitution is discoverable to anyone running with
+// verbose / debug logging.
 func execSLQRenderSQL(ctx context.Context, ru *run.Run, mArgs map[string]string) error {
 	qc := run.NewQueryContext(ru, mArgs)
 	// Rendering only reads source metadata; open read-only.
@@ -433,6 +434,18 @@ func addQueryCmdFlags(cmd *cobra.Command) {
 		OptFormatDecimal.Flag().Name,
 		completeStrings("string", "number"),
 	))
+	addOptionFlag(cmd.Flags(), OptSQLInsertDialect)
+	addOptionFlag(cmd.Flags(), OptSQLInsertTable)
+	panicOn(cmd.RegisterFlagCompletionFunc(
+		OptSQLInsertDialect.Flag().Name,
+		completeStrings(stringz.Strings(sqlInsertDialects)...),
+	))
+	addOptionFlag(cmd.Flags(), OptTemplate)
+	addOptionFlag(cmd.Flags(), OptCompress)
+	panicOn(cmd.RegisterFlagCompletionFunc(
+		OptCompress.Flag().Name,
+		completeStrings(compressValues...),
+	))
 	addResultFormatFlags(cmd)
 	cmd.MarkFlagsMutuallyExclusive(append(
 		[]string{OptFormat.Flag().Name},
@@ -466,6 +479,7 @@ func addQueryCmdFlags(cmd *cobra.Command) {
 	addOptionFlag(cmd.Flags(), csv.OptDelim)
 	panicOn(cmd.RegisterFlagCompletionFunc(csv.OptDelim.Key(), completeStrings(csv.NamedDelims()...)))
 	addOptionFlag(cmd.Flags(), csv.OptEmptyAsNull)
+	addOptionFlag(cmd.Flags(), csv.OptFixedWidthCols)
 }
 
 // addResultFormatFlags adds the individual flags that control result
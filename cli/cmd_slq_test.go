@@ -1,11 +1,14 @@
 package cli_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -20,6 +23,7 @@ import (
 	"github.com/neilotoole/sq/cli/testrun"
 	"github.com/neilotoole/sq/libsq/core/stringz"
 	"github.com/neilotoole/sq/libsq/core/tablefq"
+	"github.com/neilotoole/sq/libsq/files"
 	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/testh"
 	"github.com/neilotoole/sq/testh/proj"
@@ -700,8 +704,15 @@ func TestRenderSQLSupportsFormat(t *testing.T) {
 		{format.TSV, false},
 		{format.HTML, false},
 		{format.Markdown, false},
+		{format.LaTeX, false},
+		{format.RST, false},
 		{format.XML, false},
 		{format.XLSX, false},
+		{format.Parquet, false},
+		{format.Arrow, false},
+		{format.Avro, false},
+		{format.SQLInsert, false},
+		{format.Template, false},
 	}
 
 	seen := make(map[format.Format]bool, len(cases))
@@ -969,3 +980,95 @@ func TestCmdSLQ_FormatDecimal(t *testing.T) {
 		require.NotContains(t, out, `"20100"`)
 	})
 }
+
+// TestCmdSLQ_Compress verifies flag --compress, and compression inferred
+// from the --output file extension.
+func TestCmdSLQ_Compress(t *testing.T) {
+	t.Parallel()
+
+	// readActorCSV decompresses b, and returns the CSV records.
+	readActorCSV := func(t *testing.T, b []byte) [][]string {
+		t.Helper()
+		r, err := files.NewDecompressReader(io.NopCloser(bytes.NewReader(b)))
+		require.NoError(t, err)
+		recs, err := csv.NewReader(r).ReadAll()
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		return recs
+	}
+
+	testCases := []struct {
+		name     string
+		args     []string
+		outFile  string
+		wantComp files.Compression
+		wantErr  bool
+	}{
+		{name: "flag_gzip", args: []string{"--compress", "gzip"}, wantComp: files.CompressionGzip},
+		{name: "flag_zstd", args: []string{"--compress=zstd"}, wantComp: files.CompressionZstd},
+		{name: "ext_gz", outFile: "actor.csv.gz", wantComp: files.CompressionGzip},
+		{name: "ext_zst", outFile: "actor.csv.zst", wantComp: files.CompressionZstd},
+		{name: "flag_overrides_ext", args: []string{"--compress", "none"}, outFile: "actor.csv.gz"},
+		{name: "ext_unsupported", outFile: "actor.csv.xz", wantErr: true},
+		{name: "flag_overrides_unsupported_ext", args: []string{"--compress", "none"}, outFile: "actor.csv.xz"},
+		{name: "flag_invalid", args: []string{"--compress", "lz4"}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			th := testh.New(t)
+			src := th.Source(sakila.CSVActor)
+			tr := testrun.New(th.Context, t, nil).Add(*src)
+
+			args := append([]string{"slq", "--header=false", "--csv", src.Handle + ".data"}, tc.args...)
+			var outPath string
+			if tc.outFile != "" {
+				outPath = filepath.Join(tu.TempDir(t), tc.outFile)
+				args = append(args, "--output", outPath)
+			}
+
+			err := tr.Exec(args...)
+			if tc.wantErr {
+				require.Error(t, err)
+				if outPath != "" {
+					require.NoFileExists(t, outPath)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			got := tr.Out.Bytes()
+			if outPath != "" {
+				got, err = os.ReadFile(outPath)
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.wantComp, files.DetectCompression(got))
+			require.Len(t, readActorCSV(t, got), sakila.TblActorCount)
+		})
+	}
+}
+
+// TestCmdSLQ_Template verifies that --format template renders records via
+// the --template file.
+func TestCmdSLQ_Template(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Source(sakila.CSVActor)
+	tplFile := filepath.Join(tu.TempDir(t), "actor.tmpl")
+	const tpl = `{{- define "footer"}}count: {{.Count}}{{end -}}
+{{- if lt .Index 2}}{{.Record.first_name | lower | title}} {{.Record.last_name}}
+{{end -}}`
+	require.NoError(t, os.WriteFile(tplFile, []byte(tpl), 0o600))
+
+	tr := testrun.New(th.Context, t, nil).Add(*src)
+	require.NoError(t, tr.Exec("slq", "--format", "template", "--template", tplFile, src.Handle+".data"))
+	require.Equal(t, "Penelope GUINESS\nNick WAHLBERG\ncount: 200", tr.Out.String())
+
+	// The template file is required.
+	tr = testrun.New(th.Context, t, nil).Add(*src)
+	require.Error(t, tr.Exec("slq", "--format", "template", src.Handle+".data"))
+}
@@ -610,6 +610,11 @@ func TestCmdSQL_SelectFromUserDriver(t *testing.T) {
 			{tblName: "channel", wantRows: 1, wantCols: 7},
 			{tblName: "item", wantRows: 45, wantCols: 9},
 		},
+		testsrc.OrdersUD: {
+			{tblName: "orders", wantRows: 3, wantCols: 7},
+			{tblName: "order_line", wantRows: 3, wantCols: 5},
+			{tblName: "line_tag", wantRows: 3, wantCols: 3},
+		},
 	}
 
 	for handle, wantTbls := range testCases {
@@ -622,8 +627,9 @@ func TestCmdSQL_SelectFromUserDriver(t *testing.T) {
 				src := th.Source(handle)
 
 				tr := testrun.New(th.Context, t, nil).Add(*src)
-				udDefs := testh.DriverDefsFrom(t, testsrc.PathDriverDefPpl, testsrc.PathDriverDefRSS)
-				require.Len(t, udDefs, 2)
+				udDefs := testh.DriverDefsFrom(t, testsrc.PathDriverDefPpl, testsrc.PathDriverDefRSS,
+					testsrc.PathDriverDefOrders)
+				require.Len(t, udDefs, 3)
 				for _, udDef := range udDefs {
 					require.Empty(t, userdriver.ValidateDriverDef(udDef))
 				}
@@ -0,0 +1,208 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/neilotoole/sq/cli/flag"
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// compressNone is the OptCompress value for uncompressed output.
+const compressNone = "none"
+
+// compressValues are the allowed values of OptCompress.
+var compressValues = []string{
+	compressNone,
+	string(files.CompressionGzip),
+	string(files.CompressionZstd),
+}
+
+// getOutputCompression returns the compression of record output. If
+// OptCompress is set (via flag or config), its value is returned. Otherwise,
+// if the --output flag is set, the compression is inferred from the output
+// file's extension, e.g. "actor.csv.gz". Only the compression formats that
+// files.NewCompressWriter supports are inferred: see checkOutputCompression.
+func getOutputCompression(cmd *cobra.Command, o options.Options) files.Compression {
+	if o != nil && o.IsSet(OptCompress) {
+		if c := OptCompress.Get(o); c != compressNone {
+			return files.Compression(c)
+		}
+		return files.CompressionNone
+	}
+
+	if c, ok := outputFileCompression(cmd); ok && slices.Contains(compressValues, string(c)) {
+		return c
+	}
+
+	return files.CompressionNone
+}
+
+// checkOutputCompression returns an error if OptCompress isn't set, and the
+// --output file's extension indicates a compression format that sq can
+// read but not write, e.g. "actor.csv.xz". It's invoked before the output
+// file is created.
+func checkOutputCompression(cmd *cobra.Command, o options.Options) error {
+	if o != nil && o.IsSet(OptCompress) {
+		return nil
+	}
+
+	c, ok := outputFileCompression(cmd)
+	if !ok || slices.Contains(compressValues, string(c)) {
+		return nil
+	}
+
+	return errz.Errorf("--%s: %s compression isn't supported for output: use gzip (.gz) or zstd (.zst)",
+		flag.FileOutput, c)
+}
+
+// outputFileCompression returns the compression format indicated by the
+// extension of the --output file, if the flag is set.
+func outputFileCompression(cmd *cobra.Command) (files.Compression, bool) {
+	if !cmdFlagChanged(cmd, flag.FileOutput) {
+		return files.CompressionNone, false
+	}
+
+	fpath, _ := cmd.Flags().GetString(flag.FileOutput)
+	return files.CompressionFromExt(filepath.Ext(fpath))
+}
+
+var _ output.RecordWriter = (*compressRecordWriter)(nil)
+
+// compressRecordWriter is an output.RecordWriter that compresses the
+// output of the record writer returned by newFn. The compressed stream is
+// completed when the writer is closed.
+type compressRecordWriter struct {
+	out   io.Writer
+	pr    *output.Printing
+	c     files.Compression
+	newFn output.NewRecordWriterFunc
+
+	cw io.WriteCloser
+	rw output.RecordWriter
+}
+
+// newCompressRecordWriter returns an output.RecordWriter that compresses
+// the output of the record writer returned by newFn, using compression c.
+func newCompressRecordWriter(out io.Writer, pr *output.Printing, c files.Compression,
+	newFn output.NewRecordWriterFunc,
+) *compressRecordWriter {
+	return &compressRecordWriter{out: out, pr: pr, c: c, newFn: newFn}
+}
+
+// Open implements output.RecordWriter.
+func (w *compressRecordWriter) Open(ctx context.Context, recMeta record.Meta) error {
+	var err error
+	if w.cw, err = files.NewCompressWriter(w.out, w.c); err != nil {
+		return err
+	}
+
+	w.rw = w.newFn(w.cw, w.pr)
+	return w.rw.Open(ctx, recMeta)
+}
+
+// WriteRecords implements output.RecordWriter.
+func (w *compressRecordWriter) WriteRecords(ctx context.Context, recs []record.Record) error {
+	return w.rw.WriteRecords(ctx, recs)
+}
+
+// Flush implements output.RecordWriter. It flushes the underlying record
+// writer, but not the compressor: flushing the compressor each time would
+// degrade the compression ratio.
+func (w *compressRecordWriter) Flush(ctx context.Context) error {
+	return w.rw.Flush(ctx)
+}
+
+// Close implements output.RecordWriter. It closes the underlying record
+// writer, and then the compressor, which writes the end of the compressed
+// stream.
+func (w *compressRecordWriter) Close(ctx context.Context) error {
+	if w.rw == nil {
+		return nil
+	}
+
+	return errz.Combine(w.rw.Close(ctx), w.cw.Close())
+}
+
+// compressWriter is an io.Writer that compresses to out, using compression
+// c. The compressor is created on the first write, and Close completes the
+// compressed stream. A write after Close starts a new compressed stream:
+// a concatenation of gzip or zstd streams is itself valid.
+type compressWriter struct {
+	out io.Writer
+	c   files.Compression
+	cw  io.WriteCloser
+}
+
+// Write implements io.Writer.
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if w.cw == nil {
+		var err error
+		if w.cw, err = files.NewCompressWriter(w.out, w.c); err != nil {
+			return 0, err
+		}
+	}
+
+	return w.cw.Write(p)
+}
+
+// Close completes the compressed stream, if any. It doesn't close out.
+func (w *compressWriter) Close() error {
+	if w.cw == nil {
+		return nil
+	}
+
+	err := w.cw.Close()
+	w.cw = nil
+	return err
+}
+
+var _ output.MetadataWriter = (*compressMetadataWriter)(nil)
+
+// compressMetadataWriter is an output.MetadataWriter that compresses the
+// output of w, which writes to cw. Each method writes a complete document,
+// so the compressed stream is completed after each.
+type compressMetadataWriter struct {
+	w  output.MetadataWriter
+	cw *compressWriter
+}
+
+// TableMetadata implements output.MetadataWriter.
+func (w *compressMetadataWriter) TableMetadata(tblMeta *metadata.Table) error {
+	return errz.Combine(w.w.TableMetadata(tblMeta), w.cw.Close())
+}
+
+// SourceMetadata implements output.MetadataWriter.
+func (w *compressMetadataWriter) SourceMetadata(srcMeta *metadata.Source, showSchema bool) error {
+	return errz.Combine(w.w.SourceMetadata(srcMeta, showSchema), w.cw.Close())
+}
+
+// DBProperties implements output.MetadataWriter.
+func (w *compressMetadataWriter) DBProperties(props map[string]any) error {
+	return errz.Combine(w.w.DBProperties(props), w.cw.Close())
+}
+
+// DriverMetadata implements output.MetadataWriter.
+func (w *compressMetadataWriter) DriverMetadata(drvrs []driver.Metadata) error {
+	return errz.Combine(w.w.DriverMetadata(drvrs), w.cw.Close())
+}
+
+// Catalogs implements output.MetadataWriter.
+func (w *compressMetadataWriter) Catalogs(currentCatalog string, catalogs []string) error {
+	return errz.Combine(w.w.Catalogs(currentCatalog, catalogs), w.cw.Close())
+}
+
+// Schemata implements output.MetadataWriter.
+func (w *compressMetadataWriter) Schemata(currentSchema string, schemas []*metadata.Schema) error {
+	return errz.Combine(w.w.Schemata(currentSchema, schemas), w.cw.Close())
+}
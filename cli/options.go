@@ -185,6 +185,10 @@ func RegisterDefaultOpts(reg *options.Registry) {
 	reg.Add(
 		OptFormat,
 		OptFormatDecimal,
+		OptSQLInsertDialect,
+		OptSQLInsertTable,
+		OptTemplate,
+		OptCompress,
 		OptErrorFormat,
 		OptErrorStack,
 		OptErrorFormatTextVerbose,
@@ -244,6 +248,7 @@ func RegisterDefaultOpts(reg *options.Registry) {
 		driver.OptIngestSampleSize,
 		csv.OptDelim,
 		csv.OptEmptyAsNull,
+		csv.OptFixedWidthCols,
 		OptDebugTrackMemory,
 		pprofile.OptMode,
 		debugz.OptProgressDebugSleep,
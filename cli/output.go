@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,16 +16,23 @@ import (
 
 	"github.com/neilotoole/sq/cli/flag"
 	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/arroww"
+	"github.com/neilotoole/sq/cli/output/avrow"
 	"github.com/neilotoole/sq/cli/output/csvw"
+	"github.com/neilotoole/sq/cli/output/dbmlw"
 	"github.com/neilotoole/sq/cli/output/erdimgw"
 	"github.com/neilotoole/sq/cli/output/format"
 	"github.com/neilotoole/sq/cli/output/htmlw"
 	"github.com/neilotoole/sq/cli/output/jsonw"
 	"github.com/neilotoole/sq/cli/output/markdownw"
 	"github.com/neilotoole/sq/cli/output/mermaidw"
+	"github.com/neilotoole/sq/cli/output/parquetw"
+	"github.com/neilotoole/sq/cli/output/plantumlw"
 	"github.com/neilotoole/sq/cli/output/raww"
+	"github.com/neilotoole/sq/cli/output/sqlinsertw"
 	"github.com/neilotoole/sq/cli/output/sqlw"
 	"github.com/neilotoole/sq/cli/output/tablew"
+	"github.com/neilotoole/sq/cli/output/templatew"
 	"github.com/neilotoole/sq/cli/output/xlsxw"
 	"github.com/neilotoole/sq/cli/output/xmlw"
 	"github.com/neilotoole/sq/cli/output/yamlw"
@@ -34,13 +42,18 @@ import (
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/ioz/scannerz"
 	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
 	"github.com/neilotoole/sq/libsq/core/options"
 	"github.com/neilotoole/sq/libsq/core/progress"
 	"github.com/neilotoole/sq/libsq/core/secret"
+	"github.com/neilotoole/sq/libsq/core/stringz"
 	"github.com/neilotoole/sq/libsq/core/termz"
 	"github.com/neilotoole/sq/libsq/core/timez"
 	"github.com/neilotoole/sq/libsq/core/tuning"
+	"github.com/neilotoole/sq/libsq/driver"
 	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
 )
 
 var (
@@ -66,7 +79,8 @@ command, sq falls back to "text". Available formats:
 
   text, csv, tsv, xlsx,
   json, jsona, jsonl,
-  markdown, html, xlsx, xml, yaml, raw`,
+  markdown, latex, rst, html, xml, yaml, raw, template,
+  sql-insert, parquet, arrow, avro`,
 	)
 
 	// OptFormatDecimal controls how decimal values render in output formats that
@@ -101,6 +115,103 @@ formats such as CSV are no-ops.
 		options.TagOutput,
 	)
 
+	// OptSQLInsertDialect specifies the SQL dialect of the sql-insert format,
+	// as the type of a SQL driver, e.g. "postgres".
+	OptSQLInsertDialect = options.NewString(
+		"format.sql-insert.dialect",
+		&options.Flag{Name: "sql-dialect"},
+		drivertype.SQLite.String(),
+		func(s string) error {
+			if slices.Contains(sqlInsertDialects, drivertype.Type(s)) {
+				return nil
+			}
+			return errz.Errorf("option {format.sql-insert.dialect} must be one of: %s",
+				strings.Join(stringz.Strings(sqlInsertDialects), ", "))
+		},
+		"SQL dialect of sql-insert output",
+		`The SQL dialect of the CREATE TABLE and INSERT statements output by the
+"sql-insert" format, specified as the type of a SQL driver. Allowed values:
+
+  sqlite3, postgres, mysql, sqlserver, oracle, duckdb, clickhouse, rqlite
+
+For example:
+
+  $ sq '.actor' --format sql-insert --sql-dialect postgres > actor.sql`,
+		options.TagOutput,
+	)
+
+	// OptSQLInsertTable specifies the table name of the sql-insert format.
+	OptSQLInsertTable = options.NewString(
+		"format.sql-insert.table",
+		&options.Flag{Name: "sql-table"},
+		source.MonotableName,
+		func(s string) error {
+			if s == "" {
+				return errz.New("option {format.sql-insert.table} must not be empty")
+			}
+			return nil
+		},
+		"Table name of sql-insert output",
+		`The name of the table created and inserted into by the statements output
+by the "sql-insert" format.`,
+		options.TagOutput,
+	)
+
+	// OptTemplate specifies the path of the Go text/template file used by
+	// the template format.
+	OptTemplate = options.NewString(
+		"format.template",
+		&options.Flag{Name: "template"},
+		"",
+		nil,
+		"Template file for template output",
+		`Path of the Go text/template file used by the "template" format to render
+query output. The template is executed for each record. Its data has fields
+.Record (values keyed by column name), .Values (values in column order),
+.Columns (column metadata: .Name, .Kind, .DBType, .Index), and .Index (the
+record's index). The sprig functions are available. For example:
+
+  $ cat actor.tmpl
+  {{.Index}}: {{.Record.first_name | lower | title}} {{.Record.last_name}}
+  $ sq '.actor' --format template --template actor.tmpl
+
+The template can also define templates named "header", "footer", and "batch",
+executed before the first record, after the last record, and for each batch
+of records as it's received (batch size varies). Their data has fields .Columns,
+.Count (records written so far), and, for "batch", .Rows. If the template
+defines "row", it's executed for each record in place of the template itself.`,
+		options.TagOutput,
+	)
+
+	// OptCompress specifies the compression of record output. If not set,
+	// the compression is inferred from the extension of the --output file.
+	OptCompress = options.NewString(
+		"format.compress",
+		&options.Flag{Name: "compress"},
+		compressNone,
+		func(s string) error {
+			if slices.Contains(compressValues, s) {
+				return nil
+			}
+			return errz.Errorf("option {format.compress} must be one of: %s",
+				strings.Join(compressValues, ", "))
+		},
+		"Compression of query output: none, gzip, zstd",
+		`Compression of query output. Allowed values:
+
+  none, gzip, zstd
+
+The output of any format is compressed as it's written, as is the output of
+"sq inspect". If not set, and the --output file has a compression extension
+(.gz or .gzip for gzip; .zst or .zstd for zstd), the compression is inferred
+from the extension. The bzip2 and xz formats can be read but not written: an
+--output file with a .bz2 or .xz extension is an error. For example:
+
+  $ sq '.actor' --csv -o actor.csv.gz
+  $ sq '.actor' --json --compress zstd > actor.json.zst`,
+		options.TagOutput,
+	)
+
 	OptErrorFormat = format.NewOpt(
 		"error.format",
 		nil,
@@ -347,6 +458,16 @@ func newWriters(ru *run.Run, o options.Options) (w *output.Writers, outCfg *outp
 	outCfg = getOutputConfig(cmd, fs, clnup, fm, o, stdout, stderr)
 	log := lg.From(cmd)
 
+	// The metadata writers write to mdOut, which compresses their output
+	// if output compression is in effect.
+	comp := getOutputCompression(cmd, o)
+	var mdOut io.Writer = outCfg.out
+	var mdCompress *compressWriter
+	if comp != files.CompressionNone {
+		mdCompress = &compressWriter{out: outCfg.out, c: comp}
+		mdOut = mdCompress
+	}
+
 	// Package tablew has writer impls for each of the writer interfaces,
 	// so we use its Writers as the baseline. Later we check the format
 	// flags and set the various writer fields depending upon which
@@ -357,7 +478,7 @@ func newWriters(ru *run.Run, o options.Options) (w *output.Writers, outCfg *outp
 		Record:       tablew.NewRecordWriter(outCfg.out, outCfg.outPr),
 		RecordInsert: tablew.NewRecordInsertWriter(outCfg.out, outCfg.outPr),
 		StmtExec:     tablew.NewStmtExecWriter(outCfg.out, outCfg.outPr),
-		Metadata:     tablew.NewMetadataWriter(outCfg.out, outCfg.outPr),
+		Metadata:     tablew.NewMetadataWriter(mdOut, outCfg.outPr),
 		Source:       tablew.NewSourceWriter(outCfg.out, outCfg.outPr),
 		Ping:         tablew.NewPingWriter(outCfg.out, outCfg.outPr),
 		Error: tablew.NewErrorWriter(outCfg.errOut, outCfg.errOutPr,
@@ -378,7 +499,7 @@ func newWriters(ru *run.Run, o options.Options) (w *output.Writers, outCfg *outp
 	case format.JSON:
 		w.RecordInsert = jsonw.NewRecordInsertWriter(outCfg.out, outCfg.outPr)
 		w.StmtExec = jsonw.NewStmtExecWriter(outCfg.out, outCfg.outPr)
-		w.Metadata = jsonw.NewMetadataWriter(outCfg.out, outCfg.outPr)
+		w.Metadata = jsonw.NewMetadataWriter(mdOut, outCfg.outPr)
 		w.Source = jsonw.NewSourceWriter(outCfg.out, outCfg.outPr)
 		w.Version = jsonw.NewVersionWriter(outCfg.out, outCfg.outPr)
 		w.Ping = jsonw.NewPingWriter(outCfg.out, outCfg.outPr)
@@ -401,29 +522,54 @@ func newWriters(ru *run.Run, o options.Options) (w *output.Writers, outCfg *outp
 
 	case format.YAML:
 		w.Config = yamlw.NewConfigWriter(outCfg.out, outCfg.outPr)
-		w.Metadata = yamlw.NewMetadataWriter(outCfg.out, outCfg.outPr)
+		w.Metadata = yamlw.NewMetadataWriter(mdOut, outCfg.outPr)
 		w.Source = yamlw.NewSourceWriter(outCfg.out, outCfg.outPr)
 		w.Version = yamlw.NewVersionWriter(outCfg.out, outCfg.outPr)
 		w.SQL = sqlw.NewYAMLWriter(outCfg.out, outCfg.outPr)
 
 	case format.Markdown:
-		w.Metadata = markdownw.NewMetadataWriter(outCfg.out, outCfg.outPr)
+		w.Metadata = markdownw.NewMetadataWriter(mdOut, outCfg.outPr)
+
+	case format.LaTeX:
+		w.Metadata = markdownw.NewLaTeXMetadataWriter(mdOut, outCfg.outPr)
+
+	case format.RST:
+		w.Metadata = markdownw.NewRSTMetadataWriter(mdOut, outCfg.outPr)
 
 	case format.HTML:
-		w.Metadata = htmlw.NewMetadataWriter(outCfg.out, outCfg.outPr, OptHTMLEmbedAssets.Get(o))
+		w.Metadata = htmlw.NewMetadataWriter(mdOut, outCfg.outPr, OptHTMLEmbedAssets.Get(o))
 
 	case format.MermaidERD:
-		w.Metadata = mermaidw.NewMetadataWriter(outCfg.out, outCfg.outPr)
+		w.Metadata = mermaidw.NewMetadataWriter(mdOut, outCfg.outPr)
+
+	case format.PlantUMLERD:
+		w.Metadata = plantumlw.NewMetadataWriter(mdOut, outCfg.outPr)
+
+	case format.DBML:
+		w.Metadata = dbmlw.NewMetadataWriter(mdOut, outCfg.outPr)
 
 	case format.PNGERD:
-		w.Metadata = erdimgw.NewPNGMetadataWriter(outCfg.out, outCfg.outPr)
+		w.Metadata = erdimgw.NewPNGMetadataWriter(mdOut, outCfg.outPr)
 
 	case format.SVGERD:
-		w.Metadata = erdimgw.NewSVGMetadataWriter(outCfg.out, outCfg.outPr)
+		w.Metadata = erdimgw.NewSVGMetadataWriter(mdOut, outCfg.outPr)
 	default:
 	}
 
 	recwFn := getRecordWriterFunc(fm)
+	if fm == format.SQLInsert {
+		// The sql-insert record writer requires the driver of the target
+		// dialect, so it's not returned by getRecordWriterFunc.
+		var err error
+		if recwFn, err = getSQLInsertRecordWriterFunc(ru.DriverRegistry, o); err != nil {
+			log.Warn("No driver for sql-insert dialect", lga.Err, err)
+		}
+	}
+	if fm == format.Template {
+		// The template record writer requires the template file.
+		recwFn = getTemplateRecordWriterFunc(o)
+	}
+
 	if recwFn == nil {
 		// We can still continue, because w.Record was already set above.
 		log.Warn("No record writer impl for format", "format", fm)
@@ -431,6 +577,14 @@ func newWriters(ru *run.Run, o options.Options) (w *output.Writers, outCfg *outp
 		w.Record = recwFn(outCfg.out, outCfg.outPr)
 	}
 
+	if comp != files.CompressionNone {
+		if recwFn == nil {
+			recwFn = tablew.NewRecordWriter
+		}
+		w.Record = newCompressRecordWriter(outCfg.out, outCfg.outPr, comp, recwFn)
+		w.Metadata = &compressMetadataWriter{w: w.Metadata, cw: mdCompress}
+	}
+
 	if cmd != nil {
 		// Decorate the writers that print source locations so that the
 		// --expand flag is honored centrally, in the writer layer, much
@@ -469,24 +623,80 @@ func getRecordWriterFunc(f format.Format) output.NewRecordWriterFunc {
 		return htmlw.NewRecordWriter
 	case format.Markdown:
 		return markdownw.NewRecordWriter
+	case format.LaTeX:
+		return markdownw.NewLaTeXRecordWriter
+	case format.RST:
+		return markdownw.NewRSTRecordWriter
 	case format.XML:
 		return xmlw.NewRecordWriter
 	case format.XLSX:
 		return xlsxw.NewRecordWriter
+	case format.Parquet:
+		return parquetw.NewRecordWriter
+	case format.Arrow:
+		return arroww.NewRecordWriter
+	case format.Avro:
+		return avrow.NewRecordWriter
 	case format.YAML:
 		return yamlw.NewRecordWriter
 	case format.Raw:
 		return raww.NewRecordWriter
-	case format.MermaidERD, format.PNGERD, format.SVGERD:
-		// mermaid-erd, png-erd, and svg-erd are metadata-only (sq inspect)
-		// ERD formats; they have no record writer, so callers fall back to
-		// text for record output.
+	case format.SQLInsert:
+		// The sql-insert writer is created by getSQLInsertRecordWriterFunc.
+		return nil
+	case format.Template:
+		// The template writer is created by getTemplateRecordWriterFunc.
+		return nil
+	case format.MermaidERD, format.PlantUMLERD, format.DBML, format.PNGERD, format.SVGERD:
+		// mermaid-erd, plantuml-erd, dbml, png-erd, and svg-erd are
+		// metadata-only (sq inspect) ERD formats; they have no record writer,
+		// so callers fall back to text for record output.
 		return nil
 	default:
 		return nil
 	}
 }
 
+// sqlInsertDialects are the allowed values of OptSQLInsertDialect.
+var sqlInsertDialects = []drivertype.Type{
+	drivertype.SQLite,
+	drivertype.Pg,
+	drivertype.MySQL,
+	drivertype.MSSQL,
+	drivertype.Oracle,
+	drivertype.DuckDB,
+	drivertype.ClickHouse,
+	drivertype.Rqlite,
+}
+
+// getSQLInsertRecordWriterFunc returns a func that creates a new sql-insert
+// output.RecordWriter, for the dialect and table specified by
+// OptSQLInsertDialect and OptSQLInsertTable.
+func getSQLInsertRecordWriterFunc(reg *driver.Registry, o options.Options) (output.NewRecordWriterFunc, error) {
+	if reg == nil {
+		return nil, errz.New("driver registry not initialized")
+	}
+
+	drvr, err := reg.SQLDriverFor(drivertype.Type(OptSQLInsertDialect.Get(o)))
+	if err != nil {
+		return nil, err
+	}
+
+	tbl := OptSQLInsertTable.Get(o)
+	return func(out io.Writer, pr *output.Printing) output.RecordWriter {
+		return sqlinsertw.NewRecordWriter(out, pr, drvr, tbl)
+	}, nil
+}
+
+// getTemplateRecordWriterFunc returns a func that creates a new template
+// output.RecordWriter, for the template file specified by OptTemplate.
+func getTemplateRecordWriterFunc(o options.Options) output.NewRecordWriterFunc {
+	tplFile := OptTemplate.Get(o)
+	return func(out io.Writer, pr *output.Printing) output.RecordWriter {
+		return templatew.NewRecordWriter(out, pr, tplFile)
+	}
+}
+
 // outputConfig is a container for the various output writers.
 type outputConfig struct {
 	// outPr is the printing config for out.
@@ -654,11 +864,14 @@ func getOutputConfig(cmd *cobra.Command, fs *files.Files, clnup *cleanup.Cleanup
 
 	switch {
 	case cmdFlagChanged(cmd, flag.FileOutput) || fm == format.Raw || fm == format.XLSX ||
-		fm == format.PNGERD || fm == format.SVGERD:
-		// For file, raw, XLSX, or ERD-image output, we don't decorate stdout
-		// with any colorable decorator. XLSX and png-erd are binary and must
-		// not be modified; svg-erd is plain image markup that wants no ANSI
-		// color processing either.
+		fm == format.Parquet || fm == format.Arrow || fm == format.Avro ||
+		fm == format.PNGERD || fm == format.SVGERD ||
+		getOutputCompression(cmd, o) != files.CompressionNone:
+		// For file, raw, XLSX, Parquet, Arrow, Avro, ERD-image, or compressed
+		// output, we don't decorate stdout with any colorable decorator. XLSX,
+		// Parquet, Arrow, Avro, png-erd and compressed output are binary and
+		// must not be modified; svg-erd is plain image markup that wants no
+		// ANSI color processing either.
 		outCfg.out = stdout
 		outCfg.outPr.EnableColor(false)
 	case termz.IsColorTerminal(stdout) && !monochrome:
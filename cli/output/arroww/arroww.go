@@ -0,0 +1,120 @@
+// Package arroww implements output writers for the Apache Arrow IPC
+// streaming format. It uses the https://github.com/apache/arrow-go
+// library: records are converted to Arrow record batches via
+// package arrowz.
+// See: https://arrow.apache.org/docs/format/Columnar.html#ipc-streaming-format
+package arroww
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/record"
+)
+
+const (
+	// BatchSize is the number of rows in each record batch. When the
+	// buffered rows reach this number, a record batch is written to the
+	// output, so that the writer doesn't hold the entire result in memory.
+	BatchSize = 64 * 1024
+)
+
+type recordWriter struct {
+	out  io.Writer
+	pr   *output.Printing
+	iw   *ipc.Writer
+	bldr *arrowz.Builder
+	mu   sync.Mutex
+}
+
+var _ output.NewRecordWriterFunc = NewRecordWriter
+
+// NewRecordWriter returns an output.RecordWriter instance for the
+// Arrow IPC streaming format.
+func NewRecordWriter(out io.Writer, pr *output.Printing) output.RecordWriter {
+	return &recordWriter{out: out, pr: pr}
+}
+
+// Open implements output.RecordWriter. The Arrow schema is that
+// returned by arrowz.NewSchema: each field is nullable.
+func (w *recordWriter) Open(_ context.Context, recMeta record.Meta) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.bldr = arrowz.NewBuilder(recMeta)
+	w.iw = ipc.NewWriter(w.out, ipc.WithSchema(w.bldr.Schema()))
+	return nil
+}
+
+// WriteRecords implements output.RecordWriter.
+func (w *recordWriter) WriteRecords(ctx context.Context, recs []record.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, rec := range recs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := w.bldr.Append(rec); err != nil {
+			return errw(err)
+		}
+
+		if w.bldr.Len() >= BatchSize {
+			if err := w.writeBatch(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeBatch writes the buffered rows as a record batch.
+func (w *recordWriter) writeBatch() error {
+	if w.bldr.Len() == 0 {
+		return nil
+	}
+
+	batch := w.bldr.NewRecordBatch()
+	defer batch.Release()
+	return errw(w.iw.Write(batch))
+}
+
+// Flush implements output.RecordWriter. It's a no-op: the writer
+// writes a record batch when enough rows have accumulated.
+func (w *recordWriter) Flush(context.Context) error {
+	return nil
+}
+
+// Close implements output.RecordWriter. It writes any remaining rows,
+// and the end-of-stream marker. Subsequent invocations are no-ops.
+func (w *recordWriter) Close(context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.iw == nil {
+		return nil
+	}
+	defer func() {
+		w.bldr.Release()
+		w.iw, w.bldr = nil, nil
+	}()
+
+	if err := w.writeBatch(); err != nil {
+		return err
+	}
+	return errw(w.iw.Close())
+}
+
+func errw(err error) error {
+	return errz.Wrap(err, "arrow")
+}
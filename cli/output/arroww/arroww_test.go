@@ -0,0 +1,121 @@
+package arroww_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/arroww"
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/testh"
+)
+
+func TestRecordWriter(t *testing.T) {
+	ctx := context.Background()
+	colNames := []string{
+		"col_int", "col_float", "col_bool", "col_bytes", "col_decimal",
+		"col_datetime", "col_date", "col_time", "col_text",
+	}
+	colKinds := []kind.Kind{
+		kind.Int, kind.Float, kind.Bool, kind.Bytes, kind.Decimal,
+		kind.Datetime, kind.Date, kind.Time, kind.Text,
+	}
+	recMeta := testh.NewRecordMeta(colNames, colKinds)
+
+	dt := time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC)
+	recs := []record.Record{
+		{
+			int64(7), float64(1.5), true, []byte("hello"), decimal.RequireFromString("12.345"),
+			dt, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), "05:06:07", "huzzah",
+		},
+		make(record.Record, len(colNames)), // All null.
+	}
+
+	buf := &bytes.Buffer{}
+	w := arroww.NewRecordWriter(buf, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.NoError(t, w.WriteRecords(ctx, recs))
+	require.NoError(t, w.Flush(ctx))
+	require.NoError(t, w.Close(ctx))
+
+	r, err := ipc.NewReader(buf)
+	require.NoError(t, err)
+	defer r.Release()
+
+	wantTypes := []string{
+		"int64", "float64", "bool", "binary", "decimal(38, 6)",
+		"timestamp[us, tz=UTC]", "date32", "time64[us]", "utf8",
+	}
+	schema := r.Schema()
+	require.Equal(t, len(colNames), schema.NumFields())
+	for i, f := range schema.Fields() {
+		require.Equal(t, colNames[i], f.Name)
+		require.Equal(t, wantTypes[i], f.Type.String(), f.Name)
+		require.True(t, f.Nullable)
+	}
+
+	batch, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, int64(len(recs)), batch.NumRows())
+
+	want := []any{
+		int64(7), 1.5, true, []byte("hello"), decimal.RequireFromString("12.345"),
+		dt, "2021-03-04", "05:06:07", "huzzah",
+	}
+	for i := range colNames {
+		got, err := arrowz.Value(batch.Column(i), 0)
+		require.NoError(t, err)
+		if wantDec, ok := want[i].(decimal.Decimal); ok {
+			require.True(t, wantDec.Equal(got.(decimal.Decimal)), got)
+		} else {
+			require.Equal(t, want[i], got, colNames[i])
+		}
+
+		require.True(t, batch.Column(i).IsNull(1), colNames[i])
+	}
+
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestRecordWriter_batches(t *testing.T) {
+	ctx := context.Background()
+	recMeta := testh.NewRecordMeta([]string{"a"}, []kind.Kind{kind.Int})
+
+	const total = arroww.BatchSize + 10
+	recs := make([]record.Record, total)
+	for i := range recs {
+		recs[i] = record.Record{int64(i)}
+	}
+
+	buf := &bytes.Buffer{}
+	w := arroww.NewRecordWriter(buf, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.NoError(t, w.WriteRecords(ctx, recs))
+	require.NoError(t, w.Close(ctx))
+
+	r, err := ipc.NewReader(buf)
+	require.NoError(t, err)
+	defer r.Release()
+
+	batch, err := r.Read()
+	require.NoError(t, err)
+	require.Equal(t, int64(arroww.BatchSize), batch.NumRows())
+	batch, err = r.Read()
+	require.NoError(t, err)
+	require.Equal(t, int64(10), batch.NumRows())
+	got, err := arrowz.Value(batch.Column(0), 9)
+	require.NoError(t, err)
+	require.Equal(t, int64(total-1), got)
+	_, err = r.Read()
+	require.Equal(t, io.EOF, err)
+}
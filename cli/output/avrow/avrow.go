@@ -0,0 +1,379 @@
+// Package avrow implements output writers for the Apache Avro object
+// container file format. It uses https://github.com/linkedin/goavro.
+// See: https://avro.apache.org/docs/1.11.1/specification/#object-container-files
+package avrow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/shopspring/decimal"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+)
+
+const (
+	// RecordName is the name of the Avro record type of the output rows.
+	RecordName = "Row"
+
+	// BlockRows is the number of rows at which a data block is written.
+	BlockRows = 1000
+)
+
+type recordWriter struct {
+	out     io.Writer
+	pr      *output.Printing
+	ocfw    *goavro.OCFWriter
+	recMeta record.Meta
+
+	// cols holds the Avro column of each field of recMeta.
+	cols []column
+
+	// rows holds the rows of the pending data block.
+	rows []any
+	mu   sync.Mutex
+}
+
+// column is the Avro representation of a column.
+type column struct {
+	// name is the Avro field name.
+	name string
+
+	// branch is the name of the field's non-null union branch, as
+	// expected by goavro.Union.
+	branch string
+
+	// schema is the JSON schema of the non-null branch.
+	schema any
+
+	// precision and scale apply to a decimal column.
+	precision, scale int32
+}
+
+var _ output.NewRecordWriterFunc = NewRecordWriter
+
+// NewRecordWriter returns an output.RecordWriter instance for the
+// Avro object container file format. The data blocks are compressed
+// with the deflate codec.
+func NewRecordWriter(out io.Writer, pr *output.Printing) output.RecordWriter {
+	return &recordWriter{out: out, pr: pr}
+}
+
+// Open implements output.RecordWriter. It writes the file header.
+func (w *recordWriter) Open(_ context.Context, recMeta record.Meta) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.recMeta = recMeta
+	w.cols = buildColumns(recMeta)
+
+	schema, err := buildSchema(w.cols)
+	if err != nil {
+		return err
+	}
+
+	w.ocfw, err = goavro.NewOCFWriter(goavro.OCFConfig{
+		W:               w.out,
+		Schema:          schema,
+		CompressionName: goavro.CompressionDeflateLabel,
+	})
+	return errz.Wrap(err, "avro: create writer")
+}
+
+// buildColumns returns the Avro column for each field of recMeta. Because
+// Avro names are restricted to [A-Za-z_][A-Za-z0-9_]*, other characters in
+// a column name are replaced with underscore.
+func buildColumns(recMeta record.Meta) []column {
+	cols := make([]column, len(recMeta))
+	names := make(map[string]struct{}, len(recMeta))
+	for i, field := range recMeta {
+		col := column{name: uniqueName(names, sanitizeName(field.MungedName()))}
+		switch field.Kind() { //nolint:exhaustive
+		case kind.Int:
+			col.branch, col.schema = "long", "long"
+		case kind.Float:
+			col.branch, col.schema = "double", "double"
+		case kind.Bool:
+			col.branch, col.schema = "boolean", "boolean"
+		case kind.Bytes:
+			col.branch, col.schema = "bytes", "bytes"
+		case kind.Decimal:
+			col.precision, col.scale = arrowz.DecimalSize(field)
+			col.branch = "bytes.decimal"
+			col.schema = map[string]any{
+				"type":        "bytes",
+				"logicalType": "decimal",
+				"precision":   col.precision,
+				"scale":       col.scale,
+			}
+		case kind.Datetime:
+			col.branch = "long.timestamp-micros"
+			col.schema = map[string]any{"type": "long", "logicalType": "timestamp-micros"}
+		case kind.Date:
+			col.branch = "int.date"
+			col.schema = map[string]any{"type": "int", "logicalType": "date"}
+		case kind.Time:
+			col.branch = "long.time-micros"
+			col.schema = map[string]any{"type": "long", "logicalType": "time-micros"}
+		default:
+			// kind.Text, and anything else, is written as a string.
+			col.branch, col.schema = "string", "string"
+		}
+		cols[i] = col
+	}
+
+	return cols
+}
+
+// buildSchema returns the JSON Avro record schema for cols. Each field is
+// nullable, i.e. a union of null and the field's type.
+func buildSchema(cols []column) (string, error) {
+	fields := make([]map[string]any, len(cols))
+	for i, col := range cols {
+		fields[i] = map[string]any{
+			"name": col.name,
+			"type": []any{"null", col.schema},
+		}
+	}
+
+	b, err := json.Marshal(map[string]any{
+		"type":   "record",
+		"name":   RecordName,
+		"fields": fields,
+	})
+	if err != nil {
+		return "", errz.Err(err)
+	}
+	return string(b), nil
+}
+
+// sanitizeName returns name with each character that isn't valid in an
+// Avro name replaced with underscore.
+func sanitizeName(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			sb.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	if sb.Len() == 0 {
+		return "_"
+	}
+	return sb.String()
+}
+
+// uniqueName returns name, with a numeric suffix if necessary to make it
+// unique within names. The returned name is added to names.
+func uniqueName(names map[string]struct{}, name string) string {
+	candidate := name
+	for i := 1; ; i++ {
+		if _, ok := names[candidate]; !ok {
+			break
+		}
+		candidate = name + "_" + strconv.Itoa(i)
+	}
+
+	names[candidate] = struct{}{}
+	return candidate
+}
+
+// WriteRecords implements output.RecordWriter.
+func (w *recordWriter) WriteRecords(ctx context.Context, recs []record.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, rec := range recs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		row := make(map[string]any, len(rec))
+		for i, val := range rec {
+			v, err := w.convert(i, val)
+			if err != nil {
+				return err
+			}
+			if v == nil {
+				row[w.cols[i].name] = nil
+				continue
+			}
+			row[w.cols[i].name] = goavro.Union(w.cols[i].branch, v)
+		}
+
+		w.rows = append(w.rows, row)
+		if len(w.rows) >= BlockRows {
+			if err := w.writeBlock(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeBlock writes the pending rows, if any, as a data block.
+func (w *recordWriter) writeBlock() error {
+	if len(w.rows) == 0 {
+		return nil
+	}
+
+	if err := w.ocfw.Append(w.rows); err != nil {
+		return errz.Wrap(err, "avro: write")
+	}
+	w.rows = w.rows[:0]
+	return nil
+}
+
+// convert returns val, the value of column i, as the Go type that
+// goavro expects for the column's Avro type.
+func (w *recordWriter) convert(i int, val any) (any, error) {
+	if val == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	switch w.recMeta[i].Kind() { //nolint:exhaustive
+	case kind.Int:
+		if v, ok := val.(int64); ok {
+			return v, nil
+		}
+	case kind.Float:
+		switch v := val.(type) {
+		case float64:
+			return v, nil
+		case decimal.Decimal:
+			return v.InexactFloat64(), nil
+		}
+	case kind.Bool:
+		if v, ok := val.(bool); ok {
+			return v, nil
+		}
+	case kind.Bytes:
+		switch v := val.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			return []byte(v), nil
+		}
+	case kind.Decimal:
+		var dec decimal.Decimal
+		switch v := val.(type) {
+		case decimal.Decimal:
+			dec = v
+		case int64:
+			dec = decimal.NewFromInt(v)
+		case float64:
+			dec = decimal.NewFromFloat(v)
+		case string:
+			var err error
+			if dec, err = decimal.NewFromString(v); err != nil {
+				return nil, errz.Wrapf(err, "avro: column {%s}: invalid decimal", w.recMeta[i].MungedName())
+			}
+		default:
+			return nil, w.errUnexpected(i, val)
+		}
+		return w.decimalRat(i, dec)
+	case kind.Datetime:
+		if v, ok := val.(time.Time); ok {
+			return v, nil
+		}
+	case kind.Date:
+		if v, ok := val.(time.Time); ok {
+			// An Avro date is the number of days since the epoch, which
+			// goavro computes from the UTC time.
+			return time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	case kind.Time:
+		switch v := val.(type) {
+		case time.Time:
+			return sinceMidnight(v), nil
+		case string:
+			// Some database drivers supply kind.Time values as string.
+			t, err := time.Parse(time.TimeOnly, v)
+			if err != nil {
+				return nil, errz.Wrapf(err, "avro: column {%s}: invalid time", w.recMeta[i].MungedName())
+			}
+			return sinceMidnight(t), nil
+		}
+	default:
+		switch v := val.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		case time.Time:
+			return v.Format(time.RFC3339Nano), nil
+		default:
+			return fmt.Sprintf("%v", v), nil
+		}
+	}
+
+	return nil, w.errUnexpected(i, val)
+}
+
+// decimalRat returns dec, the value of decimal column i, rounded to the
+// column's scale. An error is returned if the rounded value doesn't fit
+// the column's precision.
+func (w *recordWriter) decimalRat(i int, dec decimal.Decimal) (*big.Rat, error) {
+	col := w.cols[i]
+	dec = dec.Round(col.scale)
+	unscaled := new(big.Int).Abs(dec.Shift(col.scale).BigInt())
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(col.precision)), nil)
+	if unscaled.Cmp(limit) >= 0 {
+		return nil, errz.Errorf("avro: column {%s}: value %s overflows decimal(%d,%d)",
+			w.recMeta[i].MungedName(), dec, col.precision, col.scale)
+	}
+	return dec.Rat(), nil
+}
+
+// sinceMidnight returns the time of day of t, as a duration.
+func sinceMidnight(t time.Time) time.Duration {
+	h, m, s := t.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+		time.Duration(s)*time.Second + time.Duration(t.Nanosecond())
+}
+
+func (w *recordWriter) errUnexpected(i int, val any) error {
+	return errz.Errorf("avro: column {%s}: unexpected %T value for kind %s",
+		w.recMeta[i].MungedName(), val, w.recMeta[i].Kind())
+}
+
+// Flush implements output.RecordWriter. It's a no-op: the writer writes
+// a data block when BlockRows rows have accumulated.
+func (w *recordWriter) Flush(context.Context) error {
+	return nil
+}
+
+// Close implements output.RecordWriter. It writes any remaining rows.
+func (w *recordWriter) Close(context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.ocfw == nil {
+		return nil
+	}
+
+	return w.writeBlock()
+}
@@ -0,0 +1,155 @@
+package avrow_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/avrow"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/testh"
+)
+
+func TestRecordWriter(t *testing.T) {
+	ctx := context.Background()
+	colNames := []string{
+		"col_int", "col_float", "col_bool", "col_bytes", "col_decimal",
+		"col_datetime", "col_date", "col_time", "col text",
+	}
+	colKinds := []kind.Kind{
+		kind.Int, kind.Float, kind.Bool, kind.Bytes, kind.Decimal,
+		kind.Datetime, kind.Date, kind.Time, kind.Text,
+	}
+	recMeta := testh.NewRecordMeta(colNames, colKinds)
+
+	dt := time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC)
+	recs := []record.Record{
+		{
+			int64(7), float64(1.5), true, []byte("hello"), decimal.RequireFromString("12.3456789"),
+			dt, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), "05:06:07", "huzzah",
+		},
+		make(record.Record, len(colNames)), // All null.
+	}
+
+	buf := &bytes.Buffer{}
+	w := avrow.NewRecordWriter(buf, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.NoError(t, w.WriteRecords(ctx, recs))
+	require.NoError(t, w.Flush(ctx))
+	require.NoError(t, w.Close(ctx))
+
+	// The output is read via goavro's own reader, to verify interop.
+	r, err := goavro.NewOCFReader(buf)
+	require.NoError(t, err)
+	require.Equal(t, goavro.CompressionDeflateLabel, r.CompressionName())
+
+	wantNames := []string{
+		"col_int", "col_float", "col_bool", "col_bytes", "col_decimal",
+		"col_datetime", "col_date", "col_time", "col_text",
+	}
+	wantTypes := []string{
+		`"long"`, `"double"`, `"boolean"`, `"bytes"`,
+		`{"logicalType":"decimal","precision":38,"scale":6,"type":"bytes"}`,
+		`{"logicalType":"timestamp-micros","type":"long"}`,
+		`{"logicalType":"date","type":"int"}`,
+		`{"logicalType":"time-micros","type":"long"}`,
+		`"string"`,
+	}
+	schema := readSchema(t, r)
+	require.Equal(t, "record", schema.Type)
+	require.Equal(t, avrow.RecordName, schema.Name)
+	require.Len(t, schema.Fields, len(colNames))
+	for i, f := range schema.Fields {
+		require.Equal(t, wantNames[i], f.Name)
+		require.Equal(t, `["null",`+wantTypes[i]+`]`, string(f.Type), f.Name)
+	}
+
+	require.True(t, r.Scan())
+	row, err := r.Read()
+	require.NoError(t, err)
+	m := row.(map[string]any)
+	require.Equal(t, map[string]any{"long": int64(7)}, m["col_int"])
+	require.Equal(t, map[string]any{"double": 1.5}, m["col_float"])
+	require.Equal(t, map[string]any{"boolean": true}, m["col_bool"])
+	require.Equal(t, map[string]any{"bytes": []byte("hello")}, m["col_bytes"])
+	gotDec := m["col_decimal"].(map[string]any)["bytes.decimal"].(*big.Rat)
+	require.Equal(t, "12.345679", gotDec.FloatString(6), "rounded to scale 6")
+	gotDT := m["col_datetime"].(map[string]any)["long.timestamp-micros"].(time.Time)
+	require.True(t, dt.Equal(gotDT))
+	gotDate := m["col_date"].(map[string]any)["int.date"].(time.Time)
+	require.True(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC).Equal(gotDate))
+	require.Equal(t, map[string]any{"long.time-micros": 5*time.Hour + 6*time.Minute + 7*time.Second}, m["col_time"])
+	require.Equal(t, map[string]any{"string": "huzzah"}, m["col_text"])
+
+	require.True(t, r.Scan())
+	row, err = r.Read()
+	require.NoError(t, err)
+	for name, val := range row.(map[string]any) {
+		require.Nil(t, val, name)
+	}
+
+	require.False(t, r.Scan())
+	require.NoError(t, r.Err())
+}
+
+func TestRecordWriter_decimalOverflow(t *testing.T) {
+	ctx := context.Background()
+	recMeta := testh.NewRecordMeta([]string{"a"}, []kind.Kind{kind.Decimal})
+	recs := []record.Record{{decimal.RequireFromString("1e40")}}
+
+	w := avrow.NewRecordWriter(&bytes.Buffer{}, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.Error(t, w.WriteRecords(ctx, recs))
+}
+
+// schema is the subset of an Avro record schema that the tests inspect.
+type schema struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Fields []struct {
+		Name string          `json:"name"`
+		Type json.RawMessage `json:"type"`
+	} `json:"fields"`
+}
+
+// readSchema returns the schema in the header of the file read by r.
+func readSchema(t *testing.T, r *goavro.OCFReader) schema {
+	t.Helper()
+	var s schema
+	require.NoError(t, json.Unmarshal(r.MetaData()["avro.schema"], &s))
+	return s
+}
+
+func TestRecordWriter_names(t *testing.T) {
+	ctx := context.Background()
+	recMeta := testh.NewRecordMeta(
+		[]string{"a-b", "a_b", "1st", "ünï"},
+		[]kind.Kind{kind.Text, kind.Text, kind.Text, kind.Text},
+	)
+
+	buf := &bytes.Buffer{}
+	w := avrow.NewRecordWriter(buf, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.NoError(t, w.Close(ctx))
+
+	r, err := goavro.NewOCFReader(buf)
+	require.NoError(t, err)
+
+	var got []string
+	for _, f := range readSchema(t, r).Fields {
+		got = append(got, f.Name)
+	}
+	require.Equal(t, []string{"a_b", "a_b_1", "_1st", "_n_"}, got)
+
+	require.False(t, r.Scan())
+	require.NoError(t, r.Err())
+}
@@ -0,0 +1,115 @@
+// Package dbmlw implements output.MetadataWriter for the "dbml"
+// format: sq inspect's schema as DBML source (see cli/output/internal/dbml),
+// the schema language of dbdiagram.io and related tools.
+//
+// Like mermaidw, it supports only source and table schema inspection
+// (SourceMetadata and TableMetadata); the other metadata operations have no
+// DBML representation and return errUnsupported.
+package dbmlw
+
+import (
+	"cmp"
+	"io"
+	"slices"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/internal/dbml"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+var _ output.MetadataWriter = (*metadataWriter)(nil)
+
+// errUnsupported is returned by the metadata operations that have no
+// DBML representation.
+var errUnsupported = errz.New(
+	"the dbml format supports only source and table schema inspection",
+)
+
+// errNothingToRender is returned when there's no schema to render, i.e. no
+// tables with columns and no foreign keys. As with mermaidw, an empty render
+// is an error rather than silent empty output.
+var errNothingToRender = errz.New(
+	"the dbml format has nothing to render: no columns or foreign keys found",
+)
+
+// metadataWriter implements output.MetadataWriter for the "dbml"
+// format, emitting DBML source.
+type metadataWriter struct {
+	out io.Writer
+}
+
+// NewMetadataWriter returns a new output.MetadataWriter that outputs bare
+// DBML source. The *output.Printing arg is accepted for call-site
+// consistency with the other metadata writers but is unused: the output is
+// always plain, so that it can be saved as a .dbml file as-is.
+func NewMetadataWriter(out io.Writer, _ *output.Printing) output.MetadataWriter {
+	return &metadataWriter{out: out}
+}
+
+// SourceMetadata implements output.MetadataWriter. It writes the whole-source
+// schema. Overview mode (showSchema=false) carries no table schema, so
+// there's nothing to render and it returns errUnsupported.
+func (w *metadataWriter) SourceMetadata(md *metadata.Source, showSchema bool) error {
+	if !showSchema {
+		return errUnsupported
+	}
+
+	// Render with a stable table ordering (tables before views, then by
+	// name), matching the ERD writers.
+	tables := append([]*metadata.Table(nil), md.Tables...)
+	slices.SortFunc(tables, compareTables)
+
+	return w.writeSchema(dbml.SourceSchema(tables))
+}
+
+// TableMetadata implements output.MetadataWriter, writing the table's
+// schema, with its references to other tables as comments.
+func (w *metadataWriter) TableMetadata(md *metadata.Table) error {
+	return w.writeSchema(dbml.TableSchema(md, nil))
+}
+
+// writeSchema writes the rendered DBML source to w.out, returning
+// errNothingToRender when src is empty (the dbml package returns "" when
+// there's nothing to render).
+func (w *metadataWriter) writeSchema(src string) error {
+	if src == "" {
+		return errNothingToRender
+	}
+	_, err := io.WriteString(w.out, src)
+	return errz.Err(err)
+}
+
+// DBProperties implements output.MetadataWriter. DB properties have no DBML
+// representation.
+func (w *metadataWriter) DBProperties(map[string]any) error {
+	return errUnsupported
+}
+
+// DriverMetadata implements output.MetadataWriter. The driver list has no
+// DBML representation.
+func (w *metadataWriter) DriverMetadata([]driver.Metadata) error {
+	return errUnsupported
+}
+
+// Catalogs implements output.MetadataWriter. A catalog list has no DBML
+// representation.
+func (w *metadataWriter) Catalogs(string, []string) error {
+	return errUnsupported
+}
+
+// Schemata implements output.MetadataWriter. A schema list has no DBML
+// representation.
+func (w *metadataWriter) Schemata(string, []*metadata.Schema) error {
+	return errUnsupported
+}
+
+// compareTables orders tables before views, then by name, so the emitted
+// schema is deterministic.
+func compareTables(a, b *metadata.Table) int {
+	if a.TableType == b.TableType {
+		return cmp.Compare(a.Name, b.Name)
+	}
+	return cmp.Compare(a.TableType, b.TableType)
+}
@@ -0,0 +1,104 @@
+package dbmlw_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/dbmlw"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// newTestSource builds a small deterministic two-table source
+// (actor + film_actor, with film_actor.actor_id → actor.actor_id) and links
+// its foreign keys so FK.Incoming is populated.
+func newTestSource() *metadata.Source {
+	actor := &metadata.Table{
+		Name: "actor", TableType: "table", RowCount: 200,
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "first_name", Position: 2, ColumnType: "TEXT", Kind: kind.Text},
+		},
+	}
+	filmActor := &metadata.Table{
+		Name: "film_actor", TableType: "table", RowCount: 5462,
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "film_id", Position: 2, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+		},
+		FK: &metadata.FKGroup{Outgoing: []*metadata.ForeignKey{{
+			Name: "fk_film_actor_actor", Table: "film_actor", Columns: []string{"actor_id"},
+			RefTable: "actor", RefColumns: []string{"actor_id"},
+		}}},
+	}
+	src := &metadata.Source{
+		Handle: "@test", Name: "testdb", Driver: drivertype.Type("sqlite3"),
+		Schema: "main", Tables: []*metadata.Table{filmActor, actor},
+	}
+	metadata.LinkForeignKeys(nil, src)
+	return src
+}
+
+// TestMetadataWriter_SourceMetadata checks the whole-source schema: DBML
+// source, with tables in a deterministic order (tables before views, then
+// by name).
+func TestMetadataWriter_SourceMetadata(t *testing.T) {
+	const want = `Table actor {
+  actor_id INTEGER [pk]
+  first_name TEXT [not null]
+}
+
+Table film_actor {
+  actor_id INTEGER [not null]
+  film_id INTEGER [not null]
+
+  indexes {
+    (actor_id, film_id) [pk]
+  }
+}
+
+Ref fk_film_actor_actor: film_actor.actor_id > actor.actor_id
+`
+
+	buf := &bytes.Buffer{}
+	w := dbmlw.NewMetadataWriter(buf, output.NewPrinting())
+	require.NoError(t, w.SourceMetadata(newTestSource(), true))
+	require.Equal(t, want, buf.String())
+}
+
+// TestMetadataWriter_TableMetadata checks the single-table schema, whose
+// reference to another table is a comment.
+func TestMetadataWriter_TableMetadata(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := dbmlw.NewMetadataWriter(buf, output.NewPrinting())
+	require.NoError(t, w.TableMetadata(newTestSource().Table("film_actor")))
+
+	got := buf.String()
+	require.True(t, strings.HasPrefix(got, "Table film_actor {\n"))
+	require.Contains(t, got, "// Ref fk_film_actor_actor: film_actor.actor_id > actor.actor_id\n")
+	require.NotContains(t, got, "Table actor")
+}
+
+// TestMetadataWriter_unsupported verifies that operations with no DBML
+// representation return an error and write nothing.
+func TestMetadataWriter_unsupported(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := dbmlw.NewMetadataWriter(buf, output.NewPrinting())
+
+	// Overview mode (showSchema=false) carries no schema to render.
+	require.ErrorContains(t, w.SourceMetadata(newTestSource(), false), "dbml")
+	require.ErrorContains(t, w.DBProperties(map[string]any{"k": "v"}), "dbml")
+	require.ErrorContains(t, w.DriverMetadata(nil), "dbml")
+	require.ErrorContains(t, w.Catalogs("sakila", []string{"sakila"}), "dbml")
+	require.ErrorContains(t, w.Schemata("public", []*metadata.Schema{{Name: "public"}}), "dbml")
+	require.Empty(t, buf.String())
+
+	// Nothing to render.
+	require.ErrorContains(t, w.TableMetadata(&metadata.Table{Name: "t", TableType: "table"}), "dbml")
+	require.Empty(t, buf.String())
+}
@@ -13,8 +13,8 @@ func (f *Format) UnmarshalText(text []byte) error {
 	default:
 		return errz.Errorf("unknown output format {%s}", string(text))
 	case JSON, JSONA, JSONL, Text, Raw,
-		HTML, Markdown, MermaidERD, PNGERD, SVGERD, XLSX, XML,
-		CSV, TSV, YAML:
+		HTML, Markdown, LaTeX, RST, MermaidERD, PlantUMLERD, DBML, PNGERD, SVGERD, XLSX, XML,
+		CSV, TSV, YAML, Parquet, Arrow, Avro, SQLInsert, Template:
 	case "table":
 		// Legacy: the "text" format used to be named "table".
 		text = []byte(Text)
@@ -37,10 +37,23 @@ const (
 	JSONA    Format = "jsona"
 	HTML     Format = "html"
 	Markdown Format = "markdown"
+	// LaTeX is a LaTeX tabular environment, or, for sq inspect, a LaTeX
+	// document fragment.
+	LaTeX Format = "latex"
+	// RST is a reStructuredText grid table, or, for sq inspect, a
+	// reStructuredText document.
+	RST Format = "rst"
 	// MermaidERD emits a bare Mermaid.js erDiagram. It's implemented only for
 	// sq inspect (source and table schema diagrams), so it's deliberately
 	// absent from All: query commands have no record writer for it.
 	MermaidERD Format = "mermaid-erd"
+	// PlantUMLERD emits sq inspect's schema entity-relationship diagram as
+	// bare PlantUML source. Inspect-only with no record writer; see
+	// MermaidERD.
+	PlantUMLERD Format = "plantuml-erd"
+	// DBML emits sq inspect's schema as DBML, the schema language of
+	// dbdiagram.io. Inspect-only with no record writer; see MermaidERD.
+	DBML Format = "dbml"
 	// PNGERD renders sq inspect's schema entity-relationship diagram to a PNG
 	// image. Like MermaidERD it's inspect-only (source and table schema) with
 	// no record writer, so it's deliberately absent from All.
@@ -54,13 +67,35 @@ const (
 	TSV    Format = "tsv"
 	Raw    Format = "raw"
 	YAML   Format = "yaml"
+
+	// Parquet is the Apache Parquet columnar format. Like XLSX, it's a
+	// binary format, and it's only implemented for query results.
+	Parquet Format = "parquet"
+
+	// Arrow is the Apache Arrow IPC streaming format. Like Parquet, it's a
+	// binary format, and it's only implemented for query results.
+	Arrow Format = "arrow"
+
+	// Avro is the Apache Avro object container file format. Like Arrow,
+	// it's a binary format, and it's only implemented for query results.
+	Avro Format = "avro"
+
+	// SQLInsert is a SQL script of a CREATE TABLE statement followed by
+	// INSERT statements for the records, in the dialect of a SQL driver.
+	// It's only implemented for query results.
+	SQLInsert Format = "sql-insert"
+
+	// Template renders records via a user-supplied Go text/template.
+	// It's only implemented for query results.
+	Template Format = "template"
 )
 
 // All returns a new slice containing all format.Format values.
 //
-// All deliberately omits MermaidERD: it's an inspect-only metadata format with
-// no record writer, so advertising it for query commands (shell completion,
-// format parity) would be misleading. See MermaidERD's doc comment.
+// All deliberately omits MermaidERD and the other inspect-only ERD formats:
+// they're metadata formats with no record writer, so advertising them for
+// query commands (shell completion, format parity) would be misleading. See
+// MermaidERD's doc comment.
 func All() []Format {
 	return []Format{
 		Text,
@@ -70,10 +105,17 @@ func All() []Format {
 		Raw,
 		HTML,
 		Markdown,
+		LaTeX,
+		RST,
 		XLSX,
 		XML,
 		CSV,
 		TSV,
 		YAML,
+		Parquet,
+		Arrow,
+		Avro,
+		SQLInsert,
+		Template,
 	}
 }
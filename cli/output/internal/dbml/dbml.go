@@ -0,0 +1,328 @@
+// Package dbml generates DBML (Database Markup Language, as used by
+// dbdiagram.io) source from sq table metadata. It returns the bare DBML
+// source, consisting of a Table definition per table, and a Ref per
+// foreign key.
+//
+// dbml is the DBML counterpart to packages mermaid, erddot, and plantuml:
+// they consume the same schema metadata and share foreign-key cardinality
+// inference via package erdmodel, so the relationships they draw agree for
+// a given schema. Unlike the diagram renderers, DBML references columns
+// rather than just tables, so each Ref carries the foreign key's columns.
+package dbml
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/neilotoole/sq/cli/output/internal/erdmodel"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// ref is a DBML Ref: a foreign-key relationship from the child
+// (referencing) table's cols to the parent (referenced) table's refCols.
+type ref struct {
+	name     string
+	child    string
+	parent   string
+	cols     []string
+	refCols  []string
+	oneToOne bool
+}
+
+// SourceSchema returns the whole-source DBML: a Table for every table that
+// has columns, plus a Ref for every in-source outgoing foreign key. Returns
+// "" when there is nothing to render.
+func SourceSchema(tables []*metadata.Table) string {
+	byName := erdmodel.Index(tables)
+	var refs []ref
+	for _, tbl := range tables {
+		if tbl.FK == nil {
+			continue
+		}
+		for _, fk := range tbl.FK.Outgoing {
+			if r, ok := fkRef(fk, byName); ok {
+				refs = append(refs, r)
+			}
+		}
+	}
+	return render(tables, sortDedupRefs(refs))
+}
+
+// TableSchema returns the DBML for tbl: its Table, plus a Ref for every
+// foreign key it participates in. DBML requires both tables of a Ref to be
+// defined, so a Ref to a table other than tbl is emitted as a comment.
+// cardIndex, when non-nil, supplies neighbor tables for cardinality
+// inference (pass Index(tables) in a whole-source context); pass nil for a
+// single-table inspect, where only tbl is known. Returns "" when there is
+// nothing to render.
+func TableSchema(tbl *metadata.Table, cardIndex map[string]*metadata.Table) string {
+	if cardIndex == nil {
+		cardIndex = map[string]*metadata.Table{tbl.Name: tbl}
+	}
+	var refs []ref
+	if tbl.FK != nil {
+		for _, fk := range tbl.FK.Outgoing {
+			if r, ok := fkRef(fk, cardIndex); ok {
+				refs = append(refs, r)
+			}
+		}
+		for _, fk := range tbl.FK.Incoming {
+			if r, ok := fkRef(fk, cardIndex); ok {
+				refs = append(refs, r)
+			}
+		}
+	}
+	return render([]*metadata.Table{tbl}, sortDedupRefs(refs))
+}
+
+// Index returns a name→table lookup, for use as TableSchema's cardIndex.
+func Index(tables []*metadata.Table) map[string]*metadata.Table {
+	return erdmodel.Index(tables)
+}
+
+// render builds the DBML source: a Table per table in tables that has
+// columns, followed by the refs. A ref whose tables aren't both defined is
+// written as a comment. Returns "" when there is neither a table nor a ref
+// to render.
+func render(tables []*metadata.Table, refs []ref) string {
+	var withCols []*metadata.Table
+	defined := make(map[string]bool)
+	for _, t := range tables {
+		if len(t.Columns) > 0 {
+			withCols = append(withCols, t)
+			defined[t.Name] = true
+		}
+	}
+	if len(withCols) == 0 && len(refs) == 0 {
+		return ""
+	}
+
+	buf := &strings.Builder{}
+	for i, t := range withCols {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		writeTable(buf, t)
+	}
+
+	var internal, external []ref
+	for _, r := range refs {
+		if defined[r.child] && defined[r.parent] {
+			internal = append(internal, r)
+		} else {
+			external = append(external, r)
+		}
+	}
+
+	if len(internal) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		for _, r := range internal {
+			buf.WriteString(refLine(r) + "\n")
+		}
+	}
+
+	if len(external) > 0 {
+		if buf.Len() > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString("// References to tables not defined above:\n")
+		for _, r := range external {
+			buf.WriteString("// " + refLine(r) + "\n")
+		}
+	}
+
+	return buf.String()
+}
+
+// writeTable writes a single table as a DBML Table. A single-column
+// primary key is declared via the column's "pk" setting; a composite
+// primary key, and any multi-column unique constraint, is declared in the
+// table's indexes block.
+func writeTable(buf *strings.Builder, t *metadata.Table) {
+	var pkCols []string
+	for _, col := range t.Columns {
+		if col.PrimaryKey {
+			pkCols = append(pkCols, col.Name)
+		}
+	}
+
+	uniqueCols := make(map[string]bool)
+	var uniqueIdxs []*metadata.UniqueConstraint
+	for _, uc := range t.UniqueConstraints {
+		switch {
+		case uc == nil || len(uc.Columns) == 0:
+		case len(uc.Columns) == 1:
+			uniqueCols[uc.Columns[0]] = true
+		default:
+			uniqueIdxs = append(uniqueIdxs, uc)
+		}
+	}
+
+	fmt.Fprintf(buf, "Table %s {\n", ident(t.Name))
+	for _, col := range t.Columns {
+		var settings []string
+		switch {
+		case col.PrimaryKey && len(pkCols) == 1:
+			settings = append(settings, "pk")
+		case !col.Nullable:
+			settings = append(settings, "not null")
+		}
+		if uniqueCols[col.Name] && !(col.PrimaryKey && len(pkCols) == 1) {
+			settings = append(settings, "unique")
+		}
+		if col.AutoIncrement || col.Identity {
+			settings = append(settings, "increment")
+		}
+		if col.Comment != "" {
+			settings = append(settings, "note: "+quote(col.Comment))
+		}
+
+		fmt.Fprintf(buf, "  %s %s", ident(col.Name), colType(col))
+		if len(settings) > 0 {
+			fmt.Fprintf(buf, " [%s]", strings.Join(settings, ", "))
+		}
+		buf.WriteString("\n")
+	}
+
+	if len(pkCols) > 1 || len(uniqueIdxs) > 0 {
+		buf.WriteString("\n  indexes {\n")
+		if len(pkCols) > 1 {
+			fmt.Fprintf(buf, "    %s [pk]\n", colList(pkCols))
+		}
+		for _, uc := range uniqueIdxs {
+			if uc.Name != "" {
+				fmt.Fprintf(buf, "    %s [unique, name: %s]\n", colList(uc.Columns), quote(uc.Name))
+			} else {
+				fmt.Fprintf(buf, "    %s [unique]\n", colList(uc.Columns))
+			}
+		}
+		buf.WriteString("  }\n")
+	}
+
+	if t.Comment != "" {
+		fmt.Fprintf(buf, "\n  Note: %s\n", quote(t.Comment))
+	}
+	buf.WriteString("}\n")
+}
+
+// fkRef builds the ref for a single foreign key, via [erdmodel.Resolve].
+// It returns false for a reference that points outside this source, or
+// whose columns aren't known.
+func fkRef(fk *metadata.ForeignKey, byName map[string]*metadata.Table) (ref, bool) {
+	e, ok := erdmodel.Resolve(fk, byName)
+	if !ok || len(fk.Columns) == 0 || len(fk.Columns) != len(fk.RefColumns) {
+		return ref{}, false
+	}
+
+	return ref{
+		name:     e.Label,
+		child:    e.Child,
+		parent:   e.Parent,
+		cols:     fk.Columns,
+		refCols:  fk.RefColumns,
+		oneToOne: e.Card.ChildUnique,
+	}, true
+}
+
+// refLine renders r as a DBML Ref line, e.g.
+// "Ref fk_film_actor_actor: film_actor.actor_id > actor.actor_id". The
+// relationship is many-to-one (">") from child to parent, or one-to-one
+// ("-") when the child side is unique.
+func refLine(r ref) string {
+	rel := ">"
+	if r.oneToOne {
+		rel = "-"
+	}
+
+	s := "Ref"
+	if r.name != "" {
+		s += " " + ident(r.name)
+	}
+	return fmt.Sprintf("%s: %s %s %s", s, endpoint(r.child, r.cols), rel, endpoint(r.parent, r.refCols))
+}
+
+// endpoint renders a Ref endpoint: "table.col", or "table.(col1, col2)"
+// for a composite key.
+func endpoint(tbl string, cols []string) string {
+	if len(cols) == 1 {
+		return ident(tbl) + "." + ident(cols[0])
+	}
+	return ident(tbl) + "." + colList(cols)
+}
+
+// colList renders cols as a parenthesized DBML column list, e.g.
+// "(actor_id, film_id)".
+func colList(cols []string) string {
+	idents := make([]string, len(cols))
+	for i, c := range cols {
+		idents[i] = ident(c)
+	}
+	return "(" + strings.Join(idents, ", ") + ")"
+}
+
+// sortDedupRefs sorts refs into a deterministic order and removes exact
+// duplicates.
+func sortDedupRefs(refs []ref) []ref {
+	slices.SortFunc(refs, func(a, b ref) int {
+		if c := cmp.Compare(a.child, b.child); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.parent, b.parent); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		return cmp.Compare(refLine(a), refLine(b))
+	})
+	return slices.CompactFunc(refs, func(a, b ref) bool { return refLine(a) == refLine(b) })
+}
+
+// identRe matches identifiers safe to emit unquoted in DBML.
+var identRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// typeRe matches column types safe to emit unquoted in DBML, such as
+// "INTEGER", "varchar(255)", "numeric(10,2)", or "int[]".
+var typeRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\([0-9, ]*\))?(\[\])?$`)
+
+// identEscape escapes the characters that are special inside a DBML
+// double-quoted identifier, and neutralizes control characters that would
+// break the line.
+var identEscape = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", " ", "\r", " ", "\t", " ")
+
+// ident renders a table or column name, quoting it when it contains
+// characters DBML wouldn't accept bare.
+func ident(s string) string {
+	if identRe.MatchString(s) {
+		return s
+	}
+	return `"` + identEscape.Replace(s) + `"`
+}
+
+// colType renders the column's database type, falling back to its kind when
+// the type isn't known, quoting it when it contains characters DBML
+// wouldn't accept bare (e.g. "character varying").
+func colType(col *metadata.Column) string {
+	typ := col.ColumnType
+	if typ == "" {
+		typ = col.Kind.String()
+	}
+	if typeRe.MatchString(typ) {
+		return typ
+	}
+	return `"` + identEscape.Replace(typ) + `"`
+}
+
+// stringEscape escapes the characters that are special inside a DBML
+// single-quoted string.
+var stringEscape = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", "", "\t", " ")
+
+// quote renders s as a DBML single-quoted string, as used for notes.
+func quote(s string) string {
+	return `'` + stringEscape.Replace(s) + `'`
+}
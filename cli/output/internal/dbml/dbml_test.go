@@ -0,0 +1,157 @@
+package dbml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output/internal/dbml"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// testTables builds a deterministic three-table source: actor, film, and
+// film_actor, which has a composite primary key, and foreign keys to both
+// actor and film. It links the foreign keys so FK.Incoming is populated.
+func testTables() []*metadata.Table {
+	actor := &metadata.Table{
+		Name: "actor", TableType: "table", Comment: "Actors' names",
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, AutoIncrement: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "first_name", Position: 2, ColumnType: "VARCHAR(45)", Kind: kind.Text},
+			{Name: "email", Position: 3, Nullable: true, ColumnType: "character varying", Kind: kind.Text},
+			{Name: "nickname", Position: 4, Nullable: true, Kind: kind.Text, Comment: "e.g. 'Bob'"},
+		},
+		UniqueConstraints: []*metadata.UniqueConstraint{
+			{Name: "actor_email_key", Table: "actor", Columns: []string{"email"}},
+		},
+	}
+	film := &metadata.Table{
+		Name: "film", TableType: "table",
+		Columns: []*metadata.Column{
+			{Name: "film_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "title", Position: 2, ColumnType: "TEXT", Kind: kind.Text},
+			{Name: "year", Position: 3, ColumnType: "INTEGER", Kind: kind.Int},
+		},
+		UniqueConstraints: []*metadata.UniqueConstraint{
+			{Name: "film_title_year_key", Table: "film", Columns: []string{"title", "year"}},
+		},
+	}
+	filmActor := &metadata.Table{
+		Name: "film_actor", TableType: "table",
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "film_id", Position: 2, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+		},
+		FK: &metadata.FKGroup{Outgoing: []*metadata.ForeignKey{
+			{
+				Name: "fk_film_actor_film", Table: "film_actor", Columns: []string{"film_id"},
+				RefTable: "film", RefColumns: []string{"film_id"},
+			},
+			{
+				Name: "fk_film_actor_actor", Table: "film_actor", Columns: []string{"actor_id"},
+				RefTable: "actor", RefColumns: []string{"actor_id"},
+			},
+		}},
+	}
+	src := &metadata.Source{Handle: "@test", Tables: []*metadata.Table{actor, film, filmActor}}
+	metadata.LinkForeignKeys(nil, src)
+	return src.Tables
+}
+
+func TestSourceSchema(t *testing.T) {
+	got := dbml.SourceSchema(testTables())
+	require.Equal(t, `Table actor {
+  actor_id INTEGER [pk, increment]
+  first_name VARCHAR(45) [not null]
+  email "character varying" [unique]
+  nickname text [note: 'e.g. \'Bob\'']
+
+  Note: 'Actors\' names'
+}
+
+Table film {
+  film_id INTEGER [pk]
+  title TEXT [not null]
+  year INTEGER [not null]
+
+  indexes {
+    (title, year) [unique, name: 'film_title_year_key']
+  }
+}
+
+Table film_actor {
+  actor_id INTEGER [not null]
+  film_id INTEGER [not null]
+
+  indexes {
+    (actor_id, film_id) [pk]
+  }
+}
+
+Ref fk_film_actor_actor: film_actor.actor_id > actor.actor_id
+Ref fk_film_actor_film: film_actor.film_id > film.film_id
+`, got)
+}
+
+// TestTableSchema_focused verifies that the single-table schema emits the
+// references to other tables as comments: DBML requires both tables of a
+// Ref to be defined.
+func TestTableSchema_focused(t *testing.T) {
+	tables := testTables()
+	got := dbml.TableSchema(tables[2], dbml.Index(tables)) // film_actor
+	require.Equal(t, `Table film_actor {
+  actor_id INTEGER [not null]
+  film_id INTEGER [not null]
+
+  indexes {
+    (actor_id, film_id) [pk]
+  }
+}
+
+// References to tables not defined above:
+// Ref fk_film_actor_actor: film_actor.actor_id > actor.actor_id
+// Ref fk_film_actor_film: film_actor.film_id > film.film_id
+`, got)
+}
+
+func TestSourceSchema_empty(t *testing.T) {
+	require.Equal(t, "", dbml.SourceSchema(nil))
+
+	// A column-less table has nothing to render.
+	src := []*metadata.Table{{Name: "t", TableType: "table"}}
+	require.Equal(t, "", dbml.SourceSchema(src))
+}
+
+// TestSourceSchema_refs verifies the Ref relationship and endpoint forms:
+// a one-to-one reference (the FK columns are the child's primary key) is
+// rendered with "-", a composite reference with parenthesized column lists,
+// and names that aren't bare identifiers are double-quoted.
+func TestSourceSchema_refs(t *testing.T) {
+	parent := &metadata.Table{
+		Name: "parent", TableType: "table",
+		Columns: []*metadata.Column{
+			{Name: "a", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "b", Position: 2, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+		},
+	}
+	child := &metadata.Table{
+		Name: "child table", TableType: "table",
+		Columns: []*metadata.Column{
+			{Name: "parent a", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "parent_b", Position: 2, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+		},
+		FK: &metadata.FKGroup{Outgoing: []*metadata.ForeignKey{{
+			Table: "child table", Columns: []string{"parent a", "parent_b"},
+			RefTable: "parent", RefColumns: []string{"a", "b"},
+		}}},
+	}
+	src := &metadata.Source{Handle: "@p", Tables: []*metadata.Table{parent, child}}
+	metadata.LinkForeignKeys(nil, src)
+	got := dbml.SourceSchema(src.Tables)
+
+	require.Contains(t, got, `Table "child table" {`)
+	require.Contains(t, got, `  "parent a" INTEGER [not null]`)
+	require.Contains(t, got, `    ("parent a", parent_b) [pk]`)
+	require.Contains(t, got, `Ref: "child table".("parent a", parent_b) - parent.(a, b)`)
+}
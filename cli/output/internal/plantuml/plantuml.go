@@ -0,0 +1,248 @@
+// Package plantuml generates PlantUML entity-relationship diagram source
+// from sq table metadata. It returns the bare diagram source (an
+// "@startuml" … "@enduml" block); it does not itself render an image.
+//
+// plantuml is the PlantUML counterpart to packages mermaid and erddot: all
+// three consume the same schema metadata and share foreign-key cardinality
+// inference via package erdmodel, so the relationships they draw agree for
+// a given schema. Entities are rendered in PlantUML's Information
+// Engineering notation: primary key columns above the entity's separator
+// line, mandatory (non-nullable) columns marked with "*", and each column's
+// database type with any PK/FK marker as a stereotype.
+package plantuml
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/neilotoole/sq/cli/output/internal/erdmodel"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// SourceDiagram returns the whole-source PlantUML diagram: an entity for
+// every table that has columns, plus a relationship for every in-source
+// outgoing foreign key. Returns "" when there is nothing to draw.
+func SourceDiagram(tables []*metadata.Table) string {
+	byName := erdmodel.Index(tables)
+	var edges []erdmodel.Edge
+	for _, tbl := range tables {
+		if tbl.FK == nil {
+			continue
+		}
+		for _, fk := range tbl.FK.Outgoing {
+			if e, ok := erdmodel.Resolve(fk, byName); ok {
+				edges = append(edges, e)
+			}
+		}
+	}
+	return render(tables, sortDedupEdges(edges))
+}
+
+// TableDiagram returns a focused PlantUML diagram for tbl: tbl's own entity
+// (its columns) plus a relationship for every foreign key it participates
+// in. Related tables are rendered as bare, column-less entities. cardIndex,
+// when non-nil, supplies neighbor tables for cardinality inference (pass
+// Index(tables) in a whole-source context); pass nil for a single-table
+// inspect, where only tbl is known. Returns "" when there is nothing to
+// draw.
+func TableDiagram(tbl *metadata.Table, cardIndex map[string]*metadata.Table) string {
+	if cardIndex == nil {
+		cardIndex = map[string]*metadata.Table{tbl.Name: tbl}
+	}
+	var edges []erdmodel.Edge
+	if tbl.FK != nil {
+		for _, fk := range tbl.FK.Outgoing {
+			if e, ok := erdmodel.Resolve(fk, cardIndex); ok {
+				edges = append(edges, e)
+			}
+		}
+		for _, fk := range tbl.FK.Incoming {
+			if e, ok := erdmodel.Resolve(fk, cardIndex); ok {
+				edges = append(edges, e)
+			}
+		}
+	}
+	return render([]*metadata.Table{tbl}, sortDedupEdges(edges))
+}
+
+// Index returns a name→table lookup, for use as TableDiagram's cardIndex.
+func Index(tables []*metadata.Table) map[string]*metadata.Table {
+	return erdmodel.Index(tables)
+}
+
+// render builds the PlantUML source: an entity per table in entities that
+// has columns, a bare entity for each other table that an edge refers to,
+// followed by the edges. Returns "" when there is neither an entity nor an
+// edge to draw.
+func render(entities []*metadata.Table, edges []erdmodel.Edge) string {
+	var withCols []*metadata.Table
+	declared := make(map[string]bool)
+	for _, t := range entities {
+		if len(t.Columns) > 0 {
+			withCols = append(withCols, t)
+			declared[t.Name] = true
+		}
+	}
+	if len(withCols) == 0 && len(edges) == 0 {
+		return ""
+	}
+
+	buf := &strings.Builder{}
+	buf.WriteString("@startuml\n")
+	buf.WriteString("hide circle\n")
+	buf.WriteString("skinparam linetype ortho\n")
+	for _, t := range withCols {
+		buf.WriteString("\n")
+		writeEntity(buf, t)
+	}
+
+	// Declare the neighbors of a focused diagram explicitly, so that PlantUML
+	// draws them as entities rather than as classes.
+	var neighbors []string
+	for _, e := range edges {
+		for _, name := range []string{e.Parent, e.Child} {
+			if !declared[name] {
+				declared[name] = true
+				neighbors = append(neighbors, name)
+			}
+		}
+	}
+	if len(neighbors) > 0 {
+		buf.WriteString("\n")
+		for _, name := range neighbors {
+			fmt.Fprintf(buf, "entity %s\n", ident(name))
+		}
+	}
+
+	if len(edges) > 0 {
+		buf.WriteString("\n")
+	}
+	for _, e := range edges {
+		writeEdge(buf, e)
+	}
+	buf.WriteString("@enduml\n")
+	return buf.String()
+}
+
+// writeEntity writes a single table as a PlantUML entity. Primary key
+// columns are listed first, above the "--" separator, as is conventional
+// for IE diagrams; the remaining columns follow in their table order.
+func writeEntity(buf *strings.Builder, t *metadata.Table) {
+	fkCols := erdmodel.FKColumnSet(t)
+	var keyCols, otherCols []*metadata.Column
+	for _, col := range t.Columns {
+		if col.PrimaryKey {
+			keyCols = append(keyCols, col)
+		} else {
+			otherCols = append(otherCols, col)
+		}
+	}
+
+	fmt.Fprintf(buf, "entity %s {\n", ident(t.Name))
+	for _, col := range keyCols {
+		writeAttr(buf, col, fkCols)
+	}
+	if len(keyCols) > 0 && len(otherCols) > 0 {
+		buf.WriteString("  --\n")
+	}
+	for _, col := range otherCols {
+		writeAttr(buf, col, fkCols)
+	}
+	buf.WriteString("}\n")
+}
+
+// writeAttr writes a single column line of an entity, e.g.
+// "* actor_id : INTEGER <<PK,FK>>". A primary key or non-nullable column
+// is marked mandatory with a leading "*". The column's database type is
+// used, falling back to its kind when the type isn't known.
+func writeAttr(buf *strings.Builder, col *metadata.Column, fkCols map[string]bool) {
+	buf.WriteString("  ")
+	if col.PrimaryKey || !col.Nullable {
+		buf.WriteString("* ")
+	}
+
+	typ := col.ColumnType
+	if typ == "" {
+		typ = col.Kind.String()
+	}
+	fmt.Fprintf(buf, "%s : %s", text(col.Name), text(typ))
+	if marker := erdmodel.KeyMarker(col, fkCols); marker != "" {
+		fmt.Fprintf(buf, " <<%s>>", marker)
+	}
+	buf.WriteString("\n")
+}
+
+// writeEdge writes a single foreign-key relationship from the parent
+// (referenced/PK) entity to the child (referencing/FK) entity, with the
+// constraint name, if any, as its label.
+func writeEdge(buf *strings.Builder, e erdmodel.Edge) {
+	fmt.Fprintf(buf, "%s %s %s", ident(e.Parent), cardToken(e.Card), ident(e.Child))
+	if e.Label != "" {
+		fmt.Fprintf(buf, " : %s", text(e.Label))
+	}
+	buf.WriteString("\n")
+}
+
+// cardToken maps a renderer-neutral cardinality to a PlantUML IE
+// relationship token. The parent side is "|o" (zero-or-one) when the
+// relationship's parent is optional, else "||" (exactly one); the child
+// side is "||" (one-to-one) when the child is unique, else "o{"
+// (zero-or-many). These match the tokens of package mermaid.
+func cardToken(c erdmodel.Cardinality) string {
+	parent, child := "||", "o{"
+	if c.ParentOptional {
+		parent = "|o"
+	}
+	if c.ChildUnique {
+		child = "||"
+	}
+	return parent + "--" + child
+}
+
+// sortDedupEdges sorts edges into a deterministic order and removes exact
+// duplicates.
+func sortDedupEdges(edges []erdmodel.Edge) []erdmodel.Edge {
+	slices.SortFunc(edges, func(a, b erdmodel.Edge) int {
+		if c := cmp.Compare(a.Child, b.Child); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Parent, b.Parent); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Label, b.Label); c != 0 {
+			return c
+		}
+		return cmp.Compare(cardToken(a.Card), cardToken(b.Card))
+	})
+	return slices.CompactFunc(edges, func(a, b erdmodel.Edge) bool { return a == b })
+}
+
+// identRe matches identifiers safe to emit unquoted in a PlantUML diagram.
+var identRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// quoteSafe strips characters that would corrupt a double-quoted PlantUML
+// name: PlantUML has no escape for a literal double-quote, and a newline,
+// carriage return, or tab would split or break the diagram line.
+var quoteSafe = strings.NewReplacer(`"`, "", "\n", " ", "\r", " ", "\t", " ")
+
+// ident renders an entity (table) name, quoting it when it contains
+// characters PlantUML wouldn't accept bare.
+func ident(s string) string {
+	if identRe.MatchString(s) {
+		return s
+	}
+	return `"` + quoteSafe.Replace(s) + `"`
+}
+
+// lineSafe neutralizes control characters that would split a line of
+// PlantUML source.
+var lineSafe = strings.NewReplacer("\n", " ", "\r", " ", "\t", " ")
+
+// text renders s as free text within a line, such as a column name or type,
+// or a relationship label.
+func text(s string) string {
+	return lineSafe.Replace(s)
+}
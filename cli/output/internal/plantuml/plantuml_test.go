@@ -0,0 +1,168 @@
+package plantuml_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output/internal/mermaid"
+	"github.com/neilotoole/sq/cli/output/internal/plantuml"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// testTables builds the same deterministic two-table source (actor +
+// film_actor, with film_actor.actor_id → actor.actor_id) used by the
+// mermaid and erddot package tests, and links its foreign keys so
+// FK.Incoming is populated.
+func testTables() []*metadata.Table {
+	actor := &metadata.Table{
+		Name: "actor", TableType: "table", RowCount: 200,
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "first_name", Position: 2, ColumnType: "TEXT", Kind: kind.Text},
+			{Name: "nickname", Position: 3, Nullable: true, Kind: kind.Text},
+		},
+	}
+	filmActor := &metadata.Table{
+		Name: "film_actor", TableType: "table", RowCount: 5462,
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "film_id", Position: 2, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+		},
+		FK: &metadata.FKGroup{Outgoing: []*metadata.ForeignKey{{
+			Name: "fk_film_actor_actor", Table: "film_actor", Columns: []string{"actor_id"},
+			RefTable: "actor", RefColumns: []string{"actor_id"},
+		}}},
+	}
+	src := &metadata.Source{Handle: "@test", Tables: []*metadata.Table{actor, filmActor}}
+	metadata.LinkForeignKeys(nil, src)
+	return src.Tables
+}
+
+func TestSourceDiagram(t *testing.T) {
+	got := plantuml.SourceDiagram(testTables())
+	require.Equal(t, `@startuml
+hide circle
+skinparam linetype ortho
+
+entity actor {
+  * actor_id : INTEGER <<PK>>
+  --
+  * first_name : TEXT
+  nickname : text
+}
+
+entity film_actor {
+  * actor_id : INTEGER <<PK,FK>>
+  * film_id : INTEGER <<PK>>
+}
+
+actor ||--o{ film_actor : fk_film_actor_actor
+@enduml
+`, got)
+}
+
+func TestTableDiagram_focused(t *testing.T) {
+	tables := testTables()
+	got := plantuml.TableDiagram(tables[1], plantuml.Index(tables)) // film_actor
+	require.Equal(t, `@startuml
+hide circle
+skinparam linetype ortho
+
+entity film_actor {
+  * actor_id : INTEGER <<PK,FK>>
+  * film_id : INTEGER <<PK>>
+}
+
+entity actor
+
+actor ||--o{ film_actor : fk_film_actor_actor
+@enduml
+`, got)
+}
+
+func TestSourceDiagram_empty(t *testing.T) {
+	require.Equal(t, "", plantuml.SourceDiagram(nil))
+
+	// A column-less table has nothing to draw.
+	src := []*metadata.Table{{Name: "t", TableType: "table"}}
+	require.Equal(t, "", plantuml.SourceDiagram(src))
+}
+
+// TestSourceDiagram_escaping verifies that a table name that isn't a bare
+// identifier is double-quoted (with any embedded quote stripped), and that
+// control characters in names are neutralized, so the emitted source stays
+// one statement per line.
+func TestSourceDiagram_escaping(t *testing.T) {
+	parent := &metadata.Table{
+		Name: `weird "table"`, TableType: "table",
+		Columns: []*metadata.Column{
+			{Name: "zip\ncode", Position: 1, PrimaryKey: true, ColumnType: "TEXT", Kind: kind.Text},
+		},
+	}
+	child := &metadata.Table{
+		Name: "child", TableType: "table",
+		Columns: []*metadata.Column{
+			{Name: "zip", Position: 1, ColumnType: "TEXT", Kind: kind.Text},
+		},
+		FK: &metadata.FKGroup{Outgoing: []*metadata.ForeignKey{{
+			Name: "fk\tweird", Table: "child", Columns: []string{"zip"},
+			RefTable: `weird "table"`, RefColumns: []string{"zip\ncode"},
+		}}},
+	}
+	src := &metadata.Source{Handle: "@q", Tables: []*metadata.Table{parent, child}}
+	metadata.LinkForeignKeys(nil, src)
+	got := plantuml.SourceDiagram(src.Tables)
+
+	require.Contains(t, got, `entity "weird table" {`)
+	require.Contains(t, got, "  * zip code : TEXT <<PK>>\n")
+	require.Contains(t, got, `"weird table" ||--o{ child : fk weird`)
+	require.NotContains(t, got, "zip\ncode")
+	require.NotContains(t, got, "fk\tweird")
+}
+
+// TestCardinalityParity verifies that the PlantUML renderer and the Mermaid
+// renderer agree on cardinality for a range of FK shapes: they share
+// erdmodel.Resolve, and the IE relationship tokens are the same in both.
+func TestCardinalityParity(t *testing.T) {
+	testCases := []struct {
+		name       string
+		childPK    bool // FK column is the child's primary key (=> unique => one-to-one)
+		fkNullable bool // FK column is nullable (=> parent optional)
+		want       string
+	}{
+		{name: "one_to_many", want: "||--o{"},
+		{name: "optional_one_to_many", fkNullable: true, want: "|o--o{"},
+		{name: "one_to_one", childPK: true, want: "||--||"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := &metadata.Table{
+				Name: "parent", TableType: "table",
+				Columns: []*metadata.Column{
+					{Name: "id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+				},
+			}
+			child := &metadata.Table{
+				Name: "child", TableType: "table",
+				Columns: []*metadata.Column{
+					{
+						Name: "parent_id", Position: 1, PrimaryKey: tc.childPK,
+						Nullable: tc.fkNullable, ColumnType: "INTEGER", Kind: kind.Int,
+					},
+				},
+				FK: &metadata.FKGroup{Outgoing: []*metadata.ForeignKey{{
+					Name: "fk_child_parent", Table: "child", Columns: []string{"parent_id"},
+					RefTable: "parent", RefColumns: []string{"id"},
+				}}},
+			}
+			src := &metadata.Source{Handle: "@p", Tables: []*metadata.Table{parent, child}}
+			metadata.LinkForeignKeys(nil, src)
+
+			require.Contains(t, mermaid.SourceDiagram(src.Tables), "parent "+tc.want+" child")
+			require.Contains(t, plantuml.SourceDiagram(src.Tables), "parent "+tc.want+" child")
+		})
+	}
+}
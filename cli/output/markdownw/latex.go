@@ -0,0 +1,131 @@
+package markdownw
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+var (
+	_ markup        = latex{}
+	_ tableStreamer = latex{}
+)
+
+// latex is the markup of the LaTeX writers. The output is a document
+// fragment, for inclusion in a document via \input, that requires only the
+// LaTeX kernel: it uses the tabular environment rather than that of a
+// package such as booktabs or longtable.
+type latex struct{}
+
+// latexReplacer escapes LaTeX special characters. The brackets are braced
+// so that a row beginning with "[" isn't read as the optional argument of
+// the preceding row's "\\". The symbols that sq itself writes, such as "→"
+// and "✓", are replaced by equivalent commands, as the default font
+// encoding doesn't include them.
+var latexReplacer = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`$`, `\$`,
+	`&`, `\&`,
+	`%`, `\%`,
+	`#`, `\#`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`<`, `\textless{}`,
+	`>`, `\textgreater{}`,
+	`[`, `{[}`,
+	`]`, `{]}`,
+	"→", `\ensuremath{\rightarrow}`,
+	"←", `\ensuremath{\leftarrow}`,
+	"·", `\textperiodcentered{}`,
+	"✓", `\ensuremath{\surd}`,
+	"✗", `\ensuremath{\times}`,
+	"\r\n", " ",
+	"\n", " ",
+	"\r", " ",
+)
+
+func (latex) text(s string) string   { return latexReplacer.Replace(s) }
+func (latex) escape(s string) string { return latexReplacer.Replace(s) }
+func (latex) emph(s string) string   { return `\emph{` + s + `}` }
+func (latex) strong(s string) string { return `\textbf{` + s + `}` }
+
+// diagrams returns false: LaTeX has no counterpart of Mermaid.
+func (latex) diagrams() bool { return false }
+
+func (l latex) code(s string) string {
+	if s == "" {
+		return ""
+	}
+	return `\texttt{` + l.escape(s) + `}`
+}
+
+func (l latex) codeCell(s string) string {
+	return l.code(s)
+}
+
+// heading writes a sectioning command: level 1 is \section, through to
+// \paragraph for level 4 and below.
+func (latex) heading(buf *bytes.Buffer, level int, title string) {
+	cmds := []string{`\section`, `\subsection`, `\subsubsection`, `\paragraph`}
+	i := min(max(level, 1), len(cmds)) - 1
+	buf.WriteString(cmds[i] + "{" + title + "}\n\n")
+}
+
+func (latex) codeBlock(buf *bytes.Buffer, _, src string) {
+	buf.WriteString("\\begin{verbatim}\n")
+	buf.WriteString(src)
+	if !strings.HasSuffix(src, "\n") {
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("\\end{verbatim}\n")
+}
+
+func (l latex) provenance(buf *bytes.Buffer, timestamp string) {
+	buf.WriteString(l.emph(l.code("sq inspect")+" @ "+l.code(timestamp)) + "\n\n")
+}
+
+// toc writes nothing: a LaTeX document has \tableofcontents.
+func (latex) toc(*bytes.Buffer, []*metadata.Table) {}
+
+func (l latex) writeTable(buf *bytes.Buffer, t *table) {
+	writeTableStreamed(l, buf, t)
+}
+
+func (l latex) writeTableHead(buf *bytes.Buffer, t *table) {
+	spec := make([]byte, len(t.aligns))
+	for i, a := range t.aligns {
+		spec[i] = 'l'
+		if a == alignCenter {
+			spec[i] = 'c'
+		}
+	}
+
+	headers := make([]string, len(t.headers))
+	for i, h := range t.headers {
+		headers[i] = l.text(h)
+	}
+
+	buf.WriteString("\\begin{tabular}{" + string(spec) + "}\n\\hline\n")
+	writeLaTeXRow(buf, headers)
+	buf.WriteString("\\hline\n")
+}
+
+func (latex) writeTableRows(buf *bytes.Buffer, _ *table, rows [][]string) {
+	for _, row := range rows {
+		writeLaTeXRow(buf, row)
+	}
+}
+
+func (latex) writeTableFoot(buf *bytes.Buffer, _ *table) {
+	buf.WriteString("\\hline\n\\end{tabular}\n")
+}
+
+// writeLaTeXRow writes a single tabular row from cells.
+func writeLaTeXRow(buf *bytes.Buffer, cells []string) {
+	buf.WriteString(strings.Join(cells, " & "))
+	buf.WriteString(" \\\\\n")
+}
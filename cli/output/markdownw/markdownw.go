@@ -1,4 +1,6 @@
-// Package markdownw implements writers for Markdown.
+// Package markdownw implements writers for Markdown. It also implements
+// writers for the LaTeX and reStructuredText markup languages, which share
+// the Markdown writers' document structure, escaping, and table alignment.
 package markdownw
 
 import (
@@ -27,40 +29,45 @@ import (
 type RecordWriter struct {
 	out     io.Writer
 	pr      *output.Printing
+	m       markup
 	buf     *bytes.Buffer
+	tbl     *table
 	recMeta record.Meta
 	mu      sync.Mutex
 }
 
-var _ output.NewRecordWriterFunc = NewRecordWriter
+var (
+	_ output.NewRecordWriterFunc = NewRecordWriter
+	_ output.NewRecordWriterFunc = NewLaTeXRecordWriter
+	_ output.NewRecordWriterFunc = NewRSTRecordWriter
+)
 
-// NewRecordWriter returns a writer instance.
+// NewRecordWriter returns a writer instance that outputs a Markdown table.
 func NewRecordWriter(out io.Writer, pr *output.Printing) output.RecordWriter {
-	return &RecordWriter{out: out, pr: pr}
+	return &RecordWriter{out: out, pr: pr, m: markdown{}}
+}
+
+// NewLaTeXRecordWriter returns a writer instance that outputs a LaTeX
+// tabular environment.
+func NewLaTeXRecordWriter(out io.Writer, pr *output.Printing) output.RecordWriter {
+	return &RecordWriter{out: out, pr: pr, m: latex{}}
+}
+
+// NewRSTRecordWriter returns a writer instance that outputs a
+// reStructuredText grid table. The table is written when the writer is
+// closed, because the width of each column must be known up front.
+func NewRSTRecordWriter(out io.Writer, pr *output.Printing) output.RecordWriter {
+	return &RecordWriter{out: out, pr: pr, m: rst{}}
 }
 
 // Open implements output.RecordWriter.
 func (w *RecordWriter) Open(_ context.Context, recMeta record.Meta) error {
 	w.recMeta = recMeta
 	w.buf = &bytes.Buffer{}
+	w.tbl = newTable(recMeta.MungedNames()...)
 
-	// Write the header
-	for i, field := range recMeta {
-		w.buf.WriteString("| ")
-		w.buf.WriteString(field.MungedName() + " ")
-
-		if i == len(recMeta)-1 {
-			w.buf.WriteString("|\n")
-		}
-	}
-
-	// Write the header separator row
-	for i := range recMeta {
-		w.buf.WriteString("| --- ")
-
-		if i == len(recMeta)-1 {
-			w.buf.WriteString("|\n")
-		}
+	if ts, ok := w.m.(tableStreamer); ok {
+		ts.writeTableHead(w.buf, w.tbl)
 	}
 
 	return nil
@@ -74,33 +81,37 @@ func (w *RecordWriter) Flush(context.Context) error {
 
 // Close implements output.RecordWriter.
 func (w *RecordWriter) Close(ctx context.Context) error {
+	if ts, ok := w.m.(tableStreamer); ok {
+		ts.writeTableFoot(w.buf, w.tbl)
+	} else {
+		w.m.writeTable(w.buf, w.tbl)
+	}
 	return w.Flush(ctx)
 }
 
-func (w *RecordWriter) writeRecord(rec record.Record) error { //nolint:unparam
-	var s string
+func (w *RecordWriter) renderRecord(rec record.Record) []string {
+	cells := make([]string, len(rec))
 	for i, field := range rec {
-		w.buf.WriteString("| ")
-
+		var s string
 		switch val := field.(type) {
 		default:
 			// should never happen
-			s = escapeMarkdown(fmt.Sprintf("%v", val))
+			s = w.m.escape(fmt.Sprintf("%v", val))
 
 		case nil:
-			// nil is rendered as empty string, which this cell already is
-		case int64:
-			s = strconv.FormatInt(val, 10)
+			// nil is rendered as empty string
 		case string:
-			s = escapeMarkdown(val)
+			s = w.m.escape(val)
+		case int64:
+			s = w.m.text(strconv.FormatInt(val, 10))
 		case decimal.Decimal:
-			s = stringz.FormatDecimal(val)
+			s = w.m.text(stringz.FormatDecimal(val))
 		case bool:
-			s = strconv.FormatBool(val)
+			s = w.m.text(strconv.FormatBool(val))
 		case float64:
-			s = stringz.FormatFloat(val)
+			s = w.m.text(stringz.FormatFloat(val))
 		case []byte:
-			s = base64.StdEncoding.EncodeToString(val)
+			s = w.m.text(base64.StdEncoding.EncodeToString(val))
 		case time.Time:
 			switch w.recMeta[i].Kind() { //nolint:exhaustive
 			default:
@@ -110,13 +121,13 @@ func (w *RecordWriter) writeRecord(rec record.Record) error { //nolint:unparam
 			case kind.Date:
 				s = w.pr.FormatDate(val)
 			}
+			s = w.m.text(s)
 		}
 
-		w.buf.WriteString(s + " ")
+		cells[i] = s
 	}
 
-	w.buf.WriteString("|\n")
-	return nil
+	return cells
 }
 
 // WriteRecords implements output.RecordWriter.
@@ -124,16 +135,19 @@ func (w *RecordWriter) WriteRecords(ctx context.Context, recs []record.Record) e
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	var err error
+	ts, streaming := w.m.(tableStreamer)
 	for _, rec := range recs {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
 		}
-		err = w.writeRecord(rec)
-		if err != nil {
-			return err
+
+		cells := w.renderRecord(rec)
+		if streaming {
+			ts.writeTableRows(w.buf, w.tbl, [][]string{cells})
+		} else {
+			w.tbl.addRow(cells...)
 		}
 	}
 
@@ -151,12 +165,45 @@ func escapeMarkdown(s string) string {
 	return s
 }
 
-// writeTablesTOC writes a compact one-line table of contents: a middot-
-// separated list of links to each table/view section (in the given order).
-// The link targets use mdAnchor so they match the heading anchors that
-// Markdown renderers (e.g. GitHub) auto-generate from the `### `+"`name`"
+var (
+	_ markup        = markdown{}
+	_ tableStreamer = markdown{}
+)
+
+// markdown is the markup of the Markdown writers.
+type markdown struct{}
+
+func (markdown) text(s string) string     { return s }
+func (markdown) escape(s string) string   { return escapeMarkdown(s) }
+func (markdown) code(s string) string     { return mdCode(s) }
+func (markdown) codeCell(s string) string { return mdCodeCell(s) }
+func (markdown) emph(s string) string     { return "_" + s + "_" }
+func (markdown) strong(s string) string   { return "**" + s + "**" }
+func (markdown) diagrams() bool           { return true }
+
+func (markdown) heading(buf *bytes.Buffer, level int, title string) {
+	fmt.Fprintf(buf, "%s %s\n\n", strings.Repeat("#", level), title)
+}
+
+func (markdown) codeBlock(buf *bytes.Buffer, lang, src string) {
+	buf.WriteString("```" + lang + "\n")
+	buf.WriteString(src)
+	if !strings.HasSuffix(src, "\n") {
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("```\n")
+}
+
+func (markdown) provenance(buf *bytes.Buffer, timestamp string) {
+	fmt.Fprintf(buf, "*[`sq inspect`](https://sq.io/docs/inspect) @ `%s`*\n\n", timestamp)
+}
+
+// toc writes a compact one-line table of contents: a middot-separated list
+// of links to each table/view section (in the given order). The link
+// targets use mdAnchor so they match the heading anchors that Markdown
+// renderers (e.g. GitHub) auto-generate from the `### `+"`name`"
 // headings.
-func writeTablesTOC(buf *bytes.Buffer, tables []*metadata.Table) {
+func (markdown) toc(buf *bytes.Buffer, tables []*metadata.Table) {
 	links := make([]string, len(tables))
 	for i, tbl := range tables {
 		link := fmt.Sprintf("[%s](#%s)", mdCode(tbl.Name), mdAnchor(tbl.Name))
@@ -169,6 +216,37 @@ func writeTablesTOC(buf *bytes.Buffer, tables []*metadata.Table) {
 	buf.WriteString(strings.Join(links, " · ") + "\n")
 }
 
+func (m markdown) writeTable(buf *bytes.Buffer, t *table) {
+	writeTableStreamed(m, buf, t)
+}
+
+func (markdown) writeTableHead(buf *bytes.Buffer, t *table) {
+	writeTableRow(buf, t.headers...)
+	seps := make([]string, len(t.aligns))
+	for i, a := range t.aligns {
+		seps[i] = "---"
+		if a == alignCenter {
+			seps[i] = ":---:"
+		}
+	}
+	writeTableRow(buf, seps...)
+}
+
+func (markdown) writeTableRows(buf *bytes.Buffer, _ *table, rows [][]string) {
+	for _, row := range rows {
+		writeTableRow(buf, row...)
+	}
+}
+
+func (markdown) writeTableFoot(*bytes.Buffer, *table) {}
+
+// writeTableRow writes a single Markdown table row from cells.
+func writeTableRow(buf *bytes.Buffer, cells ...string) {
+	buf.WriteString("| ")
+	buf.WriteString(strings.Join(cells, " | "))
+	buf.WriteString(" |\n")
+}
+
 // mdAnchor returns the heading anchor a Markdown renderer generates for a
 // table heading (`### `+"`name`"): lower-cased, with characters outside
 // [a-z0-9_-] dropped and spaces turned into hyphens. For ordinary snake_case
@@ -9,6 +9,7 @@ import (
 
 	"github.com/neilotoole/sq/cli/output"
 	"github.com/neilotoole/sq/cli/output/markdownw"
+	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/testh"
 	"github.com/neilotoole/sq/testh/sakila"
 )
@@ -51,3 +52,76 @@ func TestRecordWriter(t *testing.T) {
 		})
 	}
 }
+
+func TestLaTeXRecordWriter(t *testing.T) {
+	const want = `\begin{tabular}{llll}
+\hline
+actor\_id & first\_name & last\_name & last\_update \\
+\hline
+1 & PENELOPE & GUINESS & 2006-02-15T04:34:33Z \\
+2 & NICK & WAHLBERG & 2006-02-15T04:34:33Z \\
+3 & R\&D {[}50\%{]} & \textbackslash{}o/ & 2006-02-15T04:34:33Z \\
+\hline
+\end{tabular}
+`
+
+	ctx := context.Background()
+	recMeta, recs := testh.RecordsFromTbl(t, sakila.CSVActor, source.MonotableName)
+	recs = recs[0:3]
+	recs[2][1], recs[2][2] = "R&D [50%]", `\o/`
+
+	buf := &bytes.Buffer{}
+	w := markdownw.NewLaTeXRecordWriter(buf, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.NoError(t, w.WriteRecords(ctx, recs))
+	require.NoError(t, w.Close(ctx))
+	require.Equal(t, want, buf.String())
+}
+
+func TestRSTRecordWriter(t *testing.T) {
+	const (
+		want0 = `+----------+------------+-----------+-------------+
+| actor_id | first_name | last_name | last_update |
++----------+------------+-----------+-------------+
+`
+		want3 = `+----------+---------------+-----------+----------------------+
+| actor_id | first_name    | last_name | last_update          |
++==========+===============+===========+======================+
+| 1        | PENELOPE      | GUINESS   | 2006-02-15T04:34:33Z |
++----------+---------------+-----------+----------------------+
+| 2        | NICK          | WAHLBERG  | 2006-02-15T04:34:33Z |
++----------+---------------+-----------+----------------------+
+| 3        | \*ED\* name\_ | \- CHASE  | 2006-02-15T04:34:33Z |
++----------+---------------+-----------+----------------------+
+`
+	)
+
+	testCases := []struct {
+		name    string
+		numRecs int
+		want    string
+	}{
+		{name: "actor_0", numRecs: 0, want: want0},
+		{name: "actor_3", numRecs: 3, want: want3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			recMeta, recs := testh.RecordsFromTbl(t, sakila.CSVActor, source.MonotableName)
+			recs = recs[0:tc.numRecs]
+			if tc.numRecs == 3 {
+				recs[2][1], recs[2][2] = "*ED* name_", "- CHASE"
+			}
+
+			buf := &bytes.Buffer{}
+			w := markdownw.NewRSTRecordWriter(buf, output.NewPrinting())
+			require.NoError(t, w.Open(ctx, recMeta))
+			require.NoError(t, w.WriteRecords(ctx, recs))
+			require.NoError(t, w.Flush(ctx))
+			require.Empty(t, buf.String(), "table should only be written on close")
+			require.NoError(t, w.Close(ctx))
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
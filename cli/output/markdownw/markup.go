@@ -0,0 +1,121 @@
+package markdownw
+
+import (
+	"bytes"
+
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// markup is a lightweight markup language, such as Markdown, LaTeX, or
+// reStructuredText, in which the writers of this package render their
+// output. The writers build the document structure (headings, tables, code
+// blocks); the markup supplies the syntax and escaping.
+type markup interface {
+	// text escapes literal text, such as a heading or a label, that's
+	// written by sq itself.
+	text(s string) string
+
+	// escape escapes arbitrary data, such as a record field or a comment,
+	// for use as paragraph text or as a table cell.
+	escape(s string) string
+
+	// code renders s as an inline-code span. An empty s yields "".
+	code(s string) string
+
+	// codeCell is like code, but for use in a table cell.
+	codeCell(s string) string
+
+	// emph renders already-escaped s in italics.
+	emph(s string) string
+
+	// strong renders already-escaped s in bold.
+	strong(s string) string
+
+	// heading writes a section heading at level, where level 1 is the
+	// document title. The title is already rendered.
+	heading(buf *bytes.Buffer, level int, title string)
+
+	// codeBlock writes src as a code block in language lang, e.g. "sql".
+	codeBlock(buf *bytes.Buffer, lang, src string)
+
+	// diagrams reports whether the markup can embed a Mermaid diagram via
+	// codeBlock with lang "mermaid".
+	diagrams() bool
+
+	// provenance writes the "sq inspect @ timestamp" line that follows a
+	// document title.
+	provenance(buf *bytes.Buffer, timestamp string)
+
+	// toc writes a compact table of contents, linking to the heading of
+	// each of tables. It may write nothing.
+	toc(buf *bytes.Buffer, tables []*metadata.Table)
+
+	// writeTable writes t in its entirety.
+	writeTable(buf *bytes.Buffer, t *table)
+}
+
+// tableStreamer is implemented by a markup whose tables can be written
+// incrementally, so that RecordWriter needn't hold every record in memory.
+// A markup that doesn't implement tableStreamer (e.g. reStructuredText,
+// whose grid tables require the width of every cell up front) has its
+// record output written by markup.writeTable when the writer is closed.
+type tableStreamer interface {
+	// writeTableHead writes the start of t, up to its first row.
+	writeTableHead(buf *bytes.Buffer, t *table)
+
+	// writeTableRows writes rows of t.
+	writeTableRows(buf *bytes.Buffer, t *table, rows [][]string)
+
+	// writeTableFoot writes the end of t, after its last row.
+	writeTableFoot(buf *bytes.Buffer, t *table)
+}
+
+// align is the horizontal alignment of a table column.
+type align int
+
+const (
+	alignLeft align = iota
+	alignCenter
+)
+
+// table is a table whose cells are already rendered by the markup. Its
+// headers, however, are literal text: markup.writeTable escapes them via
+// markup.text.
+type table struct {
+	headers []string
+	aligns  []align
+	rows    [][]string
+}
+
+// newTable returns a new table with the given headers, each column aligned
+// left.
+func newTable(headers ...string) *table {
+	return &table{headers: headers, aligns: make([]align, len(headers))}
+}
+
+// center centers the columns at indices cols.
+func (t *table) center(cols ...int) *table {
+	for _, i := range cols {
+		t.aligns[i] = alignCenter
+	}
+	return t
+}
+
+// addColumn appends a column with header and alignment a.
+func (t *table) addColumn(header string, a align) {
+	t.headers = append(t.headers, header)
+	t.aligns = append(t.aligns, a)
+}
+
+// addRow appends a row of rendered cells.
+func (t *table) addRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// writeTableStreamed writes t via the head, rows, and foot methods of ts.
+// It's the implementation of markup.writeTable for a tableStreamer.
+func writeTableStreamed(ts tableStreamer, buf *bytes.Buffer, t *table) {
+	ts.writeTableHead(buf, t)
+	ts.writeTableRows(buf, t, t.rows)
+	ts.writeTableFoot(buf, t)
+}
@@ -23,20 +23,37 @@ import (
 
 var _ output.MetadataWriter = (*metadataWriter)(nil)
 
-// metadataWriter implements output.MetadataWriter for Markdown. It
-// renders source and table metadata as a Markdown document, including
-// a Mermaid.js entity-relationship diagram. The type is named
-// metadataWriter (not the "mdWriter" used by the other format packages)
-// to avoid conflating "md" = metadata with "md" = Markdown.
+// metadataWriter implements output.MetadataWriter for Markdown (and for
+// LaTeX and reStructuredText). It renders source and table metadata as a
+// document, including a Mermaid.js entity-relationship diagram where the
+// markup supports it. The type is named metadataWriter (not the
+// "mdWriter" used by the other format packages) to avoid conflating
+// "md" = metadata with "md" = Markdown.
 type metadataWriter struct {
 	out io.Writer
 	pr  *output.Printing
+	m   markup
 }
 
 // NewMetadataWriter returns a new output.MetadataWriter instance that
 // outputs metadata as a Markdown document.
 func NewMetadataWriter(out io.Writer, pr *output.Printing) output.MetadataWriter {
-	return &metadataWriter{out: out, pr: pr}
+	return &metadataWriter{out: out, pr: pr, m: markdown{}}
+}
+
+// NewLaTeXMetadataWriter returns a new output.MetadataWriter instance that
+// outputs metadata as a LaTeX document fragment, suitable for \input. The
+// fragment has no entity-relationship diagram.
+func NewLaTeXMetadataWriter(out io.Writer, pr *output.Printing) output.MetadataWriter {
+	return &metadataWriter{out: out, pr: pr, m: latex{}}
+}
+
+// NewRSTMetadataWriter returns a new output.MetadataWriter instance that
+// outputs metadata as a reStructuredText document. The entity-relationship
+// diagram is rendered via the "mermaid" directive of the
+// sphinxcontrib-mermaid Sphinx extension.
+func NewRSTMetadataWriter(out io.Writer, pr *output.Printing) output.MetadataWriter {
+	return &metadataWriter{out: out, pr: pr, m: rst{}}
 }
 
 // SourceMetadata implements output.MetadataWriter.
@@ -61,11 +78,12 @@ func (w *metadataWriter) SourceMetadata(md *metadata.Source, showSchema bool) er
 			if commonw.HasViews(tables) {
 				tablesTitle = "Tables & views"
 			}
-			fmt.Fprintf(buf, "\n## %s\n\n", tablesTitle)
-			writeTablesTOC(buf, tables)
+			buf.WriteString("\n")
+			w.m.heading(buf, 2, w.m.text(tablesTitle))
+			w.m.toc(buf, tables)
 			for _, tbl := range tables {
 				buf.WriteString("\n")
-				writeTableHeading(buf, tbl, 3)
+				w.writeTableHeading(buf, tbl, 3)
 				w.writeTableERD(buf, tbl, 4, byName)
 				w.writeTableBody(buf, tbl)
 			}
@@ -79,7 +97,7 @@ func (w *metadataWriter) SourceMetadata(md *metadata.Source, showSchema bool) er
 // TableMetadata implements output.MetadataWriter.
 func (w *metadataWriter) TableMetadata(md *metadata.Table) error {
 	buf := &bytes.Buffer{}
-	writeTableHeading(buf, md, 1)
+	w.writeTableHeading(buf, md, 1)
 	w.writeProvenance(buf)
 	w.writeTableERD(buf, md, 2, nil)
 	w.writeTableBody(buf, md)
@@ -88,7 +106,7 @@ func (w *metadataWriter) TableMetadata(md *metadata.Table) error {
 	return err
 }
 
-// writeProvenance writes an italic provenance line under the document title,
+// writeProvenance writes a provenance line under the document title,
 // "`sq inspect` @ `<timestamp>`" (the command linked to its docs), when the
 // Printing carries a generation timestamp (set by the CLI on every real run;
 // absent in unit tests, so goldens stay deterministic). The timestamp is
@@ -97,8 +115,7 @@ func (w *metadataWriter) writeProvenance(buf *bytes.Buffer) {
 	if w.pr.GeneratedAt.IsZero() {
 		return
 	}
-	fmt.Fprintf(buf, "*[`sq inspect`](https://sq.io/docs/inspect) @ `%s`*\n\n",
-		w.pr.GeneratedAt.Format(time.RFC3339))
+	w.m.provenance(buf, w.pr.GeneratedAt.Format(time.RFC3339))
 }
 
 // DBProperties implements output.MetadataWriter.
@@ -107,9 +124,7 @@ func (w *metadataWriter) DBProperties(props map[string]any) error {
 		return nil
 	}
 
-	buf := &bytes.Buffer{}
-	buf.WriteString("| Property | Value |\n| --- | --- |\n")
-
+	t := newTable("Property", "Value")
 	keys := lo.Keys(props)
 	slices.Sort(keys)
 	for _, k := range keys {
@@ -120,11 +135,10 @@ func (w *metadataWriter) DBProperties(props map[string]any) error {
 		// Most properties are scalars; nested values (maps/slices) are
 		// rendered compactly via %v. YAML/JSON output is the better
 		// choice for deeply nested DB properties.
-		writeTableRow(buf, escapeMarkdown(k), escapeMarkdown(fmt.Sprintf("%v", v)))
+		t.addRow(w.m.escape(k), w.m.escape(fmt.Sprintf("%v", v)))
 	}
 
-	_, err := buf.WriteTo(w.out)
-	return err
+	return w.writeTable(t)
 }
 
 // DriverMetadata implements output.MetadataWriter.
@@ -133,19 +147,16 @@ func (w *metadataWriter) DriverMetadata(drvrs []driver.Metadata) error {
 		return nil
 	}
 
-	buf := &bytes.Buffer{}
-	buf.WriteString("| Driver | Description | User-defined |\n| --- | --- | :---: |\n")
+	t := newTable("Driver", "Description", "User-defined").center(2)
 	for _, md := range drvrs {
-		writeTableRow(
-			buf,
-			escapeMarkdown(string(md.Type)),
-			escapeMarkdown(md.Description),
-			yesNo(md.UserDefined),
+		t.addRow(
+			w.m.escape(string(md.Type)),
+			w.m.escape(md.Description),
+			w.m.text(yesNo(md.UserDefined)),
 		)
 	}
 
-	_, err := buf.WriteTo(w.out)
-	return err
+	return w.writeTable(t)
 }
 
 // Catalogs implements output.MetadataWriter.
@@ -154,18 +165,12 @@ func (w *metadataWriter) Catalogs(currentCatalog string, catalogs []string) erro
 		return nil
 	}
 
-	buf := &bytes.Buffer{}
-	buf.WriteString("| Catalog | Active |\n| --- | :---: |\n")
+	t := newTable("Catalog", "Active").center(1)
 	for _, c := range catalogs {
-		var active string
-		if c == currentCatalog {
-			active = "✓"
-		}
-		writeTableRow(buf, escapeMarkdown(c), active)
+		t.addRow(w.m.escape(c), w.m.text(checkMark(c == currentCatalog)))
 	}
 
-	_, err := buf.WriteTo(w.out)
-	return err
+	return w.writeTable(t)
 }
 
 // Schemata implements output.MetadataWriter.
@@ -174,16 +179,23 @@ func (w *metadataWriter) Schemata(currentSchema string, schemas []*metadata.Sche
 		return nil
 	}
 
-	buf := &bytes.Buffer{}
-	buf.WriteString("| Schema | Catalog | Owner | Active |\n| --- | --- | --- | :---: |\n")
+	t := newTable("Schema", "Catalog", "Owner", "Active").center(3)
 	for _, s := range schemas {
-		var active string
-		if s.Name == currentSchema {
-			active = "✓"
-		}
-		writeTableRow(buf, escapeMarkdown(s.Name), escapeMarkdown(s.Catalog), escapeMarkdown(s.Owner), active)
+		t.addRow(
+			w.m.escape(s.Name),
+			w.m.escape(s.Catalog),
+			w.m.escape(s.Owner),
+			w.m.text(checkMark(s.Name == currentSchema)),
+		)
 	}
 
+	return w.writeTable(t)
+}
+
+// writeTable writes t to w.out.
+func (w *metadataWriter) writeTable(t *table) error {
+	buf := &bytes.Buffer{}
+	w.m.writeTable(buf, t)
 	_, err := buf.WriteTo(w.out)
 	return err
 }
@@ -193,7 +205,7 @@ func (w *metadataWriter) Schemata(currentSchema string, schemas []*metadata.Sche
 // showSchema is true; in overview mode they aren't populated (matching
 // the text and YAML writers, which also omit them there).
 func (w *metadataWriter) writeSourceOverview(buf *bytes.Buffer, md *metadata.Source, showSchema bool) {
-	fmt.Fprintf(buf, "# %s\n\n", md.Handle)
+	w.m.heading(buf, 1, w.m.text(md.Handle))
 	w.writeProvenance(buf)
 
 	loc := md.Location
@@ -201,29 +213,30 @@ func (w *metadataWriter) writeSourceOverview(buf *bytes.Buffer, md *metadata.Sou
 		loc = location.Redact(loc)
 	}
 
-	buf.WriteString("| Property | Value |\n| --- | --- |\n")
-	writeKVRow(buf, "Name", md.Name)
+	t := newTable("Property", "Value")
+	w.addKVRow(t, "Name", md.Name)
 	if md.FQName != "" && md.FQName != md.Name {
-		writeKVRow(buf, "FQ name", md.FQName)
-	}
-	writeKVRow(buf, "Driver", md.Driver.String())
-	writeKVRow(buf, "DB product", md.DBProduct)
-	writeKVRow(buf, "DB version", md.DBVersion)
-	writeKVRow(buf, "DB semver", md.DBSemver)
-	writeKVRow(buf, "Schema", md.Schema)
-	writeKVRow(buf, "Catalog", md.Catalog)
-	writeKVRow(buf, "Size", stringz.FormatSize(md.Size))
+		w.addKVRow(t, "FQ name", md.FQName)
+	}
+	w.addKVRow(t, "Driver", md.Driver.String())
+	w.addKVRow(t, "DB product", md.DBProduct)
+	w.addKVRow(t, "DB version", md.DBVersion)
+	w.addKVRow(t, "DB semver", md.DBSemver)
+	w.addKVRow(t, "Schema", md.Schema)
+	w.addKVRow(t, "Catalog", md.Catalog)
+	w.addKVRow(t, "Size", stringz.FormatSize(md.Size))
 	if showSchema {
-		writeKVRow(buf, "Tables", strconv.FormatInt(md.TableCount, 10))
-		writeKVRow(buf, "Views", strconv.FormatInt(md.ViewCount, 10))
+		w.addKVRow(t, "Tables", strconv.FormatInt(md.TableCount, 10))
+		w.addKVRow(t, "Views", strconv.FormatInt(md.ViewCount, 10))
 	}
-	writeKVRow(buf, "Location", loc)
+	w.addKVRow(t, "Location", loc)
+	w.m.writeTable(buf, t)
 }
 
-// writeTableHeading writes a heading (at the given '#' level) for tbl,
+// writeTableHeading writes a heading (at the given level) for tbl,
 // followed by a one-line summary and the table comment (if any).
-func writeTableHeading(buf *bytes.Buffer, tbl *metadata.Table, level int) {
-	fmt.Fprintf(buf, "%s %s\n\n", strings.Repeat("#", level), mdCode(tbl.Name))
+func (w *metadataWriter) writeTableHeading(buf *bytes.Buffer, tbl *metadata.Table, level int) {
+	w.m.heading(buf, level, w.m.code(tbl.Name))
 
 	typ := tbl.TableType
 	if typ == "" {
@@ -233,10 +246,10 @@ func writeTableHeading(buf *bytes.Buffer, tbl *metadata.Table, level int) {
 	if tbl.Size != nil {
 		summary += " · " + stringz.ByteSized(*tbl.Size, 1, "")
 	}
-	buf.WriteString(summary + "\n")
+	buf.WriteString(w.m.text(summary) + "\n")
 
 	if tbl.Comment != "" {
-		fmt.Fprintf(buf, "\n_%s_\n", escapeMarkdown(tbl.Comment))
+		buf.WriteString("\n" + w.m.emph(w.m.escape(tbl.Comment)) + "\n")
 	}
 }
 
@@ -272,58 +285,51 @@ func (w *metadataWriter) writeColumns(buf *bytes.Buffer, tbl *metadata.Table) {
 		hasCollation = hasCollation || col.Collation != ""
 	}
 
-	headers := []string{"Column", "Type", "Nullable", "PK", "FK"}
-	aligns := []string{"---", "---", ":---:", ":---:", ":---:"}
+	t := newTable("Column", "Type", "Nullable", "PK", "FK").center(2, 3, 4)
 	if hasAuto {
-		headers = append(headers, "Auto")
-		aligns = append(aligns, "---")
+		t.addColumn("Auto", alignLeft)
 	}
 	if hasGeneratedExpr {
-		headers = append(headers, "Generated Expr")
-		aligns = append(aligns, "---")
+		t.addColumn("Generated Expr", alignLeft)
 	}
 	if hasCollation {
-		headers = append(headers, "Collation")
-		aligns = append(aligns, "---")
+		t.addColumn("Collation", alignLeft)
 	}
 	if hasDefault {
-		headers = append(headers, "Default")
-		aligns = append(aligns, "---")
+		t.addColumn("Default", alignLeft)
 	}
 	if hasComment {
-		headers = append(headers, "Comment")
-		aligns = append(aligns, "---")
+		t.addColumn("Comment", alignLeft)
 	}
 
-	buf.WriteString("\n")
-	writeTableRow(buf, headers...)
-	writeTableRow(buf, aligns...)
-
 	for _, col := range tbl.Columns {
 		cells := []string{
-			mdCodeCell(col.Name),
-			mdCodeCell(col.ColumnType),
-			checkMark(col.Nullable),
-			checkMark(col.PrimaryKey),
-			checkMark(fkCols[col.Name]),
+			w.m.codeCell(col.Name),
+			w.m.codeCell(col.ColumnType),
+			w.m.text(checkMark(col.Nullable)),
+			w.m.text(checkMark(col.PrimaryKey)),
+			w.m.text(checkMark(fkCols[col.Name])),
 		}
 		if hasAuto {
-			cells = append(cells, commonw.ColumnAutoLabel(col))
+			cells = append(cells, w.m.text(commonw.ColumnAutoLabel(col)))
 		}
 		if hasGeneratedExpr {
-			cells = append(cells, mdCodeCell(col.GeneratedExpr))
+			cells = append(cells, w.m.codeCell(col.GeneratedExpr))
 		}
 		if hasCollation {
-			cells = append(cells, escapeMarkdown(col.Collation))
+			cells = append(cells, w.m.escape(col.Collation))
 		}
 		if hasDefault {
-			cells = append(cells, mdCodeCell(col.DefaultValue))
+			cells = append(cells, w.m.codeCell(col.DefaultValue))
 		}
 		if hasComment {
-			cells = append(cells, escapeMarkdown(col.Comment))
+			cells = append(cells, w.m.escape(col.Comment))
 		}
-		writeTableRow(buf, cells...)
+		t.addRow(cells...)
 	}
+
+	buf.WriteString("\n")
+	w.m.writeTable(buf, t)
 }
 
 func (w *metadataWriter) writeForeignKeys(buf *bytes.Buffer, tbl *metadata.Table) {
@@ -332,17 +338,18 @@ func (w *metadataWriter) writeForeignKeys(buf *bytes.Buffer, tbl *metadata.Table
 		return
 	}
 
-	buf.WriteString("\n**Foreign keys:**\n\n")
-	writeTableRow(buf, "Relationship (→ references · ← referenced by)", "Constraint", "On update", "On delete")
-	writeTableRow(buf, "---", "---", "---", "---")
+	t := newTable("Relationship (→ references · ← referenced by)", "Constraint", "On update", "On delete")
 	for _, r := range rows {
 		arrow := "→"
 		if r.Direction == "incoming" {
 			arrow = "←"
 		}
-		rel := mdCodeCell(r.Local) + " " + arrow + " " + mdCodeCell(r.Remote)
-		writeTableRow(buf, rel, mdCodeCell(r.Constraint), r.OnUpdate, r.OnDelete)
+		rel := w.m.codeCell(r.Local) + " " + w.m.text(arrow) + " " + w.m.codeCell(r.Remote)
+		t.addRow(rel, w.m.codeCell(r.Constraint), w.m.text(r.OnUpdate), w.m.text(r.OnDelete))
 	}
+
+	w.writeLabel(buf, "Foreign keys:")
+	w.m.writeTable(buf, t)
 }
 
 func (w *metadataWriter) writeUniqueConstraints(buf *bytes.Buffer, tbl *metadata.Table) {
@@ -351,12 +358,13 @@ func (w *metadataWriter) writeUniqueConstraints(buf *bytes.Buffer, tbl *metadata
 		return
 	}
 
-	buf.WriteString("\n**Unique constraints:**\n\n")
-	writeTableRow(buf, "Constraint", "Columns")
-	writeTableRow(buf, "---", "---")
+	t := newTable("Constraint", "Columns")
 	for _, r := range rows {
-		writeTableRow(buf, mdCodeCell(r.Name), mdCodeCell(r.Columns))
+		t.addRow(w.m.codeCell(r.Name), w.m.codeCell(r.Columns))
 	}
+
+	w.writeLabel(buf, "Unique constraints:")
+	w.m.writeTable(buf, t)
 }
 
 func (w *metadataWriter) writeIndexes(buf *bytes.Buffer, tbl *metadata.Table) {
@@ -365,33 +373,29 @@ func (w *metadataWriter) writeIndexes(buf *bytes.Buffer, tbl *metadata.Table) {
 		return
 	}
 
-	buf.WriteString("\n**Indexes:**\n\n")
-	writeTableRow(buf, "Index", "Columns", "Unique", "Primary", "Type")
-	writeTableRow(buf, "---", "---", ":---:", ":---:", "---")
+	t := newTable("Index", "Columns", "Unique", "Primary", "Type").center(2, 3)
 	for _, r := range rows {
-		writeTableRow(
-			buf,
-			mdCodeCell(r.Name),
-			mdCodeCell(r.Columns),
-			checkMark(r.Unique),
-			checkMark(r.Primary),
-			r.Type,
+		t.addRow(
+			w.m.codeCell(r.Name),
+			w.m.codeCell(r.Columns),
+			w.m.text(checkMark(r.Unique)),
+			w.m.text(checkMark(r.Primary)),
+			w.m.text(r.Type),
 		)
 	}
+
+	w.writeLabel(buf, "Indexes:")
+	w.m.writeTable(buf, t)
 }
 
-// writeViewDefinition renders the raw view DDL as a fenced SQL code block
+// writeViewDefinition renders the raw view DDL as a SQL code block
 // when tbl is a view/materialized_view with a non-empty ViewDefinition.
 func (w *metadataWriter) writeViewDefinition(buf *bytes.Buffer, tbl *metadata.Table) {
 	if tbl.ViewDefinition == "" {
 		return
 	}
-	buf.WriteString("\n**View definition:**\n\n```sql\n")
-	buf.WriteString(tbl.ViewDefinition)
-	if !strings.HasSuffix(tbl.ViewDefinition, "\n") {
-		buf.WriteByte('\n')
-	}
-	buf.WriteString("```\n")
+	w.writeLabel(buf, "View definition:")
+	w.m.codeBlock(buf, "sql", tbl.ViewDefinition)
 }
 
 // writeCheckConstraints renders a "Check constraints" subsection (table of
@@ -401,12 +405,13 @@ func (w *metadataWriter) writeCheckConstraints(buf *bytes.Buffer, tbl *metadata.
 		return
 	}
 
-	buf.WriteString("\n**Check constraints:**\n\n")
-	writeTableRow(buf, "Constraint", "Clause")
-	writeTableRow(buf, "---", "---")
+	t := newTable("Constraint", "Clause")
 	for _, cc := range tbl.CheckConstraints {
-		writeTableRow(buf, mdCodeCell(cc.Name), escapeMarkdown(cc.Clause))
+		t.addRow(w.m.codeCell(cc.Name), w.m.escape(cc.Clause))
 	}
+
+	w.writeLabel(buf, "Check constraints:")
+	w.m.writeTable(buf, t)
 }
 
 // writeTriggers renders a "Triggers" subsection (name, timing, events,
@@ -425,34 +430,31 @@ func (w *metadataWriter) writeTriggers(buf *bytes.Buffer, tbl *metadata.Table) {
 		hasDefinition = hasDefinition || tr.Definition != ""
 	}
 
-	buf.WriteString("\n**Triggers:**\n\n")
-	headers := []string{"Trigger", "Timing", "Events"}
-	aligns := []string{"---", "---", "---"}
+	t := newTable("Trigger", "Timing", "Events")
 	if hasEnabled {
-		headers = append(headers, "Enabled")
-		aligns = append(aligns, ":---:")
+		t.addColumn("Enabled", alignCenter)
 	}
 	if hasDefinition {
-		headers = append(headers, "Definition")
-		aligns = append(aligns, "---")
+		t.addColumn("Definition", alignLeft)
 	}
-	writeTableRow(buf, headers...)
-	writeTableRow(buf, aligns...)
 
 	for _, tr := range tbl.Triggers {
 		cells := []string{
-			mdCodeCell(tr.Name),
-			escapeMarkdown(tr.Timing),
-			escapeMarkdown(strings.Join(tr.Events, ", ")),
+			w.m.codeCell(tr.Name),
+			w.m.escape(tr.Timing),
+			w.m.escape(strings.Join(tr.Events, ", ")),
 		}
 		if hasEnabled {
-			cells = append(cells, commonw.TriggerEnabledMark(tr.Enabled))
+			cells = append(cells, w.m.text(commonw.TriggerEnabledMark(tr.Enabled)))
 		}
 		if hasDefinition {
-			cells = append(cells, mdCodeCell(tr.Definition))
+			cells = append(cells, w.m.codeCell(tr.Definition))
 		}
-		writeTableRow(buf, cells...)
+		t.addRow(cells...)
 	}
+
+	w.writeLabel(buf, "Triggers:")
+	w.m.writeTable(buf, t)
 }
 
 func compareTables(a, b *metadata.Table) int {
@@ -462,20 +464,19 @@ func compareTables(a, b *metadata.Table) int {
 	return cmp.Compare(a.TableType, b.TableType)
 }
 
-// writeKVRow writes a "| key | value |" row, skipping empty values. The value
-// is rendered as inline code (the key is a plain label).
-func writeKVRow(buf *bytes.Buffer, k, v string) {
+// addKVRow adds a "| key | value |" row to t, skipping empty values. The
+// value is rendered as inline code (the key is a plain label).
+func (w *metadataWriter) addKVRow(t *table, k, v string) {
 	if v == "" {
 		return
 	}
-	writeTableRow(buf, k, mdCodeCell(v))
+	t.addRow(w.m.text(k), w.m.codeCell(v))
 }
 
-// writeTableRow writes a single Markdown table row from cells.
-func writeTableRow(buf *bytes.Buffer, cells ...string) {
-	buf.WriteString("| ")
-	buf.WriteString(strings.Join(cells, " | "))
-	buf.WriteString(" |\n")
+// writeLabel writes a bold label, such as "Indexes:", that introduces the
+// table or code block that follows it.
+func (w *metadataWriter) writeLabel(buf *bytes.Buffer, label string) {
+	buf.WriteString("\n" + w.m.strong(w.m.text(label)) + "\n\n")
 }
 
 func yesNo(b bool) string {
@@ -2,6 +2,7 @@ package markdownw_test
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"time"
 
@@ -574,3 +575,100 @@ func TestMetadataWriter_mermaidQuoting(t *testing.T) {
 	require.NotContains(t, got, `"id col"`)
 	require.NotContains(t, got, `"zip code"`)
 }
+
+func TestLaTeXMetadataWriter_TableMetadata(t *testing.T) {
+	const want = `\section{\texttt{film\_actor}}
+
+table \textperiodcentered{} 5462 rows
+
+\emph{Links films\_ \& actors (100\%)}
+
+\begin{tabular}{llccc}
+\hline
+Column & Type & Nullable & PK & FK \\
+\hline
+\texttt{actor\_id} & \texttt{INTEGER} &  & \ensuremath{\surd} & \ensuremath{\surd} \\
+\texttt{film\_id} & \texttt{INTEGER} &  & \ensuremath{\surd} &  \\
+\hline
+\end{tabular}
+
+\textbf{Foreign keys:}
+
+\begin{tabular}{llll}
+\hline
+Relationship (\ensuremath{\rightarrow} references \textperiodcentered{} \ensuremath{\leftarrow} referenced by) & Constraint & On update & On delete \\
+\hline
+\texttt{actor\_id} \ensuremath{\rightarrow} \texttt{actor.actor\_id} & \texttt{fk\_film\_actor\_actor} & cascade &  \\
+\hline
+\end{tabular}
+`
+
+	filmActor := newTestSource().Table("film_actor")
+	filmActor.Comment = "Links films_ & actors (100%)"
+
+	buf := &bytes.Buffer{}
+	w := markdownw.NewLaTeXMetadataWriter(buf, output.NewPrinting())
+	require.NoError(t, w.TableMetadata(filmActor))
+	require.Equal(t, want, buf.String())
+}
+
+func TestRSTMetadataWriter_TableMetadata(t *testing.T) {
+	const want = "``film_actor``" + `
+==============
+
+table · 5462 rows
+
+*Links films\_ & actors (100%)*
+
+Entity Relationship Diagram
+---------------------------
+
+.. mermaid::
+
+   erDiagram
+       film_actor {
+           int actor_id PK,FK
+           int film_id PK
+       }
+       actor ||--o{ film_actor : "fk_film_actor_actor"
+
++--------------+-------------+----------+----+----+
+| Column       | Type        | Nullable | PK | FK |
++==============+=============+==========+====+====+
+| ` + "``actor_id``" + ` | ` + "``INTEGER``" + ` |          | ✓  | ✓  |
++--------------+-------------+----------+----+----+
+| ` + "``film_id``" + `  | ` + "``INTEGER``" + ` |          | ✓  |    |
++--------------+-------------+----------+----+----+
+
+**Foreign keys:**
+
++-----------------------------------------------+-------------------------+-----------+-----------+
+| Relationship (→ references · ← referenced by) | Constraint              | On update | On delete |
++===============================================+=========================+===========+===========+
+| ` + "``actor_id``" + ` → ` + "``actor.actor_id``" + `             | ` +
+		"``fk_film_actor_actor``" + ` | cascade   |           |
++-----------------------------------------------+-------------------------+-----------+-----------+
+`
+
+	filmActor := newTestSource().Table("film_actor")
+	filmActor.Comment = "Links films_ & actors (100%)"
+
+	buf := &bytes.Buffer{}
+	w := markdownw.NewRSTMetadataWriter(buf, output.NewPrinting())
+	require.NoError(t, w.TableMetadata(filmActor))
+	require.Equal(t, want, buf.String())
+}
+
+// TestRSTMetadataWriter_SourceMetadata verifies the section title
+// hierarchy, and that the table of contents references the table sections.
+func TestRSTMetadataWriter_SourceMetadata(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := markdownw.NewRSTMetadataWriter(buf, output.NewPrinting())
+	require.NoError(t, w.SourceMetadata(newTestSource(), true))
+
+	got := buf.String()
+	require.True(t, strings.HasPrefix(got, "@test\n=====\n\n"))
+	require.Contains(t, got, "\nTables\n------\n\n`actor`_ · `film_actor`_\n")
+	require.Contains(t, got, "\n``actor``\n~~~~~~~~~\n")
+	require.Contains(t, got, "\nEntity Relationship Diagram\n^^^^^^^^^^^^^^^^^^^^^^^^^^^\n")
+}
@@ -0,0 +1,173 @@
+package markdownw
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+var _ markup = rst{}
+
+// rst is the markup of the reStructuredText writers. Tables are written as
+// grid tables, which, unlike simple tables, permit an empty first cell.
+// Grid tables have no column alignment, so table.aligns is ignored.
+type rst struct{}
+
+func (rst) text(s string) string   { return rstEscape(s) }
+func (rst) escape(s string) string { return rstEscape(s) }
+func (rst) strong(s string) string { return "**" + s + "**" }
+func (rst) diagrams() bool         { return true }
+
+// emph renders s in italics. Inline markup can't begin or end with
+// whitespace, so s is trimmed.
+func (rst) emph(s string) string {
+	if s = strings.TrimSpace(s); s == "" {
+		return ""
+	}
+	return "*" + s + "*"
+}
+
+// code renders s as an inline literal. An inline literal can't contain
+// two consecutive backquotes, nor begin or end with whitespace: such an s
+// is rendered as escaped text instead.
+func (rst) code(s string) string {
+	if s == "" {
+		return ""
+	}
+	if strings.Contains(s, "``") || strings.TrimSpace(s) != s {
+		return rstEscape(s)
+	}
+	return "``" + s + "``"
+}
+
+func (r rst) codeCell(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return r.code(s)
+}
+
+// heading writes a section title, underlined with a character that
+// depends on level.
+func (rst) heading(buf *bytes.Buffer, level int, title string) {
+	const adornments = "=-~^"
+	c := adornments[min(max(level, 1), len(adornments))-1]
+	width := max(runewidth.StringWidth(title), 1)
+	buf.WriteString(title + "\n" + strings.Repeat(string(c), width) + "\n\n")
+}
+
+// codeBlock writes src via the code-block directive, or, if lang is
+// "mermaid", via the mermaid directive of the sphinxcontrib-mermaid
+// extension.
+func (rst) codeBlock(buf *bytes.Buffer, lang, src string) {
+	if lang == "mermaid" {
+		buf.WriteString(".. mermaid::\n\n")
+	} else {
+		buf.WriteString(".. code-block:: " + lang + "\n\n")
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(src, "\n"), "\n") {
+		if line = strings.TrimRight(line, " \t\r"); line != "" {
+			buf.WriteString("   " + line)
+		}
+		buf.WriteByte('\n')
+	}
+}
+
+func (r rst) provenance(buf *bytes.Buffer, timestamp string) {
+	buf.WriteString("`sq inspect <https://sq.io/docs/inspect>`__ @ " + r.code(timestamp) + "\n\n")
+}
+
+// toc writes a compact one-line table of contents: a middot-separated list
+// of references to each table/view section. The references resolve to the
+// implicit hyperlink target of each section title.
+func (rst) toc(buf *bytes.Buffer, tables []*metadata.Table) {
+	refs := make([]string, len(tables))
+	r := strings.NewReplacer(`\`, `\\`, "`", "\\`")
+	for i, tbl := range tables {
+		refs[i] = "`" + r.Replace(tbl.Name) + "`_"
+	}
+	buf.WriteString(strings.Join(refs, " · ") + "\n")
+}
+
+// writeTable writes t as a grid table. A table with no rows is written
+// without a header row separator, which docutils doesn't permit at the end
+// of a table.
+func (r rst) writeTable(buf *bytes.Buffer, t *table) {
+	headers := make([]string, len(t.headers))
+	widths := make([]int, len(t.headers))
+	for i, h := range t.headers {
+		headers[i] = r.text(h)
+		widths[i] = runewidth.StringWidth(headers[i])
+	}
+	for _, row := range t.rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], runewidth.StringWidth(cell))
+		}
+	}
+
+	writeBorder := func(c string) {
+		buf.WriteByte('+')
+		for _, w := range widths {
+			buf.WriteString(strings.Repeat(c, w+2) + "+")
+		}
+		buf.WriteByte('\n')
+	}
+	writeRow := func(cells []string) {
+		buf.WriteByte('|')
+		for i, cell := range cells {
+			buf.WriteString(" " + runewidth.FillRight(cell, widths[i]) + " |")
+		}
+		buf.WriteByte('\n')
+	}
+
+	writeBorder("-")
+	writeRow(headers)
+	if len(t.rows) == 0 {
+		writeBorder("-")
+		return
+	}
+
+	writeBorder("=")
+	for _, row := range t.rows {
+		writeRow(row)
+		writeBorder("-")
+	}
+}
+
+// rstEscape escapes s for use as reStructuredText text. It backslash-escapes
+// the characters that start inline markup, and a leading character that
+// would otherwise start a bullet list or a comment. An underscore is only
+// escaped where it would make a reference, as in "name_": an underscore
+// within a word, as in "first_name", is plain text. Line breaks are replaced
+// by spaces, so that s can be used in a table cell.
+func rstEscape(s string) string {
+	rs := []rune(s)
+	var sb strings.Builder
+	for i, r := range rs {
+		switch r {
+		case '\r':
+			if i+1 < len(rs) && rs[i+1] == '\n' {
+				continue
+			}
+			r = ' '
+		case '\n':
+			r = ' '
+		case '\\', '*', '`', '|':
+			sb.WriteByte('\\')
+		case '_':
+			if i+1 == len(rs) || !(unicode.IsLetter(rs[i+1]) || unicode.IsDigit(rs[i+1])) {
+				sb.WriteByte('\\')
+			}
+		case '-', '+', '.':
+			if i == 0 && (len(rs) == 1 || rs[1] == ' ' || rs[1] == r) {
+				sb.WriteByte('\\')
+			}
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,141 @@
+// Package parquetw implements output writers for Apache Parquet.
+// It uses the https://github.com/apache/arrow-go library: records are
+// converted to Arrow record batches via package arrowz, and written
+// using pqarrow.
+// See: https://parquet.apache.org/docs/file-format/
+package parquetw
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/record"
+)
+
+const (
+	// RowGroupLength is the number of rows in each row group. When the
+	// buffered rows reach this number, a row group is written to the output,
+	// so that the writer doesn't hold the entire result in memory.
+	RowGroupLength = 64 * 1024
+)
+
+type recordWriter struct {
+	out  io.Writer
+	pr   *output.Printing
+	fw   *pqarrow.FileWriter
+	bldr *arrowz.Builder
+	mu   sync.Mutex
+}
+
+var _ output.NewRecordWriterFunc = NewRecordWriter
+
+// NewRecordWriter returns an output.RecordWriter instance for Parquet.
+func NewRecordWriter(out io.Writer, pr *output.Printing) output.RecordWriter {
+	return &recordWriter{out: out, pr: pr}
+}
+
+// Open implements output.RecordWriter. The Parquet schema is derived
+// from the Arrow schema returned by arrowz.NewSchema: each column is
+// optional (nullable).
+func (w *recordWriter) Open(_ context.Context, recMeta record.Meta) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.bldr = arrowz.NewBuilder(recMeta)
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(compress.Codecs.Snappy),
+		parquet.WithMaxRowGroupLength(RowGroupLength),
+	)
+
+	// The file writer closes its destination if it's an io.Closer, but
+	// closing w.out is the caller's responsibility: thus it's wrapped.
+	var err error
+	w.fw, err = pqarrow.NewFileWriter(w.bldr.Schema(), struct{ io.Writer }{w.out}, props,
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		w.bldr.Release()
+		w.bldr = nil
+		return errw(err)
+	}
+	return nil
+}
+
+// WriteRecords implements output.RecordWriter.
+func (w *recordWriter) WriteRecords(ctx context.Context, recs []record.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, rec := range recs {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := w.bldr.Append(rec); err != nil {
+			return errw(err)
+		}
+
+		if w.bldr.Len() >= RowGroupLength {
+			if err := w.writeRowGroup(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeRowGroup writes the buffered rows as a row group.
+func (w *recordWriter) writeRowGroup() error {
+	if w.bldr.Len() == 0 {
+		return nil
+	}
+
+	batch := w.bldr.NewRecordBatch()
+	defer batch.Release()
+	return errw(w.fw.Write(batch))
+}
+
+// Flush implements output.RecordWriter. It's a no-op: the writer
+// writes a row group when enough rows have accumulated.
+func (w *recordWriter) Flush(context.Context) error {
+	return nil
+}
+
+// Close implements output.RecordWriter. It writes any remaining rows,
+// and the file footer. Subsequent invocations are no-ops.
+func (w *recordWriter) Close(context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.fw == nil {
+		return nil
+	}
+
+	defer func() {
+		w.bldr.Release()
+		w.fw, w.bldr = nil, nil
+	}()
+
+	if err := w.writeRowGroup(); err != nil {
+		return err
+	}
+
+	if err := w.fw.Close(); err != nil {
+		return errz.Wrap(err, "parquet: unable to write Parquet")
+	}
+	return nil
+}
+
+func errw(err error) error {
+	return errz.Wrap(err, "parquet")
+}
@@ -0,0 +1,156 @@
+package parquetw_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/parquetw"
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/testh"
+)
+
+// writeParquet writes recs via the Parquet record writer, returning
+// the output.
+func writeParquet(t *testing.T, recMeta record.Meta, recs []record.Record) []byte {
+	t.Helper()
+	ctx := context.Background()
+
+	buf := &bytes.Buffer{}
+	w := parquetw.NewRecordWriter(buf, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.NoError(t, w.WriteRecords(ctx, recs))
+	require.NoError(t, w.Close(ctx))
+
+	// Close is idempotent.
+	n := buf.Len()
+	require.NoError(t, w.Close(ctx))
+	require.Equal(t, n, buf.Len())
+	return buf.Bytes()
+}
+
+// readParquet reads the Parquet data b, returning the file reader, and
+// the data as an Arrow table.
+func readParquet(t *testing.T, b []byte) (*file.Reader, arrow.Table) {
+	t.Helper()
+
+	pf, err := file.NewParquetReader(bytes.NewReader(b))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = pf.Close() })
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	tbl, err := fr.ReadTable(context.Background())
+	require.NoError(t, err)
+	t.Cleanup(tbl.Release)
+	return pf, tbl
+}
+
+func TestRecordWriter(t *testing.T) {
+	colNames := []string{
+		"col_int", "col_float", "col_bool", "col_bytes", "col_decimal",
+		"col_datetime", "col_date", "col_time", "col_text",
+	}
+	colKinds := []kind.Kind{
+		kind.Int, kind.Float, kind.Bool, kind.Bytes, kind.Decimal,
+		kind.Datetime, kind.Date, kind.Time, kind.Text,
+	}
+	recMeta := testh.NewRecordMeta(colNames, colKinds)
+
+	dt := time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC)
+	recs := []record.Record{
+		{
+			int64(7), float64(1.5), true, []byte("hello"), decimal.RequireFromString("12.345"),
+			dt, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), "05:06:07", "huzzah",
+		},
+		make(record.Record, len(colNames)), // All null.
+	}
+
+	b := writeParquet(t, recMeta, recs)
+	require.Equal(t, []byte("PAR1"), b[:4])
+	require.Equal(t, []byte("PAR1"), b[len(b)-4:])
+
+	pf, tbl := readParquet(t, b)
+	require.Equal(t, int64(len(recs)), pf.NumRows())
+
+	sch := pf.MetaData().Schema
+	require.Equal(t, len(colNames), sch.NumColumns())
+	for i, name := range colNames {
+		require.Equal(t, name, sch.Column(i).Name())
+		require.Equal(t, 1, int(sch.Column(i).MaxDefinitionLevel()), "column is optional")
+	}
+	require.True(t, sch.Column(4).LogicalType().Equals(
+		schema.NewDecimalLogicalType(arrowz.DefaultDecimalPrecision, arrowz.DefaultDecimalScale)))
+	require.True(t, sch.Column(5).LogicalType().Equals(
+		schema.NewTimestampLogicalType(true, schema.TimeUnitMicros)))
+	require.True(t, sch.Column(6).LogicalType().Equals(schema.DateLogicalType{}))
+	require.IsType(t, schema.TimeLogicalType{}, sch.Column(7).LogicalType())
+	require.True(t, sch.Column(8).LogicalType().Equals(schema.StringLogicalType{}))
+
+	col := func(i int) arrow.Array {
+		chunks := tbl.Column(i).Data().Chunks()
+		require.Len(t, chunks, 1)
+		return chunks[0]
+	}
+
+	require.Equal(t, int64(7), col(0).(*array.Int64).Value(0))
+	require.Equal(t, float64(1.5), col(1).(*array.Float64).Value(0))
+	require.Equal(t, true, col(2).(*array.Boolean).Value(0))
+	require.Equal(t, []byte("hello"), col(3).(*array.Binary).Value(0))
+	unscaled := col(4).(*array.Decimal128).Value(0).BigInt()
+	require.Equal(t, "12.345", decimal.NewFromBigInt(unscaled, -arrowz.DefaultDecimalScale).String())
+	require.Equal(t, dt.UnixMicro(), int64(col(5).(*array.Timestamp).Value(0)))
+	require.Equal(t, int32(18690), int32(col(6).(*array.Date32).Value(0)))
+	require.Equal(t, int64((5*3600+6*60+7)*1e6), int64(col(7).(*array.Time64).Value(0)))
+	require.Equal(t, "huzzah", col(8).(*array.String).Value(0))
+
+	for i := range colNames {
+		require.True(t, col(i).IsNull(1), colNames[i])
+	}
+}
+
+func TestRecordWriter_rowGroups(t *testing.T) {
+	recMeta := testh.NewRecordMeta([]string{"a"}, []kind.Kind{kind.Int})
+	recs := make([]record.Record, parquetw.RowGroupLength+1)
+	for i := range recs {
+		recs[i] = record.Record{int64(i)}
+	}
+
+	pf, tbl := readParquet(t, writeParquet(t, recMeta, recs))
+	require.Equal(t, 2, pf.NumRowGroups())
+	require.Equal(t, int64(len(recs)), tbl.NumRows())
+}
+
+func TestRecordWriter_similarNames(t *testing.T) {
+	recMeta := testh.NewRecordMeta([]string{"a_b", "A_b"}, []kind.Kind{kind.Text, kind.Text})
+	recs := []record.Record{{"x", "y"}}
+
+	pf, _ := readParquet(t, writeParquet(t, recMeta, recs))
+	require.Equal(t, "a_b", pf.MetaData().Schema.Column(0).Name())
+	require.Equal(t, "A_b", pf.MetaData().Schema.Column(1).Name())
+}
+
+func TestRecordWriter_decimalOverflow(t *testing.T) {
+	ctx := context.Background()
+	recMeta := testh.NewRecordMeta([]string{"a"}, []kind.Kind{kind.Decimal})
+	w := parquetw.NewRecordWriter(&bytes.Buffer{}, output.NewPrinting())
+	require.NoError(t, w.Open(ctx, recMeta))
+
+	// The default precision is 38, and the default scale is 6: thus
+	// the value has 39 digits.
+	val := decimal.RequireFromString("123456789012345678901234567890123")
+	require.Error(t, w.WriteRecords(ctx, []record.Record{{val}}))
+}
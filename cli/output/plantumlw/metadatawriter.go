@@ -0,0 +1,118 @@
+// Package plantumlw implements output.MetadataWriter for the "plantuml-erd"
+// format: sq inspect's schema entity-relationship diagram as bare PlantUML
+// source (see cli/output/internal/plantuml), for rendering by PlantUML or
+// for embedding in documentation that uses it.
+//
+// Like mermaidw, it supports only source and table schema inspection
+// (SourceMetadata and TableMetadata); the other metadata operations have no
+// ERD representation and return errUnsupported.
+package plantumlw
+
+import (
+	"cmp"
+	"io"
+	"slices"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/internal/plantuml"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+var _ output.MetadataWriter = (*metadataWriter)(nil)
+
+// errUnsupported is returned by the metadata operations that have no
+// PlantUML ERD representation.
+var errUnsupported = errz.New(
+	"the plantuml-erd format supports only source and table schema diagrams",
+)
+
+// errNothingToRender is returned when there's no diagram to draw, i.e. no
+// tables with columns and no foreign keys. As with mermaidw, the diagram is
+// the entire output, so an empty render is an error rather than silent
+// empty output.
+var errNothingToRender = errz.New(
+	"the plantuml-erd format has nothing to render: no columns or foreign keys found",
+)
+
+// metadataWriter implements output.MetadataWriter for the "plantuml-erd"
+// format, emitting bare PlantUML source.
+type metadataWriter struct {
+	out io.Writer
+}
+
+// NewMetadataWriter returns a new output.MetadataWriter that outputs bare
+// PlantUML entity-relationship diagram source. The *output.Printing arg is
+// accepted for call-site consistency with the other metadata writers but is
+// unused: the output is always plain, so that it can be saved as a .puml
+// file as-is.
+func NewMetadataWriter(out io.Writer, _ *output.Printing) output.MetadataWriter {
+	return &metadataWriter{out: out}
+}
+
+// SourceMetadata implements output.MetadataWriter. It writes the whole-source
+// ERD. Overview mode (showSchema=false) carries no table schema, so there's
+// nothing to diagram and it returns errUnsupported.
+func (w *metadataWriter) SourceMetadata(md *metadata.Source, showSchema bool) error {
+	if !showSchema {
+		return errUnsupported
+	}
+
+	// Render with a stable table ordering (tables before views, then by
+	// name), matching the other ERD writers.
+	tables := append([]*metadata.Table(nil), md.Tables...)
+	slices.SortFunc(tables, compareTables)
+
+	return w.writeDiagram(plantuml.SourceDiagram(tables))
+}
+
+// TableMetadata implements output.MetadataWriter, writing a focused
+// single-table ERD.
+func (w *metadataWriter) TableMetadata(md *metadata.Table) error {
+	return w.writeDiagram(plantuml.TableDiagram(md, nil))
+}
+
+// writeDiagram writes the rendered diagram source to w.out, returning
+// errNothingToRender when src is empty (the plantuml package returns ""
+// when there's nothing to draw).
+func (w *metadataWriter) writeDiagram(src string) error {
+	if src == "" {
+		return errNothingToRender
+	}
+	_, err := io.WriteString(w.out, src)
+	return errz.Err(err)
+}
+
+// DBProperties implements output.MetadataWriter. DB properties have no ERD
+// representation.
+func (w *metadataWriter) DBProperties(map[string]any) error {
+	return errUnsupported
+}
+
+// DriverMetadata implements output.MetadataWriter. The driver list has no ERD
+// representation.
+func (w *metadataWriter) DriverMetadata([]driver.Metadata) error {
+	return errUnsupported
+}
+
+// Catalogs implements output.MetadataWriter. A catalog list has no ERD
+// representation.
+func (w *metadataWriter) Catalogs(string, []string) error {
+	return errUnsupported
+}
+
+// Schemata implements output.MetadataWriter. A schema list has no ERD
+// representation.
+func (w *metadataWriter) Schemata(string, []*metadata.Schema) error {
+	return errUnsupported
+}
+
+// compareTables orders tables before views, then by name, so the emitted
+// diagram is deterministic.
+func compareTables(a, b *metadata.Table) int {
+	if a.TableType == b.TableType {
+		return cmp.Compare(a.Name, b.Name)
+	}
+	return cmp.Compare(a.TableType, b.TableType)
+}
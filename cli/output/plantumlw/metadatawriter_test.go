@@ -0,0 +1,92 @@
+package plantumlw_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/plantumlw"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// newTestSource builds a small deterministic two-table source
+// (actor + film_actor, with film_actor.actor_id → actor.actor_id) and links
+// its foreign keys so FK.Incoming is populated.
+func newTestSource() *metadata.Source {
+	actor := &metadata.Table{
+		Name: "actor", TableType: "table", RowCount: 200,
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "first_name", Position: 2, ColumnType: "TEXT", Kind: kind.Text},
+		},
+	}
+	filmActor := &metadata.Table{
+		Name: "film_actor", TableType: "table", RowCount: 5462,
+		Columns: []*metadata.Column{
+			{Name: "actor_id", Position: 1, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+			{Name: "film_id", Position: 2, PrimaryKey: true, ColumnType: "INTEGER", Kind: kind.Int},
+		},
+		FK: &metadata.FKGroup{Outgoing: []*metadata.ForeignKey{{
+			Name: "fk_film_actor_actor", Table: "film_actor", Columns: []string{"actor_id"},
+			RefTable: "actor", RefColumns: []string{"actor_id"},
+		}}},
+	}
+	src := &metadata.Source{
+		Handle: "@test", Name: "testdb", Driver: drivertype.Type("sqlite3"),
+		Schema: "main", Tables: []*metadata.Table{filmActor, actor},
+	}
+	metadata.LinkForeignKeys(nil, src)
+	return src
+}
+
+// TestMetadataWriter_SourceMetadata checks the whole-source ERD: bare
+// PlantUML source, with entities in a deterministic order (tables before
+// views, then by name).
+func TestMetadataWriter_SourceMetadata(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := plantumlw.NewMetadataWriter(buf, output.NewPrinting())
+	require.NoError(t, w.SourceMetadata(newTestSource(), true))
+
+	got := buf.String()
+	require.True(t, strings.HasPrefix(got, "@startuml\n"))
+	require.True(t, strings.HasSuffix(got, "@enduml\n"))
+	require.Less(t, strings.Index(got, "entity actor {"), strings.Index(got, "entity film_actor {"))
+	require.Contains(t, got, "actor ||--o{ film_actor : fk_film_actor_actor\n")
+	require.NotContains(t, got, "\x1b[", "output must never be colorized")
+}
+
+// TestMetadataWriter_TableMetadata checks the focused single-table ERD.
+func TestMetadataWriter_TableMetadata(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := plantumlw.NewMetadataWriter(buf, output.NewPrinting())
+	require.NoError(t, w.TableMetadata(newTestSource().Table("film_actor")))
+
+	got := buf.String()
+	require.Contains(t, got, "entity film_actor {")
+	require.Contains(t, got, "entity actor\n")
+	require.Contains(t, got, "actor ||--o{ film_actor : fk_film_actor_actor\n")
+}
+
+// TestMetadataWriter_unsupported verifies that operations with no ERD
+// representation return an error and write nothing.
+func TestMetadataWriter_unsupported(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := plantumlw.NewMetadataWriter(buf, output.NewPrinting())
+
+	// Overview mode (showSchema=false) carries no schema to diagram.
+	require.ErrorContains(t, w.SourceMetadata(newTestSource(), false), "plantuml-erd")
+	require.ErrorContains(t, w.DBProperties(map[string]any{"k": "v"}), "plantuml-erd")
+	require.ErrorContains(t, w.DriverMetadata(nil), "plantuml-erd")
+	require.ErrorContains(t, w.Catalogs("sakila", []string{"sakila"}), "plantuml-erd")
+	require.ErrorContains(t, w.Schemata("public", []*metadata.Schema{{Name: "public"}}), "plantuml-erd")
+	require.Empty(t, buf.String())
+
+	// Nothing to diagram.
+	require.ErrorContains(t, w.TableMetadata(&metadata.Table{Name: "t", TableType: "table"}), "plantuml-erd")
+	require.Empty(t, buf.String())
+}
@@ -0,0 +1,195 @@
+// Package sqlinsertw implements output.RecordWriter for the sql-insert
+// format, which outputs records as a SQL script: a CREATE TABLE statement
+// followed by batched INSERT statements, in the dialect of a SQL driver.
+// Unlike the SQLWriter impls in package sqlw, which print the SQL rendered
+// for a query, the script contains the query's results, and can be executed
+// against a database to load them.
+package sqlinsertw
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/libsq/ast/render"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/libsq/core/schema"
+	"github.com/neilotoole/sq/libsq/driver"
+)
+
+// RecordWriter implements output.RecordWriter.
+type RecordWriter struct {
+	out  io.Writer
+	pr   *output.Printing
+	drvr driver.SQLDriver
+	rc   *render.Context
+
+	// buf holds complete statements that have yet to be written to out.
+	buf *bytes.Buffer
+
+	// insertFrag is the fragment that begins each INSERT statement, e.g.
+	// `INSERT INTO "data" ("a", "b") VALUES`.
+	insertFrag string
+
+	tbl     string
+	recMeta record.Meta
+
+	// rows holds the rendered value tuples of the pending INSERT.
+	rows [][]byte
+
+	// batchSize is the maximum number of rows in a single INSERT.
+	batchSize int
+
+	mu sync.Mutex
+}
+
+// NewRecordWriter returns a writer instance that outputs statements in the
+// dialect of drvr, for table tbl.
+func NewRecordWriter(out io.Writer, pr *output.Printing, drvr driver.SQLDriver, tbl string) output.RecordWriter {
+	return &RecordWriter{out: out, pr: pr, drvr: drvr, tbl: tbl}
+}
+
+// Open implements output.RecordWriter. It writes the CREATE TABLE
+// statement.
+func (w *RecordWriter) Open(_ context.Context, recMeta record.Meta) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(recMeta) == 0 {
+		return errz.New("sql-insert: no columns")
+	}
+
+	r := w.drvr.Renderer()
+	if r.CreateTable == nil || r.ValueLiteral == nil {
+		return errz.Errorf("sql-insert: not supported for dialect {%s}", w.drvr.Dialect().Type)
+	}
+
+	w.recMeta = recMeta
+	w.rc = &render.Context{Renderer: r, Dialect: w.drvr.Dialect()}
+	w.buf = &bytes.Buffer{}
+
+	tblDef := &schema.Table{Name: w.tbl}
+	tblDef.Cols = make([]*schema.Column, len(recMeta))
+	for i, field := range recMeta {
+		knd := field.Kind()
+		if knd == kind.Unknown || knd == kind.Null {
+			knd = kind.Text
+		}
+		tblDef.Cols[i] = &schema.Column{Table: tblDef, Name: field.MungedName(), Kind: knd}
+	}
+
+	w.buf.WriteString(r.CreateTable(tblDef))
+	w.buf.WriteString(";\n")
+
+	enquote := w.rc.Dialect.Enquote
+	ib := &bytes.Buffer{}
+	ib.WriteString("INSERT INTO ")
+	ib.WriteString(enquote(w.tbl))
+	ib.WriteString(" (")
+	for i, col := range tblDef.Cols {
+		if i > 0 {
+			ib.WriteString(", ")
+		}
+		ib.WriteString(enquote(col.Name))
+	}
+	ib.WriteString(") VALUES")
+	w.insertFrag = ib.String()
+
+	w.batchSize = w.rc.Dialect.MaxBatchValues / len(recMeta)
+	if w.batchSize == 0 {
+		w.batchSize = 1
+	}
+	return nil
+}
+
+// WriteRecords implements output.RecordWriter.
+func (w *RecordWriter) WriteRecords(ctx context.Context, recs []record.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, rec := range recs {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		default:
+		}
+
+		row, err := w.renderRow(rec)
+		if err != nil {
+			return err
+		}
+
+		w.rows = append(w.rows, row)
+		if len(w.rows) == w.batchSize {
+			w.writeInsert()
+		}
+	}
+
+	return nil
+}
+
+// renderRow renders rec as a value tuple, e.g. (1, 'abc').
+func (w *RecordWriter) renderRow(rec record.Record) ([]byte, error) {
+	b := make([]byte, 0, 16*len(rec))
+	b = append(b, '(')
+	for i, val := range rec {
+		lit, err := w.rc.Renderer.ValueLiteral(w.rc, w.recMeta[i].Kind(), val)
+		if err != nil {
+			return nil, errz.Wrapf(err, "sql-insert: column {%s}", w.recMeta[i].MungedName())
+		}
+
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = append(b, lit...)
+	}
+	return append(b, ')'), nil
+}
+
+// writeInsert writes an INSERT statement for the pending rows to buf.
+func (w *RecordWriter) writeInsert() {
+	if len(w.rows) == 0 {
+		return
+	}
+
+	w.buf.WriteRune('\n')
+	w.buf.WriteString(w.insertFrag)
+	for i, row := range w.rows {
+		if i > 0 {
+			w.buf.WriteRune(',')
+		}
+		w.buf.WriteString("\n  ")
+		w.buf.Write(row)
+	}
+	w.buf.WriteString(";\n")
+	w.rows = w.rows[:0]
+}
+
+// Flush implements output.RecordWriter. It writes the complete statements;
+// the rows of the pending INSERT are written when the batch is full, or on
+// Close.
+func (w *RecordWriter) Flush(context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := w.buf.WriteTo(w.out) // resets buf
+	return errz.Err(err)
+}
+
+// Close implements output.RecordWriter.
+func (w *RecordWriter) Close(ctx context.Context) error {
+	w.mu.Lock()
+	if w.buf == nil {
+		// Open was never successfully called.
+		w.mu.Unlock()
+		return nil
+	}
+	w.writeInsert()
+	w.mu.Unlock()
+
+	return w.Flush(ctx)
+}
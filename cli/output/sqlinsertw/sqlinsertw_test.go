@@ -0,0 +1,192 @@
+package sqlinsertw_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/sqlinsertw"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/core/tablefq"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+)
+
+// writeRecords writes recs via a sql-insert RecordWriter for dialect typ,
+// returning the output.
+func writeRecords(t *testing.T, th *testh.Helper, typ drivertype.Type, tbl string,
+	recMeta record.Meta, recs []record.Record,
+) string {
+	t.Helper()
+	ctx := context.Background()
+	drvr, err := th.Registry().SQLDriverFor(typ)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	w := sqlinsertw.NewRecordWriter(buf, output.NewPrinting(), drvr, tbl)
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.NoError(t, w.WriteRecords(ctx, recs))
+	require.NoError(t, w.Flush(ctx))
+	require.NoError(t, w.Close(ctx))
+	return buf.String()
+}
+
+// TestRecordWriter_SQLite executes the output script against a SQLite
+// database, and verifies that the records are loaded.
+func TestRecordWriter_SQLite(t *testing.T) {
+	colNames := []string{
+		"col_int", "col_float", "col_decimal", "col_bool", "col_text",
+		"col_bytes", "col_datetime", "col_date",
+	}
+	colKinds := []kind.Kind{
+		kind.Int, kind.Float, kind.Decimal, kind.Bool, kind.Text,
+		kind.Bytes, kind.Datetime, kind.Date,
+	}
+	recMeta := testh.NewRecordMeta(colNames, colKinds)
+
+	dt := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	recs := []record.Record{
+		{
+			int64(7), float64(1.5), decimal.RequireFromString("12.345"), true, `it's a \ test`,
+			[]byte{0xCA, 0xFE}, dt, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC),
+		},
+		make(record.Record, len(colNames)), // All null.
+	}
+
+	th := testh.New(t)
+	src := th.Source(sakila.SL3)
+	tbl := stringz.UniqTableName("sqlinsertw")
+
+	script := writeRecords(t, th, drivertype.SQLite, tbl, recMeta, recs)
+	require.True(t, strings.HasPrefix(script, "CREATE TABLE "), script)
+	require.Equal(t, 1, strings.Count(script, "INSERT INTO "), script)
+
+	th.ExecSQL(src, script)
+	t.Cleanup(func() { th.DropTable(src, tablefq.From(tbl)) })
+
+	sink, err := th.QuerySQL(src, nil, "SELECT * FROM "+tbl)
+	require.NoError(t, err)
+	require.Len(t, sink.Recs, len(recs))
+
+	got := sink.Recs[0]
+	require.Equal(t, int64(7), got[0])
+	require.Equal(t, 1.5, got[1])
+	require.Equal(t, true, got[3])
+	require.Equal(t, `it's a \ test`, got[4])
+	require.Equal(t, []byte{0xCA, 0xFE}, got[5])
+	require.Equal(t, dt, got[6])
+
+	for i, val := range sink.Recs[1] {
+		require.Nil(t, val, colNames[i])
+	}
+}
+
+func TestRecordWriter_batches(t *testing.T) {
+	th := testh.New(t)
+	recMeta := testh.NewRecordMeta([]string{"a", "b"}, []kind.Kind{kind.Int, kind.Text})
+
+	drvr, err := th.Registry().SQLDriverFor(drivertype.Pg)
+	require.NoError(t, err)
+	batchSize := drvr.Dialect().MaxBatchValues / len(recMeta)
+
+	recs := make([]record.Record, batchSize+1)
+	for i := range recs {
+		recs[i] = record.Record{int64(i), "x"}
+	}
+
+	script := writeRecords(t, th, drivertype.Pg, "data", recMeta, recs)
+	require.Equal(t, 2, strings.Count(script, "INSERT INTO "), script)
+	require.True(t, strings.HasSuffix(script, fmt.Sprintf("\n  (%d, 'x');\n", batchSize)), script)
+}
+
+// TestRecordWriter_dialects verifies the rendering of values whose literal
+// syntax varies by dialect.
+func TestRecordWriter_dialects(t *testing.T) {
+	recMeta := testh.NewRecordMeta(
+		[]string{"b", "s", "y", "d", "dt"},
+		[]kind.Kind{kind.Bool, kind.Text, kind.Bytes, kind.Date, kind.Datetime},
+	)
+	recs := []record.Record{{
+		true, `it's \`, []byte{0xCA, 0xFE},
+		time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 3, 4, 5, 6, 7, 500000000, time.UTC),
+	}}
+
+	testCases := []struct {
+		typ     drivertype.Type
+		wantRow string
+	}{
+		{
+			typ:     drivertype.SQLite,
+			wantRow: `(TRUE, 'it''s \', X'CAFE', '2021-03-04', '2021-03-04 05:06:07.5')`,
+		},
+		{
+			typ:     drivertype.Pg,
+			wantRow: `(TRUE, 'it''s \', '\xcafe'::bytea, '2021-03-04', '2021-03-04 05:06:07.5')`,
+		},
+		{
+			typ:     drivertype.MySQL,
+			wantRow: `(1, 'it''s \\', X'CAFE', '2021-03-04', '2021-03-04 05:06:07.5')`,
+		},
+		{
+			typ:     drivertype.MSSQL,
+			wantRow: `(1, N'it''s \', 0xCAFE, '2021-03-04', '2021-03-04T05:06:07.5')`,
+		},
+		{
+			typ: drivertype.Oracle,
+			wantRow: `(1, 'it''s \', HEXTORAW('CAFE'), DATE '2021-03-04', ` +
+				`TIMESTAMP '2021-03-04 05:06:07.5')`,
+		},
+		{
+			typ:     drivertype.DuckDB,
+			wantRow: `(TRUE, 'it''s \', '\xCA\xFE'::BLOB, '2021-03-04', '2021-03-04 05:06:07.5')`,
+		},
+		{
+			typ:     drivertype.ClickHouse,
+			wantRow: `(TRUE, 'it''s \\', unhex('CAFE'), '2021-03-04', '2021-03-04 05:06:07')`,
+		},
+	}
+
+	th := testh.New(t)
+	for _, tc := range testCases {
+		t.Run(tc.typ.String(), func(t *testing.T) {
+			script := writeRecords(t, th, tc.typ, "data", recMeta, recs)
+			require.True(t, strings.HasSuffix(script, "\n  "+tc.wantRow+";\n"), script)
+		})
+	}
+}
+
+// TestRecordWriter_createTable verifies that the CREATE TABLE statement
+// indents each column, and that MySQL and SQL Server, whose bare DECIMAL
+// type has scale zero, get explicit decimal precision and scale.
+func TestRecordWriter_createTable(t *testing.T) {
+	recMeta := testh.NewRecordMeta([]string{"a", "b"}, []kind.Kind{kind.Int, kind.Decimal})
+
+	testCases := []struct {
+		typ  drivertype.Type
+		want string
+	}{
+		{typ: drivertype.SQLite, want: "CREATE TABLE \"data\" (\n  \"a\" INTEGER,\n  \"b\" NUMERIC\n);\n"},
+		{typ: drivertype.Pg, want: "CREATE TABLE \"data\" (\n  \"a\" BIGINT,\n  \"b\" DECIMAL\n);\n"},
+		{typ: drivertype.MySQL, want: "CREATE TABLE `data` (\n  `a` INT,\n  `b` DECIMAL(65, 30)\n);\n"},
+		{typ: drivertype.MSSQL, want: "CREATE TABLE \"data\" (\n  \"a\" BIGINT,\n  \"b\" DECIMAL(38, 6)\n);\n"},
+	}
+
+	th := testh.New(t)
+	for _, tc := range testCases {
+		t.Run(tc.typ.String(), func(t *testing.T) {
+			script := writeRecords(t, th, tc.typ, "data", recMeta, nil)
+			require.Equal(t, tc.want, script)
+		})
+	}
+}
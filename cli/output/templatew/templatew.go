@@ -0,0 +1,270 @@
+// Package templatew implements output.RecordWriter for the template
+// format, which renders records via a user-supplied Go text/template.
+// The template has access to the sprig functions: see package templatez.
+//
+// The template is executed for each record, with a Row as its data. The
+// template can also define these named templates:
+//
+//   - "header": executed once, before the first record, with a Data.
+//   - "batch": executed for each batch of records, before the batch's
+//     rows are rendered, with a Data whose Rows field holds the batch.
+//   - "footer": executed once, after the last record, with a Data.
+//   - "row": executed for each record, with a Row, in place of the
+//     template itself.
+//
+// If the template consists only of named templates (that is, its body is
+// blank), and it doesn't define "row", nothing is output per record.
+package templatew
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/libsq/core/templatez"
+)
+
+// Names of the hook templates that a template can define.
+const (
+	tplHeader = "header"
+	tplBatch  = "batch"
+	tplFooter = "footer"
+	tplRow    = "row"
+)
+
+// Column is the metadata of a result column, as exposed to a template.
+type Column struct {
+	// Name is the column name, deduplicated as per other output formats.
+	Name string
+
+	// Kind is the sq kind of the column, e.g. "int" or "datetime".
+	Kind string
+
+	// DBType is the database type name of the column, e.g. "VARCHAR".
+	// It may be empty.
+	DBType string
+
+	// Index is the zero-based index of the column.
+	Index int
+}
+
+// Row is the data passed to the template (or to the "row" template) for
+// each record.
+type Row struct {
+	// Record holds the record's values, keyed by column name.
+	Record map[string]any
+
+	// Columns is the metadata of the result columns.
+	Columns []Column
+
+	// Values holds the record's values, in column order.
+	Values []any
+
+	// Index is the zero-based index of the record.
+	Index int
+}
+
+// Data is the data passed to the "header", "batch", and "footer"
+// templates.
+type Data struct {
+	// Columns is the metadata of the result columns.
+	Columns []Column
+
+	// Rows holds the rows of the batch. It's empty for the "header" and
+	// "footer" templates.
+	Rows []Row
+
+	// Count is the number of records written so far. For "batch", it
+	// includes the records of the batch; for "footer", it's the total.
+	Count int
+}
+
+// RecordWriter implements output.RecordWriter.
+type RecordWriter struct {
+	out     io.Writer
+	pr      *output.Printing
+	tpl     *template.Template
+	rowTpl  *template.Template
+	buf     *bytes.Buffer
+	tplFile string
+	cols    []Column
+	recMeta record.Meta
+	count   int
+	mu      sync.Mutex
+}
+
+var _ output.RecordWriter = (*RecordWriter)(nil)
+
+// NewRecordWriter returns a writer instance that renders records via the
+// template in tplFile. The template is loaded when the writer is opened.
+func NewRecordWriter(out io.Writer, pr *output.Printing, tplFile string) output.RecordWriter {
+	return &RecordWriter{out: out, pr: pr, tplFile: tplFile}
+}
+
+// Open implements output.RecordWriter. It loads the template, and executes
+// its "header" template, if defined.
+func (w *RecordWriter) Open(_ context.Context, recMeta record.Meta) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	tpl, err := loadTemplate(w.tplFile)
+	if err != nil {
+		return err
+	}
+
+	w.tpl = tpl
+	w.rowTpl = tpl.Lookup(tplRow)
+	if w.rowTpl == nil && !isBlank(tpl) {
+		w.rowTpl = tpl
+	}
+
+	w.recMeta = recMeta
+	w.buf = &bytes.Buffer{}
+	w.cols = make([]Column, len(recMeta))
+	for i, field := range recMeta {
+		w.cols[i] = Column{
+			Name:   field.MungedName(),
+			Kind:   field.Kind().String(),
+			DBType: field.DatabaseTypeName(),
+			Index:  i,
+		}
+	}
+
+	return w.execHook(tplHeader, Data{Columns: w.cols})
+}
+
+// WriteRecords implements output.RecordWriter.
+func (w *RecordWriter) WriteRecords(ctx context.Context, recs []record.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	rows := make([]Row, len(recs))
+	for i, rec := range recs {
+		rows[i] = w.newRow(rec, w.count+i)
+	}
+
+	if err := w.execHook(tplBatch, Data{Columns: w.cols, Rows: rows, Count: w.count + len(rows)}); err != nil {
+		return err
+	}
+
+	for i := range rows {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if w.rowTpl != nil {
+			if err := w.rowTpl.Execute(w.buf, rows[i]); err != nil {
+				return errz.Err(err)
+			}
+		}
+		w.count++
+	}
+
+	return nil
+}
+
+// Flush implements output.RecordWriter.
+func (w *RecordWriter) Flush(context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err := w.buf.WriteTo(w.out) // resets buf
+	return errz.Err(err)
+}
+
+// Close implements output.RecordWriter. It executes the template's
+// "footer" template, if defined.
+func (w *RecordWriter) Close(ctx context.Context) error {
+	if w.tpl == nil {
+		// The writer wasn't successfully opened.
+		return nil
+	}
+
+	w.mu.Lock()
+	err := w.execHook(tplFooter, Data{Columns: w.cols, Count: w.count})
+	w.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return w.Flush(ctx)
+}
+
+// execHook executes the named hook template, if the template defines it.
+func (w *RecordWriter) execHook(name string, data Data) error {
+	if w.tpl.Lookup(name) == nil {
+		return nil
+	}
+
+	return errz.Err(w.tpl.ExecuteTemplate(w.buf, name, data))
+}
+
+// newRow returns the Row for rec, the record at index. Time values are
+// formatted as per the writer's output.Printing, and []byte values are
+// base64-encoded, as in other text formats. Other values retain their
+// type, so that they can be used with template functions such as "add".
+func (w *RecordWriter) newRow(rec record.Record, index int) Row {
+	row := Row{
+		Index:   index,
+		Columns: w.cols,
+		Values:  make([]any, len(rec)),
+		Record:  make(map[string]any, len(rec)),
+	}
+
+	for i, val := range rec {
+		switch val := val.(type) {
+		case time.Time:
+			switch w.recMeta[i].Kind() { //nolint:exhaustive
+			default:
+				row.Values[i] = w.pr.FormatDatetime(val)
+			case kind.Time:
+				row.Values[i] = w.pr.FormatTime(val)
+			case kind.Date:
+				row.Values[i] = w.pr.FormatDate(val)
+			}
+		case []byte:
+			row.Values[i] = base64.StdEncoding.EncodeToString(val)
+		default:
+			row.Values[i] = val
+		}
+
+		row.Record[w.cols[i].Name] = row.Values[i]
+	}
+
+	return row
+}
+
+// loadTemplate reads and parses the template in file tplFile.
+func loadTemplate(tplFile string) (*template.Template, error) {
+	if tplFile == "" {
+		return nil, errz.New("template: no template file specified: use --template")
+	}
+
+	data, err := os.ReadFile(tplFile)
+	if err != nil {
+		return nil, errz.Wrap(err, "template")
+	}
+
+	// The parse error message includes the template name, so it's not
+	// wrapped.
+	return templatez.NewTemplate(filepath.Base(tplFile), string(data))
+}
+
+// isBlank returns true if the body of tpl, excluding its named templates,
+// is empty or only whitespace.
+func isBlank(tpl *template.Template) bool {
+	return tpl.Tree == nil || strings.TrimSpace(tpl.Tree.Root.String()) == ""
+}
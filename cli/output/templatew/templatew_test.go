@@ -0,0 +1,107 @@
+package templatew_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/output"
+	"github.com/neilotoole/sq/cli/output/templatew"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/tu"
+)
+
+func TestRecordWriter(t *testing.T) {
+	recMeta := testh.NewRecordMeta(
+		[]string{"id", "name", "created", "data"},
+		[]kind.Kind{kind.Int, kind.Text, kind.Date, kind.Bytes},
+	)
+	created := time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)
+	recs := []record.Record{
+		{int64(1), "alice", created, []byte("hi")},
+		{int64(2), nil, nil, nil},
+		{int64(3), "carol", created, nil},
+	}
+
+	testCases := []struct {
+		name string
+		tpl  string
+		want string
+	}{
+		{
+			name: "row",
+			tpl:  "{{.Index}}: {{.Record.name | default \"-\" | upper}} {{index .Values 0 | add 100}}\n",
+			want: "0: ALICE 101\n1: - 102\n2: CAROL 103\n",
+		},
+		{
+			name: "values",
+			tpl:  "{{.Record.created}} {{.Record.data}}\n",
+			want: "2021-03-04 aGk=\n<no value> <no value>\n2021-03-04 <no value>\n",
+		},
+		{
+			name: "hooks",
+			tpl: `{{- define "header"}}{{range .Columns}}{{.Name}}:{{.Kind}} {{end}}
+{{end -}}
+{{- define "batch"}}batch {{len .Rows}} {{.Count}}
+{{end -}}
+{{- define "row"}}{{.Index}}/{{len .Columns}}
+{{end -}}
+{{- define "footer"}}total {{.Count}}
+{{end -}}
+`,
+			want: "id:int name:text created:date data:bytes \nbatch 2 2\n0/4\n1/4\nbatch 1 3\n2/4\ntotal 3\n",
+		},
+		{
+			// A template that defines only hooks outputs nothing per row.
+			name: "hooks_only",
+			tpl: `{{define "header"}}BEGIN{{end}}
+{{define "footer"}} END {{.Count}}{{end}}
+`,
+			want: "BEGIN END 3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			tplFile := tu.WriteTemp(t, "*.tmpl", []byte(tc.tpl), true)
+
+			buf := &bytes.Buffer{}
+			w := templatew.NewRecordWriter(buf, output.NewPrinting(), tplFile)
+			require.NoError(t, w.Open(ctx, recMeta))
+			require.NoError(t, w.WriteRecords(ctx, recs[0:2]))
+			require.NoError(t, w.WriteRecords(ctx, recs[2:]))
+			require.NoError(t, w.Close(ctx))
+			require.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+func TestRecordWriter_errors(t *testing.T) {
+	ctx := context.Background()
+	recMeta := testh.NewRecordMeta([]string{"id"}, []kind.Kind{kind.Int})
+	recs := []record.Record{{int64(1)}}
+
+	openErr := func(tplFile string) error {
+		w := templatew.NewRecordWriter(&bytes.Buffer{}, output.NewPrinting(), tplFile)
+		err := w.Open(ctx, recMeta)
+		require.NoError(t, w.Close(ctx))
+		return err
+	}
+
+	require.Error(t, openErr(""))
+	require.Error(t, openErr(filepath.Join(t.TempDir(), "missing.tmpl")))
+	require.Error(t, openErr(tu.WriteTemp(t, "*.tmpl", []byte("{{.Record"), true)))
+
+	// Parses, but fails when executed.
+	tplFile := tu.WriteTemp(t, "*.tmpl", []byte("{{.Record.id.Nope}}"), true)
+	w := templatew.NewRecordWriter(&bytes.Buffer{}, output.NewPrinting(), tplFile)
+	require.NoError(t, w.Open(ctx, recMeta))
+	require.Error(t, w.WriteRecords(ctx, recs))
+}
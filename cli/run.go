@@ -16,19 +16,25 @@ import (
 	v0_54_0 "github.com/neilotoole/sq/cli/config/yamlstore/upgrades/v0.54.0" //nolint:revive
 	"github.com/neilotoole/sq/cli/flag"
 	"github.com/neilotoole/sq/cli/run"
+	"github.com/neilotoole/sq/drivers/arrow"
+	"github.com/neilotoole/sq/drivers/avro"
 	"github.com/neilotoole/sq/drivers/clickhouse"
 	"github.com/neilotoole/sq/drivers/csv"
 	"github.com/neilotoole/sq/drivers/duckdb"
 	"github.com/neilotoole/sq/drivers/json"
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/ods"
 	"github.com/neilotoole/sq/drivers/oracle"
+	"github.com/neilotoole/sq/drivers/parquet"
 	"github.com/neilotoole/sq/drivers/postgres"
 	"github.com/neilotoole/sq/drivers/rqlite"
 	"github.com/neilotoole/sq/drivers/sqlite3"
 	"github.com/neilotoole/sq/drivers/sqlserver"
 	"github.com/neilotoole/sq/drivers/userdriver"
+	"github.com/neilotoole/sq/drivers/userdriver/jsonud"
 	"github.com/neilotoole/sq/drivers/userdriver/xmlud"
 	"github.com/neilotoole/sq/drivers/xlsx"
+	"github.com/neilotoole/sq/drivers/xml"
 	"github.com/neilotoole/sq/libsq/core/cleanup"
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/ioz/checksum"
@@ -172,12 +178,23 @@ func preRun(cmd *cobra.Command, ru *run.Run) error {
 		ru.Stdin = f
 	}
 
+	cmdOpts, err := getOptionsFromCmd(ru.Cmd)
+	if err != nil {
+		return err
+	}
+
 	// If the --output=/some/file flag is set, then we need to
 	// override ru.Stdout (which is typically stdout) to point it at
 	// the output destination file.
 	//
 
 	if cmdFlagChanged(ru.Cmd, flag.FileOutput) && !cmdRequiresPlainStdout(ru.Cmd) {
+		// Check the output compression before creating the file, so
+		// that an empty file isn't left behind.
+		if err = checkOutputCompression(ru.Cmd, cmdOpts); err != nil {
+			return err
+		}
+
 		fpath, _ := ru.Cmd.Flags().GetString(flag.FileOutput)
 		fpath, err := filepath.Abs(fpath)
 		if err != nil {
@@ -199,11 +216,6 @@ func preRun(cmd *cobra.Command, ru *run.Run) error {
 		ru.Stdout = f
 	}
 
-	cmdOpts, err := getOptionsFromCmd(ru.Cmd)
-	if err != nil {
-		return err
-	}
-
 	// --no-redact is deprecated in favor of --reveal (see #717). Warn
 	// whenever the user explicitly sets the deprecated flag: both
 	// --no-redact and --no-redact=false are meaningful (the latter
@@ -332,12 +344,15 @@ func FinishRunInit(ctx context.Context, ru *run.Run) error {
 	csvp := &csv.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files}
 	dr.AddProvider(drivertype.CSV, csvp)
 	dr.AddProvider(drivertype.TSV, csvp)
+	dr.AddProvider(drivertype.FixedWidth, csvp)
 	ru.Files.AddDriverDetectors(csv.DetectCSV, csv.DetectTSV)
 
 	jsonp := &json.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files}
 	dr.AddProvider(drivertype.JSON, jsonp)
 	dr.AddProvider(drivertype.JSONA, jsonp)
 	dr.AddProvider(drivertype.JSONL, jsonp)
+	dr.AddProvider(drivertype.YAML, jsonp)
+	dr.AddProvider(drivertype.TOML, jsonp)
 	sampleSize := driver.OptIngestSampleSize.Get(cfg.Options)
 	ru.Files.AddDriverDetectors(
 		json.DetectJSON(sampleSize),
@@ -347,9 +362,26 @@ func FinishRunInit(ctx context.Context, ru *run.Run) error {
 
 	dr.AddProvider(drivertype.XLSX, &xlsx.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files})
 	ru.Files.AddDriverDetectors(xlsx.DetectXLSX)
-	// One day we may have more supported user driver genres.
+
+	dr.AddProvider(drivertype.ODS, &ods.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files})
+	ru.Files.AddDriverDetectors(ods.DetectODS)
+
+	dr.AddProvider(drivertype.Parquet, &parquet.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files})
+	ru.Files.AddDriverDetectors(parquet.DetectParquet)
+
+	dr.AddProvider(drivertype.Arrow, &arrow.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files})
+	ru.Files.AddDriverDetectors(arrow.DetectArrow)
+
+	// Avro is detected via its magic number by files.DetectMagicNumber,
+	// so there's no driver-specific detector.
+	dr.AddProvider(drivertype.Avro, &avro.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files})
+
+	dr.AddProvider(drivertype.XML, &xml.Provider{Log: log, Ingester: ru.Grips, Files: ru.Files})
+	ru.Files.AddDriverDetectors(xml.DetectXML)
+
 	userDriverImporters := map[string]userdriver.IngestFunc{
-		xmlud.Genre: xmlud.Ingest,
+		xmlud.Genre:  xmlud.Ingest,
+		jsonud.Genre: jsonud.Ingest,
 	}
 
 	for i, udd := range cfg.Ext.UserDrivers {
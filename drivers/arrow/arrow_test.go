@@ -0,0 +1,129 @@
+package arrow_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/drivers/arrow"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgt"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/tu"
+)
+
+func TestDetectArrow(t *testing.T) {
+	testCases := []struct {
+		fpath string
+		want  drivertype.Type
+	}{
+		{fpath: filepath.Join("testdata", "actor.arrow"), want: drivertype.Arrow},
+		{fpath: filepath.Join("testdata", "types.arrow"), want: drivertype.Arrow},
+		{fpath: filepath.Join("testdata", "types.arrows"), want: drivertype.Arrow},
+		{fpath: filepath.Join("..", "parquet", "testdata", "actor.parquet")},
+		{fpath: filepath.Join("..", "csv", "testdata", "person.csv")},
+		{fpath: filepath.Join("..", "json", "testdata", "empty.file")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tu.Name(tc.fpath), func(t *testing.T) {
+			newRdrFn := func(_ context.Context) (io.ReadCloser, error) { return os.Open(tc.fpath) }
+			ctx := lg.NewContext(context.Background(), lgt.New(t))
+
+			gotType, gotScore, gotErr := arrow.DetectArrow(ctx, newRdrFn)
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.want, gotType)
+			if tc.want == drivertype.None {
+				require.Equal(t, float32(0), gotScore)
+			} else {
+				require.Equal(t, float32(1.0), gotScore)
+			}
+		})
+	}
+}
+
+func TestSmoke(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@arrow_actor",
+		Type:     drivertype.Arrow,
+		Location: filepath.Join("testdata", "actor.arrow"),
+	})
+
+	sink, err := th.QuerySLQ(src.Handle+".data", nil)
+	require.NoError(t, err)
+	require.Equal(t, sakila.TblActorCols(), sink.RecMeta.MungedNames())
+	require.Equal(t, sakila.TblActorCount, len(sink.Recs))
+	require.Equal(t, "PENELOPE", sink.Recs[0][1])
+	require.Equal(t, time.Date(2006, 2, 15, 4, 34, 33, 0, time.UTC), sink.Recs[0][3].(time.Time).UTC())
+}
+
+func TestIngest_Kinds(t *testing.T) {
+	tu.SkipIssueWindows(t, tu.GH355SQLiteDecimalWin)
+
+	// types.arrow and types.arrows have the same data, in the file and
+	// streaming formats respectively.
+	for _, fname := range []string{"types.arrow", "types.arrows"} {
+		t.Run(fname, func(t *testing.T) {
+			t.Parallel()
+
+			th := testh.New(t)
+			src := th.Add(&source.Source{
+				Handle:   "@arrow_types",
+				Type:     drivertype.Arrow,
+				Location: filepath.Join("testdata", fname),
+			})
+
+			sink, err := th.QuerySLQ(src.Handle+".data", nil)
+			require.NoError(t, err)
+
+			wantKinds := []kind.Kind{
+				kind.Int, kind.Int, kind.Int, kind.Int, kind.Int,
+				kind.Float, kind.Float, kind.Float, kind.Bool, kind.Bytes, kind.Bytes,
+				kind.Decimal, kind.Datetime, kind.Datetime, kind.Date, kind.Date,
+				kind.Time, kind.Time, kind.Text, kind.Text,
+			}
+			require.Equal(t, wantKinds, sink.RecMeta.Kinds())
+			require.Len(t, sink.Recs, 3)
+
+			rec := sink.Recs[0]
+			require.Equal(t, int64(1), rec[0])
+			require.Equal(t, int64(4000000000), rec[4])
+			require.Equal(t, 1.5, rec[5])
+			require.Equal(t, 1.5, rec[7])
+			require.Equal(t, true, rec[8])
+			require.Equal(t, []byte("hello"), rec[9])
+			require.Equal(t, []byte("abc"), rec[10])
+			require.True(t, decimal.RequireFromString("12.345").Equal(rec[11].(decimal.Decimal)))
+			require.Equal(t, time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), rec[12].(time.Time).UTC())
+			require.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), rec[14].(time.Time).UTC())
+			require.Equal(t, "huzzah", rec[18])
+			require.Nil(t, rec[19])
+
+			rec = sink.Recs[1]
+			require.Equal(t, int64(-2), rec[0])
+			require.Equal(t, -0.25, rec[5])
+			require.True(t, decimal.RequireFromString("-7.5").Equal(rec[11].(decimal.Decimal)))
+			require.Equal(t, time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC), rec[13].(time.Time).UTC())
+			require.Equal(t, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), rec[15].(time.Time).UTC())
+			require.Equal(t, "ünïcödé", rec[18])
+
+			// The last row is all NULL.
+			for i, val := range sink.Recs[2] {
+				require.Nil(t, val, sink.RecMeta[i].Name())
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package arrow
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+// continuation is the marker that precedes each message of an Arrow
+// IPC stream.
+var continuation = []byte{0xFF, 0xFF, 0xFF, 0xFF}
+
+// maxDetectSize is the maximum amount of data read to detect an Arrow
+// stream: the schema message is expected to be within this size.
+const maxDetectSize = 1024 * 1024
+
+var _ files.TypeDetectFunc = DetectArrow
+
+// DetectArrow implements files.TypeDetectFunc, returning
+// drivertype.Arrow and a score of 1.0 if the data is in the Arrow IPC
+// file format, which starts with the magic number "ARROW1", or if the
+// data is an Arrow IPC stream, which starts with a schema message.
+func DetectArrow(ctx context.Context, newRdrFn files.NewReaderFunc) (detected drivertype.Type, score float32,
+	err error,
+) {
+	log := lg.FromContext(ctx)
+	var r io.ReadCloser
+	r, err = newRdrFn(ctx)
+	if err != nil {
+		return drivertype.None, 0, errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+
+	br := bufio.NewReader(io.LimitReader(r, maxDetectSize))
+	b, _ := br.Peek(len(ipc.Magic))
+	switch {
+	case bytes.Equal(b, ipc.Magic):
+		return drivertype.Arrow, 1.0, nil
+	case !bytes.HasPrefix(b, continuation):
+		return drivertype.None, 0, nil
+	}
+
+	// It could be a stream: check that it starts with a valid schema
+	// message.
+	var ir *ipc.Reader
+	if ir, err = ipc.NewReader(br); err != nil {
+		log.Debug("Arrow stream not detected", lga.Err, err)
+		return drivertype.None, 0, nil
+	}
+	ir.Release()
+
+	return drivertype.Arrow, 1.0, nil
+}
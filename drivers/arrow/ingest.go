@@ -0,0 +1,116 @@
+package arrow
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// ingestArrow loads the src Arrow data into destGrip.
+func ingestArrow(ctx context.Context, fs *files.Files, src *source.Source, destGrip driver.Grip) error {
+	log := lg.FromContext(ctx)
+
+	rc, err := fs.NewReader(ctx, src, true)
+	if err != nil {
+		return err
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, rc)
+
+	ar, err := newBatchReader(ctx, fs, rc)
+	if err != nil {
+		return err
+	}
+	defer ar.Release()
+
+	return arrowz.Ingest(ctx, destGrip, ar)
+}
+
+// batchReader reads Arrow record batches. It's implemented by ipc.Reader,
+// and by fileBatchReader.
+type batchReader interface {
+	arrowz.BatchIterator
+	Release()
+}
+
+// newBatchReader returns a batchReader for the Arrow data in r, which may
+// be in the file format, or the streaming format. A stream is read
+// sequentially. The file format's record batches are located via the
+// file's footer, so the reader requires random access: the data is
+// copied to a temp file, because the source could be a remote file,
+// or stdin. The temp file is deleted when fs is closed.
+func newBatchReader(ctx context.Context, fs *files.Files, r io.Reader) (batchReader, error) {
+	br := bufio.NewReader(r)
+	if b, _ := br.Peek(len(ipc.Magic)); !bytes.Equal(b, ipc.Magic) {
+		ir, err := ipc.NewReader(br)
+		if err != nil {
+			return nil, errz.Wrap(err, "arrow: invalid Arrow data")
+		}
+		return ir, nil
+	}
+
+	f, err := fs.CreateTemp("*.arrow", true)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.Copy(f, br); err != nil {
+		lg.WarnIfCloseError(lg.FromContext(ctx), lgm.CloseFileWriter, f)
+		return nil, errz.Wrap(err, "arrow: copy source data")
+	}
+
+	fr, err := ipc.NewFileReader(f)
+	if err != nil {
+		lg.WarnIfCloseError(lg.FromContext(ctx), lgm.CloseFileReader, f)
+		return nil, errz.Wrap(err, "arrow: invalid Arrow data")
+	}
+
+	return &fileBatchReader{FileReader: fr, f: f}, nil
+}
+
+// fileBatchReader is a batchReader for an Arrow file. Release closes
+// the underlying file.
+type fileBatchReader struct {
+	*ipc.FileReader
+	f     *os.File
+	batch arrow.RecordBatch
+	err   error
+}
+
+// Next implements batchReader.
+func (r *fileBatchReader) Next() bool {
+	r.batch, r.err = r.FileReader.Read()
+	return r.err == nil
+}
+
+// RecordBatch implements batchReader.
+func (r *fileBatchReader) RecordBatch() arrow.RecordBatch {
+	return r.batch
+}
+
+// Err implements batchReader.
+func (r *fileBatchReader) Err() error {
+	if errors.Is(r.err, io.EOF) {
+		return nil
+	}
+	return r.err
+}
+
+// Release implements batchReader.
+func (r *fileBatchReader) Release() {
+	_ = r.FileReader.Close()
+	_ = r.f.Close()
+}
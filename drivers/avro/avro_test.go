@@ -0,0 +1,114 @@
+package avro_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/tu"
+)
+
+func TestSmoke(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@avro_actor",
+		Type:     drivertype.Avro,
+		Location: filepath.Join("testdata", "actor.avro"),
+	})
+
+	sink, err := th.QuerySLQ(src.Handle+".data", nil)
+	require.NoError(t, err)
+	require.Equal(t, sakila.TblActorCols(), sink.RecMeta.MungedNames())
+	require.Equal(t, sakila.TblActorCount, len(sink.Recs))
+	require.Equal(t, "PENELOPE", sink.Recs[0][1])
+	require.Equal(t, time.Date(2006, 2, 15, 4, 34, 33, 0, time.UTC), sink.Recs[0][3].(time.Time).UTC())
+}
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@avro_actor",
+		Type:     drivertype.Avro,
+		Location: filepath.Join("testdata", "actor.avro"),
+	})
+	csvSrc := th.Source(sakila.CSVActor)
+
+	sink, err := th.QuerySLQ(src.Handle+".data | join("+csvSrc.Handle+".data, .actor_id) | .[0]", nil)
+	require.NoError(t, err)
+	require.Len(t, sink.Recs, 1)
+	require.Equal(t, "PENELOPE", sink.Recs[0][1])
+}
+
+func TestIngest_Kinds(t *testing.T) {
+	tu.SkipIssueWindows(t, tu.GH355SQLiteDecimalWin)
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@avro_types",
+		Type:     drivertype.Avro,
+		Location: filepath.Join("testdata", "types.avro"),
+	})
+
+	sink, err := th.QuerySLQ(src.Handle+".data", nil)
+	require.NoError(t, err)
+
+	wantKinds := []kind.Kind{
+		kind.Int, kind.Int, kind.Float, kind.Float, kind.Bool, kind.Bytes, kind.Bytes,
+		kind.Decimal, kind.Datetime, kind.Datetime, kind.Date, kind.Time, kind.Time,
+		kind.Text, kind.Text, kind.Text, kind.Text, kind.Text, kind.Text, kind.Bytes, kind.Text,
+	}
+	require.Equal(t, wantKinds, sink.RecMeta.Kinds())
+	require.Len(t, sink.Recs, 3)
+
+	rec := sink.Recs[0]
+	require.Equal(t, int64(1), rec[0])
+	require.Equal(t, int64(4000000000), rec[1])
+	require.Equal(t, 1.5, rec[2])
+	require.Equal(t, 2.25, rec[3])
+	require.Equal(t, true, rec[4])
+	require.Equal(t, []byte("hello"), rec[5])
+	require.Equal(t, []byte("abcd"), rec[6])
+	require.True(t, decimal.RequireFromString("12.345").Equal(rec[7].(decimal.Decimal)))
+	require.Equal(t, time.Date(2021, 3, 4, 5, 6, 7, 123000000, time.UTC), rec[8].(time.Time).UTC())
+	require.Equal(t, time.Date(2021, 3, 4, 5, 6, 7, 123000000, time.UTC), rec[9].(time.Time).UTC())
+	require.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), rec[10].(time.Time).UTC())
+	require.Equal(t, "huzzah", rec[13])
+	require.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", rec[14])
+	require.Equal(t, "GREEN", rec[15])
+	require.Equal(t, "[1,2]", rec[16])
+	require.Equal(t, `{"k":"v"}`, rec[17])
+	require.Equal(t, `{"x":3,"y":-4}`, rec[18])
+	require.Equal(t, []byte("wxyz"), rec[19])
+	require.Nil(t, rec[20])
+
+	rec = sink.Recs[1]
+	require.Equal(t, int64(-2), rec[0])
+	require.Equal(t, int64(-9000000000000000000), rec[1])
+	require.Equal(t, -0.25, rec[2])
+	require.True(t, decimal.RequireFromString("-7.5").Equal(rec[7].(decimal.Decimal)))
+	require.Equal(t, time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC), rec[9].(time.Time).UTC())
+	require.Equal(t, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), rec[10].(time.Time).UTC())
+	require.Equal(t, "ünïcödé", rec[13])
+	require.Nil(t, rec[14])
+	require.Equal(t, "BLUE", rec[15])
+	require.Equal(t, "[]", rec[16])
+	require.Equal(t, "{}", rec[17])
+
+	// The last row is all NULL.
+	for i, val := range sink.Recs[2] {
+		require.Nil(t, val, sink.RecMeta[i].Name())
+	}
+}
@@ -0,0 +1,264 @@
+package avro
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/neilotoole/sq/libsq"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/libsq/core/schema"
+	"github.com/neilotoole/sq/libsq/core/tuning"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// column describes an Avro record field, and how its values are
+// converted for ingest.
+type column struct {
+	name   string
+	kind   kind.Kind
+	mungeF kind.MungeFunc
+}
+
+// ingestAvro loads the src Avro data into destGrip.
+func ingestAvro(ctx context.Context, fs *files.Files, src *source.Source, destGrip driver.Grip) error {
+	log := lg.FromContext(ctx)
+	start := time.Now()
+
+	// Unlike Parquet, the Avro data can be read sequentially, so there's
+	// no need for a temp file.
+	rc, err := fs.NewReader(ctx, src, true)
+	if err != nil {
+		return err
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, rc)
+
+	ar, err := newReader(rc)
+	if err != nil {
+		return errz.Wrap(err, "avro: invalid Avro data")
+	}
+	defer ar.Close()
+
+	cols, err := buildColumns(ctx, ar.fields, ar.types)
+	if err != nil {
+		return err
+	}
+
+	tblDef := &schema.Table{Name: source.MonotableName}
+	tblDef.Cols = make([]*schema.Column, len(cols))
+	for i, col := range cols {
+		tblDef.Cols[i] = &schema.Column{Table: tblDef, Name: col.name, Kind: col.kind}
+	}
+
+	db, err := destGrip.DB(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = destGrip.SQLDriver().CreateTable(ctx, db, tblDef); err != nil {
+		return errz.Wrap(err, "avro: failed to create dest scratch table")
+	}
+
+	recMeta, err := getIngestRecMeta(ctx, destGrip, tblDef)
+	if err != nil {
+		return err
+	}
+
+	insertWriter := libsq.NewDBWriter(
+		libsq.MsgIngestRecords,
+		destGrip,
+		tblDef.Name,
+		tuning.OptRecBufSize.Get(destGrip.Source().Options),
+	)
+
+	if err = execInsert(ctx, insertWriter, recMeta, cols, ar); err != nil {
+		return err
+	}
+
+	inserted, err := insertWriter.Wait()
+	if err != nil {
+		return err
+	}
+
+	log.Info(
+		"Ingested rows",
+		lga.Count, inserted,
+		lga.Elapsed, time.Since(start).Round(time.Millisecond),
+		lga.Target, source.Target(destGrip.Source(), tblDef.Name),
+	)
+	return nil
+}
+
+// valueColName is the name of the column for a file whose schema isn't
+// a record: each row of the file is a single value.
+const valueColName = "value"
+
+// buildColumns returns a column for each field of the Avro record schema,
+// as returned by parseSchema.
+func buildColumns(ctx context.Context, fields []string, types []*avroType) ([]*column, error) {
+	names := fields
+	if names == nil {
+		names = []string{valueColName}
+	}
+
+	cols := make([]*column, len(types))
+	for i, typ := range types {
+		knd := typ.kind
+		if knd == kind.Null {
+			// The values are all NULL.
+			knd = kind.Text
+		}
+		cols[i] = &column{kind: knd, mungeF: mungeFuncFor(knd)}
+	}
+
+	names, err := driver.MungeIngestColNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cols {
+		cols[i].name = names[i]
+	}
+
+	return cols, nil
+}
+
+// mungeFuncFor returns a kind.MungeFunc that converts the values read from
+// a field of kind knd to a value to be inserted. See reader.Next for the Go
+// type of the values read.
+func mungeFuncFor(knd kind.Kind) kind.MungeFunc {
+	switch knd { //nolint:exhaustive
+	case kind.Date:
+		return func(v any) (any, error) {
+			t, ok := v.(time.Time)
+			if !ok {
+				return nil, errz.Errorf("avro: unexpected %T value for date", v)
+			}
+			return t.Format(time.DateOnly), nil
+		}
+	case kind.Time:
+		return func(v any) (any, error) {
+			d, ok := v.(time.Duration)
+			if !ok {
+				return nil, errz.Errorf("avro: unexpected %T value for time", v)
+			}
+			return time.Unix(0, int64(d)).UTC().Format(time.TimeOnly), nil
+		}
+	case kind.Text:
+		return textValue
+	}
+
+	return nil
+}
+
+// textValue is the kind.MungeFunc for a kind.Text column. The value of an
+// array, map or nested record is converted to JSON; the value of a union
+// whose branches differ in kind is converted to its text form.
+func textValue(v any) (any, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case []any, map[string]any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, errz.Wrap(err, "avro: convert value to JSON")
+		}
+		return string(b), nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
+
+// execInsert reads the rows from ar, writing them via recw. The caller
+// should wait on recw to complete.
+func execInsert(ctx context.Context, recw libsq.RecordWriter, recMeta record.Meta,
+	cols []*column, ar *reader,
+) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	// We don't do "defer cancelFn" here. The cancelFn is passed
+	// to recw.
+
+	recordCh, errCh, err := recw.Open(ctx, cancelFn, recMeta)
+	if err != nil {
+		return err
+	}
+	defer close(recordCh)
+
+	for {
+		var rec []any
+		if rec, err = ar.Next(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			cancelFn()
+			return err
+		}
+
+		if err = mungeRecord(cols, rec); err != nil {
+			cancelFn()
+			return err
+		}
+
+		select {
+		case err = <-errCh:
+			cancelFn()
+			return err
+		case <-ctx.Done():
+			cancelFn()
+			return ctx.Err()
+		case recordCh <- rec:
+		}
+	}
+}
+
+// mungeRecord applies each column's munge func, if any, to rec's
+// non-nil values, in place.
+func mungeRecord(cols []*column, rec []any) error {
+	for i, col := range cols {
+		if col.mungeF == nil || rec[i] == nil {
+			continue
+		}
+
+		var err error
+		if rec[i], err = col.mungeF(rec[i]); err != nil {
+			return errz.Wrapf(err, "column {%s}", col.name)
+		}
+	}
+	return nil
+}
+
+// getIngestRecMeta returns record.Meta to use with RecordWriter.Open.
+func getIngestRecMeta(ctx context.Context, destGrip driver.Grip, tblDef *schema.Table) (record.Meta, error) {
+	db, err := destGrip.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	drvr := destGrip.SQLDriver()
+
+	colTypes, err := drvr.TableColumnTypes(ctx, db, tblDef.Name, tblDef.ColNames())
+	if err != nil {
+		return nil, err
+	}
+
+	destMeta, _, err := drvr.RecordMeta(ctx, colTypes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return destMeta, nil
+}
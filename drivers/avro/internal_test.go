@@ -0,0 +1,238 @@
+package avro
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/linkedin/goavro/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+
+	"github.com/neilotoole/sq/libsq/core/kind"
+)
+
+const testSchema = `{"type": "record", "name": "Row", "fields": [
+	{"name": "id", "type": "long"},
+	{"name": "name", "type": ["null", "string"]},
+	{"name": "amount", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+	{"name": "day", "type": {"type": "int", "logicalType": "date"}},
+	{"name": "ts", "type": {"type": "long", "logicalType": "local-timestamp-micros"}}
+]}`
+
+var testDay = time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+
+// testNatives returns the rows of testSchema, as expected by goavro.
+func testNatives() []any {
+	return []any{
+		map[string]any{
+			"id":     int64(1),
+			"name":   goavro.Union("string", "Alice"),
+			"amount": big.NewRat(12345, 100),
+			"day":    testDay,
+			"ts":     int64(1_700_000_000_123_456),
+		},
+		map[string]any{
+			"id":     int64(2),
+			"name":   nil,
+			"amount": big.NewRat(-5, 1),
+			"day":    testDay,
+			"ts":     int64(0),
+		},
+	}
+}
+
+// testRows returns the rows of testSchema, as returned by reader.Next.
+func testRows() [][]any {
+	return [][]any{
+		{int64(1), "Alice", decimal.RequireFromString("123.45"), testDay, time.UnixMicro(1_700_000_000_123_456).UTC()},
+		{int64(2), nil, decimal.RequireFromString("-5"), testDay, time.UnixMicro(0).UTC()},
+	}
+}
+
+// TestReader_goavro verifies that files written by goavro's OCFWriter are
+// read, for each codec that it supports.
+func TestReader_goavro(t *testing.T) {
+	for _, codec := range []string{
+		goavro.CompressionNullLabel,
+		goavro.CompressionDeflateLabel,
+		goavro.CompressionSnappyLabel,
+	} {
+		t.Run(codec, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w, err := goavro.NewOCFWriter(goavro.OCFConfig{W: buf, Schema: testSchema, CompressionName: codec})
+			require.NoError(t, err)
+			require.NoError(t, w.Append(testNatives()))
+
+			requireRows(t, buf.Bytes())
+		})
+	}
+}
+
+// TestReader_codecs verifies the codecs that goavro doesn't support.
+func TestReader_codecs(t *testing.T) {
+	testCases := map[string]func([]byte) []byte{
+		"zstandard": func(b []byte) []byte {
+			enc, err := zstd.NewWriter(nil)
+			require.NoError(t, err)
+			defer enc.Close()
+			return enc.EncodeAll(b, nil)
+		},
+		"xz": func(b []byte) []byte {
+			out := &bytes.Buffer{}
+			xw, err := xz.NewWriter(out)
+			require.NoError(t, err)
+			_, err = xw.Write(b)
+			require.NoError(t, err)
+			require.NoError(t, xw.Close())
+			return out.Bytes()
+		},
+	}
+
+	for codec, compress := range testCases {
+		t.Run(codec, func(t *testing.T) {
+			requireRows(t, writeOCF(t, codec, compress))
+		})
+	}
+}
+
+func TestReader_invalid(t *testing.T) {
+	data := writeOCF(t, "null", func(b []byte) []byte { return b })
+
+	testCases := map[string][]byte{
+		"empty":            nil,
+		"magic":            []byte("Obj\x02"),
+		"truncated_header": data[:20],
+		"truncated_block":  data[:len(data)-20],
+		"truncated_sync":   data[:len(data)-1],
+	}
+
+	for name, b := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := readAll(b)
+			require.Error(t, err)
+			require.False(t, errors.Is(err, io.EOF), err)
+		})
+	}
+
+	t.Run("codec", func(t *testing.T) {
+		_, err := newReader(bytes.NewReader(writeOCF(t, "lz4", func(b []byte) []byte { return b })))
+		require.Error(t, err)
+	})
+}
+
+func TestParseSchema(t *testing.T) {
+	fields, types, err := parseSchema([]byte(testSchema))
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name", "amount", "day", "ts"}, fields)
+
+	gotKinds := make([]kind.Kind, len(types))
+	for i, typ := range types {
+		gotKinds[i] = typ.kind
+	}
+	require.Equal(t, []kind.Kind{kind.Int, kind.Text, kind.Decimal, kind.Date, kind.Datetime}, gotKinds)
+	require.Equal(t, 2, types[2].scale)
+
+	fields, types, err = parseSchema([]byte(`["null", "int", "long"]`))
+	require.NoError(t, err)
+	require.Nil(t, fields)
+	require.Equal(t, kind.Int, types[0].kind)
+
+	_, types, err = parseSchema([]byte(`["null", "int", "string"]`))
+	require.NoError(t, err)
+	require.Equal(t, kind.Text, types[0].kind)
+}
+
+// requireRows requires that data, an object container file of testSchema,
+// holds testRows.
+func requireRows(t *testing.T, data []byte) {
+	t.Helper()
+
+	r, err := newReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer r.Close()
+
+	for _, want := range testRows() {
+		got, err := r.Next()
+		require.NoError(t, err)
+		require.Len(t, got, len(want))
+		for i := range want {
+			switch w := want[i].(type) {
+			case decimal.Decimal:
+				require.True(t, w.Equal(got[i].(decimal.Decimal)), "%s != %v", w, got[i])
+			default:
+				require.Equal(t, w, got[i])
+			}
+		}
+	}
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+// readAll reads each row of data, returning the first error other than the
+// io.EOF at the end of the data.
+func readAll(data []byte) error {
+	r, err := newReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for {
+		if _, err = r.Next(); err != nil {
+			if err == io.EOF { //nolint:errorlint
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// writeOCF returns an object container file of testSchema holding a single
+// data block of testNatives, compressed via compress, with its header
+// specifying codec.
+func writeOCF(t *testing.T, codec string, compress func([]byte) []byte) []byte {
+	t.Helper()
+
+	c, err := goavro.NewCodec(testSchema)
+	require.NoError(t, err)
+
+	var block []byte
+	for _, native := range testNatives() {
+		block, err = c.BinaryFromNative(block, native)
+		require.NoError(t, err)
+	}
+	block = compress(block)
+
+	sync := []byte("0123456789abcdef")
+	buf := &bytes.Buffer{}
+	buf.WriteString(magic)
+	writeLong(buf, 2)
+	writeBytes(buf, []byte(metaSchema))
+	writeBytes(buf, []byte(testSchema))
+	writeBytes(buf, []byte(metaCodec))
+	writeBytes(buf, []byte(codec))
+	writeLong(buf, 0)
+	buf.Write(sync)
+
+	writeLong(buf, int64(len(testNatives())))
+	writeBytes(buf, block)
+	buf.Write(sync)
+	return buf.Bytes()
+}
+
+func writeLong(buf *bytes.Buffer, n int64) {
+	buf.Write(binary.AppendVarint(nil, n))
+}
+
+func writeBytes(buf *bytes.Buffer, b []byte) {
+	writeLong(buf, int64(len(b)))
+	buf.Write(b)
+}
@@ -0,0 +1,350 @@
+package avro
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/linkedin/goavro/v2"
+	"github.com/shopspring/decimal"
+	"github.com/ulikunitz/xz"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+)
+
+// magic is the magic number that starts an Avro object container file.
+const magic = "Obj\x01"
+
+// Header metadata keys.
+const (
+	metaSchema = "avro.schema"
+	metaCodec  = "avro.codec"
+)
+
+// maxMetaSize is the maximum size of a header metadata value, such as
+// the schema.
+const maxMetaSize = 16 << 20
+
+// reader reads the rows of an Avro object container file. The values are
+// decoded by the github.com/linkedin/goavro library. The container itself
+// is read by reader rather than by goavro.OCFReader, because the latter
+// doesn't support the zstandard, bzip2 and xz codecs.
+//
+// The rows of a file are the values of its top-level schema: if the schema
+// is a record, a row holds the values of the record's fields; otherwise, a
+// row holds a single value. See reader.Next for the Go types of the values.
+type reader struct {
+	br         *bufio.Reader
+	codec      *goavro.Codec
+	decompress func([]byte) ([]byte, error)
+	closeFn    func()
+
+	// fields is the name of each field of a record schema, or nil if the
+	// schema isn't a record.
+	fields []string
+
+	// types is the type of each column.
+	types []*avroType
+	sync  [16]byte
+
+	// block is the remaining data of the current block, which holds count
+	// more rows.
+	block []byte
+	count int64
+}
+
+// newReader returns a reader that reads from r. It reads the file header.
+// The caller must invoke reader.Close when done.
+func newReader(r io.Reader) (*reader, error) {
+	rdr := &reader{br: bufio.NewReader(r)}
+
+	b := make([]byte, len(magic))
+	if _, err := io.ReadFull(rdr.br, b); err != nil {
+		return nil, errz.Wrap(noEOF(err), "avro: read header")
+	}
+	if string(b) != magic {
+		return nil, errz.New("avro: not an object container file")
+	}
+
+	meta, err := rdr.readMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.ReadFull(rdr.br, rdr.sync[:]); err != nil {
+		return nil, errz.Wrap(noEOF(err), "avro: read header")
+	}
+
+	if rdr.codec, err = goavro.NewCodec(string(meta[metaSchema])); err != nil {
+		return nil, errz.Wrap(err, "avro: invalid schema")
+	}
+
+	if rdr.fields, rdr.types, err = parseSchema(meta[metaSchema]); err != nil {
+		return nil, err
+	}
+
+	if err = rdr.setCodec(string(meta[metaCodec])); err != nil {
+		return nil, err
+	}
+	return rdr, nil
+}
+
+// readMeta reads the header metadata, which is encoded as an Avro map of
+// bytes.
+func (r *reader) readMeta() (map[string][]byte, error) {
+	meta := map[string][]byte{}
+	for {
+		count, err := r.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return meta, nil
+		}
+		if count < 0 {
+			// A negative count is followed by the size of the block.
+			count = -count
+			if _, err = r.readLong(); err != nil {
+				return nil, err
+			}
+		}
+
+		for ; count > 0; count-- {
+			var key, val []byte
+			if key, err = r.readBytes(maxMetaSize); err != nil {
+				return nil, err
+			}
+			if val, err = r.readBytes(maxMetaSize); err != nil {
+				return nil, err
+			}
+			meta[string(key)] = val
+		}
+	}
+}
+
+// readLong reads an Avro long, which is a zig-zag encoded varint.
+func (r *reader) readLong() (int64, error) {
+	n, err := binary.ReadVarint(r.br)
+	if err != nil {
+		return 0, errz.Wrap(noEOF(err), "avro: invalid data")
+	}
+	return n, nil
+}
+
+// readBytes reads a length-prefixed byte sequence, whose length must not
+// exceed limit. The data is copied as it's read, so that a corrupt length
+// doesn't result in a huge allocation.
+func (r *reader) readBytes(limit int64) ([]byte, error) {
+	n, err := r.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > limit {
+		return nil, errz.Errorf("avro: invalid data: length %d", n)
+	}
+
+	buf := &bytes.Buffer{}
+	if _, err = io.CopyN(buf, r.br, n); err != nil {
+		return nil, errz.Wrap(noEOF(err), "avro: invalid data")
+	}
+	return buf.Bytes(), nil
+}
+
+// noEOF returns io.ErrUnexpectedEOF if err is io.EOF, otherwise err.
+func noEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// setCodec sets the decompress function for the data blocks. As per the
+// Avro spec, a file without a codec uses the null codec.
+func (r *reader) setCodec(codec string) error {
+	switch codec {
+	case "", "null":
+		r.decompress = func(b []byte) ([]byte, error) { return b, nil }
+	case "deflate":
+		r.decompress = func(b []byte) ([]byte, error) {
+			return io.ReadAll(flate.NewReader(bytes.NewReader(b)))
+		}
+	case "snappy":
+		r.decompress = decompressSnappy
+	case "zstandard":
+		dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return errz.Err(err)
+		}
+		r.decompress = func(b []byte) ([]byte, error) { return dec.DecodeAll(b, nil) }
+		r.closeFn = dec.Close
+	case "bzip2":
+		r.decompress = func(b []byte) ([]byte, error) {
+			return io.ReadAll(bzip2.NewReader(bytes.NewReader(b)))
+		}
+	case "xz":
+		r.decompress = func(b []byte) ([]byte, error) {
+			xr, err := xz.NewReader(bytes.NewReader(b))
+			if err != nil {
+				return nil, err
+			}
+			return io.ReadAll(xr)
+		}
+	default:
+		return errz.Errorf("avro: codec {%s} is not supported", codec)
+	}
+	return nil
+}
+
+// decompressSnappy decompresses a snappy block, which is followed by the
+// big-endian CRC32 checksum of the uncompressed data.
+func decompressSnappy(b []byte) ([]byte, error) {
+	if len(b) < 4 {
+		return nil, errz.New("invalid snappy block")
+	}
+
+	n := len(b) - 4
+	out, err := snappy.Decode(nil, b[:n])
+	if err != nil {
+		return nil, err
+	}
+	if crc32.ChecksumIEEE(out) != binary.BigEndian.Uint32(b[n:]) {
+		return nil, errz.New("snappy block checksum mismatch")
+	}
+	return out, nil
+}
+
+// Close releases the reader's resources. It doesn't close the underlying
+// io.Reader.
+func (r *reader) Close() {
+	if r.closeFn != nil {
+		r.closeFn()
+	}
+}
+
+// Next returns the next row. At the end of the data, io.EOF is returned.
+// The Go type of a value depends on its Avro type:
+//
+//	null                             nil
+//	boolean                          bool
+//	int, long                        int64
+//	float, double                    float64
+//	bytes, fixed                     []byte
+//	string, enum                     string
+//	array                            []any
+//	map, record                      map[string]any
+//	decimal                          decimal.Decimal
+//	date, timestamp-*,
+//	local-timestamp-*                time.Time (UTC)
+//	time-millis, time-micros         time.Duration (since midnight)
+//
+// The value of a union is the value of its branch. The elements of an
+// array, and the values of a map or nested record, are as decoded by
+// goavro.
+func (r *reader) Next() ([]any, error) {
+	for r.count == 0 {
+		if err := r.readBlock(); err != nil {
+			return nil, err
+		}
+	}
+
+	native, rest, err := r.codec.NativeFromBinary(r.block)
+	if err != nil {
+		return nil, errz.Wrap(err, "avro: invalid data")
+	}
+	r.block = rest
+	r.count--
+
+	if r.fields == nil {
+		return []any{r.types[0].value(native)}, nil
+	}
+
+	m, ok := native.(map[string]any)
+	if !ok {
+		return nil, errz.Errorf("avro: unexpected %T record value", native)
+	}
+
+	row := make([]any, len(r.fields))
+	for i, name := range r.fields {
+		row[i] = r.types[i].value(m[name])
+	}
+	return row, nil
+}
+
+// readBlock reads the next data block. At the end of the data, io.EOF is
+// returned.
+func (r *reader) readBlock() error {
+	if _, err := r.br.Peek(1); errors.Is(err, io.EOF) {
+		return io.EOF
+	}
+
+	count, err := r.readLong()
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return errz.Errorf("avro: invalid data: block count %d", count)
+	}
+
+	data, err := r.readBytes(1<<63 - 1)
+	if err != nil {
+		return err
+	}
+
+	var sync [16]byte
+	if _, err = io.ReadFull(r.br, sync[:]); err != nil {
+		return errz.Wrap(noEOF(err), "avro: invalid data")
+	}
+	if sync != r.sync {
+		return errz.New("avro: invalid data: block sync marker mismatch")
+	}
+
+	if r.block, err = r.decompress(data); err != nil {
+		return errz.Wrap(err, "avro: decompress block")
+	}
+	r.count = count
+	return nil
+}
+
+// value returns v, a value of type t as decoded by goavro, as the Go type
+// documented by reader.Next.
+func (t *avroType) value(v any) any {
+	if m, ok := v.(map[string]any); ok && t.union {
+		// goavro decodes the non-null value of a union as a map of the
+		// branch's name to the value.
+		for _, val := range m {
+			v = val
+		}
+	}
+
+	switch v := v.(type) {
+	case int32:
+		return int64(v)
+	case int64:
+		// goavro doesn't implement local-timestamp-*.
+		switch t.logical {
+		case "local-timestamp-millis":
+			return time.UnixMilli(v).UTC()
+		case "local-timestamp-micros":
+			return time.UnixMicro(v).UTC()
+		}
+	case float32:
+		return float64(v)
+	case *big.Rat:
+		if t.kind == kind.Decimal {
+			return decimal.NewFromBigRat(v, int32(t.scale)) //nolint:gosec
+		}
+		return decimal.NewFromBigRat(v, 0)
+	}
+
+	return v
+}
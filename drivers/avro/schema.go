@@ -0,0 +1,214 @@
+package avro
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+)
+
+// avroType describes an Avro type, as relevant to ingest. The schema
+// itself is validated, and its values decoded, by goavro: but goavro
+// doesn't expose the parsed schema.
+type avroType struct {
+	// kind is the kind of the type's values. It is kind.Null for the null
+	// type, and for a union whose branches are all null.
+	kind kind.Kind
+
+	// logical is the logical type, such as "decimal", or empty. For a
+	// union, it is the logical type of the first non-null branch.
+	logical string
+
+	// scale is the scale of a decimal type.
+	scale int
+
+	// union is true if the type is a union.
+	union bool
+}
+
+// parseSchema parses the Avro schema b. If the schema is a record, the
+// name and type of each field are returned. Otherwise, fields is nil, and
+// types holds the type of the schema.
+func parseSchema(b []byte) (fields []string, types []*avroType, err error) {
+	var node any
+	if err = json.Unmarshal(b, &node); err != nil {
+		return nil, nil, errz.Wrap(err, "avro: invalid schema")
+	}
+
+	p := &schemaParser{named: map[string]*avroType{}}
+	if m, ok := node.(map[string]any); ok && m["type"] == "record" {
+		ns := p.namespace(m, "")
+		p.register(m, ns, &avroType{kind: kind.Text})
+		for _, f := range p.fields(m) {
+			name, _ := f["name"].(string)
+			fields = append(fields, name)
+			types = append(types, p.typeOf(f["type"], ns))
+		}
+		return fields, types, nil
+	}
+
+	return nil, []*avroType{p.typeOf(node, "")}, nil
+}
+
+// schemaParser determines the types of an Avro schema.
+type schemaParser struct {
+	// named holds the named types (record, enum and fixed) defined so far,
+	// by full name, and by unqualified name.
+	named map[string]*avroType
+}
+
+// typeOf returns the type of node, a schema as decoded from JSON. Named
+// types in node are registered. The namespace ns is the enclosing
+// namespace.
+func (p *schemaParser) typeOf(node any, ns string) *avroType {
+	switch node := node.(type) {
+	case string:
+		return p.typeOfName(node, ns)
+	case []any:
+		return p.typeOfUnion(node, ns)
+	case map[string]any:
+		return p.typeOfMap(node, ns)
+	default:
+		return &avroType{kind: kind.Text}
+	}
+}
+
+// typeOfName returns the type of a primitive or named type.
+func (p *schemaParser) typeOfName(name, ns string) *avroType {
+	switch name {
+	case "null":
+		return &avroType{kind: kind.Null}
+	case "boolean":
+		return &avroType{kind: kind.Bool}
+	case "int", "long":
+		return &avroType{kind: kind.Int}
+	case "float", "double":
+		return &avroType{kind: kind.Float}
+	case "bytes":
+		return &avroType{kind: kind.Bytes}
+	case "string":
+		return &avroType{kind: kind.Text}
+	}
+
+	if t, ok := p.named[name]; ok {
+		return t
+	}
+	if t, ok := p.named[ns+"."+name]; ok {
+		return t
+	}
+	return &avroType{kind: kind.Text}
+}
+
+// typeOfUnion returns the type of a union. A union whose non-null
+// branches are all of the same kind, such as the common ["null", "long"],
+// is of that kind; any other union is kind.Text.
+func (p *schemaParser) typeOfUnion(branches []any, ns string) *avroType {
+	t := &avroType{kind: kind.Null, union: true}
+	for _, branch := range branches {
+		bt := p.typeOf(branch, ns)
+		switch {
+		case bt.kind == kind.Null:
+		case t.kind == kind.Null:
+			t.kind, t.logical, t.scale = bt.kind, bt.logical, bt.scale
+		case t.kind != bt.kind:
+			t.kind = kind.Text
+		}
+	}
+	return t
+}
+
+// typeOfMap returns the type of a schema object, such as a record, or a
+// primitive with a logical type.
+func (p *schemaParser) typeOfMap(m map[string]any, ns string) *avroType {
+	typ, ok := m["type"].(string)
+	if !ok {
+		// The type is itself a schema object, or a union.
+		return p.typeOf(m["type"], ns)
+	}
+
+	switch typ {
+	case "record", "error":
+		ns = p.namespace(m, ns)
+		t := p.register(m, ns, &avroType{kind: kind.Text})
+		for _, f := range p.fields(m) {
+			p.typeOf(f["type"], ns)
+		}
+		return t
+	case "enum":
+		return p.register(m, p.namespace(m, ns), &avroType{kind: kind.Text})
+	case "fixed":
+		t := p.logicalType(m, "fixed", &avroType{kind: kind.Bytes})
+		return p.register(m, p.namespace(m, ns), t)
+	case "array":
+		p.typeOf(m["items"], ns)
+		return &avroType{kind: kind.Text}
+	case "map":
+		p.typeOf(m["values"], ns)
+		return &avroType{kind: kind.Text}
+	default:
+		return p.logicalType(m, typ, p.typeOfName(typ, ns))
+	}
+}
+
+// logicalType returns the type of m, a schema object of type typ, whose
+// type without regard to its logical type is base. As per the Avro spec,
+// a logical type that's invalid for typ is ignored.
+func (p *schemaParser) logicalType(m map[string]any, typ string, base *avroType) *avroType {
+	logical, _ := m["logicalType"].(string)
+	t := &avroType{logical: logical}
+	switch {
+	case logical == "decimal" && (typ == "bytes" || typ == "fixed"):
+		scale, _ := m["scale"].(float64)
+		t.kind, t.scale = kind.Decimal, int(scale)
+	case logical == "date" && typ == "int":
+		t.kind = kind.Date
+	case (logical == "time-millis" && typ == "int") || (logical == "time-micros" && typ == "long"):
+		t.kind = kind.Time
+	case typ == "long" && (logical == "timestamp-millis" || logical == "timestamp-micros" ||
+		logical == "local-timestamp-millis" || logical == "local-timestamp-micros"):
+		t.kind = kind.Datetime
+	default:
+		return base
+	}
+	return t
+}
+
+// fields returns the fields of record schema m.
+func (p *schemaParser) fields(m map[string]any) []map[string]any {
+	a, _ := m["fields"].([]any)
+	fields := make([]map[string]any, 0, len(a))
+	for _, f := range a {
+		if f, ok := f.(map[string]any); ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// namespace returns the namespace of named schema m, whose enclosing
+// namespace is ns.
+func (p *schemaParser) namespace(m map[string]any, ns string) string {
+	name, _ := m["name"].(string)
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[:i]
+	}
+	if s, ok := m["namespace"].(string); ok {
+		return s
+	}
+	return ns
+}
+
+// register registers t as the type of named schema m, in namespace ns,
+// returning t.
+func (p *schemaParser) register(m map[string]any, ns string, t *avroType) *avroType {
+	name, _ := m["name"].(string)
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	if ns != "" {
+		p.named[ns+"."+name] = t
+	}
+	p.named[name] = t
+	return t
+}
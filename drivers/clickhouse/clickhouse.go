@@ -321,6 +321,12 @@ func (d *driveri) Renderer() *render.Renderer {
 	r.FunctionOverrides[ast.FuncNameSum] = render.FuncOverrideCastResult(
 		fmt.Sprintf("Nullable(Decimal(%d, %d))", render.AggDecimalPrecision, render.AggDecimalScale),
 	)
+	r.SetOp = renderSetOp
+	r.DBTypeName = castTypeNameFromKind
+	r.CreateTable = buildCreateTableStmt
+	r.ValueLiteral = renderValueLiteral
+	render.RegisterDatetimeFuncsClickHouse(r)
+	render.RegisterStringFuncsClickHouse(r)
 	return r
 }
 
@@ -1,7 +1,9 @@
 package clickhouse
 
 import (
+	"encoding/hex"
 	"strings"
+	"time"
 
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/ast/render"
@@ -82,6 +84,14 @@ func dbTypeNameFromKind(knd kind.Kind) string {
 	return "String"
 }
 
+// castTypeNameFromKind returns the type name used by the cast() function.
+// The dbTypeNameFromKind type is wrapped in Nullable: ClickHouse types are
+// non-nullable by default, and casting a NULL to a non-nullable type raises
+// an error, e.g. cast(.x, int) where .x is NULL.
+func castTypeNameFromKind(knd kind.Kind) string {
+	return "Nullable(" + dbTypeNameFromKind(knd) + ")"
+}
+
 // buildCreateTableStmt builds a CREATE TABLE statement for ClickHouse.
 //
 // ClickHouse tables differ from traditional SQL tables in several ways:
@@ -345,3 +355,35 @@ func renderFuncIEndsWith(rc *render.Context, fn *ast.FuncNode) (string, error) {
 func renderFuncILike(rc *render.Context, fn *ast.FuncNode) (string, error) {
 	return render.RenderLikeRaw(rc, fn, render.LikeRawOpts{Op: "ILIKE"})
 }
+
+// renderSetOp renders a set operation keyword. ClickHouse rejects a bare
+// UNION unless the union_default_mode setting is configured, so union()
+// is rendered explicitly as UNION DISTINCT.
+func renderSetOp(rc *render.Context, op *ast.SetOpNode) (string, error) {
+	if op.Op() == ast.SetOpUnion {
+		return "UNION DISTINCT", nil
+	}
+	return render.RenderSetOpDefault(rc, op)
+}
+
+// renderValueLiteral implements render.Renderer.ValueLiteral. ClickHouse
+// treats backslash as an escape character in string literals, so it's
+// escaped. A []byte value is rendered via unhex, as binary data is stored in
+// a String column. Datetime and time values are rendered with second
+// precision, as both are stored as DateTime, a kind.Time value on 1970-01-01.
+func renderValueLiteral(rc *render.Context, knd kind.Kind, val any) (string, error) {
+	switch val := val.(type) {
+	case string:
+		return render.SingleQuoteBackslash(val), nil
+	case []byte:
+		return "unhex('" + strings.ToUpper(hex.EncodeToString(val)) + "')", nil
+	case time.Time:
+		switch knd { //nolint:exhaustive
+		case kind.Datetime:
+			return stringz.SingleQuote(val.UTC().Format(time.DateTime)), nil
+		case kind.Time:
+			return "'1970-01-01 " + val.Format(time.TimeOnly) + "'", nil
+		}
+	}
+	return render.RenderValueLiteralDefault(rc, knd, val)
+}
@@ -15,8 +15,10 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/neilotoole/sq/cli/testrun"
+	"github.com/neilotoole/sq/drivers/csv"
 	"github.com/neilotoole/sq/libsq/core/kind"
 	"github.com/neilotoole/sq/libsq/core/langz"
+	"github.com/neilotoole/sq/libsq/core/options"
 	"github.com/neilotoole/sq/libsq/core/record"
 	"github.com/neilotoole/sq/libsq/core/stringz"
 	"github.com/neilotoole/sq/libsq/core/timez"
@@ -436,6 +438,94 @@ func TestIngest_Kind_Time(t *testing.T) {
 	}
 }
 
+func TestIngest_FixedWidth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("actor", func(t *testing.T) {
+		t.Parallel()
+
+		th := testh.New(t)
+		src := th.Add(&source.Source{
+			Handle:   "@fixedwidth_actor",
+			Type:     drivertype.FixedWidth,
+			Location: filepath.Join("testdata", "actor_fixedwidth.txt"),
+		})
+
+		sink, err := th.QuerySLQ(src.Handle+".data", nil)
+		require.NoError(t, err)
+		require.Equal(t, sakila.TblActorCols(), sink.RecMeta.MungedNames())
+		require.Equal(t, sakila.TblActorColKinds(), sink.RecMeta.Kinds())
+		require.Equal(t, sakila.TblActorCount, len(sink.Recs))
+		require.Equal(t, int64(1), sink.Recs[0][0])
+		require.Equal(t, "PENELOPE", sink.Recs[0][1])
+		require.Equal(t, time.Date(2006, 2, 15, 4, 34, 33, 0, time.UTC), sink.Recs[0][3].(time.Time).UTC())
+	})
+
+	t.Run("person", func(t *testing.T) {
+		tu.SkipIssueWindows(t, tu.GH355SQLiteDecimalWin)
+		t.Parallel()
+
+		th := testh.New(t)
+		src := th.Add(&source.Source{
+			Handle:   "@fixedwidth_person",
+			Type:     drivertype.FixedWidth,
+			Location: filepath.Join("testdata", "person_fixedwidth.txt"),
+		})
+
+		sink, err := th.QuerySLQ(src.Handle+".data", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"ID", "NAME", "BALANCE", "JOINED", "ACTIVE"}, sink.RecMeta.MungedNames())
+		require.Equal(t,
+			[]kind.Kind{kind.Int, kind.Text, kind.Decimal, kind.Date, kind.Bool},
+			sink.RecMeta.Kinds())
+		require.Len(t, sink.Recs, 3)
+
+		require.Equal(t, "Ada Lovelace", sink.Recs[0][1])
+		require.True(t, decimal.RequireFromString("-2500").Equal(sink.Recs[1][2].(decimal.Decimal)))
+		require.Nil(t, sink.Recs[1][3], "blank field should be null")
+		require.Equal(t, false, sink.Recs[1][4])
+		require.Equal(t, int64(101), sink.Recs[2][0])
+		require.Nil(t, sink.Recs[2][4], "missing trailing field should be null")
+	})
+
+	t.Run("detect_header_merge_cols", func(t *testing.T) {
+		t.Parallel()
+
+		th := testh.New(t)
+		src := th.Add(&source.Source{
+			Handle:   "@fixedwidth_city",
+			Type:     drivertype.FixedWidth,
+			Location: filepath.Join("testdata", "city_fixedwidth.txt"),
+		})
+
+		sink, err := th.QuerySLQ(src.Handle+".data", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"id", "nm", "city"}, sink.RecMeta.MungedNames())
+		require.Len(t, sink.Recs, 3)
+		require.Equal(t, "Ann Lee", sink.Recs[0][1])
+		require.Equal(t, "Oslo", sink.Recs[0][2])
+		require.Equal(t, "Cya Ng", sink.Recs[2][1])
+	})
+
+	t.Run("opt_cols", func(t *testing.T) {
+		t.Parallel()
+
+		th := testh.New(t)
+		src := th.Add(&source.Source{
+			Handle:   "@fixedwidth_person_cols",
+			Type:     drivertype.FixedWidth,
+			Location: filepath.Join("testdata", "person_fixedwidth.txt"),
+			Options:  options.Options{csv.OptFixedWidthCols.Key(): "1-5,8-11"},
+		})
+
+		sink, err := th.QuerySLQ(src.Handle+".data", nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{"ID", "NAME"}, sink.RecMeta.MungedNames())
+		require.Len(t, sink.Recs, 3)
+		require.Equal(t, "Niko", sink.Recs[1][1])
+	})
+}
+
 // TestGenerateTimestampVals is a utility test that prints out a bunch
 // of timestamp values in various time formats and locations.
 // It was used to generate values for use in testdata/test_timestamp.tsv.
@@ -0,0 +1,307 @@
+package csv
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// OptFixedWidthCols specifies the column boundaries of fixed-width data.
+var OptFixedWidthCols = options.NewString(
+	"driver.fixedwidth.cols",
+	nil,
+	"",
+	func(s string) error {
+		_, err := parseFixedWidthCols(s)
+		return err
+	},
+	"Column boundaries for ingest fixed-width data",
+	`Column boundaries for fixed-width text, as a comma-separated list of
+character positions, e.g. "1-10,11-25,26-". Positions start at 1, and each
+range is inclusive. The end of the last range may be omitted, in which case
+the column extends to the end of the line. Characters outside of the ranges
+are ignored. If not set, the boundaries are inferred from the columns of
+whitespace in a sample of the data (see ingest.sample-size).`,
+	options.TagSource,
+	options.TagIngestMutate,
+	"fixedwidth",
+)
+
+// fwCol is a fixed-width column, spanning the character positions
+// [start, end) of a line, where the first position is zero. If end
+// is -1, the column extends to the end of the line.
+type fwCol struct {
+	start int
+	end   int
+}
+
+// parseFixedWidthCols parses a column boundaries value, such as
+// "1-10,11-25,26-". See OptFixedWidthCols. An empty string returns
+// nil, indicating that the boundaries should be inferred.
+func parseFixedWidthCols(s string) ([]fwCol, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(s, ",")
+	cols := make([]fwCol, len(parts))
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		startText, endText, ok := strings.Cut(part, "-")
+		if !ok {
+			return nil, errz.Errorf("fixed-width cols: invalid range {%s}: should be START-END, e.g. 1-10", part)
+		}
+
+		start, err := strconv.Atoi(strings.TrimSpace(startText))
+		if err != nil || start < 1 {
+			return nil, errz.Errorf("fixed-width cols: invalid range {%s}: start must be a position >= 1", part)
+		}
+
+		col := fwCol{start: start - 1, end: -1}
+		if endText = strings.TrimSpace(endText); endText == "" {
+			if i != len(parts)-1 {
+				return nil, errz.Errorf("fixed-width cols: invalid range {%s}: only the last range may omit END",
+					part)
+			}
+		} else {
+			var end int
+			if end, err = strconv.Atoi(endText); err != nil || end < start {
+				return nil, errz.Errorf("fixed-width cols: invalid range {%s}: end must be a position >= %d",
+					part, start)
+			}
+			col.end = end
+		}
+
+		if i > 0 && col.start < cols[i-1].end {
+			return nil, errz.Errorf("fixed-width cols: range {%s} overlaps previous range", part)
+		}
+		cols[i] = col
+	}
+
+	return cols, nil
+}
+
+// inferFixedWidthCols infers the column boundaries of the fixed-width text
+// lines. A column of character positions that's whitespace in every line
+// separates fields: each field starts at the first non-whitespace position
+// after such a gap, and extends until the start of the next field. Thus,
+// both left-aligned and right-aligned fields are handled.
+//
+// Note that a gap within a field's data, such as the space in "Alice Smith",
+// also starts a column. If the data has a header row, such columns can be
+// merged via mergeUnnamedFixedWidthCols.
+func inferFixedWidthCols(lines []string) ([]fwCol, error) {
+	var blank []bool
+	for _, line := range lines {
+		pos := 0
+		for _, r := range line {
+			if pos == len(blank) {
+				blank = append(blank, true)
+			}
+			if !unicode.IsSpace(r) {
+				blank[pos] = false
+			}
+			pos++
+		}
+	}
+
+	var cols []fwCol
+	for pos := range blank {
+		if blank[pos] || (pos > 0 && !blank[pos-1]) {
+			continue
+		}
+
+		// pos is the start of a field.
+		if len(cols) == 0 {
+			// The first field always starts at the start of the line,
+			// in case it's right-aligned.
+			cols = append(cols, fwCol{start: 0, end: -1})
+			continue
+		}
+		cols[len(cols)-1].end = pos
+		cols = append(cols, fwCol{start: pos, end: -1})
+	}
+
+	if len(cols) == 0 {
+		return nil, errz.New("fixed-width: unable to infer columns: no data")
+	}
+
+	return cols, nil
+}
+
+// mergeUnnamedFixedWidthCols merges each column of cols, other than the
+// first, that doesn't contain the start of a token of the header line into
+// the preceding column.
+func mergeUnnamedFixedWidthCols(cols []fwCol, header string) []fwCol {
+	runes := []rune(header)
+	named := func(col fwCol) bool {
+		end := col.end
+		if end == -1 || end > len(runes) {
+			end = len(runes)
+		}
+		for pos := col.start; pos < end; pos++ {
+			if !unicode.IsSpace(runes[pos]) && (pos == 0 || unicode.IsSpace(runes[pos-1])) {
+				return true
+			}
+		}
+		return false
+	}
+
+	merged := cols[:1]
+	for _, col := range cols[1:] {
+		if named(col) {
+			merged = append(merged, col)
+			continue
+		}
+		merged[len(merged)-1].end = col.end
+	}
+	return merged
+}
+
+// splitFixedWidth splits line into trimmed fields, per cols.
+func splitFixedWidth(line string, cols []fwCol) []string {
+	runes := []rune(line)
+	fields := make([]string, len(cols))
+	for i, col := range cols {
+		if col.start >= len(runes) {
+			continue
+		}
+
+		end := col.end
+		if end == -1 || end > len(runes) {
+			end = len(runes)
+		}
+		fields[i] = strings.TrimSpace(string(runes[col.start:end]))
+	}
+	return fields
+}
+
+// fixedWidthReader reads fixed-width text, one record per line. Blank lines
+// are skipped. It implements recordReader.
+type fixedWidthReader struct {
+	br *bufio.Reader
+
+	// cols is the column boundaries. It must be set before Read is invoked.
+	cols []fwCol
+}
+
+func newFixedWidthReader(r io.Reader) *fixedWidthReader {
+	return &fixedWidthReader{br: bufio.NewReader(&crFilterReader{r: r})}
+}
+
+// readLine returns the next non-blank line, without its line terminator,
+// or io.EOF.
+func (r *fixedWidthReader) readLine() (string, error) {
+	for {
+		line, err := r.br.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", errz.Err(err)
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(line) != "" {
+			return line, nil
+		}
+
+		if err != nil {
+			return "", io.EOF
+		}
+	}
+}
+
+// readLines reads a maximum of n non-blank lines.
+func (r *fixedWidthReader) readLines(n int) ([]string, error) {
+	lines := make([]string, 0, n)
+	for range n {
+		line, err := r.readLine()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return lines, nil
+			}
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// Read implements recordReader.
+func (r *fixedWidthReader) Read() ([]string, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	return splitFixedWidth(line, r.cols), nil
+}
+
+// ingestFixedWidth loads the src fixed-width data into destGrip.
+func (d *driveri) ingestFixedWidth(ctx context.Context, src *source.Source, destGrip driver.Grip) error {
+	log := lg.FromContext(ctx)
+	startUTC := time.Now().UTC()
+
+	rc, err := d.files.NewReader(ctx, src, true)
+	if err != nil {
+		return err
+	}
+
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, rc)
+
+	fr := newFixedWidthReader(rc)
+	lines, err := fr.readLines(driver.OptIngestSampleSize.Get(src.Options))
+	if err != nil {
+		return err
+	}
+
+	if fr.cols, err = parseFixedWidthCols(OptFixedWidthCols.Get(src.Options)); err != nil {
+		return err
+	}
+
+	inferred := fr.cols == nil
+	if inferred {
+		if fr.cols, err = inferFixedWidthCols(lines); err != nil {
+			return err
+		}
+	}
+
+	recs := splitFixedWidthLines(lines, fr.cols)
+	if inferred {
+		// A gap within the data of a field, such as the space in
+		// "Alice Smith", results in a column with no header name. If
+		// there's a header row, such columns are merged.
+		var headerPresent bool
+		if headerPresent, err = hasHeaderRow(ctx, recs, src.Options); err != nil {
+			return err
+		}
+
+		if headerPresent {
+			fr.cols = mergeUnnamedFixedWidthCols(fr.cols, lines[0])
+			recs = splitFixedWidthLines(lines, fr.cols)
+		}
+		log.Debug("Inferred fixed-width columns", lga.Count, len(fr.cols))
+	}
+
+	return ingestRecords(ctx, src, destGrip, recs, fr, startUTC)
+}
+
+// splitFixedWidthLines splits each of lines into trimmed fields, per cols.
+func splitFixedWidthLines(lines []string, cols []fwCol) [][]string {
+	recs := make([][]string, len(lines))
+	for i := range lines {
+		recs[i] = splitFixedWidth(lines[i], cols)
+	}
+	return recs
+}
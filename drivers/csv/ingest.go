@@ -75,6 +75,15 @@ func (d *driveri) ingestCSV(ctx context.Context, src *source.Source, destGrip dr
 		return err
 	}
 
+	return ingestRecords(ctx, src, destGrip, recs, cr, startUTC)
+}
+
+// ingestRecords loads into destGrip the sampled records recs, followed by
+// the remaining records from r. The first of recs may be a header row.
+func ingestRecords(ctx context.Context, src *source.Source, destGrip driver.Grip,
+	recs [][]string, r recordReader, startUTC time.Time,
+) error {
+	log := lg.FromContext(ctx)
 	headerPresent, err := hasHeaderRow(ctx, recs, src.Options)
 	if err != nil {
 		return err
@@ -133,7 +142,7 @@ func (d *driveri) ingestCSV(ctx context.Context, src *source.Source, destGrip dr
 		tuning.OptRecBufSize.Get(destGrip.Source().Options),
 	)
 
-	err = execInsert(ctx, insertWriter, recMeta, mungers, recs, cr)
+	err = execInsert(ctx, insertWriter, recMeta, mungers, recs, r)
 	if err != nil {
 		return err
 	}
@@ -302,8 +311,14 @@ func (r *crFilterReader) Read(p []byte) (n int, err error) {
 	return n, err
 }
 
+// recordReader reads records, one at a time. Read returns io.EOF when there
+// are no more records. It is implemented by *csv.Reader.
+type recordReader interface {
+	Read() (record []string, err error)
+}
+
 // readRecords reads a maximum of n records from cr.
-func readRecords(cr *csv.Reader, n int) ([][]string, error) {
+func readRecords(cr recordReader, n int) ([][]string, error) {
 	recs := make([][]string, 0, n)
 
 	for range n {
@@ -2,7 +2,6 @@ package csv
 
 import (
 	"context"
-	"encoding/csv"
 	"errors"
 	"io"
 
@@ -17,9 +16,9 @@ import (
 )
 
 // execInsert inserts the CSV records in readAheadRecs (followed by records
-// from the csv.Reader) via recw. The caller should wait on recw to complete.
+// from r) via recw. The caller should wait on recw to complete.
 func execInsert(ctx context.Context, recw libsq.RecordWriter, recMeta record.Meta,
-	mungers []kind.MungeFunc, readAheadRecs [][]string, r *csv.Reader,
+	mungers []kind.MungeFunc, readAheadRecs [][]string, r recordReader,
 ) error {
 	ctx, cancelFn := context.WithCancel(ctx)
 	// We don't do "defer cancelFn" here. The cancelFn is passed
@@ -59,7 +58,7 @@ func execInsert(ctx context.Context, recw libsq.RecordWriter, recMeta record.Met
 		}
 		if err != nil {
 			cancelFn()
-			return errz.Wrap(err, "read from CSV data source")
+			return errz.Wrap(err, "read from data source")
 		}
 
 		var rec []any
@@ -122,3 +122,146 @@ func TestCRFilterReader(t *testing.T) {
 		require.Equal(t, tc.want, string(actual))
 	}
 }
+
+func Test_parseFixedWidthCols(t *testing.T) {
+	testCases := []struct {
+		in      string
+		want    []fwCol
+		wantErr bool
+	}{
+		{in: "", want: nil},
+		{in: "1-10", want: []fwCol{{0, 10}}},
+		{in: "1-10,11-25,26-", want: []fwCol{{0, 10}, {10, 25}, {25, -1}}},
+		{in: " 1-3 , 7-7 ", want: []fwCol{{0, 3}, {6, 7}}},
+		{in: "3-", want: []fwCol{{2, -1}}},
+		{in: "1-10,5-12", wantErr: true},
+		{in: "1-,5-12", wantErr: true},
+		{in: "0-10", wantErr: true},
+		{in: "5-1", wantErr: true},
+		{in: "10", wantErr: true},
+		{in: "a-b", wantErr: true},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tu.Name(i, tc.in), func(t *testing.T) {
+			got, gotErr := parseFixedWidthCols(tc.in)
+			if tc.wantErr {
+				require.Error(t, gotErr)
+				return
+			}
+
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_inferFixedWidthCols(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lines    []string
+		header   bool
+		want     []fwCol
+		wantRecs [][]string
+		wantErr  bool
+	}{
+		{
+			name:    "empty",
+			lines:   []string{"   "},
+			wantErr: true,
+		},
+		{
+			name:     "single",
+			lines:    []string{"abc", " d"},
+			want:     []fwCol{{0, -1}},
+			wantRecs: [][]string{{"abc"}, {"d"}},
+		},
+		{
+			name: "aligned",
+			lines: []string{
+				"  id  name       amount",
+				"   1  Ada Byron    10.5",
+				"  22  Tesla       -2000",
+				" 333  Plato",
+			},
+			want: []fwCol{{0, 6}, {6, 17}, {17, -1}},
+			wantRecs: [][]string{
+				{"id", "name", "amount"},
+				{"1", "Ada Byron", "10.5"},
+				{"22", "Tesla", "-2000"},
+				{"333", "Plato", ""},
+			},
+		},
+		{
+			name:     "unicode",
+			lines:    []string{"ünï  b", "çö   d"},
+			want:     []fwCol{{0, 5}, {5, -1}},
+			wantRecs: [][]string{{"ünï", "b"}, {"çö", "d"}},
+		},
+		{
+			name: "header_off_splits_name",
+			lines: []string{
+				"ID   NAME        AMOUNT  DT",
+				"1    Alice Smith 10.50   2024-01-01",
+				"2    Bob         3.00    2024-01-02",
+				"3    Carol Jones 7.25    2024-01-03",
+			},
+			want: []fwCol{{0, 5}, {5, 11}, {11, 17}, {17, 25}, {25, -1}},
+			wantRecs: [][]string{
+				{"ID", "NAME", "", "AMOUNT", "DT"},
+				{"1", "Alice", "Smith", "10.50", "2024-01-01"},
+				{"2", "Bob", "", "3.00", "2024-01-02"},
+				{"3", "Carol", "Jones", "7.25", "2024-01-03"},
+			},
+		},
+		{
+			name: "header",
+			lines: []string{
+				"ID   NAME        AMOUNT  DT",
+				"1    Alice Smith 10.50   2024-01-01",
+				"2    Bob         3.00    2024-01-02",
+				"3    Carol Jones 7.25    2024-01-03",
+			},
+			header: true,
+			want:   []fwCol{{0, 5}, {5, 17}, {17, 25}, {25, -1}},
+			wantRecs: [][]string{
+				{"ID", "NAME", "AMOUNT", "DT"},
+				{"1", "Alice Smith", "10.50", "2024-01-01"},
+				{"2", "Bob", "3.00", "2024-01-02"},
+				{"3", "Carol Jones", "7.25", "2024-01-03"},
+			},
+		},
+		{
+			// The data of a right-aligned column is wider than its header.
+			name:   "header_right_aligned",
+			lines:  []string{"id     amt", "1   123456", "22      10"},
+			header: true,
+			want:   []fwCol{{0, 4}, {4, -1}},
+			wantRecs: [][]string{
+				{"id", "amt"},
+				{"1", "123456"},
+				{"22", "10"},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tu.Name(i, tc.name), func(t *testing.T) {
+			got, gotErr := inferFixedWidthCols(tc.lines)
+			if tc.wantErr {
+				require.Error(t, gotErr)
+				return
+			}
+
+			require.NoError(t, gotErr)
+			if tc.header {
+				got = mergeUnnamedFixedWidthCols(got, tc.lines[0])
+			}
+			require.Equal(t, tc.want, got)
+
+			for j, line := range tc.lines {
+				require.Equal(t, tc.wantRecs[j], splitFixedWidth(line, got))
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package duckdb
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -71,5 +72,25 @@ func (d *driveri) Renderer() *render.Renderer {
 	// the same tradeoff accepted for sqlite3/rqlite. See #853 (and #839).
 	r.FunctionResultKinds[ast.FuncNameSum] = kind.Decimal
 	render.RegisterILikeFamily(r)
+	r.DBTypeName = dbTypeNameFromKind
+	r.CreateTable = buildCreateTableStmt
+	r.ValueLiteral = renderValueLiteral
 	return r
 }
+
+// renderValueLiteral implements render.Renderer.ValueLiteral. It renders
+// []byte values as a BLOB literal of escaped bytes, e.g. '\xCA\xFE'::BLOB.
+func renderValueLiteral(rc *render.Context, knd kind.Kind, val any) (string, error) {
+	b, ok := val.([]byte)
+	if !ok {
+		return render.RenderValueLiteralDefault(rc, knd, val)
+	}
+
+	sb := strings.Builder{}
+	sb.WriteRune('\'')
+	for _, c := range b {
+		fmt.Fprintf(&sb, `\x%02X`, c)
+	}
+	sb.WriteString(`'::BLOB`)
+	return sb.String(), nil
+}
@@ -103,6 +103,8 @@ var (
 	_ ingestFunc = ingestJSON
 	_ ingestFunc = ingestJSONA
 	_ ingestFunc = ingestJSONL
+	_ ingestFunc = ingestYAML
+	_ ingestFunc = ingestTOML
 )
 
 // getRecMeta returns record.Meta to use with RecordWriter.Open.
@@ -160,12 +162,14 @@ type processor struct {
 	flatten bool
 }
 
-func newProcessor(flatten bool) *processor {
+// newProcessor returns a new processor whose objects are ingested
+// into table tblName, which is typically source.MonotableName.
+func newProcessor(tblName string, flatten bool) *processor {
 	return &processor{
 		flatten:   flatten,
 		curSchema: nil,
 		root: &entity{
-			name:      source.MonotableName,
+			name:      tblName,
 			detectors: map[string]*kind.Detector{},
 			kinds:     map[string]kind.Kind{},
 		},
@@ -204,7 +208,7 @@ func (p *processor) calcColName(ent *entity, fieldName string) string {
 // buildSchemaFlat currently only builds a flat (single table) schema.
 func (p *processor) buildSchemaFlat() (*ingestSchema, error) {
 	tblDef := &schema.Table{
-		Name: source.MonotableName,
+		Name: p.root.name,
 	}
 
 	var colDefs []*schema.Column
@@ -0,0 +1,345 @@
+package json
+
+// ingest_doc.go contains functionality common to ingesting YAML and
+// TOML documents. The decoded documents are converted to JSON objects,
+// which are then fed to the JSON processor.
+
+import (
+	"bytes"
+	"context"
+	stdj "encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	yaml "github.com/goccy/go-yaml"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/progress"
+	"github.com/neilotoole/sq/libsq/core/sqlz"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// decodeDocsFunc decodes the documents in r, invoking fn for each
+// document. A decoded document is an ordered map (yaml.MapSlice), or
+// an array ([]any) of values, where each value is an ordered map,
+// an array, or a scalar.
+type decodeDocsFunc func(ctx context.Context, r io.Reader, fn func(doc any) error) error
+
+// ingestDocs is the common implementation of the YAML and TOML ingest
+// funcs. The documents are read from job by decodeFn, and ingested via
+// docIngester. Arg format is the name of the document format, for use
+// in messages, e.g. "YAML".
+func ingestDocs(ctx context.Context, job *ingestJob, format string, decodeFn decodeDocsFunc) error {
+	bar := progress.FromContext(ctx).NewUnitCounter("Ingest "+format, "document")
+	defer bar.Stop()
+
+	log := lg.FromContext(ctx)
+	defer lg.WarnIfCloseError(log, "Close "+format+" ingest job", job)
+
+	r, err := job.newRdrFn(ctx)
+	if err != nil {
+		return err
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+
+	db, err := job.destGrip.DB(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseDB, conn)
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return errz.Err(err)
+	}
+	// Roll back unless we reach the explicit Commit below, as per ingestJSON.
+	committed := false
+	defer func() {
+		if !committed {
+			lg.WarnIfError(log, "Rollback "+format+" ingest tx", errz.Err(tx.Rollback()))
+		}
+	}()
+
+	di := &docIngester{
+		job:  job,
+		drvr: job.destGrip.SQLDriver(),
+		db:   tx,
+		tbls: map[string]*docTable{},
+	}
+
+	err = decodeFn(ctx, r, func(doc any) error {
+		bar.Incr(1)
+		return di.ingestDoc(ctx, doc)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = di.finish(ctx); err != nil {
+		return err
+	}
+
+	if di.rowCount == 0 {
+		return errz.Errorf("empty %s input", format)
+	}
+
+	log.Debug("Ingested "+format, lga.Count, di.rowCount, "tables", di.tblNames)
+
+	committed = true
+	if err = tx.Commit(); err != nil {
+		return errz.Err(err)
+	}
+	return nil
+}
+
+// docTable is a table that document rows are ingested into.
+type docTable struct {
+	proc *processor
+
+	// rowCount is the count of rows passed to proc.
+	rowCount int
+
+	// schemaModified is true if the most recent row passed to proc
+	// modified the schema.
+	schemaModified bool
+}
+
+// docIngester ingests decoded YAML or TOML documents into one or more
+// tables, using a JSON processor for each table.
+type docIngester struct {
+	job  *ingestJob
+	drvr driver.SQLDriver
+	db   sqlz.DB
+
+	// tbls is keyed by table name.
+	tbls map[string]*docTable
+
+	// tblNames holds the table names, in the order they were encountered.
+	tblNames []string
+
+	// rowCount is the count of rows across all tables.
+	rowCount int
+}
+
+// ingestDoc ingests the decoded document doc. If doc is a map, each
+// field whose value is a non-empty array of objects is ingested into the
+// table named for the field, with each object being a row. The remaining
+// fields of doc, if any, are ingested as a single row of the
+// source.MonotableName table. If doc is an array of objects, each object
+// is a row of the source.MonotableName table.
+func (di *docIngester) ingestDoc(ctx context.Context, doc any) error {
+	switch doc := doc.(type) {
+	case nil:
+		// Empty document.
+		return nil
+	case yaml.MapSlice:
+		var row yaml.MapSlice
+		for _, item := range doc {
+			if objs, ok := asObjectArray(item.Value); ok {
+				tblName := fmt.Sprint(item.Key)
+				for _, obj := range objs {
+					if err := di.addRow(ctx, tblName, obj); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			row = append(row, item)
+		}
+
+		if len(row) == 0 {
+			return nil
+		}
+		return di.addRow(ctx, source.MonotableName, row)
+	case []any:
+		if len(doc) == 0 {
+			return nil
+		}
+
+		objs, ok := asObjectArray(doc)
+		if !ok {
+			return errz.New("expected document to be an array of objects, but found a non-object element")
+		}
+
+		for _, obj := range objs {
+			if err := di.addRow(ctx, source.MonotableName, obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errz.Errorf("expected document to be an object or an array of objects, but got %T", doc)
+	}
+}
+
+// addRow adds row to the named table. Much like ingestJSON, the rows are
+// sampled until the table's schema is built, after which the rows are
+// inserted, with the schema being altered as necessary.
+func (di *docIngester) addRow(ctx context.Context, tblName string, row yaml.MapSlice) error {
+	tbl, ok := di.tbls[tblName]
+	if !ok {
+		tbl = &docTable{proc: newProcessor(tblName, di.job.flatten)}
+		di.tbls[tblName] = tbl
+		di.tblNames = append(di.tblNames, tblName)
+	}
+
+	if tbl.schemaModified && tbl.rowCount >= di.job.sampleSize {
+		if err := di.flushSchema(ctx, tbl); err != nil {
+			return err
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := writeOrderedJSON(buf, row); err != nil {
+		return err
+	}
+	chunk := buf.Bytes()
+
+	var obj map[string]any
+	if err := stdj.Unmarshal(chunk, &obj); err != nil {
+		return errz.Err(err)
+	}
+
+	tbl.rowCount++
+	di.rowCount++
+
+	var err error
+	if tbl.schemaModified, err = tbl.proc.processObject(obj, chunk); err != nil {
+		return err
+	}
+
+	if tbl.proc.curSchema == nil || tbl.schemaModified {
+		// We're still sampling, or the schema is dirty.
+		return nil
+	}
+
+	insertions, err := tbl.proc.buildInsertionsFlat(tbl.proc.curSchema)
+	if err != nil {
+		return err
+	}
+	return di.job.execInsertions(ctx, di.drvr, di.db, insertions)
+}
+
+// flushSchema (re)builds the schema of tbl, executes the schema delta,
+// and inserts any rows not yet written.
+func (di *docIngester) flushSchema(ctx context.Context, tbl *docTable) error {
+	log := lg.FromContext(ctx)
+	log.Debug("Time to (re)build the schema", lga.Table, tbl.proc.root.name, lga.Count, tbl.rowCount)
+
+	newSchema, err := tbl.proc.buildSchemaFlat()
+	if err != nil {
+		return err
+	}
+
+	if err = execSchemaDelta(ctx, di.drvr, di.db, tbl.proc.curSchema, newSchema); err != nil {
+		return err
+	}
+
+	// The DB has been updated with the current schema,
+	// so we mark it as clean.
+	tbl.proc.markSchemaClean()
+	tbl.proc.curSchema = newSchema
+	tbl.schemaModified = false
+
+	insertions, err := tbl.proc.buildInsertionsFlat(tbl.proc.curSchema)
+	if err != nil {
+		return err
+	}
+
+	return di.job.execInsertions(ctx, di.drvr, di.db, insertions)
+}
+
+// finish flushes the schema and rows of each table that has been
+// modified since it was last flushed.
+func (di *docIngester) finish(ctx context.Context) error {
+	for _, tblName := range di.tblNames {
+		tbl := di.tbls[tblName]
+		if !tbl.schemaModified {
+			continue
+		}
+
+		if err := di.flushSchema(ctx, tbl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// asObjectArray returns v's elements as ordered maps, if v is a
+// non-empty array whose elements are all ordered maps.
+func asObjectArray(v any) (objs []yaml.MapSlice, ok bool) {
+	a, ok := v.([]any)
+	if !ok || len(a) == 0 {
+		return nil, false
+	}
+
+	objs = make([]yaml.MapSlice, len(a))
+	for i := range a {
+		if objs[i], ok = a[i].(yaml.MapSlice); !ok {
+			return nil, false
+		}
+	}
+
+	return objs, true
+}
+
+// writeOrderedJSON writes v to buf as JSON. Unlike encoding/json, the
+// fields of an ordered map (yaml.MapSlice) are written in order, which
+// matters because the JSON processor derives column order from the JSON
+// text. Non-finite floats, such as YAML's .inf, are written as strings.
+func writeOrderedJSON(buf *bytes.Buffer, v any) error {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		buf.WriteByte('{')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeOrderedJSON(buf, fmt.Sprint(item.Key)); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeOrderedJSON(buf, item.Value); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	case []any:
+		buf.WriteByte('[')
+		for i := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeOrderedJSON(buf, v[i]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return writeOrderedJSON(buf, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+	}
+
+	b, err := stdj.Marshal(v)
+	if err != nil {
+		return errz.Err(err)
+	}
+	buf.Write(b)
+	return nil
+}
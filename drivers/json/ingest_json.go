@@ -16,6 +16,7 @@ import (
 	"github.com/neilotoole/sq/libsq/core/lg/lgm"
 	"github.com/neilotoole/sq/libsq/core/progress"
 	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/libsq/source/drivertype"
 )
 
@@ -191,7 +192,7 @@ func ingestJSON(ctx context.Context, job *ingestJob) error { //nolint:gocognit
 		}
 	}()
 
-	proc := newProcessor(job.flatten)
+	proc := newProcessor(source.MonotableName, job.flatten)
 	scan := newObjectInArrayScanner(log, r)
 
 	var (
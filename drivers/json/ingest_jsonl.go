@@ -15,6 +15,7 @@ import (
 	"github.com/neilotoole/sq/libsq/core/lg/lgm"
 	"github.com/neilotoole/sq/libsq/core/progress"
 	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/libsq/source/drivertype"
 )
 
@@ -137,7 +138,7 @@ func ingestJSONL(ctx context.Context, job *ingestJob) error { //nolint:gocognit
 		}
 	}()
 
-	proc := newProcessor(job.flatten)
+	proc := newProcessor(source.MonotableName, job.flatten)
 	scan := newLineScanner(ctx, r, '{')
 
 	var (
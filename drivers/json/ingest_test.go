@@ -255,6 +255,151 @@ func TestIngestJSON_Flat(t *testing.T) {
 	require.Equal(t, sakila.TblActorCount, len(sink.Recs))
 }
 
+// ingestDocTable is the expected ingest result for a table of a YAML
+// or TOML document.
+type ingestDocTable struct {
+	name      string
+	wantRows  int
+	wantCols  []string
+	wantKinds []kind.Kind
+}
+
+func TestIngestYAML(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		input   string
+		want    []ingestDocTable
+		wantErr bool
+	}{
+		{
+			name:  "single_doc",
+			input: "a: 1\nb: {c: x, d: 2020-06-11}\n",
+			want: []ingestDocTable{
+				{name: "data", wantRows: 1, wantCols: []string{"a", "b_c", "b_d"}, wantKinds: []kind.Kind{kind.Int, kind.Text, kind.Date}},
+			},
+		},
+		{
+			name:  "multi_doc",
+			input: "a: 1\n---\na: 2.5\nb: true\n---\n",
+			want: []ingestDocTable{
+				{name: "data", wantRows: 2, wantCols: []string{"a", "b"}, wantKinds: []kind.Kind{kind.Float, kind.Bool}},
+			},
+		},
+		{
+			name:  "array_of_objects",
+			input: "- a: 1\n- a: 2\n  b: x\n",
+			want: []ingestDocTable{
+				{name: "data", wantRows: 2, wantCols: []string{"a", "b"}, wantKinds: []kind.Kind{kind.Int, kind.Text}},
+			},
+		},
+		{
+			name:  "tables",
+			input: "env: prod\nhosts:\n  - {name: h1, cpus: 2}\n  - {name: h2, cpus: 4}\n---\nhosts:\n  - {name: h3}\n",
+			want: []ingestDocTable{
+				{name: "data", wantRows: 1, wantCols: []string{"env"}, wantKinds: []kind.Kind{kind.Text}},
+				{name: "hosts", wantRows: 3, wantCols: []string{"name", "cpus"}, wantKinds: []kind.Kind{kind.Text, kind.Int}},
+			},
+		},
+		{
+			name:    "empty",
+			input:   "# nothing here\n",
+			wantErr: true,
+		},
+		{
+			name:    "scalar_doc",
+			input:   "hello\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			input:   "a: [1\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testIngestDoc(t, json.IngestYAML, tc.input, tc.want, tc.wantErr)
+		})
+	}
+}
+
+func TestIngestTOML(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		input   string
+		want    []ingestDocTable
+		wantErr bool
+	}{
+		{
+			name:  "tables",
+			input: "z = 1\na = 2021-01-02T03:04:05Z\n[m]\nk = \"v\"\n",
+			want: []ingestDocTable{
+				{name: "data", wantRows: 1, wantCols: []string{"z", "a", "m_k"}, wantKinds: []kind.Kind{kind.Int, kind.Datetime, kind.Text}},
+			},
+		},
+		{
+			name:  "array_of_tables",
+			input: "[[items]]\nsku = \"a1\"\nqty = 2\nday = 2024-01-02\n\n[[items]]\nsku = \"b2\"\nqty = 3\nday = 2024-01-03\n",
+			want: []ingestDocTable{
+				{name: "items", wantRows: 2, wantCols: []string{"sku", "qty", "day"}, wantKinds: []kind.Kind{kind.Text, kind.Int, kind.Date}},
+			},
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			input:   "a = \n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			testIngestDoc(t, json.IngestTOML, tc.input, tc.want, tc.wantErr)
+		})
+	}
+}
+
+// testIngestDoc ingests input via ingestFn, and verifies that the
+// resulting tables match want.
+func testIngestDoc(t *testing.T, ingestFn func(context.Context, *json.IngestJob) error,
+	input string, want []ingestDocTable, wantErr bool,
+) {
+	t.Helper()
+
+	newRdrFn := func(_ context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(input)), nil
+	}
+
+	th, src, _, grip, _ := testh.NewWith(t, testsrc.EmptyDB)
+	job := json.NewIngestJob(src, newRdrFn, grip, 0, true)
+
+	err := ingestFn(th.Context, job)
+	if wantErr {
+		require.Error(t, err)
+		return
+	}
+	require.NoError(t, err)
+
+	for _, tbl := range want {
+		sink, err := th.QuerySQL(src, nil, "SELECT * FROM "+tbl.name)
+		require.NoError(t, err)
+		require.Equal(t, tbl.wantRows, len(sink.Recs), tbl.name)
+		require.Equal(t, tbl.wantCols, sink.RecMeta.Names(), tbl.name)
+		require.Equal(t, tbl.wantKinds, sink.RecMeta.Kinds(), tbl.name)
+	}
+}
+
 func TestScanObjectsInArray(t *testing.T) {
 	t.Parallel()
 
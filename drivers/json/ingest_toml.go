@@ -0,0 +1,102 @@
+package json
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	yaml "github.com/goccy/go-yaml"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+func ingestTOML(ctx context.Context, job *ingestJob) error {
+	return ingestDocs(ctx, job, "TOML", decodeTOML)
+}
+
+var _ decodeDocsFunc = decodeTOML
+
+// decodeTOML decodes the TOML document r. The TOML decoder returns tables
+// as Go maps, so the tables are converted to yaml.MapSlice, with keys
+// in the order that they appear in the document.
+func decodeTOML(_ context.Context, r io.Reader, fn func(doc any) error) error {
+	var m map[string]any
+	md, err := toml.NewDecoder(r).Decode(&m)
+	if err != nil {
+		return errz.Err(err)
+	}
+
+	keys := md.Keys()
+	keyOrder := make(map[string]int, len(keys))
+	for i, key := range keys {
+		if _, ok := keyOrder[key.String()]; !ok {
+			keyOrder[key.String()] = i
+		}
+	}
+
+	return fn(orderTOML(m, nil, keyOrder))
+}
+
+// orderTOML returns the TOML value v, at the key path, with each table
+// converted to yaml.MapSlice, ordered per keyOrder. Date and time values
+// are converted to strings.
+func orderTOML(v any, path toml.Key, keyOrder map[string]int) any {
+	switch v := v.(type) {
+	case map[string]any:
+		keyIndex := func(k string) int {
+			if i, ok := keyOrder[append(slices.Clip(path), k).String()]; ok {
+				return i
+			}
+			return len(keyOrder)
+		}
+
+		keys := slices.SortedFunc(maps.Keys(v), func(a, b string) int {
+			return cmp.Or(cmp.Compare(keyIndex(a), keyIndex(b)), cmp.Compare(a, b))
+		})
+
+		ms := make(yaml.MapSlice, len(keys))
+		for i, k := range keys {
+			ms[i] = yaml.MapItem{Key: k, Value: orderTOML(v[k], append(slices.Clip(path), k), keyOrder)}
+		}
+		return ms
+	case []map[string]any:
+		a := make([]any, len(v))
+		for i := range v {
+			a[i] = orderTOML(v[i], path, keyOrder)
+		}
+		return a
+	case []any:
+		a := make([]any, len(v))
+		for i := range v {
+			a[i] = orderTOML(v[i], path, keyOrder)
+		}
+		return a
+	case time.Time:
+		return formatTOMLTime(v)
+	default:
+		return v
+	}
+}
+
+// formatTOMLTime formats a TOML date or time value. The TOML decoder
+// indicates a local date-time, local date, or local time value via the
+// name of the value's time.Location. A local date-time (without offset)
+// is treated as UTC.
+func formatTOMLTime(t time.Time) string {
+	switch t.Location().String() {
+	case "date-local":
+		return t.Format(time.DateOnly)
+	case "time-local":
+		return t.Format("15:04:05.999999999")
+	case "datetime-local":
+		y, mon, d := t.Date()
+		return time.Date(y, mon, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).
+			Format(time.RFC3339Nano)
+	default:
+		return t.Format(time.RFC3339Nano)
+	}
+}
@@ -0,0 +1,44 @@
+package json
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	yaml "github.com/goccy/go-yaml"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+func ingestYAML(ctx context.Context, job *ingestJob) error {
+	return ingestDocs(ctx, job, "YAML", decodeYAML)
+}
+
+var _ decodeDocsFunc = decodeYAML
+
+// decodeYAML decodes each of the documents in the YAML stream r. Mappings
+// are decoded as yaml.MapSlice, thus preserving the order of the keys.
+func decodeYAML(ctx context.Context, r io.Reader, fn func(doc any) error) error {
+	dec := yaml.NewDecoder(r, yaml.UseOrderedMap())
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		default:
+		}
+
+		var doc any
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			// Note that the decoder parses the entire stream on the first
+			// call to Decode, so we don't know which document is at fault.
+			return errz.Wrap(err, "yaml")
+		}
+
+		if err := fn(doc); err != nil {
+			return errz.Wrapf(err, "yaml: document[%d]", i)
+		}
+	}
+}
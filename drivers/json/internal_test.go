@@ -24,10 +24,15 @@ var (
 	IngestJSON      = ingestJSON
 	IngestJSONA     = ingestJSONA
 	IngestJSONL     = ingestJSONL
+	IngestYAML      = ingestYAML
+	IngestTOML      = ingestTOML
 	ColumnOrderFlat = columnOrderFlat
 	NewIngestJob    = newIngestJob
 )
 
+// IngestJob is exported for testing.
+type IngestJob = ingestJob
+
 // newIngestJob is a constructor for the unexported ingestJob type.
 // If sampleSize <= 0, a default value is used.
 func newIngestJob(fromSrc *source.Source, newRdrFn files.NewReaderFunc, destGrip driver.Grip, sampleSize int,
@@ -3,6 +3,11 @@
 // - JSON: plain old JSON
 // - JSONA: JSON Array, where each record is an array of JSON values on its own line.
 // - JSONL: JSON Lines, where each record a JSON object on its own line.
+//
+// The package also implements the YAML and TOML drivers, which share
+// the JSON ingest processor. Unlike the JSON types, a YAML or TOML
+// source may have several tables: each top-level array of objects
+// is a table.
 package json
 
 import (
@@ -43,6 +48,10 @@ func (d *Provider) DriverFor(typ drivertype.Type) (driver.Driver, error) {
 		ingestFn = ingestJSONA
 	case drivertype.JSONL:
 		ingestFn = ingestJSONL
+	case drivertype.YAML:
+		ingestFn = ingestYAML
+	case drivertype.TOML:
+		ingestFn = ingestTOML
 	default:
 		return nil, errz.Errorf("unsupported driver type {%s}", typ)
 	}
@@ -65,7 +74,7 @@ type driveri struct {
 
 // DriverMetadata implements driver.Driver.
 func (d *driveri) DriverMetadata() driver.Metadata {
-	md := driver.Metadata{Type: d.typ, Monotable: true}
+	md := driver.Metadata{Type: d.typ, Monotable: isMonotable(d.typ)}
 
 	switch d.typ { //nolint:exhaustive
 	case drivertype.JSON:
@@ -77,6 +86,12 @@ func (d *driveri) DriverMetadata() driver.Metadata {
 	case drivertype.JSONL:
 		md.Description = "JSON Lines: LF-delimited JSON objects"
 		md.Doc = "https://en.wikipedia.org/wiki/JSON_streaming#Line-delimited_JSON"
+	case drivertype.YAML:
+		md.Description = "YAML"
+		md.Doc = "https://en.wikipedia.org/wiki/YAML"
+	case drivertype.TOML:
+		md.Description = "TOML"
+		md.Doc = "https://toml.io"
 	}
 
 	return md
@@ -160,6 +175,10 @@ func (g *grip) Source() *source.Source {
 
 // TableMetadata implements driver.Grip.
 func (g *grip) TableMetadata(ctx context.Context, tblName string) (*metadata.Table, error) {
+	if !isMonotable(g.src.Type) {
+		return g.impl.TableMetadata(ctx, tblName)
+	}
+
 	if tblName != source.MonotableName {
 		return nil, errz.Errorf("table name should be %s for CSV/TSV etc., but got: %s",
 			source.MonotableName, tblName)
@@ -211,3 +230,10 @@ func (g *grip) Close() error {
 
 	return errz.Append(g.impl.Close(), g.clnup.Run())
 }
+
+// isMonotable returns true if typ is one of the JSON types, whose data
+// is ingested into the single table source.MonotableName. The YAML and
+// TOML types may have several tables.
+func isMonotable(typ drivertype.Type) bool {
+	return typ != drivertype.YAML && typ != drivertype.TOML
+}
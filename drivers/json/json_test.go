@@ -12,8 +12,11 @@ import (
 	"github.com/neilotoole/sq/drivers/json"
 	"github.com/neilotoole/sq/libsq/core/lg"
 	"github.com/neilotoole/sq/libsq/core/lg/lgt"
+	"github.com/neilotoole/sq/libsq/core/stringz"
 	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
 	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
 	"github.com/neilotoole/sq/testh/tu"
 )
 
@@ -125,3 +128,47 @@ func TestDriverDetectorFuncs(t *testing.T) {
 		})
 	}
 }
+
+// TestYAMLTOML_Inventory verifies that the equivalent YAML and TOML
+// inventory documents are ingested into the same tables.
+func TestYAMLTOML_Inventory(t *testing.T) {
+	testCases := []struct {
+		typ   drivertype.Type
+		fname string
+	}{
+		{typ: drivertype.YAML, fname: "inventory.yaml"},
+		{typ: drivertype.TOML, fname: "inventory.toml"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fname, func(t *testing.T) {
+			t.Parallel()
+
+			th := testh.New(t)
+			src := th.Add(&source.Source{
+				Handle:   "@inventory_" + tc.typ.String(),
+				Type:     tc.typ,
+				Location: filepath.Join("testdata", tc.fname),
+			})
+
+			srcMeta, err := th.SourceMetadata(src)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{source.MonotableName, "hosts", "services"}, srcMeta.TableNames())
+
+			sink, err := th.QuerySLQ(src.Handle+".hosts", nil)
+			require.NoError(t, err)
+			require.Equal(t, []string{"name", "ip", "cpus", "spec_ram_gb", "spec_disk"}, sink.RecMeta.MungedNames())
+			require.Len(t, sink.Recs, 3)
+
+			sink, err = th.QuerySLQ(src.Handle+".services", nil)
+			require.NoError(t, err)
+			require.Equal(t, []string{"name", "host", "port"}, sink.RecMeta.MungedNames())
+			require.Len(t, sink.Recs, 2)
+
+			sink, err = th.QuerySLQ(src.Handle+".data | .datacenter", nil)
+			require.NoError(t, err)
+			require.Len(t, sink.Recs, 1)
+			require.Equal(t, "us-east-1", stringz.Val(sink.Recs[0][0]))
+		})
+	}
+}
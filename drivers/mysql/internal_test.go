@@ -208,3 +208,72 @@ func TestSupportsRenameColumn(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportsWindowFuncs(t *testing.T) {
+	testCases := []struct {
+		v    string
+		want bool
+	}{
+		{v: "", want: false},         // version unknown
+		{v: "bogus", want: false},    // invalid
+		{v: "v5.7.44", want: false},  // MySQL, pre-8.0.2
+		{v: "v8.0.1", want: false},   // MySQL, just below threshold
+		{v: "v8.0.2", want: true},    // MySQL, at threshold
+		{v: "v8.0.36", want: true},   // MySQL
+		{v: "v10.1.48", want: false}, // MariaDB, pre-10.2.0
+		{v: "v10.2.0", want: true},   // MariaDB, at threshold
+		{v: "v11.2.2", want: true},   // MariaDB
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.v, func(t *testing.T) {
+			require.Equal(t, tc.want, supportsWindowFuncs(tc.v))
+		})
+	}
+}
+
+func TestSupportsIntersectExcept(t *testing.T) {
+	testCases := []struct {
+		v    string
+		want bool
+	}{
+		{v: "", want: false},         // version unknown
+		{v: "bogus", want: false},    // invalid
+		{v: "v5.7.44", want: false},  // MySQL, pre-8.0.31
+		{v: "v8.0.30", want: false},  // MySQL, just below threshold
+		{v: "v8.0.31", want: true},   // MySQL, at threshold
+		{v: "v8.4.0", want: true},    // MySQL
+		{v: "v10.2.44", want: false}, // MariaDB, pre-10.3.0
+		{v: "v10.3.0", want: true},   // MariaDB, at threshold
+		{v: "v11.2.2", want: true},   // MariaDB
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.v, func(t *testing.T) {
+			require.Equal(t, tc.want, supportsIntersectExcept(tc.v))
+		})
+	}
+}
+
+func TestSupportsCTE(t *testing.T) {
+	testCases := []struct {
+		v    string
+		want bool
+	}{
+		{v: "", want: false},        // version unknown
+		{v: "bogus", want: false},   // invalid
+		{v: "v5.7.44", want: false}, // MySQL, pre-8.0.1
+		{v: "v8.0.0", want: false},  // MySQL, just below threshold
+		{v: "v8.0.1", want: true},   // MySQL, at threshold
+		{v: "v8.4.0", want: true},   // MySQL
+		{v: "v10.2.0", want: false}, // MariaDB, pre-10.2.1
+		{v: "v10.2.1", want: true},  // MariaDB, at threshold
+		{v: "v11.2.2", want: true},  // MariaDB
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.v, func(t *testing.T) {
+			require.Equal(t, tc.want, supportsCTE(tc.v))
+		})
+	}
+}
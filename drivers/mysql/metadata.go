@@ -1461,6 +1461,33 @@ func supportsCastAsDouble(v string) bool {
 	return semver.Compare(v, "v8.0.17") >= 0 // MySQL
 }
 
+// supportsWindowFuncs reports whether the server supports window functions
+// (e.g. RANK() OVER (...)), added in MySQL 8.0.2 and MariaDB 10.2.0.
+func supportsWindowFuncs(v string) bool {
+	if semver.Compare(v, "v10.0.0") >= 0 { // MariaDB
+		return semver.Compare(v, "v10.2.0") >= 0
+	}
+	return semver.Compare(v, "v8.0.2") >= 0 // MySQL
+}
+
+// supportsIntersectExcept reports whether the server supports the INTERSECT
+// and EXCEPT set operations, added in MySQL 8.0.31 and MariaDB 10.3.0.
+func supportsIntersectExcept(v string) bool {
+	if semver.Compare(v, "v10.0.0") >= 0 { // MariaDB
+		return semver.Compare(v, "v10.3.0") >= 0
+	}
+	return semver.Compare(v, "v8.0.31") >= 0 // MySQL
+}
+
+// supportsCTE reports whether the server supports common table expressions
+// (WITH ... AS), added in MySQL 8.0.1 and MariaDB 10.2.1.
+func supportsCTE(v string) bool {
+	if semver.Compare(v, "v10.0.0") >= 0 { // MariaDB
+		return semver.Compare(v, "v10.2.1") >= 0
+	}
+	return semver.Compare(v, "v8.0.1") >= 0 // MySQL
+}
+
 // supportsRenameColumn reports whether the server supports
 // ALTER TABLE ... RENAME COLUMN, added in MySQL 8.0.0 and MariaDB 10.5.2. Below
 // that, a rename must go through CHANGE COLUMN (restating the column definition).
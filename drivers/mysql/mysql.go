@@ -174,6 +174,18 @@ func (d *driveri) Renderer() *render.Renderer {
 	// LIKE BINARY pattern below for the i* family or ilike, or
 	// case-insensitivity will break on case-sensitive collations.
 	r.FunctionOverrides[ast.FuncNameLike] = renderFuncLikeBinary
+	r.Window = renderWindow
+	r.SetOp = renderSetOp
+	r.Subquery = renderSubquery
+	r.With = renderWith
+	// cast() can't use dbTypeNameFromKind: several of MySQL's column types,
+	// e.g. TEXT and INT, aren't valid CAST targets. See castTypeNameFromKind.
+	r.DBTypeName = castTypeNameFromKind
+	r.CreateTable = buildCreateTableStmt
+	r.ValueLiteral = renderValueLiteral
+	r.FunctionOverrides[ast.FuncNameCast] = renderFuncCast
+	render.RegisterDatetimeFuncsMySQL(r)
+	render.RegisterStringFuncsMySQL(r)
 	return r
 }
 
@@ -102,7 +102,7 @@ func TestDBTypeNameFromKind(t *testing.T) {
 		kind.Text:     "TEXT",
 		kind.Int:      "INT",
 		kind.Float:    "DOUBLE",
-		kind.Decimal:  "DECIMAL",
+		kind.Decimal:  "DECIMAL(65, 30)",
 		kind.Bool:     "TINYINT(1)",
 		kind.Datetime: "DATETIME",
 		kind.Time:     "TIME",
@@ -143,7 +143,7 @@ func TestBuildUpdateStmt(t *testing.T) {
 func TestBuildCreateTableStmt_Minimal(t *testing.T) {
 	tbl := schema.NewTable("t", []string{"a"}, []kind.Kind{kind.Int})
 	got := buildCreateTableStmt(tbl)
-	require.Equal(t, "CREATE TABLE `t` (\n`a` INT\n)", got)
+	require.Equal(t, "CREATE TABLE `t` (\n  `a` INT\n)", got)
 }
 
 func TestBuildCreateTableStmt_Full(t *testing.T) {
@@ -13,6 +13,10 @@ import (
 	"github.com/neilotoole/sq/libsq/core/stringz"
 )
 
+// dbTypeNameFromKind returns the column type used by CREATE TABLE. Note
+// that kind.Decimal is DECIMAL(65, 30), MySQL's maximum precision and
+// scale: a bare DECIMAL is DECIMAL(10, 0), which would discard the
+// fractional part of values.
 func dbTypeNameFromKind(knd kind.Kind) string {
 	switch knd { //nolint:exhaustive // ignore kind.Unknown and kind.Null
 	case kind.Text:
@@ -22,7 +26,7 @@ func dbTypeNameFromKind(knd kind.Kind) string {
 	case kind.Float:
 		return "DOUBLE"
 	case kind.Decimal:
-		return "DECIMAL"
+		return "DECIMAL(65, 30)"
 	case kind.Bool:
 		return "TINYINT(1)"
 	case kind.Datetime:
@@ -38,6 +42,35 @@ func dbTypeNameFromKind(knd kind.Kind) string {
 	}
 }
 
+// castTypeNameFromKind returns the type name used by the cast() function.
+// MySQL's CAST accepts only a subset of its column types: for example,
+// CAST(x AS INT) and CAST(x AS TEXT) are syntax errors. MySQL has no boolean
+// type (BOOL is an alias for TINYINT(1), which also isn't a valid CAST
+// target), so kind.Bool is cast to SIGNED. Note that DOUBLE is only valid
+// from MySQL 8.0.17: see renderFuncCast.
+func castTypeNameFromKind(knd kind.Kind) string {
+	switch knd { //nolint:exhaustive // ignore kind.Unknown and kind.Null
+	case kind.Text:
+		return "CHAR"
+	case kind.Int, kind.Bool:
+		return "SIGNED"
+	case kind.Float:
+		return "DOUBLE"
+	case kind.Decimal:
+		return "DECIMAL(65, 30)"
+	case kind.Datetime:
+		return "DATETIME"
+	case kind.Time:
+		return "TIME"
+	case kind.Date:
+		return "DATE"
+	case kind.Bytes:
+		return "BINARY"
+	default:
+		panic(fmt.Sprintf("unsupported data kind {%s}", knd))
+	}
+}
+
 // createTblKindDefaults is a map of Kind to the value
 // to use for a column's DEFAULT clause in a CREATE TABLE statement.
 //
@@ -86,7 +119,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 	if tblDef.PKColName != "" {
 		buf.WriteString("PRIMARY KEY (")
 		buf.WriteString(stringz.BacktickQuote(tblDef.PKColName))
-		buf.WriteString("),\n")
+		buf.WriteString("),\n  ")
 		buf.WriteString("UNIQUE KEY ")
 		buf.WriteString(stringz.BacktickQuote(tblDef.Name + "_" + tblDef.PKColName + "_uindex"))
 		buf.WriteString(" (")
@@ -105,7 +138,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 
 		if col.Unique {
 			if buf.Len() > 0 {
-				buf.WriteString(",\n")
+				buf.WriteString(",\n  ")
 			}
 			buf.WriteString("UNIQUE KEY ")
 			buf.WriteString(stringz.BacktickQuote(tblDef.Name + "_" + col.Name + "_uindex"))
@@ -124,7 +157,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 		}
 
 		if buf.Len() > 0 {
-			buf.WriteString(",\n")
+			buf.WriteString(",\n  ")
 		}
 		fkBase := tblDef.Name + "_" + col.Name + "_" +
 			col.ForeignKey.RefTable + "_" + col.ForeignKey.RefCol
@@ -132,7 +165,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 		buf.WriteString(stringz.BacktickQuote(fkBase + "_key"))
 		buf.WriteString(" (")
 		buf.WriteString(stringz.BacktickQuote(col.Name))
-		buf.WriteString("),\nCONSTRAINT ")
+		buf.WriteString("),\n  CONSTRAINT ")
 		buf.WriteString(stringz.BacktickQuote(fkBase + "_fk"))
 		buf.WriteString(" FOREIGN KEY (")
 		buf.WriteString(stringz.BacktickQuote(col.Name))
@@ -161,21 +194,23 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 	buf.WriteString(" (\n")
 
 	for x := 0; x < len(cols)-1; x++ {
+		buf.WriteString("  ")
 		buf.WriteString(cols[x])
 		buf.WriteString(",\n")
 	}
+	buf.WriteString("  ")
 	buf.WriteString(cols[len(cols)-1])
 
 	if pk != "" {
-		buf.WriteString(",\n")
+		buf.WriteString(",\n  ")
 		buf.WriteString(pk)
 	}
 	if uniq != "" {
-		buf.WriteString(",\n")
+		buf.WriteString(",\n  ")
 		buf.WriteString(uniq)
 	}
 	if fk != "" {
-		buf.WriteString(",\n")
+		buf.WriteString(",\n  ")
 		buf.WriteString(fk)
 	}
 	buf.WriteString("\n)")
@@ -258,6 +293,89 @@ func renderFuncAvg(rc *render.Context, fn *ast.FuncNode) (string, error) {
 	return "CAST(" + inner + " AS DOUBLE)", nil
 }
 
+// renderFuncCast renders the cast() function. As with renderFuncAvg, a
+// cast to kind.Float on a server that doesn't support CAST(... AS DOUBLE)
+// falls back to "(inner + 0e0)".
+func renderFuncCast(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	if fn.CastKind() != kind.Float || supportsCastAsDouble(rc.DBSemver) {
+		return render.RenderCastDefault(rc, fn)
+	}
+
+	args := fn.Args()
+	if len(args) != 1 {
+		return "", errz.Errorf("%s() requires 2 arguments (expr, kind), got %d", fn.FuncName(), len(args)+1)
+	}
+
+	arg, err := render.RenderFuncArg(rc, args[0])
+	if err != nil {
+		return "", err
+	}
+	return "(" + arg + " + 0e0)", nil
+}
+
+// renderWindow renders a window function, e.g. "rank() over(...)". Window
+// functions aren't supported before MySQL 8.0.2 (MariaDB 10.2.0), so on
+// those servers an error is returned instead of SQL that fails to parse.
+// When the server version is unknown, the SQL is rendered regardless, and
+// it's up to the server to accept or reject it.
+func renderWindow(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	if rc.DBSemver != "" && !supportsWindowFuncs(rc.DBSemver) {
+		return "", errz.Errorf(
+			"window function %s() over(): not supported by %s %s: requires MySQL 8.0.2 or MariaDB 10.2.0",
+			fn.FuncName(), rc.Dialect, rc.DBSemver)
+	}
+	return render.RenderWindowDefault(rc, fn)
+}
+
+// renderSetOp renders a set operation keyword. INTERSECT and EXCEPT aren't
+// supported before MySQL 8.0.31 (MariaDB 10.3.0), so on those servers an
+// error is returned. As with renderWindow, when the server version is
+// unknown, the SQL is rendered regardless.
+func renderSetOp(rc *render.Context, op *ast.SetOpNode) (string, error) {
+	switch op.Op() {
+	case ast.SetOpIntersect, ast.SetOpExcept:
+		if rc.DBSemver != "" && !supportsIntersectExcept(rc.DBSemver) {
+			return "", errz.Errorf(
+				"set operation %s(): not supported by %s %s: requires MySQL 8.0.31 or MariaDB 10.3.0",
+				op.Op(), rc.Dialect, rc.DBSemver)
+		}
+	default:
+	}
+	return render.RenderSetOpDefault(rc, op)
+}
+
+// renderWith renders the WITH clause for the query's let statements.
+// Common table expressions aren't supported before MySQL 8.0.1 (MariaDB
+// 10.2.1), so on those servers an error is returned. As with renderWindow,
+// when the server version is unknown, the SQL is rendered regardless.
+func renderWith(rc *render.Context, lets []*ast.LetNode) (string, error) {
+	if len(lets) > 0 && rc.DBSemver != "" && !supportsCTE(rc.DBSemver) {
+		return "", errz.Errorf(
+			"let %s: not supported by %s %s: requires MySQL 8.0.1 or MariaDB 10.2.1",
+			lets[0].Name(), rc.Dialect, rc.DBSemver)
+	}
+	return render.RenderWithDefault(rc, lets)
+}
+
+// renderSubquery renders a subquery. MySQL (and MariaDB) don't support
+// LIMIT in a subquery that is the operand of IN, so an error is returned
+// if such a subquery has a row range. A derived table, which doesn't have
+// that restriction, can be used instead.
+func renderSubquery(rc *render.Context, sub *ast.SubqueryNode) (string, error) {
+	if sub.IsInOperand() {
+		rr, err := ast.NewInspector(sub.Query()).FindRowRangeNode()
+		if err != nil {
+			return "", err
+		}
+		if rr != nil {
+			return "", errz.Errorf(
+				"subquery %s: row range in operand of in is not supported by %s: instead, use a derived table",
+				sub.Text(), rc.Dialect)
+		}
+	}
+	return render.RenderSubqueryDefault(rc, sub)
+}
+
 func renderFuncContainsBinary(rc *render.Context, fn *ast.FuncNode) (string, error) {
 	return render.RenderLikeOp(rc, fn, render.LikeOpts{Mode: render.LikeContains, Op: "LIKE BINARY"})
 }
@@ -273,3 +391,12 @@ func renderFuncEndsWithBinary(rc *render.Context, fn *ast.FuncNode) (string, err
 func renderFuncLikeBinary(rc *render.Context, fn *ast.FuncNode) (string, error) {
 	return render.RenderLikeRaw(rc, fn, render.LikeRawOpts{Op: "LIKE BINARY"})
 }
+
+// renderValueLiteral implements render.Renderer.ValueLiteral. MySQL treats
+// backslash as an escape character in string literals, so it's escaped.
+func renderValueLiteral(rc *render.Context, knd kind.Kind, val any) (string, error) {
+	if s, ok := val.(string); ok {
+		return render.SingleQuoteBackslash(s), nil
+	}
+	return render.RenderValueLiteralDefault(rc, knd, val)
+}
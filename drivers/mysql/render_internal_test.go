@@ -56,3 +56,51 @@ func TestRenderFuncAvg_versionCast(t *testing.T) {
 		})
 	}
 }
+
+// TestRenderFuncCast_versionCast verifies that cast() to float falls back to
+// "(x + 0e0)" on servers that don't support CAST(... AS DOUBLE), as with
+// TestRenderFuncAvg_versionCast, while casts to other kinds are unaffected.
+func TestRenderFuncCast_versionCast(t *testing.T) {
+	d := &driveri{}
+	r := d.Renderer()
+	dl := d.Dialect()
+
+	parseCast := func(slq string) *ast.FuncNode {
+		a, err := ast.Parse(lg.Discard(), slq)
+		require.NoError(t, err)
+		fn := ast.FindFirstNode[*ast.FuncNode](a)
+		require.NotNil(t, fn)
+		require.Equal(t, ast.FuncNameCast, fn.FuncName())
+		return fn
+	}
+
+	floatFn := parseCast(".actor | cast(.actor_id, float)")
+	textFn := parseCast(".actor | cast(.actor_id, text)")
+
+	newCtx := func(semver string) *render.Context {
+		return &render.Context{Renderer: r, Dialect: dl, DBSemver: semver}
+	}
+
+	testCases := []struct {
+		semver   string
+		wantFrag string
+	}{
+		{semver: "v8.0.17", wantFrag: "CAST(`actor_id` AS DOUBLE)"},
+		{semver: "v8.0.16", wantFrag: "(`actor_id` + 0e0)"},
+		{semver: "v10.4.0", wantFrag: "CAST(`actor_id` AS DOUBLE)"},
+		{semver: "v10.3.39", wantFrag: "(`actor_id` + 0e0)"},
+		{semver: "", wantFrag: "(`actor_id` + 0e0)"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.semver, func(t *testing.T) {
+			got, err := renderFuncCast(newCtx(tc.semver), floatFn)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantFrag, got)
+
+			got, err = renderFuncCast(newCtx(tc.semver), textFn)
+			require.NoError(t, err)
+			require.Equal(t, "CAST(`actor_id` AS CHAR)", got)
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package ods
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+)
+
+// XML namespaces of the OpenDocument elements and attributes that are
+// read from content.xml.
+const (
+	nsOffice = "urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+	nsTable  = "urn:oasis:names:tc:opendocument:xmlns:table:1.0"
+	nsText   = "urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+)
+
+// maxRepeat is the maximum number of times that a non-empty row or cell is
+// repeated via table:number-rows-repeated or table:number-columns-repeated.
+// Spreadsheet applications use those attributes to pad a sheet to its
+// maximum size, e.g. 1048576 rows, which is of no use to us.
+const maxRepeat = 1 << 16
+
+// odsSheet is a sheet (table) of a spreadsheet document.
+type odsSheet struct {
+	name string
+
+	// rows holds the sheet's non-empty rows. Each cell is the cell's value
+	// as text, or empty string if the cell is empty. Trailing empty cells
+	// are omitted, so the rows may have different lengths.
+	rows [][]string
+}
+
+// readSheets reads the sheets of the ODS document from r. The entire
+// document is read into memory.
+func readSheets(ctx context.Context, r io.Reader) ([]*odsSheet, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errw(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errw(err)
+	}
+
+	f, err := zr.Open("content.xml")
+	if err != nil {
+		return nil, errz.Wrap(err, "ods: invalid document")
+	}
+	defer lg.WarnIfCloseError(lg.FromContext(ctx), lgm.CloseFileReader, f)
+
+	return parseContent(ctx, f)
+}
+
+// cellValue holds the state of the table:table-cell being parsed.
+type cellValue struct {
+	// val is the cell's value as derived from its office:*-value
+	// attributes. If empty, the cell's text is used.
+	val string
+
+	// text is the text of the cell's text:p paragraphs.
+	text strings.Builder
+
+	// paras is the count of text:p paragraphs seen.
+	paras int
+
+	// repeat is the value of table:number-columns-repeated.
+	repeat int
+}
+
+func (c *cellValue) String() string {
+	if c.val != "" {
+		return c.val
+	}
+	return c.text.String()
+}
+
+// parseContent parses the sheets from the content.xml document r.
+//
+//nolint:gocognit,funlen
+func parseContent(ctx context.Context, r io.Reader) ([]*odsSheet, error) {
+	var (
+		dec    = xml.NewDecoder(r)
+		sheets []*odsSheet
+		sheet  *odsSheet
+
+		row          []string
+		rowRepeat    int
+		pendingEmpty int // Count of empty cells not yet appended to row.
+
+		cell      *cellValue
+		inPara    bool
+		skipDepth int // Depth within an element whose text is ignored.
+		count     int
+	)
+
+	for {
+		if count++; count%10000 == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, context.Cause(ctx)
+			default:
+			}
+		}
+
+		tok, err := dec.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errz.Wrap(err, "ods: content.xml")
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 {
+				skipDepth++
+				continue
+			}
+
+			switch tok.Name {
+			case xml.Name{Space: nsTable, Local: "table"}:
+				sheet = &odsSheet{name: attr(tok, nsTable, "name")}
+				sheets = append(sheets, sheet)
+			case xml.Name{Space: nsTable, Local: "table-row"}:
+				row = nil
+				pendingEmpty = 0
+				rowRepeat = repeatAttr(tok, "number-rows-repeated")
+			case xml.Name{Space: nsTable, Local: "table-cell"},
+				xml.Name{Space: nsTable, Local: "covered-table-cell"}:
+				cell = &cellValue{
+					val:    typedValue(tok),
+					repeat: repeatAttr(tok, "number-columns-repeated"),
+				}
+			case xml.Name{Space: nsText, Local: "p"}:
+				if cell != nil {
+					if cell.paras > 0 {
+						cell.text.WriteByte('\n')
+					}
+					cell.paras++
+					inPara = true
+				}
+			case xml.Name{Space: nsText, Local: "s"}:
+				if inPara {
+					n := 1
+					if c := attr(tok, nsText, "c"); c != "" {
+						n, _ = strconv.Atoi(c)
+					}
+					cell.text.WriteString(strings.Repeat(" ", max(n, 1)))
+				}
+			case xml.Name{Space: nsText, Local: "tab"}:
+				if inPara {
+					cell.text.WriteByte('\t')
+				}
+			case xml.Name{Space: nsText, Local: "line-break"}:
+				if inPara {
+					cell.text.WriteByte('\n')
+				}
+			case xml.Name{Space: nsOffice, Local: "annotation"},
+				xml.Name{Space: nsText, Local: "note"}:
+				// Comments and notes aren't part of the cell's value.
+				skipDepth = 1
+			}
+
+		case xml.CharData:
+			if inPara && skipDepth == 0 {
+				cell.text.Write(tok)
+			}
+
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+
+			switch tok.Name {
+			case xml.Name{Space: nsText, Local: "p"}:
+				inPara = false
+			case xml.Name{Space: nsTable, Local: "table-cell"},
+				xml.Name{Space: nsTable, Local: "covered-table-cell"}:
+				if cell == nil {
+					continue
+				}
+				val := cell.String()
+				if val == "" {
+					// Defer appending empty cells until we know
+					// that they're not trailing cells.
+					pendingEmpty += cell.repeat
+				} else {
+					for range min(pendingEmpty, maxRepeat) {
+						row = append(row, "")
+					}
+					pendingEmpty = 0
+					for range min(cell.repeat, maxRepeat) {
+						row = append(row, val)
+					}
+				}
+				cell = nil
+			case xml.Name{Space: nsTable, Local: "table-row"}:
+				if sheet != nil && len(row) > 0 {
+					sheet.rows = append(sheet.rows, row)
+					for range min(rowRepeat, maxRepeat) - 1 {
+						sheet.rows = append(sheet.rows, slices.Clone(row))
+					}
+				}
+				row = nil
+			case xml.Name{Space: nsTable, Local: "table"}:
+				sheet = nil
+			}
+		}
+	}
+
+	return sheets, nil
+}
+
+// typedValue returns the value of a table:table-cell element per its
+// office:value-type attribute, or empty string if the cell's value is
+// its text. The values are rendered in forms that kind.Detector
+// understands: for example, a date-time value is rendered as RFC3339.
+func typedValue(el xml.StartElement) string {
+	switch attr(el, nsOffice, "value-type") {
+	case "float", "percentage", "currency":
+		return attr(el, nsOffice, "value")
+	case "boolean":
+		return attr(el, nsOffice, "boolean-value")
+	case "date":
+		return formatDateValue(attr(el, nsOffice, "date-value"))
+	case "time":
+		return formatTimeValue(attr(el, nsOffice, "time-value"))
+	default:
+		return ""
+	}
+}
+
+// formatDateValue formats an office:date-value, which is a date such as
+// "2006-02-15", or a date-time such as "2006-02-15T04:34:33". A date is
+// returned as is. A date-time is returned as RFC3339, in UTC if the value
+// has no time zone. If s can't be parsed, it's returned unchanged.
+func formatDateValue(s string) string {
+	if !strings.Contains(s, "T") {
+		return s
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.Format(time.RFC3339Nano)
+	}
+
+	if t, err := time.Parse("2006-01-02T15:04:05.999999999", s); err == nil {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+
+	return s
+}
+
+// formatTimeValue formats an office:time-value, which is an ISO 8601
+// duration such as "PT04H34M33S", as a time of day, such as "04:34:33".
+// If s can't be parsed, it's returned unchanged.
+func formatTimeValue(s string) string {
+	rest, ok := strings.CutPrefix(s, "PT")
+	if !ok {
+		return s
+	}
+
+	var h, m int
+	var sec float64
+	for rest != "" {
+		i := strings.IndexAny(rest, "HMS")
+		if i <= 0 {
+			return s
+		}
+
+		num := rest[:i]
+		var err error
+		switch rest[i] {
+		case 'H':
+			h, err = strconv.Atoi(num)
+		case 'M':
+			m, err = strconv.Atoi(num)
+		case 'S':
+			sec, err = strconv.ParseFloat(num, 64)
+		}
+		if err != nil {
+			return s
+		}
+		rest = rest[i+1:]
+	}
+
+	d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+		time.Duration(sec*float64(time.Second)).Round(time.Millisecond)
+	t := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d)
+	if t.Nanosecond() == 0 {
+		return t.Format(time.TimeOnly)
+	}
+	return t.Format("15:04:05.999")
+}
+
+// attr returns the value of el's attribute with the given namespace and
+// local name, or empty string.
+func attr(el xml.StartElement, space, local string) string {
+	for _, a := range el.Attr {
+		if a.Name.Space == space && a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// repeatAttr returns the value of el's table namespace attribute
+// local, such as "number-rows-repeated", or 1 if not set or invalid.
+func repeatAttr(el xml.StartElement, local string) int {
+	n, err := strconv.Atoi(attr(el, nsTable, local))
+	if err != nil || n < 1 {
+		return 1
+	}
+	return n
+}
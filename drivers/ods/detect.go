@@ -0,0 +1,171 @@
+package ods
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"slices"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+var _ files.TypeDetectFunc = DetectODS
+
+// MediaType is the media type of an ODS document, as found in the
+// document's "mimetype" ZIP entry.
+const MediaType = "application/vnd.oasis.opendocument.spreadsheet"
+
+// DetectODS implements files.TypeDetectFunc, returning drivertype.ODS and
+// a score of 1.0 if valid ODS.
+//
+// An OpenDocument package is a ZIP archive whose "mimetype" entry,
+// conventionally the first entry, is stored uncompressed and holds the
+// document's media type. Detection works by scanning the first portion of
+// the file for that entry, and checking that its content is MediaType.
+// Thus, an OpenDocument text document (.odt), which has a different media
+// type, is not detected as ODS.
+func DetectODS(ctx context.Context, newRdrFn files.NewReaderFunc) (detected drivertype.Type, score float32,
+	err error,
+) {
+	const detectBufSize = 64 * 1024
+
+	log := lg.FromContext(ctx)
+	var r io.ReadCloser
+	r, err = newRdrFn(ctx)
+	if err != nil {
+		return drivertype.None, 0, errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+
+	buf := make([]byte, detectBufSize)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return drivertype.None, 0, errz.Err(err)
+	}
+	buf = buf[:n]
+
+	if hasODSMimetype(buf) {
+		return drivertype.ODS, 1.0, nil
+	}
+
+	return drivertype.None, 0, nil
+}
+
+// zipLocalFileHeaderSig is the ZIP local file header signature.
+var zipLocalFileHeaderSig = []byte{'P', 'K', 0x03, 0x04}
+
+// hasODSMimetype scans buf for ZIP local file headers and returns true if
+// there's an uncompressed entry named "mimetype" whose content is
+// MediaType. See xlsx.DetectXLSX for the ZIP local file header format.
+func hasODSMimetype(buf []byte) bool {
+	if !bytes.HasPrefix(buf, zipLocalFileHeaderSig) {
+		return false
+	}
+
+	const (
+		methodStored = 0
+		entryName    = "mimetype"
+	)
+
+	pos := 0
+	for pos+30 <= len(buf) {
+		idx := bytes.Index(buf[pos:], zipLocalFileHeaderSig)
+		if idx == -1 {
+			break
+		}
+		pos += idx
+
+		if pos+30 > len(buf) {
+			break
+		}
+
+		method := binary.LittleEndian.Uint16(buf[pos+8 : pos+10])
+		size := int(binary.LittleEndian.Uint32(buf[pos+18 : pos+22]))
+		filenameLen := int(binary.LittleEndian.Uint16(buf[pos+26 : pos+28]))
+		extraLen := int(binary.LittleEndian.Uint16(buf[pos+28 : pos+30]))
+
+		filenameStart := pos + 30
+		filenameEnd := filenameStart + filenameLen
+		if filenameEnd > len(buf) {
+			break
+		}
+
+		if method == methodStored && string(buf[filenameStart:filenameEnd]) == entryName {
+			dataStart := filenameEnd + extraLen
+			dataEnd := dataStart + size
+			if dataEnd > len(buf) {
+				break
+			}
+			return string(buf[dataStart:dataEnd]) == MediaType
+		}
+
+		pos = filenameEnd
+	}
+
+	return false
+}
+
+func detectHeaderRow(sheet *odsSheet, sampleRows [][]string) (hasHeader bool, err error) {
+	if len(sampleRows) < 2 {
+		// If zero records, obviously no header row.
+		// If one record... well, is there any way of determining if
+		// it's a header row or not? Probably best to treat it as a data row.
+		return false, nil
+	}
+
+	kinds1, _, err := detectColumnKinds(sheet, sampleRows, 0)
+	if err != nil {
+		return false, err
+	}
+	kinds2, _, err := detectColumnKinds(sheet, sampleRows, 1)
+	if err != nil {
+		return false, err
+	}
+
+	// The rows may differ in length (ragged edges).
+	length := min(len(kinds1), len(kinds2))
+	return !slices.Equal(kinds1[:length], kinds2[:length]), nil
+}
+
+// detectColumnKinds calculates the lowest-common-denominator kind for the
+// columns of sampleRows, starting at row rangeStart. It also returns munge
+// funcs for ingesting each column's data (the munge func may be nil for
+// any column).
+func detectColumnKinds(sheet *odsSheet, sampleRows [][]string, rangeStart int) ([]kind.Kind,
+	[]kind.MungeFunc, error,
+) {
+	if rangeStart > len(sampleRows) {
+		// Shouldn't happen
+		return nil, nil, errz.Errorf("ods: sheet {%s} is empty", sheet.name)
+	}
+
+	var detectors []*kind.Detector
+	for i := rangeStart; i < len(sampleRows); i++ {
+		for j := len(detectors); j < len(sampleRows[i]); j++ {
+			detectors = append(detectors, kind.NewDetector())
+		}
+
+		for j := range sampleRows[i] {
+			detectors[j].Sample(sampleRows[i][j])
+		}
+	}
+
+	kinds := make([]kind.Kind, len(detectors))
+	mungeFns := make([]kind.MungeFunc, len(detectors))
+	var err error
+
+	for j := range detectors {
+		if kinds[j], mungeFns[j], err = detectors[j].Detect(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return kinds, mungeFns, nil
+}
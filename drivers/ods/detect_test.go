@@ -0,0 +1,62 @@
+package ods_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/drivers/ods"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+func TestDetectODS(t *testing.T) {
+	testCases := []struct {
+		file      string
+		wantType  drivertype.Type
+		wantScore float32
+	}{
+		{file: "testdata/actor.ods", wantType: drivertype.ODS, wantScore: 1.0},
+		{file: "testdata/actor_no_header.ods", wantType: drivertype.ODS, wantScore: 1.0},
+		{file: "testdata/types.ods", wantType: drivertype.ODS, wantScore: 1.0},
+		{file: "../xlsx/testdata/actor_header.xlsx", wantType: drivertype.None},
+		{file: "../csv/testdata/person.csv", wantType: drivertype.None},
+	}
+
+	for _, tc := range testCases {
+		t.Run(filepath.Base(tc.file), func(t *testing.T) {
+			newRdrFn := func(_ context.Context) (io.ReadCloser, error) {
+				return os.Open(tc.file)
+			}
+
+			gotType, gotScore, err := ods.DetectODS(context.Background(), newRdrFn)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantType, gotType)
+			require.Equal(t, tc.wantScore, gotScore)
+		})
+	}
+}
+
+func TestDetectODS_OtherMediaType(t *testing.T) {
+	// An OpenDocument master document (.odm) is also a ZIP archive with
+	// a stored "mimetype" entry, but it's not a spreadsheet. Its media
+	// type has the same length as MediaType, so the ZIP remains valid.
+	data, err := os.ReadFile("testdata/actor.ods")
+	require.NoError(t, err)
+	data = bytes.Replace(data,
+		[]byte(ods.MediaType),
+		[]byte("application/vnd.oasis.opendocument.text-master"), 1)
+
+	newRdrFn := func(_ context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	gotType, gotScore, err := ods.DetectODS(context.Background(), newRdrFn)
+	require.NoError(t, err)
+	require.Equal(t, drivertype.None, gotType)
+	require.Equal(t, float32(0), gotScore)
+}
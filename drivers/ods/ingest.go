@@ -0,0 +1,343 @@
+package ods
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neilotoole/sq/libsq/core/debugz"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/core/progress"
+	"github.com/neilotoole/sq/libsq/core/schema"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// sheetTable maps a sheet to a database table.
+type sheetTable struct {
+	sheet             *odsSheet
+	def               *schema.Table
+	colIngestMungeFns []kind.MungeFunc
+	hasHeaderRow      bool
+}
+
+// ingestODS loads the data in sheets into destGrip.
+func ingestODS(ctx context.Context, src *source.Source, destGrip driver.Grip, sheets []*odsSheet) error {
+	log := lg.FromContext(ctx)
+	start := time.Now()
+	log.Debug("Beginning import from ODS",
+		lga.Src, src,
+		lga.Target, destGrip.Source())
+
+	srcIngestHeader := getSrcIngestHeader(src.Options)
+
+	var sheetTbls []*sheetTable
+	for _, sheet := range sheets {
+		sheetTbl, err := buildSheetTable(ctx, srcIngestHeader, sheet)
+		if err != nil {
+			if errz.Has[driver.EmptyDataError](err) {
+				// If the sheet has no data, we log it and skip it.
+				log.Warn("ODS sheet has no data", laSheet, sheet.name, lga.Err, err)
+				continue
+			}
+			return err
+		}
+		sheetTbls = append(sheetTbls, sheetTbl)
+	}
+
+	bar := progress.FromContext(ctx).NewUnitTotalCounter(
+		"Ingesting sheets",
+		"",
+		int64(len(sheetTbls)),
+	)
+	defer bar.Stop()
+
+	for _, sheetTbl := range sheetTbls {
+		db, err := destGrip.DB(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = destGrip.SQLDriver().CreateTable(ctx, db, sheetTbl.def); err != nil {
+			return err
+		}
+	}
+
+	log.Debug("Tables created (but not yet populated)",
+		lga.Count, len(sheetTbls),
+		lga.Target, destGrip.Source(),
+		lga.Elapsed, time.Since(start))
+
+	for _, sheetTbl := range sheetTbls {
+		debugz.DebugSleep(ctx)
+
+		if err := ingestSheetToTable(ctx, destGrip, sheetTbl); err != nil {
+			return err
+		}
+		bar.Incr(1)
+	}
+
+	log.Debug(
+		"Sheets ingested",
+		lga.Count, len(sheetTbls),
+		"skipped", len(sheets)-len(sheetTbls),
+		lga.From, src,
+		lga.To, destGrip.Source(),
+		lga.Elapsed, time.Since(start),
+	)
+
+	return nil
+}
+
+// ingestSheetToTable imports the sheet data into the appropriate table
+// in destGrip. The scratch table must already exist.
+func ingestSheetToTable(ctx context.Context, destGrip driver.Grip, sheetTbl *sheetTable) error {
+	var (
+		log          = lg.FromContext(ctx)
+		startTime    = time.Now()
+		sheet        = sheetTbl.sheet
+		tblDef       = sheetTbl.def
+		destColKinds = tblDef.ColKinds()
+	)
+
+	db, err := destGrip.DB(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseDB, conn)
+
+	bi, err := destGrip.SQLDriver().NewBatchInsert(
+		ctx,
+		fmt.Sprintf("Ingest {%s}", sheet.name),
+		conn,
+		destGrip.Source(),
+		tblDef.Name,
+		tblDef.ColNames(),
+	)
+	if err != nil {
+		return err
+	}
+
+	rows := sheet.rows
+	if sheetTbl.hasHeaderRow {
+		rows = rows[1:]
+	}
+
+LOOP:
+	for i, cells := range rows {
+		rec := rowToRecord(ctx, destColKinds, sheetTbl.colIngestMungeFns, sheet.name, i, cells)
+		if err = bi.Munge(rec); err != nil {
+			close(bi.RecordCh)
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			close(bi.RecordCh)
+			return ctx.Err()
+		case err = <-bi.ErrCh:
+			if err != nil {
+				close(bi.RecordCh)
+				return err
+			}
+
+			// The batch inserter successfully completed
+			break LOOP
+		case bi.RecordCh <- rec:
+		}
+	}
+
+	close(bi.RecordCh) // Indicate that we're finished writing records
+
+	if err = <-bi.ErrCh; err != nil { // Wait for bi to complete
+		return err
+	}
+
+	log.Debug("Inserted rows from sheet into table",
+		lga.Count, bi.Written(),
+		laSheet, sheet.name,
+		lga.Target, source.Target(destGrip.Source(), tblDef.Name),
+		lga.Elapsed, time.Since(startTime))
+
+	return nil
+}
+
+// getSrcIngestHeader returns nil if driver.OptIngestHeader is not set,
+// and has the value of the opt if set.
+func getSrcIngestHeader(o options.Options) *bool {
+	if driver.OptIngestHeader.IsSet(o) {
+		b := driver.OptIngestHeader.Get(o)
+		return &b
+	}
+
+	return nil
+}
+
+// buildSheetTable constructs a table definition for the given sheet. If
+// srcIngestHeader is nil, the function attempts to detect if the sheet has
+// a header row. If the sheet has no data, driver.EmptyDataError is
+// returned.
+func buildSheetTable(ctx context.Context, srcIngestHeader *bool, sheet *odsSheet) (*sheetTable, error) {
+	log := lg.FromContext(ctx)
+
+	if len(sheet.rows) == 0 {
+		return nil, driver.NewEmptyDataError("ods: sheet {%s} has no row data", sheet.name)
+	}
+
+	sampleSize := driver.OptIngestSampleSize.Get(options.FromContext(ctx))
+	sampleRows := sheet.rows[:min(sampleSize, len(sheet.rows))]
+
+	var hasHeader bool
+	if srcIngestHeader != nil {
+		hasHeader = *srcIngestHeader
+	} else {
+		var err error
+		if hasHeader, err = detectHeaderRow(sheet, sampleRows); err != nil {
+			return nil, err
+		}
+
+		log.Debug("Detect header row for sheet", laSheet, sheet.name, lga.Val, hasHeader)
+	}
+
+	var maxCols int
+	for _, row := range sampleRows {
+		maxCols = max(maxCols, len(row))
+	}
+
+	colNames := make([]string, maxCols)
+	colKinds := make([]kind.Kind, maxCols)
+	colIngestMungeFns := make([]kind.MungeFunc, maxCols)
+
+	firstDataRow := 0
+	if hasHeader {
+		firstDataRow = 1
+		copy(colNames, sampleRows[0])
+	} else {
+		for i := range maxCols {
+			colNames[i] = stringz.GenerateAlphaColName(i, false)
+		}
+	}
+
+	if firstDataRow >= len(sampleRows) {
+		// The sheet contains only one row (the header row). Let's
+		// explicitly set the column type nonetheless.
+		for i := range maxCols {
+			colKinds[i] = kind.Text
+		}
+	} else {
+		kinds, mungeFns, err := detectColumnKinds(sheet, sampleRows, firstDataRow)
+		if err != nil {
+			return nil, err
+		}
+		copy(colKinds, kinds)
+		copy(colIngestMungeFns, mungeFns)
+	}
+
+	colNames, colKinds = syncColNamesKinds(colNames, colKinds)
+
+	var err error
+	if colNames, err = driver.MungeIngestColNames(ctx, colNames); err != nil {
+		return nil, err
+	}
+
+	tblDef := &schema.Table{Name: sheet.name}
+	cols := make([]*schema.Column, len(colNames))
+	for i, colName := range colNames {
+		cols[i] = &schema.Column{Table: tblDef, Name: colName, Kind: colKinds[i]}
+	}
+	tblDef.Cols = cols
+	log.Debug("Built table def",
+		laSheet, sheet.name,
+		"cols", strings.Join(colNames, ", "))
+
+	return &sheetTable{
+		sheet:             sheet,
+		def:               tblDef,
+		hasHeaderRow:      hasHeader,
+		colIngestMungeFns: colIngestMungeFns,
+	}, nil
+}
+
+// syncColNamesKinds ensures that column names and kinds are in a working
+// state vis-à-vis each other. Any empty column name is replaced with a
+// generated name, and kind.Null or kind.Unknown becomes kind.Text.
+func syncColNamesKinds(colNames []string, colKinds []kind.Kind) (names []string, kinds []kind.Kind) {
+	for i := range colNames {
+		if colNames[i] == "" {
+			colName := stringz.GenerateAlphaColName(i, false)
+			for stringz.InSlice(colNames[0:i], colName) {
+				// If colName already exists, just append an
+				// underscore and try again.
+				colName += "_"
+			}
+			colNames[i] = colName
+		}
+	}
+
+	for i := range colKinds {
+		if colKinds[i] == kind.Null || colKinds[i] == kind.Unknown {
+			colKinds[i] = kind.Text
+		}
+	}
+
+	return colNames, colKinds
+}
+
+// rowToRecord accepts a row (in arg cells), and converts it into an
+// appropriate format for insertion to the DB.
+func rowToRecord(ctx context.Context, destColKinds []kind.Kind, ingestMungeFns []kind.MungeFunc,
+	sheetName string, rowi int, cells []string,
+) []any {
+	log := lg.FromContext(ctx)
+
+	vals := make([]any, len(destColKinds))
+	for coli, str := range cells {
+		if coli >= len(vals) {
+			log.Warn(
+				"Skipping additional cells because there's more cells than expected",
+				laSheet, sheetName,
+				lga.Col, fmt.Sprintf("%d:%d", rowi, coli),
+				lga.Count, len(vals),
+				lga.Expected, len(destColKinds),
+			)
+			continue
+		}
+
+		if str == "" {
+			continue
+		}
+
+		if fn := ingestMungeFns[coli]; fn != nil {
+			v, err := fn(str)
+			if err != nil {
+				// This shouldn't happen, but if it does, fall back
+				// to the string value.
+				vals[coli] = str
+				log.Warn(
+					"Cell munge func failed",
+					laSheet, sheetName,
+					"cell", fmt.Sprintf("%d:%d", rowi, coli),
+					lga.Val, str,
+				)
+			} else {
+				vals[coli] = v
+			}
+			continue
+		}
+
+		vals[coli] = str
+	}
+	return vals
+}
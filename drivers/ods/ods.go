@@ -0,0 +1,112 @@
+// Package ods implements the sq driver for OpenDocument Spreadsheet (ODS)
+// documents, as produced by LibreOffice Calc and others. Like the xlsx
+// driver, each sheet of the document is a table.
+package ods
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+const (
+	// laSheet is a constant for the "sheet" log attribute.
+	laSheet = "sheet"
+)
+
+// Provider implements driver.Provider.
+type Provider struct {
+	Log      *slog.Logger
+	Files    *files.Files
+	Ingester driver.GripOpenIngester
+}
+
+// DriverFor implements driver.Provider.
+func (p *Provider) DriverFor(typ drivertype.Type) (driver.Driver, error) {
+	if typ != drivertype.ODS {
+		return nil, errz.Errorf("unsupported driver type {%s}", typ)
+	}
+
+	return &Driver{log: p.Log, ingester: p.Ingester, files: p.Files}, nil
+}
+
+// Driver implements driver.Driver.
+type Driver struct {
+	log      *slog.Logger
+	ingester driver.GripOpenIngester
+	files    *files.Files
+}
+
+// DriverMetadata implements driver.Driver.
+func (d *Driver) DriverMetadata() driver.Metadata {
+	return driver.Metadata{
+		Type:        drivertype.ODS,
+		Description: "OpenDocument Spreadsheet",
+		Doc:         "https://en.wikipedia.org/wiki/OpenDocument",
+	}
+}
+
+// Open implements driver.Driver.
+func (d *Driver) Open(ctx context.Context, src *source.Source, _ driver.AccessMode) (driver.Grip, error) {
+	log := lg.FromContext(ctx).With(lga.Src, src)
+	log.Debug(lgm.OpenSrc, lga.Src, src)
+
+	g := &grip{
+		log:   log,
+		src:   src,
+		files: d.files,
+	}
+
+	allowCache := driver.OptIngestCache.Get(options.FromContext(ctx))
+
+	ingestFn := func(ctx context.Context, destGrip driver.Grip) error {
+		log.Debug("Ingest ODS", lga.Src, g.src)
+		r, err := g.files.NewReader(ctx, g.src, false)
+		if err != nil {
+			return err
+		}
+		defer lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+
+		sheets, err := readSheets(ctx, r)
+		if err != nil {
+			return err
+		}
+
+		return ingestODS(ctx, g.src, destGrip, sheets)
+	}
+
+	var err error
+	if g.dbGrip, err = d.ingester.OpenIngest(ctx, g.src, allowCache, ingestFn); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// ValidateSource implements driver.Driver.
+func (d *Driver) ValidateSource(src *source.Source) (*source.Source, error) {
+	d.log.Debug("Validating source", lga.Src, src)
+	if src.Type != drivertype.ODS {
+		return nil, errz.Errorf("expected driver type {%s} but got {%s}", drivertype.ODS, src.Type)
+	}
+
+	return src, nil
+}
+
+// Ping implements driver.Driver.
+func (d *Driver) Ping(ctx context.Context, src *source.Source, _ driver.AccessMode) (err error) {
+	return d.files.Ping(ctx, src)
+}
+
+func errw(err error) error {
+	return errz.Wrap(err, "ods")
+}
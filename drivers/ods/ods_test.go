@@ -0,0 +1,164 @@
+package ods_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/tu"
+)
+
+func TestSmoke(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@ods_actor",
+		Type:     drivertype.ODS,
+		Location: "testdata/actor.ods",
+	})
+
+	sink, err := th.QuerySLQ(src.Handle+".actor", nil)
+	require.NoError(t, err)
+	require.Equal(t, sakila.TblActorCols(), sink.RecMeta.MungedNames())
+	require.Equal(t, sakila.TblActorCount, len(sink.Recs))
+	require.Equal(t, int64(1), stringz.Val(sink.Recs[0][0]))
+	require.Equal(t, "PENELOPE", stringz.Val(sink.Recs[0][1]))
+	require.Equal(t, "GUINESS", stringz.Val(sink.Recs[0][2]))
+	require.Equal(t, time.Date(2006, 2, 15, 4, 34, 33, 0, time.UTC), stringz.Val(sink.Recs[0][3]))
+}
+
+func TestHandleEmptySheet(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@ods_actor",
+		Type:     drivertype.ODS,
+		Location: "testdata/actor.ods",
+	})
+
+	srcMeta, err := th.SourceMetadata(src)
+	require.NoError(t, err)
+	require.Equal(t, []string{"actor"}, srcMeta.TableNames())
+
+	_, err = th.TableMetadata(src, "empty")
+	require.Error(t, err)
+	require.True(t, errz.Has[*driver.NotExistError](err))
+}
+
+func TestIngestHeader(t *testing.T) {
+	testCases := []struct {
+		file      string
+		header    *bool
+		wantCols  []string
+		wantCount int
+	}{
+		{
+			file:      "actor.ods",
+			wantCols:  sakila.TblActorCols(),
+			wantCount: sakila.TblActorCount,
+		},
+		{
+			file:      "actor_no_header.ods",
+			wantCols:  []string{"A", "B", "C", "D"},
+			wantCount: sakila.TblActorCount,
+		},
+		{
+			file:      "actor.ods",
+			header:    new(false),
+			wantCols:  []string{"A", "B", "C", "D"},
+			wantCount: sakila.TblActorCount + 1,
+		},
+		{
+			file:      "actor_no_header.ods",
+			header:    new(true),
+			wantCols:  []string{"1", "PENELOPE", "GUINESS", "2006-02-15T04:34:33Z"},
+			wantCount: sakila.TblActorCount - 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tu.Name(tc.file, tc.header), func(t *testing.T) {
+			t.Parallel()
+
+			src := &source.Source{
+				Handle:   "@ods_header",
+				Type:     drivertype.ODS,
+				Location: "testdata/" + tc.file,
+			}
+			if tc.header != nil {
+				src.Options = options.Options{driver.OptIngestHeader.Key(): *tc.header}
+			}
+
+			th := testh.New(t)
+			src = th.Add(src)
+
+			sink, err := th.QuerySLQ(src.Handle+".actor", nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantCols, sink.RecMeta.MungedNames())
+			require.Equal(t, tc.wantCount, len(sink.Recs))
+		})
+	}
+}
+
+func TestIngestTypes(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@ods_types",
+		Type:     drivertype.ODS,
+		Location: "testdata/types.ods",
+	})
+
+	tblMeta, err := th.TableMetadata(src, "types")
+	require.NoError(t, err)
+	wantKinds := []kind.Kind{
+		kind.Text, kind.Int, kind.Decimal, kind.Decimal, kind.Date,
+		kind.Datetime, kind.Time, kind.Bool, kind.Text,
+	}
+	gotKinds := make([]kind.Kind, len(tblMeta.Columns))
+	for i, col := range tblMeta.Columns {
+		gotKinds[i] = col.Kind
+	}
+	require.Equal(t, wantKinds, gotKinds)
+
+	sink, err := th.QuerySLQ(src.Handle+".types", nil)
+	require.NoError(t, err)
+	// The second data row is repeated via table:number-rows-repeated.
+	require.Len(t, sink.Recs, 4)
+
+	rec := sink.Recs[0]
+	require.Equal(t, "alpha", stringz.Val(rec[0]))
+	require.Equal(t, int64(1), stringz.Val(rec[1]))
+	require.True(t, decimal.RequireFromString("9.99").Equal(stringz.Val(rec[2]).(decimal.Decimal)))
+	require.True(t, decimal.RequireFromString("0.25").Equal(stringz.Val(rec[3]).(decimal.Decimal)))
+	require.Equal(t, time.Date(2006, 2, 15, 4, 34, 33, 0, time.UTC), stringz.Val(rec[5]))
+	require.Equal(t, true, stringz.Val(rec[7]))
+	// The cell's annotation is ignored, and its paragraphs are joined.
+	require.Equal(t, "two  spaces\nsecond line", stringz.Val(rec[8]))
+
+	require.Equal(t, sink.Recs[1], sink.Recs[2])
+	require.Equal(t, false, stringz.Val(sink.Recs[1][7]))
+	require.Nil(t, sink.Recs[1][8])
+
+	// Empty cells, including repeated empty cells, are NULL.
+	rec = sink.Recs[3]
+	require.Equal(t, "gamma", stringz.Val(rec[0]))
+	for i := 1; i < 8; i++ {
+		require.Nil(t, rec[i], "col %d", i)
+	}
+	require.Equal(t, "last", stringz.Val(rec[8]))
+}
@@ -164,6 +164,8 @@ func (d *driveri) Renderer() *render.Renderer {
 	r := render.NewDefaultRenderer()
 	r.Range = renderRowRange
 	r.PreRender = append(r.PreRender, preRenderOracle)
+	r.SetOp = renderSetOp
+	r.Subquery = renderSubquery
 
 	const oracleSchemaFrag = `SYS_CONTEXT('USERENV', 'CURRENT_SCHEMA')`
 	// Oracle's catalog-equivalent is the database name (DB_NAME). In a 12c+
@@ -200,6 +202,11 @@ func (d *driveri) Renderer() *render.Renderer {
 	r.FunctionResultKinds[ast.FuncNameCount] = kind.Int
 	r.FunctionResultKinds[ast.FuncNameCountUnique] = kind.Int
 	r.FunctionResultKinds[ast.FuncNameRowNum] = kind.Int
+	r.DBTypeName = dbTypeNameFromKind
+	r.CreateTable = buildCreateTableStmt
+	r.ValueLiteral = renderValueLiteral
+	render.RegisterDatetimeFuncsOracle(r)
+	render.RegisterStringFuncsOracle(r)
 	return r
 }
 
@@ -2,14 +2,17 @@ package oracle
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"log/slog"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/ast/render"
+	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/kind"
 	"github.com/neilotoole/sq/libsq/core/schema"
 	"github.com/neilotoole/sq/libsq/core/sqlz"
@@ -311,3 +314,55 @@ func (d *driveri) CreateTable(ctx context.Context, db sqlz.DB, tblDef *schema.Ta
 	_, err := db.ExecContext(ctx, stmt)
 	return errw(err)
 }
+
+// renderSetOp renders a set operation keyword. Oracle only accepts EXCEPT
+// from 21c onwards, so except() is rendered as the equivalent MINUS, which
+// all versions support.
+func renderSetOp(rc *render.Context, op *ast.SetOpNode) (string, error) {
+	if op.Op() == ast.SetOpExcept {
+		return "MINUS", nil
+	}
+	return render.RenderSetOpDefault(rc, op)
+}
+
+// renderSubquery renders a subquery. As with a table reference (see
+// stripOracleTableAliasAS), Oracle rejects the AS keyword between a derived
+// table and its alias, so the alias directly follows the parenthesized query.
+func renderSubquery(rc *render.Context, sub *ast.SubqueryNode) (string, error) {
+	if !sub.IsDerivedTable() || sub.Alias() == "" {
+		return render.RenderSubqueryDefault(rc, sub)
+	}
+
+	if rc.SubquerySQL == nil {
+		return "", errz.Errorf("subquery not supported in this context: %s", sub.Text())
+	}
+
+	s, err := rc.SubquerySQL(sub)
+	if err != nil {
+		return "", err
+	}
+	return "(" + s + ") " + rc.Dialect.Enquote(sub.Alias()), nil
+}
+
+// renderValueLiteral implements render.Renderer.ValueLiteral. Oracle doesn't
+// implicitly convert strings to DATE or TIMESTAMP independent of the session's
+// NLS settings, so time values are rendered as datetime literals, e.g.
+// DATE '2021-01-02'. A kind.Time value is rendered as a TIMESTAMP on
+// 1970-01-01, as kind.Time is stored as TIMESTAMP. A []byte value is rendered
+// via HEXTORAW.
+func renderValueLiteral(rc *render.Context, knd kind.Kind, val any) (string, error) {
+	switch val := val.(type) {
+	case []byte:
+		return "HEXTORAW('" + strings.ToUpper(hex.EncodeToString(val)) + "')", nil
+	case time.Time:
+		switch knd { //nolint:exhaustive
+		case kind.Date:
+			return "DATE '" + render.FormatTimeValue(knd, val) + "'", nil
+		case kind.Time:
+			return "TIMESTAMP '1970-01-01 " + render.FormatTimeValue(knd, val) + "'", nil
+		default:
+			return "TIMESTAMP '" + render.FormatTimeValue(knd, val) + "'", nil
+		}
+	}
+	return render.RenderValueLiteralDefault(rc, knd, val)
+}
@@ -0,0 +1,52 @@
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+// magic is the "magic number" that starts (and ends) a Parquet file.
+var magic = []byte("PAR1")
+
+var _ files.TypeDetectFunc = DetectParquet
+
+// DetectParquet implements files.TypeDetectFunc, returning
+// drivertype.Parquet and a score of 1.0 if the data starts with
+// the Parquet magic number "PAR1".
+//
+// Only the start of the data is checked: the magic number also ends
+// a Parquet file, but checking that would require reading the data
+// in its entirety.
+func DetectParquet(ctx context.Context, newRdrFn files.NewReaderFunc) (detected drivertype.Type, score float32,
+	err error,
+) {
+	log := lg.FromContext(ctx)
+	var r io.ReadCloser
+	r, err = newRdrFn(ctx)
+	if err != nil {
+		return drivertype.None, 0, errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+
+	buf := make([]byte, len(magic))
+	if _, err = io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return drivertype.None, 0, nil
+		}
+		return drivertype.None, 0, errz.Err(err)
+	}
+
+	if bytes.Equal(buf, magic) {
+		return drivertype.Parquet, 1.0, nil
+	}
+
+	return drivertype.None, 0, nil
+}
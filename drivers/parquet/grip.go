@@ -0,0 +1,87 @@
+package parquet
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/libsq/source/location"
+	"github.com/neilotoole/sq/libsq/source/metadata"
+)
+
+// grip implements driver.Grip. It implements a deferred ingest
+// of the Parquet data.
+type grip struct {
+	log    *slog.Logger
+	src    *source.Source
+	files  *files.Files
+	dbGrip driver.Grip
+}
+
+// DB implements driver.Grip.
+func (g *grip) DB(ctx context.Context) (*sql.DB, error) {
+	return g.dbGrip.DB(ctx)
+}
+
+// SQLDriver implements driver.Grip.
+func (g *grip) SQLDriver() driver.SQLDriver {
+	return g.dbGrip.SQLDriver()
+}
+
+// Source implements driver.Grip.
+func (g *grip) Source() *source.Source {
+	return g.src
+}
+
+// SourceMetadata implements driver.Grip.
+func (g *grip) SourceMetadata(ctx context.Context, noSchema bool) (*metadata.Source, error) {
+	md, err := g.dbGrip.SourceMetadata(ctx, noSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	md.Handle = g.src.Handle
+	md.Driver = drivertype.Parquet
+	md.Location = g.src.Location
+	if md.Name, err = location.Filename(g.src.Location); err != nil {
+		return nil, err
+	}
+	md.FQName = md.Name
+
+	var size int64
+	if size, err = g.files.Filesize(ctx, g.src); err != nil {
+		return nil, err
+	}
+	md.Size = &size
+
+	return md, nil
+}
+
+// DBSemver implements driver.Grip.
+func (g *grip) DBSemver(ctx context.Context) (string, error) {
+	return g.dbGrip.DBSemver(ctx)
+}
+
+// TableMetadata implements driver.Grip.
+func (g *grip) TableMetadata(ctx context.Context, tblName string) (*metadata.Table, error) {
+	if tblName != source.MonotableName {
+		return nil, errz.Errorf("table name should be %s for Parquet, but got: %s",
+			source.MonotableName, tblName)
+	}
+
+	return g.dbGrip.TableMetadata(ctx, tblName)
+}
+
+// Close implements driver.Grip.
+func (g *grip) Close() error {
+	g.log.Debug(lgm.CloseDB, lga.Handle, g.src.Handle)
+
+	return g.dbGrip.Close()
+}
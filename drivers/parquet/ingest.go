@@ -0,0 +1,83 @@
+package parquet
+
+import (
+	"context"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// readBatchSize is the number of rows read from the Parquet file at a time.
+const readBatchSize = 1000
+
+// ingestParquet loads the src Parquet data into destGrip.
+func ingestParquet(ctx context.Context, fs *files.Files, src *source.Source, destGrip driver.Grip) error {
+	log := lg.FromContext(ctx)
+
+	// The Parquet metadata is at the end of the file, and the column
+	// chunks are read at arbitrary offsets, so the reader requires random
+	// access. The data is copied to a temp file, because the source
+	// could be a remote file, or stdin.
+	fpath, err := copyToTemp(ctx, fs, src)
+	if err != nil {
+		return err
+	}
+
+	pf, err := file.OpenParquetFile(fpath, false)
+	if err != nil {
+		return errz.Wrap(err, "parquet: invalid Parquet data")
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, pf)
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: readBatchSize},
+		memory.DefaultAllocator)
+	if err != nil {
+		return errz.Wrap(err, "parquet: invalid Parquet data")
+	}
+
+	rr, err := fr.GetRecordReader(ctx, nil, nil)
+	if err != nil {
+		return errz.Wrap(err, "parquet: read rows")
+	}
+	defer rr.Release()
+
+	return arrowz.Ingest(ctx, destGrip, rr)
+}
+
+// copyToTemp copies the src data to a temp file, returning the file path.
+// The file is deleted when fs is closed.
+func copyToTemp(ctx context.Context, fs *files.Files, src *source.Source) (fpath string, err error) {
+	log := lg.FromContext(ctx)
+
+	rc, err := fs.NewReader(ctx, src, true)
+	if err != nil {
+		return "", err
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, rc)
+
+	f, err := fs.CreateTemp("*.parquet", true)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = io.Copy(f, rc); err != nil {
+		lg.WarnIfCloseError(log, lgm.CloseFileWriter, f)
+		return "", errz.Wrap(err, "parquet: copy source data")
+	}
+
+	if err = f.Close(); err != nil {
+		return "", errz.Err(err)
+	}
+
+	return f.Name(), nil
+}
@@ -0,0 +1,94 @@
+// Package parquet implements the sq driver for Apache Parquet.
+// It uses the https://github.com/apache/arrow-go library: the Parquet
+// data is read as Arrow record batches via pqarrow.
+// See: https://parquet.apache.org/docs/file-format/
+package parquet
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+// Provider implements driver.Provider.
+type Provider struct {
+	Log      *slog.Logger
+	Files    *files.Files
+	Ingester driver.GripOpenIngester
+}
+
+// DriverFor implements driver.Provider.
+func (p *Provider) DriverFor(typ drivertype.Type) (driver.Driver, error) {
+	if typ != drivertype.Parquet {
+		return nil, errz.Errorf("unsupported driver type {%s}", typ)
+	}
+
+	return &Driver{log: p.Log, ingester: p.Ingester, files: p.Files}, nil
+}
+
+// Driver implements driver.Driver.
+type Driver struct {
+	log      *slog.Logger
+	ingester driver.GripOpenIngester
+	files    *files.Files
+}
+
+// DriverMetadata implements driver.Driver.
+func (d *Driver) DriverMetadata() driver.Metadata {
+	return driver.Metadata{
+		Type:        drivertype.Parquet,
+		Description: "Apache Parquet",
+		Doc:         "https://parquet.apache.org",
+		Monotable:   true,
+	}
+}
+
+// Open implements driver.Driver.
+func (d *Driver) Open(ctx context.Context, src *source.Source, _ driver.AccessMode) (driver.Grip, error) {
+	log := lg.FromContext(ctx).With(lga.Src, src)
+	log.Debug(lgm.OpenSrc, lga.Src, src)
+
+	g := &grip{
+		log:   log,
+		src:   src,
+		files: d.files,
+	}
+
+	allowCache := driver.OptIngestCache.Get(options.FromContext(ctx))
+
+	ingestFn := func(ctx context.Context, destGrip driver.Grip) error {
+		log.Debug("Ingest Parquet", lga.Src, src)
+		return ingestParquet(ctx, d.files, src, destGrip)
+	}
+
+	var err error
+	if g.dbGrip, err = d.ingester.OpenIngest(ctx, src, allowCache, ingestFn); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// ValidateSource implements driver.Driver.
+func (d *Driver) ValidateSource(src *source.Source) (*source.Source, error) {
+	d.log.Debug("Validating source", lga.Src, src)
+	if src.Type != drivertype.Parquet {
+		return nil, errz.Errorf("expected driver type {%s} but got {%s}", drivertype.Parquet, src.Type)
+	}
+
+	return src, nil
+}
+
+// Ping implements driver.Driver.
+func (d *Driver) Ping(ctx context.Context, src *source.Source, _ driver.AccessMode) error {
+	return d.files.Ping(ctx, src)
+}
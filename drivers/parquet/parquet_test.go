@@ -0,0 +1,111 @@
+package parquet_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/drivers/parquet"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgt"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/tu"
+)
+
+func TestDetectParquet(t *testing.T) {
+	testCases := []struct {
+		fpath string
+		want  drivertype.Type
+	}{
+		{fpath: filepath.Join("testdata", "actor.parquet"), want: drivertype.Parquet},
+		{fpath: filepath.Join("testdata", "types.parquet"), want: drivertype.Parquet},
+		{fpath: filepath.Join("..", "csv", "testdata", "person.csv")},
+		{fpath: filepath.Join("..", "json", "testdata", "empty.file")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tu.Name(tc.fpath), func(t *testing.T) {
+			newRdrFn := func(_ context.Context) (io.ReadCloser, error) { return os.Open(tc.fpath) }
+			ctx := lg.NewContext(context.Background(), lgt.New(t))
+
+			gotType, gotScore, gotErr := parquet.DetectParquet(ctx, newRdrFn)
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.want, gotType)
+			if tc.want == drivertype.None {
+				require.Equal(t, float32(0), gotScore)
+			} else {
+				require.Equal(t, float32(1.0), gotScore)
+			}
+		})
+	}
+}
+
+func TestSmoke(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@parquet_actor",
+		Type:     drivertype.Parquet,
+		Location: filepath.Join("testdata", "actor.parquet"),
+	})
+
+	sink, err := th.QuerySLQ(src.Handle+".data", nil)
+	require.NoError(t, err)
+	require.Equal(t, sakila.TblActorCols(), sink.RecMeta.MungedNames())
+	require.Equal(t, sakila.TblActorCount, len(sink.Recs))
+	require.Equal(t, "PENELOPE", sink.Recs[0][1])
+}
+
+func TestIngest_Kinds(t *testing.T) {
+	t.Parallel()
+	tu.SkipIssueWindows(t, tu.GH355SQLiteDecimalWin)
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@parquet_types",
+		Type:     drivertype.Parquet,
+		Location: filepath.Join("testdata", "types.parquet"),
+	})
+
+	sink, err := th.QuerySLQ(src.Handle+".data", nil)
+	require.NoError(t, err)
+
+	wantKinds := []kind.Kind{
+		kind.Int, kind.Float, kind.Bool, kind.Bytes, kind.Decimal,
+		kind.Datetime, kind.Date, kind.Time, kind.Text,
+	}
+	require.Equal(t, wantKinds, sink.RecMeta.Kinds())
+	require.Len(t, sink.Recs, 3)
+
+	rec := sink.Recs[0]
+	require.Equal(t, int64(1), rec[0])
+	require.Equal(t, 1.5, rec[1])
+	require.Equal(t, true, rec[2])
+	require.Equal(t, []byte("hello"), rec[3])
+	require.True(t, decimal.RequireFromString("12.345").Equal(rec[4].(decimal.Decimal)))
+	require.Equal(t, time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC), rec[5].(time.Time).UTC())
+	require.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), rec[6].(time.Time).UTC())
+	require.Equal(t, "huzzah", rec[8])
+
+	rec = sink.Recs[1]
+	require.Equal(t, int64(-2), rec[0])
+	require.True(t, decimal.RequireFromString("-7.5").Equal(rec[4].(decimal.Decimal)))
+	require.Equal(t, time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC), rec[5].(time.Time).UTC())
+	require.Equal(t, "ünïcödé", rec[8])
+
+	// The last row is all NULL.
+	for i, val := range sink.Recs[2] {
+		require.Nil(t, val, sink.RecMeta[i].Name())
+	}
+}
@@ -153,6 +153,9 @@ func (d *driveri) Renderer() *render.Renderer {
 	// constraining precision or scale.
 	r.FunctionOverrides[ast.FuncNameSum] = render.FuncOverrideCastResult("NUMERIC")
 	render.RegisterILikeFamily(r)
+	r.DBTypeName = dbTypeNameFromKind
+	r.CreateTable = buildCreateTableStmt
+	r.ValueLiteral = renderValueLiteral
 	return r
 }
 
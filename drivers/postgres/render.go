@@ -1,10 +1,12 @@
 package postgres
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/neilotoole/sq/libsq/ast/render"
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/kind"
 	"github.com/neilotoole/sq/libsq/core/schema"
@@ -73,7 +75,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 	sb.WriteString(" (")
 
 	for i, colDef := range tblDef.Cols {
-		sb.WriteString("\n")
+		sb.WriteString("\n  ")
 		sb.WriteString(idSanitize(colDef.Name))
 		sb.WriteString(" ")
 		sb.WriteString(dbTypeNameFromKind(colDef.Kind))
@@ -152,3 +154,12 @@ func replacePlaceholders(input string) string {
 
 	return sb.String()
 }
+
+// renderValueLiteral implements render.Renderer.ValueLiteral. It renders
+// []byte values as a bytea hex literal, e.g. '\xcafe'::bytea.
+func renderValueLiteral(rc *render.Context, knd kind.Kind, val any) (string, error) {
+	if b, ok := val.([]byte); ok {
+		return `'\x` + hex.EncodeToString(b) + `'::bytea`, nil
+	}
+	return render.RenderValueLiteralDefault(rc, knd, val)
+}
@@ -75,7 +75,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 		}
 
 		if buf.Len() > 0 {
-			buf.WriteString(",\n")
+			buf.WriteString(",\n  ")
 		}
 
 		fkName := tblDef.Name + "_" + col.Name + "_" +
@@ -109,13 +109,15 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 	buf.WriteString(" (\n")
 
 	for x := 0; x < len(cols)-1; x++ {
+		buf.WriteString("  ")
 		buf.WriteString(cols[x])
 		buf.WriteString(",\n")
 	}
+	buf.WriteString("  ")
 	buf.WriteString(cols[len(cols)-1])
 
 	if fk != "" {
-		buf.WriteString(",\n")
+		buf.WriteString(",\n  ")
 		buf.WriteString(fk)
 	}
 	buf.WriteString("\n)")
@@ -6,6 +6,8 @@ import (
 
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/ast/render"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
 	"github.com/neilotoole/sq/libsq/core/stringz"
 )
 
@@ -69,3 +71,37 @@ func renderFuncIEndsWithLike(rc *render.Context, fn *ast.FuncNode) (string, erro
 func renderFuncLike(rc *render.Context, fn *ast.FuncNode) (string, error) {
 	return render.RenderLikeRaw(rc, fn, render.LikeRawOpts{})
 }
+
+// renderFuncCast renders SLQ's cast() function. As with the sqlite3 driver,
+// the date/time kinds are rendered via SQLite's date and time functions,
+// and kind.Bool as a comparison with zero, because SQLite's CAST to those
+// types applies NUMERIC affinity, which mangles the value. The other kinds
+// use the standard CAST expression.
+func renderFuncCast(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	switch fn.CastKind() { //nolint:exhaustive
+	case kind.Datetime, kind.Date, kind.Time, kind.Bool:
+	default:
+		return render.RenderCastDefault(rc, fn)
+	}
+
+	args := fn.Args()
+	if len(args) != 1 {
+		return "", errz.Errorf("%s() requires 2 arguments (expr, kind), got %d", fn.FuncName(), len(args)+1)
+	}
+
+	arg, err := render.RenderFuncArg(rc, args[0])
+	if err != nil {
+		return "", err
+	}
+
+	switch fn.CastKind() { //nolint:exhaustive
+	case kind.Datetime:
+		return "datetime(" + arg + ")", nil
+	case kind.Date:
+		return "date(" + arg + ")", nil
+	case kind.Time:
+		return "time(" + arg + ")", nil
+	default: // kind.Bool
+		return "(" + arg + " <> 0)", nil
+	}
+}
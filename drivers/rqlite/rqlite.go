@@ -396,6 +396,12 @@ func (d *driveri) Renderer() *render.Renderer {
 	// The SQLite float-computation caveat for non-integer columns applies.
 	r.FunctionResultKinds[ast.FuncNameSum] = kind.Decimal
 
+	r.DBTypeName = DBTypeForKind
+	r.CreateTable = buildCreateTableStmt
+	r.FunctionOverrides[ast.FuncNameCast] = renderFuncCast
+	render.RegisterDatetimeFuncsSQLite(r)
+	render.RegisterStringFuncsSQLite(r)
+
 	return r
 }
 
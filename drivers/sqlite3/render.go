@@ -74,7 +74,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 		}
 
 		if buf.Len() > 0 {
-			buf.WriteString(",\n")
+			buf.WriteString(",\n  ")
 		}
 
 		fkName := tblDef.Name + "_" + col.Name + "_" +
@@ -108,13 +108,15 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 	buf.WriteString(" (\n")
 
 	for x := 0; x < len(cols)-1; x++ {
+		buf.WriteString("  ")
 		buf.WriteString(cols[x])
 		buf.WriteString(",\n")
 	}
+	buf.WriteString("  ")
 	buf.WriteString(cols[len(cols)-1])
 
 	if fk != "" {
-		buf.WriteString(",\n")
+		buf.WriteString(",\n  ")
 		buf.WriteString(fk)
 	}
 	buf.WriteString("\n)")
@@ -204,3 +206,38 @@ func renderFuncIEndsWithLike(rc *render.Context, fn *ast.FuncNode) (string, erro
 func renderFuncLike(rc *render.Context, fn *ast.FuncNode) (string, error) {
 	return render.RenderLikeRaw(rc, fn, render.LikeRawOpts{})
 }
+
+// renderFuncCast renders SLQ's cast() function. SQLite has no date, time or
+// boolean storage class: CAST(x AS DATETIME) applies NUMERIC affinity, which
+// turns "2005-05-25 11:30:37" into 2005, and CAST(2.99 AS BOOLEAN) yields
+// 2.99. Thus the date/time kinds are rendered via SQLite's date and time
+// functions, which yield the canonical text forms, and kind.Bool is rendered
+// as a comparison with zero. The other kinds use the standard CAST expression.
+func renderFuncCast(rc *render.Context, fn *ast.FuncNode) (string, error) {
+	switch fn.CastKind() { //nolint:exhaustive
+	case kind.Datetime, kind.Date, kind.Time, kind.Bool:
+	default:
+		return render.RenderCastDefault(rc, fn)
+	}
+
+	args := fn.Args()
+	if len(args) != 1 {
+		return "", errz.Errorf("%s() requires 2 arguments (expr, kind), got %d", fn.FuncName(), len(args)+1)
+	}
+
+	arg, err := render.RenderFuncArg(rc, args[0])
+	if err != nil {
+		return "", err
+	}
+
+	switch fn.CastKind() { //nolint:exhaustive
+	case kind.Datetime:
+		return "datetime(" + arg + ")", nil
+	case kind.Date:
+		return "date(" + arg + ")", nil
+	case kind.Time:
+		return "time(" + arg + ")", nil
+	default: // kind.Bool
+		return "(" + arg + " <> 0)", nil
+	}
+}
@@ -300,6 +300,12 @@ func (d *driveri) Renderer() *render.Renderer {
 	// is not corrected.
 	r.FunctionResultKinds[ast.FuncNameSum] = kind.Decimal
 
+	r.DBTypeName = DBTypeForKind
+	r.CreateTable = buildCreateTableStmt
+	r.FunctionOverrides[ast.FuncNameCast] = renderFuncCast
+	render.RegisterDatetimeFuncsSQLite(r)
+	render.RegisterStringFuncsSQLite(r)
+
 	return r
 }
 
@@ -1,9 +1,11 @@
 package sqlserver
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/ast/render"
@@ -60,6 +62,10 @@ func preRender(_ *render.Context, f *render.Fragments) error {
 	return nil
 }
 
+// dbTypeNameFromKind returns the column type used by CREATE TABLE, and by
+// the cast() function. Note that kind.Decimal has an explicit precision and
+// scale: a bare DECIMAL in SQL Server is DECIMAL(18, 0), which would discard
+// the fractional part of values.
 func dbTypeNameFromKind(knd kind.Kind) string {
 	switch knd { //nolint:exhaustive // ignore kind.Null
 	default:
@@ -73,7 +79,7 @@ func dbTypeNameFromKind(knd kind.Kind) string {
 	case kind.Float:
 		return "FLOAT"
 	case kind.Decimal:
-		return "DECIMAL"
+		return fmt.Sprintf("DECIMAL(%d, %d)", render.AggDecimalPrecision, render.AggDecimalScale)
 	case kind.Bool:
 		return "BIT"
 	case kind.Datetime:
@@ -114,7 +120,7 @@ func buildCreateTableStmt(tblDef *schema.Table) string {
 	sb.WriteString(" (")
 
 	for i, colDef := range tblDef.Cols {
-		sb.WriteRune('\n')
+		sb.WriteString("\n  ")
 		sb.WriteString(stringz.DoubleQuote(colDef.Name))
 		sb.WriteRune(' ')
 		sb.WriteString(dbTypeNameFromKind(colDef.Kind))
@@ -295,3 +301,27 @@ func renderFuncILikeCollate(rc *render.Context, fn *ast.FuncNode) (string, error
 		ColCollate: mssqlCICollate,
 	})
 }
+
+// renderValueLiteral implements render.Renderer.ValueLiteral. Strings are
+// rendered as Unicode literals, e.g. N'abc'; bool values as 1 or 0, because
+// SQL Server has no TRUE or FALSE; []byte values as a binary constant, e.g.
+// 0xCAFE; and datetime values in ISO 8601 form with millisecond precision,
+// which is what the DATETIME type accepts.
+func renderValueLiteral(rc *render.Context, knd kind.Kind, val any) (string, error) {
+	switch val := val.(type) {
+	case string:
+		return "N" + stringz.SingleQuote(val), nil
+	case bool:
+		if val {
+			return "1", nil
+		}
+		return "0", nil
+	case []byte:
+		return "0x" + strings.ToUpper(hex.EncodeToString(val)), nil
+	case time.Time:
+		if knd != kind.Date && knd != kind.Time {
+			return stringz.SingleQuote(val.UTC().Format("2006-01-02T15:04:05.999")), nil
+		}
+	}
+	return render.RenderValueLiteralDefault(rc, knd, val)
+}
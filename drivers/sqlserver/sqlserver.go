@@ -190,6 +190,11 @@ func (d *driveri) Renderer() *render.Renderer {
 	r.FunctionOverrides[ast.FuncNameIEndsWith] = renderFuncIEndsWithCollate
 	r.FunctionOverrides[ast.FuncNameLike] = renderFuncLikeCollate
 	r.FunctionOverrides[ast.FuncNameILike] = renderFuncILikeCollate
+	r.DBTypeName = dbTypeNameFromKind
+	r.CreateTable = buildCreateTableStmt
+	r.ValueLiteral = renderValueLiteral
+	render.RegisterDatetimeFuncsSQLServer(r)
+	render.RegisterStringFuncsSQLServer(r)
 
 	defaultLiteralFn := r.Literal
 	r.Literal = func(rc *render.Context, lit *ast.LiteralNode) (string, error) {
@@ -0,0 +1,490 @@
+// Package jsonud provides user driver JSON import functionality. It
+// imports a JSON document, such as the payload of a vendor API, into
+// the tables declared by a user driver definition of genre "json".
+//
+// Selectors use a subset of JSONPath. The driver's selector, and each
+// table's selector, is an absolute path that begins with "$", such as
+// "$.data.orders[*]". Each node selected by a table's selector is a row of
+// that table. Members are selected via dot notation (".name") or bracket
+// notation ("['name']"), and array elements via "[N]" or the wildcard
+// "[*]". A table whose selector extends another table's selector, such as
+// "$.data.orders[*].lines[*]", is nested in that table: each of its rows is
+// a child of a row of the parent table, so that a column can refer to the
+// parent row via "foreign: ../col_name".
+//
+// A column's selector is relative to the row's node, e.g. "customer.name"
+// or "@.customer.name"; if the selector is empty, the column's name is used
+// as the member name. The selector "@" selects the row node itself, which
+// is useful for an array of scalars. A column whose selector is
+// "../sequence()" is assigned a sequence value, as for XML user drivers. A
+// column of kind text whose selected value is a JSON object or array
+// receives that value's JSON text.
+//
+//	user_drivers:
+//	  - driver: acme
+//	    genre: json
+//	    title: Acme Orders API
+//	    selector: $
+//	    tables:
+//	      - table: orders
+//	        selector: $.data.orders[*]
+//	        primary_key: [order_id]
+//	        cols:
+//	          - col: order_id
+//	            selector: id
+//	            kind: int
+//	          - col: customer
+//	            selector: customer.name
+//	            kind: text
+//	      - table: lines
+//	        selector: $.data.orders[*].lines[*]
+//	        primary_key: [line_id]
+//	        cols:
+//	          - col: line_id
+//	            selector: ../sequence()
+//	            kind: int
+//	          - col: order_id
+//	            foreign: ../order_id
+//	            kind: int
+//	          - col: sku
+//	            kind: text
+package jsonud
+
+import (
+	"bytes"
+	"context"
+	stdj "encoding/json"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/neilotoole/sq/drivers/userdriver"
+	"github.com/neilotoole/sq/libsq/core/cleanup"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/sqlz"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// Genre is the user driver genre that this package supports.
+const Genre = "json"
+
+// Ingest implements userdriver.IngestFunc.
+func Ingest(ctx context.Context, def *userdriver.DriverDef, data io.Reader, destGrip driver.Grip) error {
+	if def.Genre != Genre {
+		return errz.Errorf("jsonud.Ingest does not support genre {%s}", def.Genre)
+	}
+
+	rootPath, tables, err := buildTables(def)
+	if err != nil {
+		return errz.Wrap(err, "json ingest")
+	}
+
+	dec := stdj.NewDecoder(data)
+	dec.UseNumber()
+	var doc any
+	if err = dec.Decode(&doc); err != nil {
+		return errz.Wrap(err, "json ingest")
+	}
+
+	db, err := destGrip.DB(ctx)
+	if err != nil {
+		return err
+	}
+
+	ing := &ingester{
+		log:      lg.FromContext(ctx),
+		destGrip: destGrip,
+		destDB:   db,
+		clnup:    cleanup.New(),
+	}
+
+	if err = ing.execIngest(ctx, rootPath.eval(doc), tables); err != nil {
+		lg.WarnIfFuncError(ing.log, "json ingest: cleanup", ing.clnup.Run)
+		return errz.Wrap(err, "json ingest")
+	}
+
+	return errz.Wrap(ing.clnup.Run(), "json ingest: cleanup")
+}
+
+// tableNode is a table of the driver definition, with its parsed
+// selectors. The tableNode instances form a tree: a table whose selector
+// extends another table's selector is a child of that table.
+type tableNode struct {
+	tbl *userdriver.TableMapping
+
+	// parent is the parent table, or nil for a top-level table.
+	parent *tableNode
+
+	// children are the nested tables.
+	children []*tableNode
+
+	// path is the table's absolute selector path.
+	path path
+
+	// relPath is the table's selector path, relative to the parent
+	// table's path, or to the driver's root path for a top-level table.
+	relPath path
+
+	// cols are the table's columns, in the order of tbl.Cols.
+	cols []*colNode
+
+	// insertFn inserts a row into the table. It's set by createTables.
+	insertFn func(ctx context.Context, vals []any) error
+
+	// seq is the table's sequence value: the number of rows inserted.
+	seq int64
+}
+
+// colNode is a column of a table, with its parsed selector.
+type colNode struct {
+	col *userdriver.ColMapping
+
+	// path is the column's selector path, relative to the row node. It's
+	// nil for a sequence or foreign column.
+	path path
+
+	// foreign is the name of the parent table's column whose value is
+	// the value of this column, or empty.
+	foreign string
+
+	// sequence is true if the column is assigned the table's sequence
+	// value.
+	sequence bool
+}
+
+// buildTables parses the selectors of def, returning the driver's root
+// path, and the top-level tables, whose descendants are available via
+// tableNode.children.
+func buildTables(def *userdriver.DriverDef) (rootPath path, topLevel []*tableNode, err error) {
+	if rootPath, err = parseAbsPath(def.Selector); err != nil {
+		return nil, nil, err
+	}
+
+	nodes := make([]*tableNode, len(def.Tables))
+	for i, tbl := range def.Tables {
+		tn := &tableNode{tbl: tbl}
+		if tn.path, err = parseAbsPath(tbl.Selector); err != nil {
+			return nil, nil, errz.Wrapf(err, "table {%s}", tbl.Name)
+		}
+		if !tn.path.hasPrefix(rootPath) || len(tn.path) == len(rootPath) {
+			return nil, nil, errz.Errorf("table {%s}: selector {%s} must be beneath driver selector {%s}",
+				tbl.Name, tbl.Selector, def.Selector)
+		}
+		nodes[i] = tn
+	}
+
+	// The parent of a table is the table with the longest selector
+	// path that's a prefix of the table's path.
+	for i, tn := range nodes {
+		for j, other := range nodes {
+			if i == j || !tn.path.hasPrefix(other.path) {
+				continue
+			}
+			if len(other.path) == len(tn.path) {
+				return nil, nil, errz.Errorf("tables {%s} and {%s} have the same selector",
+					other.tbl.Name, tn.tbl.Name)
+			}
+			if tn.parent == nil || len(other.path) > len(tn.parent.path) {
+				tn.parent = other
+			}
+		}
+
+		if tn.parent == nil {
+			tn.relPath = tn.path[len(rootPath):]
+			topLevel = append(topLevel, tn)
+		} else {
+			tn.relPath = tn.path[len(tn.parent.path):]
+			tn.parent.children = append(tn.parent.children, tn)
+		}
+	}
+
+	for _, tn := range nodes {
+		if tn.cols, err = buildCols(tn); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return rootPath, topLevel, nil
+}
+
+// buildCols parses the selectors of the columns of tn.
+func buildCols(tn *tableNode) ([]*colNode, error) {
+	cols := make([]*colNode, len(tn.tbl.Cols))
+	for i, col := range tn.tbl.Cols {
+		cn := &colNode{col: col}
+		cols[i] = cn
+
+		switch {
+		case col.Foreign != "":
+			// The "foreign" field should be of form "../col_name".
+			name, ok := strings.CutPrefix(col.Foreign, "../")
+			if !ok || name == "" || strings.Contains(name, "/") {
+				return nil, errz.Errorf(`%s.%s: "foreign" field should be of form "../col_name" but was {%s}`,
+					tn.tbl.Name, col.Name, col.Foreign)
+			}
+			if tn.parent == nil {
+				return nil, errz.Errorf("%s.%s: table has no parent table for foreign key {%s}",
+					tn.tbl.Name, col.Name, col.Foreign)
+			}
+			if !slices.Contains(userdriver.NamesFromCols(tn.parent.tbl.Cols), name) {
+				return nil, errz.Errorf("%s.%s: parent table {%s} has no column {%s}",
+					tn.tbl.Name, col.Name, tn.parent.tbl.Name, name)
+			}
+			cn.foreign = name
+		case col.Selector == "../sequence()":
+			cn.sequence = true
+		case col.Selector == "":
+			cn.path = path{{key: col.Name}}
+		default:
+			p, err := parseRelPath(col.Selector)
+			if err != nil {
+				return nil, errz.Wrapf(err, "%s.%s", tn.tbl.Name, col.Name)
+			}
+			if p.hasWildcard() {
+				return nil, errz.Errorf("%s.%s: selector {%s} must not contain a wildcard",
+					tn.tbl.Name, col.Name, col.Selector)
+			}
+			cn.path = p
+		}
+	}
+
+	return cols, nil
+}
+
+// ingester does the work of importing data from JSON.
+type ingester struct {
+	log      *slog.Logger
+	destGrip driver.Grip
+	destDB   sqlz.DB
+
+	// clnup holds cleanup funcs that should be run when the ingester
+	// finishes.
+	clnup *cleanup.Cleanup
+}
+
+func (in *ingester) execIngest(ctx context.Context, roots []any, tables []*tableNode) error {
+	if err := in.createTables(ctx, tables); err != nil {
+		return err
+	}
+
+	for _, root := range roots {
+		for _, tn := range tables {
+			if err := in.ingestRows(ctx, tn, root, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ingestRows inserts a row into tn for each node selected by tn.relPath
+// from node, followed by the rows of tn's children beneath each such
+// node. The arg parentVals holds the values of the parent row, keyed by
+// column name, or is nil for a top-level table.
+func (in *ingester) ingestRows(ctx context.Context, tn *tableNode, node any, parentVals map[string]any) error {
+	for _, rowNode := range tn.relPath.eval(node) {
+		if err := ctx.Err(); err != nil {
+			return errz.Err(err)
+		}
+
+		tn.seq++
+		vals := make([]any, len(tn.cols))
+		rowVals := make(map[string]any, len(tn.cols))
+		for i, cn := range tn.cols {
+			var err error
+			switch {
+			case cn.sequence:
+				vals[i] = tn.seq
+			case cn.foreign != "":
+				vals[i] = parentVals[cn.foreign]
+			default:
+				if found := cn.path.eval(rowNode); len(found) > 0 {
+					if vals[i], err = convertVal(tn.tbl.Name, cn.col, found[0]); err != nil {
+						return err
+					}
+				}
+			}
+			rowVals[cn.col.Name] = vals[i]
+		}
+
+		for _, col := range tn.tbl.RequiredCols() {
+			if rowVals[col.Name] == nil {
+				return errz.Errorf("no value for required column %s.%s", tn.tbl.Name, col.Name)
+			}
+		}
+
+		if err := tn.insertFn(ctx, vals); err != nil {
+			return errz.Wrapf(err, "failed to insert to table {%s}", tn.tbl.Name)
+		}
+
+		for _, child := range tn.children {
+			if err := in.ingestRows(ctx, child, rowNode, rowVals); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// createTables creates each of tables and their descendants, and prepares
+// the insert statement of each.
+func (in *ingester) createTables(ctx context.Context, tables []*tableNode) error {
+	for _, tn := range tables {
+		tblDef, err := userdriver.ToTableDef(tn.tbl)
+		if err != nil {
+			return err
+		}
+
+		drvr := in.destGrip.SQLDriver()
+		if err = drvr.CreateTable(ctx, in.destDB, tblDef); err != nil {
+			return err
+		}
+		in.log.Debug("Created table", lga.Target, source.Target(in.destGrip.Source(), tblDef.Name))
+
+		colNames := userdriver.NamesFromCols(tn.tbl.Cols)
+		stmtExecer, err := drvr.PrepareInsertStmt(ctx, in.destDB, tblDef.Name, colNames, 1)
+		if err != nil {
+			return err
+		}
+
+		// Make sure we close stmt eventually.
+		in.clnup.AddC(stmtExecer)
+
+		tn.insertFn = func(ctx context.Context, vals []any) error {
+			// Munge vals so that they're as the target DB expects
+			if err := stmtExecer.Munge(vals); err != nil {
+				return err
+			}
+
+			_, err := stmtExecer.Exec(ctx, vals...)
+			return errz.Err(err)
+		}
+
+		if err = in.createTables(ctx, tn.children); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertVal converts the JSON value data, as decoded with
+// json.Decoder.UseNumber, to a value of col's kind. A JSON null is
+// converted to nil.
+func convertVal(tbl string, col *userdriver.ColMapping, data any) (any, error) {
+	const errTpl = `conversion error: %s.%s: expected "%s" but got %T(%v)`
+	const errTplMsg = `conversion error: %s.%s: expected "%s" but got %T(%v): %v`
+
+	if data == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	switch col.Kind { //nolint:exhaustive
+	default:
+		return nil, errz.Errorf("unknown data kind {%s} for col %s", col.Kind, col.Name)
+	case kind.Text, kind.Time, kind.Datetime, kind.Date, kind.Decimal:
+		switch data := data.(type) {
+		case string:
+			return data, nil
+		case stdj.Number:
+			return data.String(), nil
+		case bool:
+			return strconv.FormatBool(data), nil
+		default:
+			// An object or array: use its JSON text.
+			b, err := marshalCompact(data)
+			if err != nil {
+				return nil, errz.Errorf(errTplMsg, tbl, col.Name, col.Kind, data, data, err)
+			}
+			return b, nil
+		}
+	case kind.Int:
+		switch data := data.(type) {
+		case stdj.Number:
+			if val, err := data.Int64(); err == nil {
+				return val, nil
+			}
+			f, err := data.Float64()
+			if err != nil || f != float64(int64(f)) {
+				return nil, errz.Errorf(errTpl, tbl, col.Name, col.Kind, data, data)
+			}
+			return int64(f), nil
+		case string:
+			val, err := strconv.ParseInt(data, 0, 64)
+			if err != nil {
+				return nil, errz.Errorf(errTplMsg, tbl, col.Name, col.Kind, data, data, err)
+			}
+			return val, nil
+		case bool:
+			if data {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		default:
+			return nil, errz.Errorf(errTpl, tbl, col.Name, col.Kind, data, data)
+		}
+	case kind.Float:
+		switch data := data.(type) {
+		case stdj.Number:
+			val, err := data.Float64()
+			if err != nil {
+				return nil, errz.Errorf(errTplMsg, tbl, col.Name, col.Kind, data, data, err)
+			}
+			return val, nil
+		case string:
+			val, err := strconv.ParseFloat(data, 64)
+			if err != nil {
+				return nil, errz.Errorf(errTplMsg, tbl, col.Name, col.Kind, data, data, err)
+			}
+			return val, nil
+		default:
+			return nil, errz.Errorf(errTpl, tbl, col.Name, col.Kind, data, data)
+		}
+	case kind.Bool:
+		switch data := data.(type) {
+		case bool:
+			return data, nil
+		case stdj.Number:
+			f, err := data.Float64()
+			if err != nil {
+				return nil, errz.Errorf(errTplMsg, tbl, col.Name, col.Kind, data, data, err)
+			}
+			return f != 0, nil
+		case string:
+			val, err := strconv.ParseBool(data)
+			if err != nil {
+				return nil, errz.Errorf(errTplMsg, tbl, col.Name, col.Kind, data, data, err)
+			}
+			return val, nil
+		default:
+			return nil, errz.Errorf(errTpl, tbl, col.Name, col.Kind, data, data)
+		}
+	case kind.Bytes:
+		switch data := data.(type) {
+		case string:
+			return []byte(data), nil
+		default:
+			return nil, errz.Errorf(errTpl, tbl, col.Name, col.Kind, data, data)
+		}
+	}
+}
+
+// marshalCompact returns the compact JSON text of v, without HTML
+// escaping.
+func marshalCompact(v any) (string, error) {
+	buf := &bytes.Buffer{}
+	enc := stdj.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,186 @@
+package jsonud_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/cli/config"
+	"github.com/neilotoole/sq/drivers/userdriver"
+	"github.com/neilotoole/sq/drivers/userdriver/jsonud"
+	"github.com/neilotoole/sq/libsq/core/ioz"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/proj"
+	"github.com/neilotoole/sq/testh/testsrc"
+)
+
+const driverOrders = "orders"
+
+func loadOrdersDef(t *testing.T) *userdriver.DriverDef {
+	t.Helper()
+
+	ext := &config.Ext{}
+	require.NoError(t, ioz.UnmarshallYAML(proj.ReadFile(testsrc.PathDriverDefOrders), ext))
+	require.Equal(t, 1, len(ext.UserDrivers))
+	udDef := ext.UserDrivers[0]
+	require.Equal(t, driverOrders, udDef.Name)
+	require.Equal(t, jsonud.Genre, udDef.Genre)
+	return udDef
+}
+
+func TestIngest_Orders(t *testing.T) {
+	th := testh.New(t)
+	udDef := loadOrdersDef(t)
+
+	grip, err := th.Grips().OpenEphemeral(th.Context)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, grip.Close())
+	})
+
+	data := proj.ReadFile("drivers/userdriver/jsonud/testdata/orders.json")
+	err = jsonud.Ingest(th.Context, udDef, bytes.NewReader(data), grip)
+	require.NoError(t, err)
+
+	srcMeta, err := grip.SourceMetadata(th.Context, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"line_tag", "order_line", "orders"}, srcMeta.TableNames())
+
+	sink, err := th.QuerySQL(grip.Source(), nil, "SELECT * FROM orders ORDER BY order_id")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(sink.Recs))
+	rec := sink.Recs[0]
+	require.Equal(t, int64(1001), stringz.Val(rec[0]))
+	require.Equal(t, "Nikola Tesla", stringz.Val(rec[1]))
+	require.Equal(t, "nikola@example.com", stringz.Val(rec[2]))
+	require.Equal(t, 31.5, stringz.Val(rec[4]))
+	require.Equal(t, true, stringz.Val(rec[5]))
+	// A JSON object selected by a text col is its JSON text.
+	require.Equal(t, `{"days":5,"method":"ground"}`, stringz.Val(rec[6]))
+
+	rec = sink.Recs[1]
+	require.Equal(t, "Ada Lovelace", stringz.Val(rec[1]))
+	require.Nil(t, rec[2], "missing member should be null")
+	require.Equal(t, false, stringz.Val(rec[5]))
+	require.Nil(t, rec[6], "JSON null should be null")
+
+	sink, err = th.QuerySQL(grip.Source(), nil, "SELECT * FROM order_line ORDER BY line_id")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(sink.Recs))
+	wantLines := []struct {
+		orderID int64
+		sku     string
+		qty     int64
+		price   float64
+	}{
+		{1001, "COIL-01", 2, 10.25},
+		{1001, "LAMP-07", 1, 11},
+		{1002, "CARD-50", 50, 0.0998},
+	}
+	for i, rec := range sink.Recs {
+		// Verify that the primary id cols are sequential
+		require.Equal(t, int64(i+1), stringz.Val(rec[0]))
+		require.Equal(t, wantLines[i].orderID, stringz.Val(rec[1]))
+		require.Equal(t, wantLines[i].sku, stringz.Val(rec[2]))
+		require.Equal(t, wantLines[i].qty, stringz.Val(rec[3]))
+		require.Equal(t, wantLines[i].price, stringz.Val(rec[4]))
+	}
+
+	sink, err = th.QuerySQL(grip.Source(), nil, "SELECT * FROM line_tag ORDER BY tag_id")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(sink.Recs))
+	wantTags := []struct {
+		lineID int64
+		tag    string
+	}{
+		{1, "electrical"},
+		{1, "fragile"},
+		{3, "paper"},
+	}
+	for i, rec := range sink.Recs {
+		require.Equal(t, int64(i+1), stringz.Val(rec[0]))
+		require.Equal(t, wantTags[i].lineID, stringz.Val(rec[1]))
+		require.Equal(t, wantTags[i].tag, stringz.Val(rec[2]))
+	}
+}
+
+func TestIngest_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mungeFn func(def *userdriver.DriverDef)
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "bad_json",
+			data:    `{"data": {"orders": [}`,
+			wantErr: "invalid character",
+		},
+		{
+			name:    "required_missing",
+			data:    `{"data": {"orders": [{"id": 1, "customer": {}}]}}`,
+			wantErr: "no value for required column orders.customer_name",
+		},
+		{
+			name:    "conversion",
+			data:    `{"data": {"orders": [{"id": "abc", "customer": {"name": "x"}}]}}`,
+			wantErr: "conversion error: orders.order_id",
+		},
+		{
+			name: "table_not_beneath_root",
+			mungeFn: func(def *userdriver.DriverDef) {
+				def.Tables[0].Selector = "$.meta"
+			},
+			wantErr: "must be beneath driver selector",
+		},
+		{
+			name: "col_wildcard",
+			mungeFn: func(def *userdriver.DriverDef) {
+				def.Tables[0].Cols[1].Selector = "customer[*]"
+			},
+			wantErr: "must not contain a wildcard",
+		},
+		{
+			name: "foreign_no_parent_col",
+			mungeFn: func(def *userdriver.DriverDef) {
+				def.Tables[1].Cols[1].Foreign = "../nope"
+			},
+			wantErr: "parent table {orders} has no column {nope}",
+		},
+		{
+			name: "same_selector",
+			mungeFn: func(def *userdriver.DriverDef) {
+				def.Tables[2].Selector = def.Tables[1].Selector
+			},
+			wantErr: "have the same selector",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			th := testh.New(t)
+			udDef := loadOrdersDef(t)
+			if tc.mungeFn != nil {
+				tc.mungeFn(udDef)
+			}
+
+			grip, err := th.Grips().OpenEphemeral(th.Context)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				assert.NoError(t, grip.Close())
+			})
+
+			data := tc.data
+			if data == "" {
+				data = `{"data": {"orders": []}}`
+			}
+			err = jsonud.Ingest(th.Context, udDef, strings.NewReader(data), grip)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package jsonud
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// segment is a single step of a selector path.
+type segment struct {
+	// key is the name of an object member. It's empty if the segment
+	// is an array index or a wildcard.
+	key string
+
+	// index is the array index, valid only if isIndex is true.
+	index int
+
+	// isIndex is true if the segment is an array index, e.g. "[0]".
+	isIndex bool
+
+	// wildcard is true if the segment is "[*]", which selects each element
+	// of an array.
+	wildcard bool
+}
+
+// String returns the segment in bracket notation, e.g. "['name']".
+func (s segment) String() string {
+	switch {
+	case s.wildcard:
+		return "[*]"
+	case s.isIndex:
+		return "[" + strconv.Itoa(s.index) + "]"
+	default:
+		return "['" + s.key + "']"
+	}
+}
+
+// path is a parsed selector: a sequence of segments, evaluated relative to
+// a document node. The zero-length path selects the node itself.
+type path []segment
+
+// String returns the path in normalized bracket notation, e.g.
+// "$['data']['orders'][*]".
+func (p path) String() string {
+	var sb strings.Builder
+	sb.WriteByte('$')
+	for _, seg := range p {
+		sb.WriteString(seg.String())
+	}
+	return sb.String()
+}
+
+// hasWildcard returns true if p contains a wildcard segment.
+func (p path) hasWildcard() bool {
+	for _, seg := range p {
+		if seg.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrefix returns true if prefix is a (possibly equal) prefix of p.
+func (p path) hasPrefix(prefix path) bool {
+	if len(prefix) > len(p) {
+		return false
+	}
+	for i := range prefix {
+		if p[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// eval returns the nodes selected by p, starting from node. A wildcard
+// segment selects each element of an array; a node that doesn't have the
+// member or element selected by a segment contributes nothing. The nodes
+// are returned in document order.
+func (p path) eval(node any) []any {
+	nodes := []any{node}
+	for _, seg := range p {
+		var next []any
+		for _, n := range nodes {
+			switch n := n.(type) {
+			case map[string]any:
+				if seg.key == "" {
+					continue
+				}
+				if v, ok := n[seg.key]; ok {
+					next = append(next, v)
+				}
+			case []any:
+				switch {
+				case seg.wildcard:
+					next = append(next, n...)
+				case seg.isIndex:
+					i := seg.index
+					if i < 0 {
+						i += len(n)
+					}
+					if i >= 0 && i < len(n) {
+						next = append(next, n[i])
+					}
+				}
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// parseAbsPath parses an absolute selector, such as "$.data.orders[*]",
+// whose first character must be "$".
+func parseAbsPath(sel string) (path, error) {
+	if !strings.HasPrefix(sel, "$") {
+		return nil, errz.Errorf("selector {%s}: must begin with $", sel)
+	}
+	return parseSegments(sel, sel[1:])
+}
+
+// parseRelPath parses a column selector, which is relative to the row's
+// node. The selector may begin with "@" (the row node), as in
+// "@.customer.name", or may omit it, as in "customer.name" or "[0]". The
+// selector "@" selects the row node itself, which is useful for rows that
+// are scalar values, such as the elements of an array of strings.
+func parseRelPath(sel string) (path, error) {
+	rest := strings.TrimPrefix(sel, "@")
+	if rest != "" && rest[0] != '.' && rest[0] != '[' {
+		rest = "." + rest
+	}
+	return parseSegments(sel, rest)
+}
+
+// parseSegments parses the segments of selector sel, of which rest is the
+// remainder after the leading "$" or "@". Member names may use dot
+// notation (".name") or bracket notation ("['name']" or "[\"name\"]");
+// array elements are selected via "[N]", where a negative N counts from
+// the end of the array, or via the wildcard "[*]".
+func parseSegments(sel, rest string) (path, error) {
+	var p path
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			if strings.HasPrefix(rest, "*") {
+				p = append(p, segment{wildcard: true})
+				rest = rest[1:]
+				continue
+			}
+
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, errz.Errorf("selector {%s}: empty member name", sel)
+			}
+			p = append(p, segment{key: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			rest = strings.TrimLeft(rest[1:], " ")
+			if rest != "" && (rest[0] == '\'' || rest[0] == '"') {
+				// A quoted member name, which may itself contain "]".
+				end := strings.IndexByte(rest[1:], rest[0])
+				if end < 0 {
+					return nil, errz.Errorf("selector {%s}: unclosed quote", sel)
+				}
+				key := rest[1 : end+1]
+				rest = strings.TrimLeft(rest[end+2:], " ")
+				if !strings.HasPrefix(rest, "]") {
+					return nil, errz.Errorf("selector {%s}: unclosed [", sel)
+				}
+				p = append(p, segment{key: key})
+				rest = rest[1:]
+				continue
+			}
+
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, errz.Errorf("selector {%s}: unclosed [", sel)
+			}
+
+			inner := strings.TrimSpace(rest[:end])
+			if inner == "*" {
+				p = append(p, segment{wildcard: true})
+			} else {
+				i, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, errz.Errorf("selector {%s}: invalid array index {%s}", sel, inner)
+				}
+				p = append(p, segment{index: i, isIndex: true})
+			}
+			rest = rest[end+1:]
+		default:
+			return nil, errz.Errorf("selector {%s}: unexpected character {%c}", sel, rest[0])
+		}
+	}
+
+	return p, nil
+}
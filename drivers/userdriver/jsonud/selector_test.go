@@ -0,0 +1,121 @@
+package jsonud
+
+import (
+	stdj "encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAbsPath(t *testing.T) {
+	testCases := []struct {
+		sel     string
+		want    string
+		wantErr bool
+	}{
+		{sel: "$", want: "$"},
+		{sel: "$.data", want: "$['data']"},
+		{sel: "$.data.orders[*]", want: "$['data']['orders'][*]"},
+		{sel: "$.data.*", want: "$['data'][*]"},
+		{sel: "$['a b'].c", want: "$['a b']['c']"},
+		{sel: `$["x]y"][0]`, want: "$['x]y'][0]"},
+		{sel: "$.items[-1]", want: "$['items'][-1]"},
+		{sel: "$[ * ]", want: "$[*]"},
+		{sel: "data", wantErr: true},
+		{sel: "$.", wantErr: true},
+		{sel: "$..data", wantErr: true},
+		{sel: "$[abc]", wantErr: true},
+		{sel: "$['abc'", wantErr: true},
+		{sel: "$['abc'x]", wantErr: true},
+		{sel: "$[0", wantErr: true},
+		{sel: "$data", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sel, func(t *testing.T) {
+			p, err := parseAbsPath(tc.sel)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, p.String())
+		})
+	}
+}
+
+func TestParseRelPath(t *testing.T) {
+	testCases := []struct {
+		sel  string
+		want string
+	}{
+		{sel: "@", want: "$"},
+		{sel: "name", want: "$['name']"},
+		{sel: "customer.name", want: "$['customer']['name']"},
+		{sel: "@.customer.name", want: "$['customer']['name']"},
+		{sel: "[0]", want: "$[0]"},
+		{sel: "@['email address']", want: "$['email address']"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sel, func(t *testing.T) {
+			p, err := parseRelPath(tc.sel)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, p.String())
+		})
+	}
+}
+
+func TestPathEval(t *testing.T) {
+	const doc = `{
+  "a": {"b": [{"c": 1}, {"c": 2}, {"d": 3}]},
+  "s": ["x", "y", "z"],
+  "n": null
+}`
+
+	var node any
+	require.NoError(t, stdj.Unmarshal([]byte(doc), &node))
+
+	testCases := []struct {
+		sel  string
+		want string
+	}{
+		{sel: "$.a.b[*].c", want: "[1,2]"},
+		{sel: "$.a.b[1]", want: `[{"c":2}]`},
+		{sel: "$.s[-1]", want: `["z"]`},
+		{sel: "$.s[3]", want: "null"},
+		{sel: "$.s[*]", want: `["x","y","z"]`},
+		{sel: "$.n", want: "[null]"},
+		{sel: "$.nope.c", want: "null"},
+		{sel: "$.s.c", want: "null"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sel, func(t *testing.T) {
+			p, err := parseAbsPath(tc.sel)
+			require.NoError(t, err)
+			got, err := stdj.Marshal(p.eval(node))
+			require.NoError(t, err)
+			require.Equal(t, tc.want, strings.TrimSpace(string(got)))
+		})
+	}
+}
+
+func TestPathHasPrefix(t *testing.T) {
+	mustParse := func(sel string) path {
+		p, err := parseAbsPath(sel)
+		require.NoError(t, err)
+		return p
+	}
+
+	p := mustParse("$.data.orders[*].lines[*]")
+	require.True(t, p.hasPrefix(mustParse("$")))
+	require.True(t, p.hasPrefix(mustParse("$.data.orders[*]")))
+	require.True(t, p.hasPrefix(mustParse("$['data']['orders'][*]")))
+	require.True(t, p.hasPrefix(p))
+	require.False(t, p.hasPrefix(mustParse("$.data.orders[0]")))
+	require.False(t, p.hasPrefix(mustParse("$.data.orders[*].lines[*].tags")))
+	require.True(t, p.hasWildcard())
+	require.False(t, mustParse("$.data").hasWildcard())
+}
@@ -1,8 +1,8 @@
 // Package userdriver implements the "user-driver" functionality
 // that allows users to define source driver types declaratively.
 // Note pkg userdriver itself is the framework: an actual
-// implementation for each genre (such as XML) must be defined
-// separately as in the "xmlud" sub-package.
+// implementation for each genre (such as XML or JSON) must be defined
+// separately as in the "xmlud" and "jsonud" sub-packages.
 package userdriver
 
 import (
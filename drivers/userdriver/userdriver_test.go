@@ -23,6 +23,7 @@ func TestDriver(t *testing.T) {
 	}{
 		{handle: testsrc.PplUD, tbl: "person", wantRecs: 3},
 		{handle: testsrc.RSSNYTLocalUD, tbl: "item", wantRecs: 45},
+		{handle: testsrc.OrdersUD, tbl: "order_line", wantRecs: 3},
 	}
 
 	for _, tc := range testCases {
@@ -52,7 +53,7 @@ func TestDriver(t *testing.T) {
 func TestValidateDriverDef_KnownGood(t *testing.T) {
 	t.Parallel()
 
-	testCases := []string{testsrc.PathDriverDefPpl, testsrc.PathDriverDefRSS}
+	testCases := []string{testsrc.PathDriverDefPpl, testsrc.PathDriverDefRSS, testsrc.PathDriverDefOrders}
 
 	for _, defFile := range testCases {
 		t.Run(defFile, func(t *testing.T) {
@@ -0,0 +1,72 @@
+package xml
+
+import (
+	"bytes"
+	"context"
+	stdxml "encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+var _ files.TypeDetectFunc = DetectXML
+
+// utf8BOM is the UTF-8 byte order mark, which may precede the document.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// DetectXML implements files.TypeDetectFunc, returning drivertype.XML if
+// the input begins with a well-formed XML prolog and root start element.
+// The score is 1.0 if the document has an XML declaration (<?xml ...?>),
+// and 0.9 otherwise. An HTML document (root element <html>) is not
+// detected as XML.
+func DetectXML(ctx context.Context, newRdrFn files.NewReaderFunc) (detected drivertype.Type, score float32,
+	err error,
+) {
+	// detectBufSize is the maximum number of bytes read to find the
+	// root element.
+	const detectBufSize = 64 * 1024
+
+	log := lg.FromContext(ctx)
+	var r io.ReadCloser
+	r, err = newRdrFn(ctx)
+	if err != nil {
+		return drivertype.None, 0, errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+
+	dec := newDecoder(io.LimitReader(r, detectBufSize))
+	var hasDecl bool
+	for {
+		var tok stdxml.Token
+		if tok, err = dec.Token(); err != nil {
+			// Not XML, or the root element wasn't found within
+			// detectBufSize.
+			return drivertype.None, 0, nil
+		}
+
+		switch tok := tok.(type) {
+		case stdxml.ProcInst:
+			if tok.Target == "xml" {
+				hasDecl = true
+			}
+		case stdxml.CharData:
+			if len(bytes.TrimSpace(bytes.TrimPrefix(tok, utf8BOM))) > 0 {
+				// Text before the root element: not XML.
+				return drivertype.None, 0, nil
+			}
+		case stdxml.StartElement:
+			if strings.EqualFold(tok.Name.Local, "html") {
+				return drivertype.None, 0, nil
+			}
+			if hasDecl {
+				return drivertype.XML, 1.0, nil
+			}
+			return drivertype.XML, 0.9, nil
+		}
+	}
+}
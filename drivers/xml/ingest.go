@@ -0,0 +1,668 @@
+package xml
+
+// ingest.go implements ingest of an XML document. The document is read
+// twice: the first pass (scanStructure) builds a tree of the document's
+// element paths, from which the tables and columns are inferred, and
+// samples values to detect the column kinds; the second pass (insertRows)
+// inserts the rows.
+
+import (
+	"bytes"
+	"context"
+	stdxml "encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/encoding/ianaindex"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/progress"
+	"github.com/neilotoole/sq/libsq/core/schema"
+	"github.com/neilotoole/sq/libsq/core/sqlz"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+const (
+	// colPK is the name of the generated primary key column of each table.
+	colPK = "_id"
+
+	// colScopeSep is used when generating the column name of a
+	// flattened element or attribute. Thus the "id" attribute of an
+	// <author> element becomes "author_id".
+	colScopeSep = "_"
+
+	// ctxCheckInterval is the number of tokens between checks of
+	// whether the context is done.
+	ctxCheckInterval = 1000
+)
+
+// field is a value-bearing part of an element: either an attribute, or
+// the element's text.
+type field struct {
+	// name is the attribute's local name, or empty for the element's text.
+	name string
+
+	// detector detects the kind of the field's values.
+	detector *kind.Detector
+
+	// samples is the number of values sampled by detector.
+	samples int
+
+	// col is the index of the field's column in the owning table's
+	// cols, set by inferTables.
+	col int
+}
+
+func newField(name string) *field {
+	return &field{name: name, detector: kind.NewDetector(), col: -1}
+}
+
+// sample samples the value s, unless the field has already taken
+// sampleSize samples.
+func (f *field) sample(s string, sampleSize int) {
+	if f.samples < sampleSize {
+		f.detector.Sample(s)
+		f.samples++
+	}
+}
+
+// node describes the elements found at a particular path of the
+// document, such as "/catalog/book/author".
+type node struct {
+	parent *node
+
+	// tbl is the table of which each element at this path is a row, or
+	// nil if the elements are not rows of their own.
+	tbl *table
+
+	// text is the field for the element's text, or nil if no element at
+	// this path has non-whitespace text.
+	text *field
+
+	children    []*node
+	childByName map[string]*node
+
+	attrs      []*field
+	attrByName map[string]*field
+
+	// name is the element's local name.
+	name string
+
+	// repeats is true if an element at this path occurs more than once
+	// beneath a single parent element.
+	repeats bool
+}
+
+func newNode(name string, parent *node) *node {
+	return &node{
+		name:        name,
+		parent:      parent,
+		childByName: map[string]*node{},
+		attrByName:  map[string]*field{},
+	}
+}
+
+// child returns the child node with the given name, creating it if
+// necessary.
+func (n *node) child(name string) *node {
+	c, ok := n.childByName[name]
+	if !ok {
+		c = newNode(name, n)
+		n.childByName[name] = c
+		n.children = append(n.children, c)
+	}
+	return c
+}
+
+// attr returns the field for the named attribute, creating it if
+// necessary.
+func (n *node) attr(name string) *field {
+	f, ok := n.attrByName[name]
+	if !ok {
+		f = newField(name)
+		n.attrByName[name] = f
+		n.attrs = append(n.attrs, f)
+	}
+	return f
+}
+
+// column is a column of a table.
+type column struct {
+	mungeFn kind.MungeFunc
+	name    string
+	kind    kind.Kind
+}
+
+// convert converts the text value s to a value of the column's kind.
+func (c *column) convert(s string) (any, error) {
+	if c.mungeFn != nil {
+		return c.mungeFn(s)
+	}
+
+	switch c.kind { //nolint:exhaustive
+	case kind.Int:
+		return strconv.ParseInt(s, 10, 64)
+	case kind.Float:
+		return strconv.ParseFloat(s, 64)
+	case kind.Bool:
+		return stringz.ParseBool(s)
+	default:
+		return s, nil
+	}
+}
+
+// table is a table inferred from the document.
+type table struct {
+	// node is the path of the elements that are the table's rows.
+	node *node
+
+	// parent is the table of the nearest enclosing table element, or nil.
+	parent *table
+
+	// stmt inserts a row into the table. It's set by createTables.
+	stmt *driver.StmtExecer
+
+	name string
+
+	// fields are the data fields of the table's rows, in column order.
+	fields []*field
+
+	// colNames holds the proposed name of each of fields.
+	colNames []string
+
+	// cols are the table's columns: the generated key columns, followed
+	// by a column for each of fields. It's set by inferTables.
+	cols []*column
+
+	// seq is the sequence value of the table's most recent row.
+	seq int64
+}
+
+// addField adds f to the table's fields, with the proposed column name.
+func (t *table) addField(f *field, colName string) {
+	t.fields = append(t.fields, f)
+	t.colNames = append(t.colNames, colName)
+}
+
+// ingestXML loads the XML document at src into destGrip.
+func ingestXML(ctx context.Context, fs *files.Files, src *source.Source, destGrip driver.Grip) error {
+	log := lg.FromContext(ctx)
+	start := time.Now()
+
+	r, err := fs.NewReader(ctx, src, false)
+	if err != nil {
+		return err
+	}
+
+	root, err := scanStructure(ctx, r, driver.OptIngestSampleSize.Get(src.Options))
+	lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+	if err != nil {
+		return err
+	}
+
+	tables, err := inferTables(ctx, root)
+	if err != nil {
+		return err
+	}
+
+	db, err := destGrip.DB(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errz.Err(err)
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseDB, conn)
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return errz.Err(err)
+	}
+	// Roll back unless we reach the explicit Commit below.
+	committed := false
+	defer func() {
+		if !committed {
+			lg.WarnIfError(log, "Rollback XML ingest tx", errz.Err(tx.Rollback()))
+		}
+	}()
+
+	if err = createTables(ctx, destGrip.SQLDriver(), tx, tables); err != nil {
+		return err
+	}
+	defer func() {
+		for _, tbl := range tables {
+			if tbl.stmt != nil {
+				lg.WarnIfCloseError(log, "Close XML insert statement", tbl.stmt)
+			}
+		}
+	}()
+
+	if r, err = fs.NewReader(ctx, src, true); err != nil {
+		return err
+	}
+	defer lg.WarnIfCloseError(log, lgm.CloseFileReader, r)
+
+	if err = insertRows(ctx, r, root); err != nil {
+		return err
+	}
+
+	committed = true
+	if err = tx.Commit(); err != nil {
+		return errz.Err(err)
+	}
+
+	for _, tbl := range tables {
+		log.Info(
+			"Ingested rows",
+			lga.Count, tbl.seq,
+			lga.Elapsed, time.Since(start).Round(time.Millisecond),
+			lga.Target, source.Target(destGrip.Source(), tbl.name),
+		)
+	}
+	return nil
+}
+
+// newDecoder returns a decoder for the XML document read from r. The
+// decoder supports documents in any IANA-registered character encoding,
+// such as ISO-8859-1, as declared by the document's XML declaration.
+func newDecoder(r io.Reader) *stdxml.Decoder {
+	dec := stdxml.NewDecoder(r)
+	dec.CharsetReader = func(label string, input io.Reader) (io.Reader, error) {
+		enc, err := ianaindex.IANA.Encoding(label)
+		if err != nil {
+			return nil, errz.Wrapf(err, "xml: unsupported encoding {%s}", label)
+		}
+		if enc == nil {
+			return nil, errz.Errorf("xml: unsupported encoding {%s}", label)
+		}
+		return enc.NewDecoder().Reader(input), nil
+	}
+	return dec
+}
+
+// isNamespaceDecl returns true if name is the name of a namespace
+// declaration attribute, such as "xmlns" or "xmlns:dc".
+func isNamespaceDecl(name stdxml.Name) bool {
+	return name.Space == "xmlns" || (name.Space == "" && name.Local == "xmlns")
+}
+
+// scanStructure reads the XML document from r, returning the node tree of
+// the document's element paths. At most sampleSize values are sampled for
+// each field.
+func scanStructure(ctx context.Context, r io.Reader, sampleSize int) (*node, error) {
+	type frame struct {
+		n      *node
+		counts map[*node]int
+		text   bytes.Buffer
+	}
+
+	var (
+		dec   = newDecoder(r)
+		root  *node
+		stack []*frame
+		tok   stdxml.Token
+		err   error
+	)
+
+	for i := 0; ; i++ {
+		if i%ctxCheckInterval == 0 {
+			if err = ctx.Err(); err != nil {
+				return nil, errz.Err(err)
+			}
+		}
+
+		if tok, err = dec.Token(); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errz.Wrap(err, "xml")
+		}
+
+		switch tok := tok.(type) {
+		case stdxml.StartElement:
+			var n *node
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, errz.Errorf("xml: document has more than one root element: {%s}, {%s}",
+						root.name, tok.Name.Local)
+				}
+				root = newNode(tok.Name.Local, nil)
+				n = root
+			} else {
+				top := stack[len(stack)-1]
+				n = top.n.child(tok.Name.Local)
+				if top.counts == nil {
+					top.counts = map[*node]int{}
+				}
+				top.counts[n]++
+				if top.counts[n] > 1 {
+					n.repeats = true
+				}
+			}
+
+			for _, attr := range tok.Attr {
+				if isNamespaceDecl(attr.Name) {
+					continue
+				}
+				f := n.attr(attr.Name.Local)
+				if s := strings.TrimSpace(attr.Value); s != "" {
+					f.sample(s, sampleSize)
+				}
+			}
+
+			stack = append(stack, &frame{n: n})
+		case stdxml.CharData:
+			// Skip the whitespace between elements, so that we don't
+			// accumulate it for the enclosing elements.
+			if len(stack) > 0 && len(bytes.TrimSpace(tok)) > 0 {
+				stack[len(stack)-1].text.Write(tok)
+			}
+		case stdxml.EndElement:
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if s := strings.TrimSpace(top.text.String()); s != "" {
+				if top.n.text == nil {
+					top.n.text = newField("")
+				}
+				top.n.text.sample(s, sampleSize)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errz.New("xml: document has no root element")
+	}
+
+	return root, nil
+}
+
+// inferTables infers the tables from the node tree rooted at root,
+// returning the tables in document order. On return, each table node's
+// node.tbl field is set, and each field's col is set to its column index
+// in the table whose rows hold the field's values.
+func inferTables(ctx context.Context, root *node) ([]*table, error) {
+	rootTbl := &table{node: root, name: root.name}
+	tables := []*table{rootTbl}
+
+	// visit adds n's fields to owner, with the column name prefix, and
+	// then visits n's children.
+	var visit func(n *node, owner *table, prefix string)
+	visit = func(n *node, owner *table, prefix string) {
+		childPrefix := ""
+		if n == owner.node {
+			for _, f := range n.attrs {
+				owner.addField(f, f.name)
+			}
+			if n.text != nil {
+				owner.addField(n.text, n.name)
+			}
+		} else {
+			// A flattened element: its text is named for the element,
+			// e.g. "price", and the names of its attributes and children
+			// are scoped by the element's name, e.g. "price_currency".
+			if n.text != nil {
+				owner.addField(n.text, strings.TrimSuffix(prefix, colScopeSep))
+			}
+			for _, f := range n.attrs {
+				owner.addField(f, prefix+f.name)
+			}
+			childPrefix = prefix
+		}
+
+		for _, c := range n.children {
+			if c.repeats {
+				tbl := &table{node: c, name: c.name, parent: owner}
+				tables = append(tables, tbl)
+				visit(c, tbl, "")
+				continue
+			}
+			visit(c, owner, childPrefix+c.name+colScopeSep)
+		}
+	}
+	visit(root, rootTbl, "")
+
+	if len(rootTbl.fields) == 0 && len(tables) > 1 {
+		// The root element is merely a container, e.g. <catalog>, so
+		// there's no need for a table of it.
+		tables = tables[1:]
+		for _, tbl := range tables {
+			if tbl.parent == rootTbl {
+				tbl.parent = nil
+			}
+		}
+	}
+
+	nameTables(tables)
+
+	for _, tbl := range tables {
+		tbl.node.tbl = tbl
+
+		colNames := []string{colPK}
+		tbl.cols = []*column{{name: colPK, kind: kind.Int}}
+		if tbl.parent != nil {
+			fkName := "_" + tbl.parent.name + colScopeSep + "id"
+			colNames = append(colNames, fkName)
+			tbl.cols = append(tbl.cols, &column{name: fkName, kind: kind.Int})
+		}
+
+		for i, f := range tbl.fields {
+			k, mungeFn, err := f.detector.Detect()
+			if err != nil {
+				return nil, errz.Err(err)
+			}
+			if k == kind.Null {
+				k = kind.Text
+			}
+
+			f.col = len(tbl.cols)
+			colNames = append(colNames, tbl.colNames[i])
+			tbl.cols = append(tbl.cols, &column{kind: k, mungeFn: mungeFn})
+		}
+
+		var err error
+		if colNames, err = driver.MungeIngestColNames(ctx, colNames); err != nil {
+			return nil, err
+		}
+		for i, name := range colNames {
+			tbl.cols[i].name = name
+		}
+	}
+
+	return tables, nil
+}
+
+// nameTables ensures that each of tables has a unique name. A table whose
+// element name is shared with another table is named for its parent
+// element as well, e.g. "book_author", and any remaining duplicate is
+// suffixed with a number, e.g. "author_2".
+func nameTables(tables []*table) {
+	counts := map[string]int{}
+	for _, tbl := range tables {
+		counts[tbl.name]++
+	}
+
+	for _, tbl := range tables {
+		if counts[tbl.name] > 1 && tbl.node.parent != nil {
+			tbl.name = tbl.node.parent.name + colScopeSep + tbl.name
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, tbl := range tables {
+		name := tbl.name
+		for i := 2; seen[name]; i++ {
+			name = tbl.name + colScopeSep + strconv.Itoa(i)
+		}
+		tbl.name = name
+		seen[name] = true
+	}
+}
+
+// createTables creates each of tables, and prepares its insert statement.
+func createTables(ctx context.Context, drvr driver.SQLDriver, db sqlz.DB, tables []*table) error {
+	log := lg.FromContext(ctx)
+	for _, tbl := range tables {
+		tblDef := &schema.Table{Name: tbl.name, PKColName: colPK}
+		colNames := make([]string, len(tbl.cols))
+		for i, col := range tbl.cols {
+			colNames[i] = col.name
+			tblDef.Cols = append(tblDef.Cols, &schema.Column{Table: tblDef, Name: col.name, Kind: col.kind})
+		}
+
+		if err := drvr.CreateTable(ctx, db, tblDef); err != nil {
+			return errz.Wrapf(err, "xml: failed to create table {%s}", tbl.name)
+		}
+		log.Debug("Created table", lga.Table, tbl.name)
+
+		var err error
+		if tbl.stmt, err = drvr.PrepareInsertStmt(ctx, db, tbl.name, colNames, 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertRows reads the XML document from r, inserting a row for each
+// element that's a row of a table of the node tree rooted at root. The
+// tree must have been built by scanStructure and inferTables from the
+// same document.
+func insertRows(ctx context.Context, r io.Reader, root *node) error {
+	bar := progress.FromContext(ctx).NewUnitCounter("Ingest XML", "row")
+	defer bar.Stop()
+
+	type frame struct {
+		n *node
+
+		// row holds the values of the row of the nearest enclosing
+		// table element, or is nil if there's no such element.
+		row []any
+
+		text bytes.Buffer
+	}
+
+	var (
+		dec   = newDecoder(r)
+		stack []*frame
+		tok   stdxml.Token
+		err   error
+	)
+
+	// setVal sets the value of the field's column in row, converting
+	// the text value s to the column's kind.
+	setVal := func(tbl *table, row []any, f *field, s string) error {
+		if row == nil || f.col < 0 || s == "" {
+			return nil
+		}
+
+		col := tbl.cols[f.col]
+		if row[f.col], err = col.convert(s); err != nil {
+			return errz.Wrapf(err, "xml: %s.%s: value {%s} is not of the detected kind {%s}: consider increasing %s",
+				tbl.name, col.name, s, col.kind, driver.OptIngestSampleSize.Key())
+		}
+		return nil
+	}
+
+	// rowTable returns the table of the row of the frame at index i of
+	// the stack.
+	rowTable := func(i int) *table {
+		for ; i >= 0; i-- {
+			if stack[i].n.tbl != nil {
+				return stack[i].n.tbl
+			}
+		}
+		return nil
+	}
+
+	for i := 0; ; i++ {
+		if i%ctxCheckInterval == 0 {
+			if err = ctx.Err(); err != nil {
+				return errz.Err(err)
+			}
+		}
+
+		if tok, err = dec.Token(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errz.Wrap(err, "xml")
+		}
+
+		switch tok := tok.(type) {
+		case stdxml.StartElement:
+			fr := &frame{}
+			if len(stack) == 0 {
+				if tok.Name.Local != root.name {
+					return errz.Errorf("xml: document changed during ingest: root element {%s} is now {%s}",
+						root.name, tok.Name.Local)
+				}
+				fr.n = root
+			} else {
+				top := stack[len(stack)-1]
+				if fr.n = top.n.childByName[tok.Name.Local]; fr.n == nil {
+					return errz.Errorf("xml: document changed during ingest: unexpected element {%s}",
+						tok.Name.Local)
+				}
+				fr.row = top.row
+			}
+
+			if tbl := fr.n.tbl; tbl != nil {
+				tbl.seq++
+				parentRow := fr.row
+				fr.row = make([]any, len(tbl.cols))
+				fr.row[0] = tbl.seq
+				if tbl.parent != nil {
+					fr.row[1] = parentRow[0]
+				}
+			}
+			stack = append(stack, fr)
+
+			for _, attr := range tok.Attr {
+				if isNamespaceDecl(attr.Name) {
+					continue
+				}
+				if f := fr.n.attrByName[attr.Name.Local]; f != nil {
+					if err = setVal(rowTable(len(stack)-1), fr.row, f, strings.TrimSpace(attr.Value)); err != nil {
+						return err
+					}
+				}
+			}
+		case stdxml.CharData:
+			if len(stack) > 0 {
+				if top := stack[len(stack)-1]; top.n.text != nil && len(bytes.TrimSpace(tok)) > 0 {
+					top.text.Write(tok)
+				}
+			}
+		case stdxml.EndElement:
+			fr := stack[len(stack)-1]
+			if fr.n.text != nil {
+				if err = setVal(rowTable(len(stack)-1), fr.row, fr.n.text, strings.TrimSpace(fr.text.String())); err != nil {
+					return err
+				}
+			}
+			stack = stack[:len(stack)-1]
+
+			if tbl := fr.n.tbl; tbl != nil {
+				if err = tbl.stmt.Munge(fr.row); err != nil {
+					return err
+				}
+				if _, err = tbl.stmt.Exec(ctx, fr.row...); err != nil {
+					return errz.Wrapf(err, "xml: failed to insert to table {%s}", tbl.name)
+				}
+				bar.Incr(1)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Package xml implements the sq driver for generic XML documents. Unlike
+// the XML user drivers (package xmlud), which require a declarative
+// definition of the document's tables, this driver infers the tables from
+// the structure of the document.
+//
+// An element that repeats beneath its parent (such as each <book> in a
+// <catalog>) is a table, of which each such element is a row. The document
+// root element is also a table, with a single row, unless it holds no data
+// of its own. An element's attributes and text are columns of the table of
+// the nearest enclosing table element. The data of a non-repeating
+// descendant element is flattened into that table, with a column name
+// scoped by the element's path: for example, an <author> element with a
+// <name> child and an "id" attribute yields columns "author_name" and
+// "author_id".
+//
+// Each table has a generated primary key column "_id", holding the row's
+// sequence number. The table of a repeating element that's nested inside
+// another table element has a column "_<parent>_id", holding the "_id" of
+// the enclosing row, so that the tables can be joined.
+package xml
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/lg/lgm"
+	"github.com/neilotoole/sq/libsq/core/options"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+// Provider implements driver.Provider.
+type Provider struct {
+	Log      *slog.Logger
+	Files    *files.Files
+	Ingester driver.GripOpenIngester
+}
+
+// DriverFor implements driver.Provider.
+func (p *Provider) DriverFor(typ drivertype.Type) (driver.Driver, error) {
+	if typ != drivertype.XML {
+		return nil, errz.Errorf("unsupported driver type {%s}", typ)
+	}
+
+	return &Driver{log: p.Log, ingester: p.Ingester, files: p.Files}, nil
+}
+
+// Driver implements driver.Driver.
+type Driver struct {
+	log      *slog.Logger
+	ingester driver.GripOpenIngester
+	files    *files.Files
+}
+
+// DriverMetadata implements driver.Driver.
+func (d *Driver) DriverMetadata() driver.Metadata {
+	return driver.Metadata{
+		Type:        drivertype.XML,
+		Description: "XML",
+		Doc:         "https://en.wikipedia.org/wiki/XML",
+	}
+}
+
+// Open implements driver.Driver.
+func (d *Driver) Open(ctx context.Context, src *source.Source, _ driver.AccessMode) (driver.Grip, error) {
+	log := lg.FromContext(ctx).With(lga.Src, src)
+	log.Debug(lgm.OpenSrc, lga.Src, src)
+
+	g := &grip{
+		log:   log,
+		src:   src,
+		files: d.files,
+	}
+
+	allowCache := driver.OptIngestCache.Get(options.FromContext(ctx))
+
+	ingestFn := func(ctx context.Context, destGrip driver.Grip) error {
+		log.Debug("Ingest XML", lga.Src, src)
+		return ingestXML(ctx, d.files, src, destGrip)
+	}
+
+	var err error
+	if g.dbGrip, err = d.ingester.OpenIngest(ctx, src, allowCache, ingestFn); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// ValidateSource implements driver.Driver.
+func (d *Driver) ValidateSource(src *source.Source) (*source.Source, error) {
+	d.log.Debug("Validating source", lga.Src, src)
+	if src.Type != drivertype.XML {
+		return nil, errz.Errorf("expected driver type {%s} but got {%s}", drivertype.XML, src.Type)
+	}
+
+	return src, nil
+}
+
+// Ping implements driver.Driver.
+func (d *Driver) Ping(ctx context.Context, src *source.Source, _ driver.AccessMode) error {
+	return d.files.Ping(ctx, src)
+}
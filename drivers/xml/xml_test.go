@@ -0,0 +1,227 @@
+package xml_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/drivers/xml"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lgt"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/tu"
+)
+
+func TestDetectXML(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      string
+		want      drivertype.Type
+		wantScore float32
+	}{
+		{name: "decl", data: `<?xml version="1.0"?><a><b>1</b></a>`, want: drivertype.XML, wantScore: 1.0},
+		{name: "no_decl", data: "\n  <a><b>1</b></a>", want: drivertype.XML, wantScore: 0.9},
+		{name: "comment", data: "<!-- hello --><a/>", want: drivertype.XML, wantScore: 0.9},
+		{name: "bom", data: "\xef\xbb\xbf<?xml version=\"1.0\"?><a/>", want: drivertype.XML, wantScore: 1.0},
+		{name: "html", data: "<!DOCTYPE html><html><body><p>x</p></body></html>"},
+		{name: "json", data: `{"a": "<b/>"}`},
+		{name: "csv", data: "a,b\n1,2\n"},
+		{name: "empty"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newRdrFn := func(_ context.Context) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(tc.data)), nil
+			}
+			ctx := lg.NewContext(context.Background(), lgt.New(t))
+
+			gotType, gotScore, gotErr := xml.DetectXML(ctx, newRdrFn)
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.want, gotType)
+			require.Equal(t, tc.wantScore, gotScore)
+		})
+	}
+}
+
+func TestSmoke(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@xml_actor",
+		Type:     drivertype.XML,
+		Location: filepath.Join("testdata", "actor.xml"),
+	})
+
+	sink, err := th.QuerySLQ(src.Handle+".actor", nil)
+	require.NoError(t, err)
+	require.Equal(t, append([]string{"_id"}, sakila.TblActorCols()...), sink.RecMeta.MungedNames())
+	require.Equal(t, sakila.TblActorCount, len(sink.Recs))
+	require.Equal(t, int64(1), stringz.Val(sink.Recs[0][0]))
+	require.Equal(t, int64(1), stringz.Val(sink.Recs[0][1]))
+	require.Equal(t, "PENELOPE", stringz.Val(sink.Recs[0][2]))
+}
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@xml_actor",
+		Type:     drivertype.XML,
+		Location: filepath.Join("testdata", "actor.xml"),
+	})
+	csvSrc := th.Source(sakila.CSVActor)
+
+	sink, err := th.QuerySLQ(src.Handle+".actor | join("+csvSrc.Handle+".data, .actor_id) | .[0]", nil)
+	require.NoError(t, err)
+	require.Len(t, sink.Recs, 1)
+	require.Equal(t, "PENELOPE", stringz.Val(sink.Recs[0][2]))
+}
+
+func TestIngest_Catalog(t *testing.T) {
+	tu.SkipIssueWindows(t, tu.GH355SQLiteDecimalWin)
+	t.Parallel()
+
+	th := testh.New(t)
+	src := th.Add(&source.Source{
+		Handle:   "@xml_catalog",
+		Type:     drivertype.XML,
+		Location: filepath.Join("testdata", "catalog.xml"),
+	})
+
+	srcMeta, err := th.SourceMetadata(src)
+	require.NoError(t, err)
+	require.Equal(t, []string{"author", "book", "catalog", "review"}, srcMeta.TableNames())
+
+	sink, err := th.QuerySQL(src, nil, "SELECT * FROM catalog")
+	require.NoError(t, err)
+	require.Equal(t,
+		[]string{"_id", "version", "generated", "publisher_country", "publisher_name"},
+		sink.RecMeta.MungedNames())
+	require.Len(t, sink.Recs, 1)
+	require.Equal(t, "UK", stringz.Val(sink.Recs[0][3]))
+	require.Equal(t, "Acme Books", stringz.Val(sink.Recs[0][4]))
+
+	sink, err = th.QuerySQL(src, nil, "SELECT * FROM book ORDER BY _id")
+	require.NoError(t, err)
+	require.Equal(t,
+		[]string{"_id", "_catalog_id", "id", "available", "title", "price", "price_currency", "published"},
+		sink.RecMeta.MungedNames())
+	require.Len(t, sink.Recs, 3)
+	rec := sink.Recs[0]
+	require.Equal(t, int64(1), stringz.Val(rec[1]))
+	require.Equal(t, "bk101", stringz.Val(rec[2]))
+	require.Equal(t, true, stringz.Val(rec[3]))
+	require.Equal(t, "XML Developer's Guide", stringz.Val(rec[4]))
+	require.True(t, decimal.RequireFromString("44.95").Equal(rec[5].(decimal.Decimal)))
+	require.Equal(t, "USD", stringz.Val(rec[6]))
+	rec = sink.Recs[2]
+	require.Equal(t, "bk103", stringz.Val(rec[2]))
+	require.Nil(t, rec[3], "missing attribute should be null")
+	require.Nil(t, rec[6], "missing attribute should be null")
+	require.Nil(t, rec[7], "missing element should be null")
+
+	sink, err = th.QuerySQL(src, nil, "SELECT _book_id, name FROM author ORDER BY _id")
+	require.NoError(t, err)
+	require.Len(t, sink.Recs, 6)
+	wantAuthors := []struct {
+		bookID int64
+		name   string
+	}{
+		{1, "Gambardella, Matthew"},
+		{1, "Knorr, Stefan"},
+		{2, "Ralls, Kim"},
+		{3, "Corets, Eva"},
+		{3, "Randall, Cynthia"},
+		{3, "Thurman, Paula"},
+	}
+	for i, rec := range sink.Recs {
+		require.Equal(t, wantAuthors[i].bookID, stringz.Val(rec[0]))
+		require.Equal(t, wantAuthors[i].name, stringz.Val(rec[1]))
+	}
+
+	sink, err = th.QuerySQL(src, nil, "SELECT * FROM review ORDER BY _id")
+	require.NoError(t, err)
+	require.Equal(t, []string{"_id", "_book_id", "rating", "author", "text"}, sink.RecMeta.MungedNames())
+	require.Len(t, sink.Recs, 2)
+	require.Equal(t, int64(4), stringz.Val(sink.Recs[0][2]))
+	require.Equal(t, "An in-depth look at <XML> & friends.", stringz.Val(sink.Recs[0][4]))
+}
+
+func TestIngest_Inference(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+		// want is a map of table name to col names.
+		want map[string][]string
+	}{
+		{
+			name: "root_only",
+			data: `<person id="7"><name>Ada</name><born>1815</born></person>`,
+			want: map[string][]string{"person": {"_id", "id", "name", "born"}},
+		},
+		{
+			name: "root_dropped",
+			data: `<rows><row a="1"/><row a="2"/></rows>`,
+			want: map[string][]string{"row": {"_id", "a"}},
+		},
+		{
+			name: "text_and_attrs",
+			data: `<r><v unit="kg">1</v><v unit="g">2</v></r>`,
+			want: map[string][]string{"v": {"_id", "unit", "v"}},
+		},
+		{
+			name: "flattened",
+			data: `<r><p><q k="1"><s>x</s></q></p><p><q k="2"/></p></r>`,
+			want: map[string][]string{"p": {"_id", "q_k", "q_s"}},
+		},
+		{
+			name: "duplicate_names",
+			data: `<r><a><x>1</x><x>2</x></a><a/><z><x k="1"/><x k="2"/></z></r>`,
+			want: map[string][]string{
+				"a":   {"_id"},
+				"a_x": {"_id", "_a_id", "x"},
+				"z_x": {"_id", "k"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fpath := filepath.Join(t.TempDir(), tc.name+".xml")
+			require.NoError(t, os.WriteFile(fpath, []byte(tc.data), 0o600))
+
+			th := testh.New(t)
+			src := th.Add(&source.Source{
+				Handle:   "@xml_" + tc.name,
+				Type:     drivertype.XML,
+				Location: fpath,
+			})
+
+			srcMeta, err := th.SourceMetadata(src)
+			require.NoError(t, err)
+			require.Len(t, srcMeta.Tables, len(tc.want), srcMeta.TableNames())
+			for _, tblMeta := range srcMeta.Tables {
+				wantCols, ok := tc.want[tblMeta.Name]
+				require.True(t, ok, "unexpected table {%s}", tblMeta.Name)
+				gotCols := make([]string, len(tblMeta.Columns))
+				for i, col := range tblMeta.Columns {
+					gotCols[i] = col.Name
+				}
+				require.Equal(t, wantCols, gotCols)
+			}
+		})
+	}
+}
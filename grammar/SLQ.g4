@@ -77,15 +77,28 @@ grammar SLQ;
 // below define the tree structure; the lexer rules at the bottom of the
 // file define the alphabet of tokens that the parser sees.
 
-// stmtList is the top-level entry point: zero or more queries separated by
-// semicolons. Leading and trailing semicolons are tolerated, and runs of
-// consecutive semicolons are collapsed. This permits scripts like:
+// stmtList is the top-level entry point: one or more statements separated
+// by semicolons. Leading and trailing semicolons are tolerated, and runs of
+// consecutive semicolons are collapsed. In practice nearly all SLQ inputs
+// are a single query. The statements preceding the query must be `let`
+// statements, which name intermediate results for use by the statements
+// that follow:
 //
-//     ; @sakila | .actor ;; @sakila | .film ;
+//     let big = .payment | where(.amount > 10); .big | group_by(.customer_id) | .customer_id, count
 //
-// although in practice nearly all SLQ inputs are a single query and the
-// semicolon machinery is rarely used. `libsq/ast` builds an AST per query.
-stmtList: ';'* query ( ';'+ query)* ';'*;
+// `libsq/ast` builds a single AST from the final query, to which the lets
+// are attached.
+stmtList: ';'* stmt ( ';'+ stmt)* ';'*;
+
+// stmt is a single statement: a `let`, or a query.
+stmt: let | query;
+
+// let names the result of a query, i.e. a common table expression. A later
+// statement references the let via a table selector without a handle, e.g.
+// `.big` above. The renderer emits SQL's `WITH big AS (SELECT ...)`. When
+// the lets and the query span several sources, the tables are first copied
+// to the scratch DB, as for a cross-source join.
+let: 'let' ID '=' query;
 
 // query is a sequence of segments joined by the pipe operator. The pipe
 // is the defining feature of SLQ: each segment receives "what the previous
@@ -117,6 +130,7 @@ element
 	| handle
 	| selectorElement
 	| join
+	| setOp
 	| groupBy
 	| having
 	| orderBy
@@ -143,13 +157,88 @@ funcElement: func (alias)?;
 
 // func is the syntactic form `name(arg, arg, ...)`. The single-argument
 // form `name(*)` is also accepted (e.g. `count(*)`). Empty parens are
-// permitted: `name()`.
-func: funcName '(' ( expr ( ',' expr)* | '*')? ')';
+// permitted: `name()`. An optional trailing `over` makes the call a
+// window function.
+//
+// `cast` is special-cased: its second argument is the bare name of the
+// target kind (see `libsq/core/kind`), which is not an `expr`:
+//
+//     .actor | where(cast(.actor_id, text) == "1")
+//     .payment | cast(.amount, int):amount
+//
+// The renderer translates the kind to the DB's native type, e.g.
+// `CAST("amount" AS BIGINT)` for Postgres.
+func
+  : funcName '(' ( expr ( ',' expr)* | '*')? ')' (over)?
+  | 'cast' '(' expr ',' ID ')'
+  ;
+
+// over is the window clause of a window function, SQL's `OVER (...)`.
+// Both the partitioning and the ordering are optional:
+//
+//     .payment | .customer_id, sum(.amount) over(partition_by(.customer_id), order_by(.payment_date))
+//     .film | .title, rank() over(order_by(.length-)):len_rank
+//     .film | .title, lag(.title) over()
+//
+// The `order_by` here reuses the segment-level `orderBy` rule, but it is
+// scoped to the window: it is not the query's ORDER BY clause. Support for
+// window functions varies by database; the renderer returns an error for
+// dialects that lack it (e.g. MySQL before 8.0).
+over: 'over' '(' (partitionBy (',' orderBy)? | orderBy)? ')';
+
+// partitionBy is the `PARTITION BY` component of a window. Its terms have
+// the same shape as `group_by` terms.
+partitionBy: 'partition_by' '(' groupByTerm (',' groupByTerm)* ')';
 
 // funcName is the closed set of "portable" function names known to SLQ,
 // plus the open-ended `PROPRIETARY_FUNC_NAME` for DB-native escapes.
 // Adding a new portable function requires (a) adding the literal here and
 // (b) implementing/handling it in `libsq/ast` and the SQL renderer.
+//
+// `case` is the conditional expression, SQL's `CASE WHEN`. Its arguments
+// are pairs of (condition, value), optionally followed by a single
+// trailing "else" value:
+//
+//     .film | .title, case(.length < 60, "short", .length < 120, "medium", "long"):len
+//
+// renders as:
+//
+//     CASE WHEN "length" < 60 THEN 'short' WHEN "length" < 120 THEN 'medium'
+//     ELSE 'long' END AS "len"
+//
+// Syntactically `case` is just another `funcName`; the special rendering
+// is implemented by `render.doFuncCase`.
+//
+// `now`, `date_trunc`, `date_part` and `date_add` are the portable datetime
+// functions. The unit argument is a string literal, e.g. "month":
+//
+//     .payment | date_trunc("month", .payment_date):month, sum(.amount) | group_by(date_trunc("month", .payment_date))
+//     .payment | where(date_part("dow", .payment_date) == 0)
+//     .rental | where(.return_date > date_add(.rental_date, 7, "day"))
+//
+// Each dialect spells these differently; the rendering is implemented in
+// `libsq/ast/render/function_datetime.go`.
+//
+// `lower`, `upper`, `trim`, `substr`, `replace`, `length` and `concat` are
+// the portable string functions. `substr` is 1-based, and `concat` treats
+// NULL as the empty string:
+//
+//     .actor | concat(upper(.first_name), " ", lower(.last_name)):name
+//     .film | where(length(.title) > 20) | substr(.title, 1, 10)
+//
+// The rendering is implemented in `libsq/ast/render/function_string.go`.
+//
+// `coalesce` returns its first non-null argument, and `nullif` returns null
+// if its two arguments are equal, or else the first argument:
+//
+//     .address | coalesce(.address2, "n/a"):address2
+//     .film | .title, .rental_rate / nullif(.length, 0)
+//
+// The rendering is implemented in `libsq/ast/render/function_null.go`.
+//
+// `rank`, `dense_rank`, `row_number`, `ntile`, `lag`, `lead`, `first_value`
+// and `last_value` are window functions: they must be followed by an
+// `over` clause. Aggregates such as `sum` may optionally take one.
 funcName
   : 'sum'
 	| 'avg'
@@ -166,6 +255,28 @@ funcName
 	| 'iendswith'
 	| 'like'
 	| 'ilike'
+	| 'case'
+	| 'rank'
+	| 'dense_rank'
+	| 'row_number'
+	| 'ntile'
+	| 'lag'
+	| 'lead'
+	| 'first_value'
+	| 'last_value'
+	| 'now'
+	| 'date_trunc'
+	| 'date_part'
+	| 'date_add'
+	| 'lower'
+	| 'upper'
+	| 'trim'
+	| 'substr'
+	| 'replace'
+	| 'length'
+	| 'concat'
+	| 'coalesce'
+	| 'nullif'
 	| PROPRIETARY_FUNC_NAME
   ;
 
@@ -227,6 +338,31 @@ JOIN_TYPE
  ;
 
 
+// -----------------------------------------------------------------------------
+// setOp -- UNION, UNION ALL, INTERSECT, EXCEPT
+// -----------------------------------------------------------------------------
+//
+// A set operation combines the result of the query so far (the left
+// operand) with the result of a parenthesized sub-query (the right operand):
+//
+//     @staging.actor | .first_name | union(@prod.actor | .first_name)
+//     @sakila | .actor | union_all(.actor) | union_all(@csv.data)
+//
+// Set operations may be chained; they are applied left-to-right. The right
+// operand is a complete query, and can reference a different source than
+// the left operand: the engine then copies the operands into a scratch DB,
+// just as it does for a cross-source join.
+setOp: SET_OP '(' query ')';
+
+// SET_OP is the closed set of set operation keywords.
+SET_OP
+ : 'union'
+ | 'union_all'
+ | 'intersect'
+ | 'except'
+ ;
+
+
 // -----------------------------------------------------------------------------
 // uniqueFunc -- DISTINCT
 // -----------------------------------------------------------------------------
@@ -254,10 +390,11 @@ uniqueFunc: 'unique' | 'uniq';
 //     .actor | count()                        -- empty-paren form
 //     .actor | count(*)                       -- COUNT(*)
 //     .actor | count(.first_name):quantity    -- COUNT(<col>) with alias
+//     .actor | count() over(partition_by(.last_name))  -- window COUNT
 //
 // In particular, the bare form `count` (no parens) is the reason this
 // can't just live under `funcName`/`func`.
-countFunc: 'count' (LPAR (selector)? RPAR)? (alias)?;
+countFunc: 'count' (LPAR (selector)? RPAR (over)?)? (alias)?;
 
 
 // -----------------------------------------------------------------------------
@@ -345,8 +482,11 @@ having: HAVING '(' expr ')';
 
 ORDER_BY: 'order_by' | 'sort_by' | 'ob';
 
-// orderByTerm is a selector with an optional direction suffix.
-orderByTerm: selector ('+' | '-')?;
+// orderByTerm is a selector or function call (e.g. a `case` expression),
+// with an optional direction suffix.
+//
+//     .actor | order_by(case(.first_name == "TOM", 0, 1), .actor_id-)
+orderByTerm: (selector | func) ('+' | '-')?;
 
 orderBy: ORDER_BY '(' orderByTerm (',' orderByTerm)* ')';
 
@@ -396,7 +536,45 @@ selectorElement: (selector) (alias)?;
 //     .first_name:"given name"      -- alias with spaces
 //
 // `ALIAS_RESERVED` is a wart: see comment on that lexer rule below.
-alias: ALIAS_RESERVED | ':' (ARG | ID | STRING);
+alias: ALIAS_RESERVED | ':' (ARG | ID | STRING | aliasKeyword);
+
+// aliasKeyword is a keyword that is also accepted as an alias, e.g.
+// `.first_name:in`. Unlike ALIAS_RESERVED, the keyword remains its own
+// token, so an alias that merely starts with a keyword, such as
+// `:in_stock`, is still lexed as an ID. When a keyword is added to the
+// grammar, it should be added here too.
+aliasKeyword
+  : 'in'
+  | 'not'
+  | 'case'
+  | 'rank'
+  | 'dense_rank'
+  | 'row_number'
+  | 'ntile'
+  | 'lag'
+  | 'lead'
+  | 'first_value'
+  | 'last_value'
+  | 'over'
+  | 'partition_by'
+  | SET_OP
+  | 'cast'
+  | 'now'
+  | 'date_trunc'
+  | 'date_part'
+  | 'date_add'
+  | 'lower'
+  | 'upper'
+  | 'trim'
+  | 'substr'
+  | 'replace'
+  | 'length'
+  | 'concat'
+  | 'let'
+  | 'coalesce'
+  | 'nullif'
+  | 'is'
+  ;
 
 // ALIAS_RESERVED works around an ANTLR pain point: when an alias text
 // happens to be a reserved keyword (e.g. `:count` after a `count`
@@ -404,9 +582,9 @@ alias: ALIAS_RESERVED | ':' (ARG | ID | STRING);
 // parser would get confused. Each entry here is a literal `:keyword`
 // pre-baked as a single token, sidestepping the conflict.
 //
-// This list must be kept in sync as new keywords are added. The grammar
-// could likely be refactored to eliminate the need for this, but that
-// has not been done.
+// This list must not be extended: new keywords belong in `aliasKeyword`
+// instead. A `:keyword` token here would also swallow the prefix of a
+// longer alias, e.g. `:count` in `:counter`.
 ALIAS_RESERVED
     // TODO: Update ALIAS_RESERVED with all "keywords"
     : ':count'
@@ -494,6 +672,7 @@ exprElement: expr (alias)?;
 // tightest to loosest:
 //
 //     1.  '(' expr ')'         -- parenthesized
+//         subquery             -- parenthesized nested query
 //     2.  selector             -- column reference
 //     3.  literal              -- number / string / bool / null
 //     4.  arg                  -- $param reference
@@ -504,8 +683,10 @@ exprElement: expr (alias)?;
 //     9.  <<  >>  &            -- bitwise
 //     10. <  <=  >  >=
 //     11. ==  !=
-//     12. &&                   -- logical-and (and logical-or sibling)
-//     13. func                 -- function call
+//     12. in  not in           -- set membership
+//         is null  is not null -- null predicate (postfix)
+//     13. &&                   -- logical-and (and logical-or sibling)
+//     14. func                 -- function call
 //
 // IMPORTANT: `||` here is the SQL string-concatenation operator (as in
 // SQLite/Postgres `'a' || 'b' = 'ab'`), NOT logical-or. Logical-or, if
@@ -514,6 +695,7 @@ exprElement: expr (alias)?;
 // logical-or token.
 expr:
 	'(' expr ')'
+	| subquery
 	| selector
 	| literal
 	| arg
@@ -524,10 +706,46 @@ expr:
 	| expr ( '<<' | '>>' | '&') expr
 	| expr ( '<' | '<=' | '>' | '>=') expr
 	| expr ( '==' | '!=' |) expr
+	| expr inOperator (exprList | subquery)
+	| expr nullOperator
 	| expr '&&' expr
 	| func
 	;
 
+// inOperator is the set-membership operator: `in` or `not in`. The
+// right-hand side is a parenthesized `exprList`, or a `subquery`:
+//
+//     .actor | where(.actor_id in (1, 2, 3))
+//     .actor | where(.first_name not in ("TOM", $name))
+//     .customer | where(.customer_id in (.payment | where(.amount > 10) | .customer_id))
+inOperator: 'not'? 'in';
+
+// nullOperator is the postfix null predicate: `is null` or `is not null`.
+//
+//     .address | where(.address2 is null)
+//     .rental | where(.return_date is not null)
+//
+// The comparisons `== null` and `!= null` are rendered identically, but
+// the explicit form is preferred.
+nullOperator: 'is' 'not'? NULL;
+
+// exprList is a parenthesized, comma-separated list of one or more
+// expressions, e.g. the `(1, 2, $id)` of `.actor_id in (1, 2, $id)`.
+// Typically the members are literals or `$arg` references.
+exprList: '(' expr (',' expr)* ')';
+
+// subquery is a nested query, enclosed in parentheses. It must contain at
+// least one pipe, which distinguishes it from a parenthesized expression.
+// A subquery can be the right-hand side of `in`, a scalar operand, or, when
+// it's the first element of a query, a derived table:
+//
+//     .film | where(.length > (.film | avg(.length)))
+//     (.payment | .customer_id, sum(.amount):total | group_by(.customer_id)):t | where(.total > 150)
+//
+// A subquery can reference the tables of the enclosing query (a correlated
+// subquery), typically via a table alias. See `ast.SubqueryNode`.
+subquery: '(' segment ('|' segment)+ ')';
+
 // literal is the set of scalar literals admitted by an expression.
 literal: NN | NUMBER | BOOL | STRING | NULL;
 
@@ -10,7 +10,7 @@ import (
 // extractAliasValue returns the alias value from ctx, handling every token
 // shape permitted by the grammar's alias rule:
 //
-//	alias: ALIAS_RESERVED | ':' (ARG | ID | STRING)
+//	alias: ALIAS_RESERVED | ':' (ARG | ID | STRING | aliasKeyword)
 //
 // The shapes are:
 //
@@ -20,6 +20,8 @@ import (
 //     pre-baked ":keyword" token (e.g. ":count") that the grammar uses to
 //     work around the lexer otherwise tokenizing the keyword; the leading
 //     colon is trimmed to recover the name.
+//   - aliasKeyword: e.g. ":in" yields "in". The keyword is lexed as its
+//     own token, and its text is the alias.
 //   - ARG: e.g. ":$x" is rejected. An ARG (e.g. "$x") is a parameter
 //     reference, not a name, and sq does not substitute it in the alias
 //     position. Rather than silently produce a literal "$x" column, it is
@@ -38,6 +40,8 @@ func extractAliasValue(ctx slq.IAliasContext) (string, error) {
 		return stringz.StripDoubleQuote(ctx.STRING().GetText()), nil
 	case ctx.ALIAS_RESERVED() != nil:
 		return strings.TrimPrefix(ctx.ALIAS_RESERVED().GetText(), ":"), nil
+	case ctx.AliasKeyword() != nil:
+		return ctx.AliasKeyword().GetText(), nil
 	case ctx.ARG() != nil:
 		return "", errorf("alias may not be an argument reference: %s", ctx.ARG().GetText())
 	default:
@@ -45,6 +49,12 @@ func extractAliasValue(ctx slq.IAliasContext) (string, error) {
 	}
 }
 
+// VisitAliasKeyword implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitAliasKeyword(_ *slq.AliasKeywordContext) any {
+	// no-op: handled by extractAliasValue.
+	return nil
+}
+
 // VisitAlias implements slq.SLQVisitor.
 func (v *parseTreeVisitor) VisitAlias(ctx *slq.AliasContext) any {
 	if ctx == nil {
@@ -85,6 +85,54 @@ func TestAlias_ReservedWordApplied(t *testing.T) {
 	}
 }
 
+// aliasKeywords are the grammar's keywords (the aliasKeyword rule) that
+// must remain usable as an alias.
+var aliasKeywords = []string{
+	"in", "not",
+	"case",
+	"rank", "dense_rank", "row_number", "ntile", "lag", "lead", "first_value", "last_value", "over", "partition_by",
+	"union", "union_all", "intersect", "except",
+	"cast",
+	"now", "date_trunc", "date_part", "date_add",
+	"lower", "upper", "trim", "substr", "replace", "length", "concat",
+	"let",
+	"coalesce", "nullif", "is",
+}
+
+// TestAlias_KeywordApplied verifies that a keyword is accepted as an alias,
+// e.g. ".first_name:in", and that an alias that merely starts with a
+// keyword, e.g. ".first_name:in_stock", is lexed as a plain identifier.
+func TestAlias_KeywordApplied(t *testing.T) {
+	t.Parallel()
+
+	for _, kw := range aliasKeywords {
+		for _, alias := range []string{kw, kw + "_x"} {
+			testCases := []struct {
+				name string
+				in   string
+			}{
+				{"col-selector", `@sakila | .actor | .first_name:` + alias},
+				{"tbl-selector-multiseg", `@sakila | .actor:` + alias + ` | .first_name`},
+				{"handle-table", `@sakila.actor:` + alias + ` | .first_name`},
+				{"expr-element", `@sakila | .actor | (1+2):` + alias},
+				{"func", `@sakila | .actor | sum(.actor_id):` + alias},
+			}
+
+			for _, tc := range testCases {
+				t.Run(tu.Name(alias, tc.name), func(t *testing.T) {
+					t.Parallel()
+
+					log := lgt.New(t)
+					ast, err := Parse(log, tc.in)
+					require.NoError(t, err)
+					require.Equal(t, []string{alias}, collectAliases(ast),
+						"%q should be the only alias, exactly %q", tc.in, alias)
+				})
+			}
+		}
+	}
+}
+
 // TestAlias_ArgRejected verifies that an argument reference used as an alias
 // (the ARG token, e.g. ":$x") is rejected with an error rather than silently
 // dropped or applied as a literal "$x" column. See issue #646.
@@ -40,27 +40,33 @@ func Parse(log *slog.Logger, input string) (*AST, error) { //nolint:staticcheck
 	return ast, nil
 }
 
-// buildAST constructs sq's AST from a parse tree.
-func buildAST(log *slog.Logger, query slq.IQueryContext) (*AST, error) {
-	if query == nil {
+// buildAST constructs sq's AST from a parse tree, which is either a
+// statement list (*slq.StmtListContext), or a single query.
+func buildAST(log *slog.Logger, ptree antlr.ParseTree) (*AST, error) {
+	switch ptree := ptree.(type) {
+	case nil:
 		return nil, errorf("query is nil")
+	case *slq.StmtListContext:
+		return buildStmtList(log, ptree)
+	case *slq.QueryContext:
+		tree := &parseTreeVisitor{log: log}
+		if err := ptree.Accept(tree); err != nil {
+			return nil, err.(error)
+		}
+		return narrowAST(tree.ast)
+	default:
+		return nil, errorf("unable to convert %T to *parser.QueryContext", ptree)
 	}
+}
 
-	q, ok := query.(*slq.QueryContext)
-	if !ok {
-		return nil, errorf("unable to convert %T to *parser.QueryContext", query)
-	}
-
-	tree := &parseTreeVisitor{log: log}
-
-	if err := q.Accept(tree); err != nil {
-		return nil, err.(error)
-	}
-
+// narrowAST runs the narrowing visitors over a freshly visited AST,
+// converting generic nodes (e.g. SelectorNode) into their specific types.
+func narrowAST(a *AST) (*AST, error) {
 	visitors := []struct {
 		typ reflect.Type
 		fn  nodeVisitorFn
 	}{
+		{typeExprElementNode, narrowDerivedTable},
 		{typeSelectorNode, narrowTblSel},
 		{typeSelectorNode, narrowTblColSel},
 		{typeSelectorNode, narrowColSel},
@@ -68,13 +74,13 @@ func buildAST(log *slog.Logger, query slq.IQueryContext) (*AST, error) {
 	}
 
 	for _, visitor := range visitors {
-		w := NewWalker(tree.ast).AddVisitor(visitor.typ, visitor.fn)
+		w := NewWalker(a).AddVisitor(visitor.typ, visitor.fn)
 		if err := w.Walk(); err != nil {
 			return nil, err
 		}
 	}
 
-	return tree.ast, nil
+	return a, nil
 }
 
 // verify performs additional checks on the state of the built AST.
@@ -94,9 +100,10 @@ var _ Node = (*AST)(nil)
 
 // AST is the Abstract Syntax Tree. It is the root node of a SQL query/stmt.
 type AST struct {
-	ctx  *slq.QueryContext
+	ctx  antlr.ParserRuleContext
 	text string
 	segs []*SegmentNode
+	lets []*LetNode
 }
 
 // ast implements ast.Node.
@@ -130,6 +137,13 @@ func (a *AST) Segments() []*SegmentNode {
 	return a.segs
 }
 
+// Lets returns the let statements that precede the query, in order.
+// Only the AST returned by Parse has lets; the ASTs of nested queries,
+// such as subqueries, do not.
+func (a *AST) Lets() []*LetNode {
+	return a.lets
+}
+
 // AddChild implements ast.Node.
 func (a *AST) AddChild(node Node) error {
 	seg, ok := node.(*SegmentNode)
@@ -165,12 +179,15 @@ func (a *AST) context() antlr.ParseTree {
 
 // setContext implements ast.Node.
 func (a *AST) setContext(ctx antlr.ParseTree) error {
-	qCtx, ok := ctx.(*slq.QueryContext)
-	if !ok {
-		return errorf("expected *parser.QueryContext, but got %T", ctx)
+	switch ctx := ctx.(type) {
+	case *slq.QueryContext:
+		a.ctx = ctx
+	case *slq.SubqueryContext:
+		a.ctx = ctx
+	default:
+		return errorf("expected *parser.QueryContext or *parser.SubqueryContext, but got %T", ctx)
 	}
 
-	a.ctx = qCtx
 	return nil
 }
 
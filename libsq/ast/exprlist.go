@@ -0,0 +1,82 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+// Operator text values for the set-membership operators. The text of
+// the OperatorNode produced for an inOperator is normalized to one of
+// these values, regardless of the whitespace in the input.
+const (
+	OpIn    = "in"
+	OpNotIn = "not in"
+)
+
+var _ Node = (*ExprListNode)(nil)
+
+// ExprListNode models a parenthesized list of expressions, such as the
+// right-hand side of an "in" operator.
+//
+//	.actor | where(.actor_id in (1, 2, 3))
+//
+// In the example above, the ExprListNode is "(1, 2, 3)", and each of its
+// children is an element of the list.
+type ExprListNode struct {
+	baseNode
+}
+
+// AddChild implements Node.
+func (n *ExprListNode) AddChild(child Node) error {
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements Node.
+func (n *ExprListNode) SetChildren(children []Node) error {
+	n.doSetChildren(children)
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *ExprListNode) String() string {
+	return nodeString(n)
+}
+
+// VisitExprList implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitExprList(ctx *slq.ExprListContext) any {
+	node := &ExprListNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+	if err := node.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	if len(ctx.AllExpr()) == 0 {
+		return errorf("parser: invalid expression list: %s", ctx.GetText())
+	}
+
+	for _, exprCtx := range ctx.AllExpr() {
+		if e := v.using(node, func() any {
+			return v.VisitExpr(exprCtx.(*slq.ExprContext))
+		}); e != nil {
+			return e
+		}
+	}
+
+	return v.cur.AddChild(node)
+}
+
+// VisitInOperator implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitInOperator(ctx *slq.InOperatorContext) any {
+	op := &OperatorNode{}
+	op.ctx = ctx
+	op.text = OpIn
+	if ctx.GetChildCount() == 2 {
+		op.text = OpNotIn
+	}
+
+	if err := op.SetParent(v.cur); err != nil {
+		return err
+	}
+	return v.cur.AddChild(op)
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+	"github.com/neilotoole/sq/libsq/core/kind"
 )
 
 const (
@@ -24,8 +25,44 @@ const (
 	FuncNameIEndsWith   = "iendswith"
 	FuncNameLike        = "like"
 	FuncNameILike       = "ilike"
+	FuncNameCase        = "case"
+	FuncNameCast        = "cast"
+	FuncNameNow         = "now"
+	FuncNameDateTrunc   = "date_trunc"
+	FuncNameDatePart    = "date_part"
+	FuncNameDateAdd     = "date_add"
+	FuncNameLower       = "lower"
+	FuncNameUpper       = "upper"
+	FuncNameTrim        = "trim"
+	FuncNameSubstr      = "substr"
+	FuncNameReplace     = "replace"
+	FuncNameLength      = "length"
+	FuncNameConcat      = "concat"
+	FuncNameCoalesce    = "coalesce"
+	FuncNameNullIf      = "nullif"
+	FuncNameRank        = "rank"
+	FuncNameDenseRank   = "dense_rank"
+	FuncNameRowNumber   = "row_number"
+	FuncNameNTile       = "ntile"
+	FuncNameLag         = "lag"
+	FuncNameLead        = "lead"
+	FuncNameFirstValue  = "first_value"
+	FuncNameLastValue   = "last_value"
 )
 
+// IsWindowOnlyFunc returns true if fnName is the name of a function that
+// is only valid as a window function, i.e. it must have an over() clause.
+// For example, "rank".
+func IsWindowOnlyFunc(fnName string) bool {
+	switch fnName {
+	case FuncNameRank, FuncNameDenseRank, FuncNameRowNumber, FuncNameNTile,
+		FuncNameLag, FuncNameLead, FuncNameFirstValue, FuncNameLastValue:
+		return true
+	default:
+		return false
+	}
+}
+
 var (
 	_ Node         = (*FuncNode)(nil)
 	_ ResultColumn = (*FuncNode)(nil)
@@ -37,6 +74,9 @@ type FuncNode struct {
 	alias  string
 	baseNode
 	proprietary bool
+
+	// castKind is the target kind of a cast() function.
+	castKind kind.Kind
 }
 
 // resultColumn implements ast.ResultColumn.
@@ -59,6 +99,31 @@ func (fn *FuncNode) IsProprietary() bool {
 	return fn.proprietary
 }
 
+// CastKind returns the target kind of a cast() function, e.g. kind.Int
+// for "cast(.x, int)". For any other function, kind.Unknown is returned.
+func (fn *FuncNode) CastKind() kind.Kind {
+	return fn.castKind
+}
+
+// Window returns the function's window (the "over()" clause), or nil
+// if fn is not a window function.
+func (fn *FuncNode) Window() *WindowNode {
+	if len(fn.children) == 0 {
+		return nil
+	}
+	w, _ := fn.children[len(fn.children)-1].(*WindowNode)
+	return w
+}
+
+// Args returns the function's arguments. This is the same as Children,
+// except that the WindowNode (if any) is excluded.
+func (fn *FuncNode) Args() []Node {
+	if fn.Window() == nil {
+		return fn.children
+	}
+	return fn.children[:len(fn.children)-1]
+}
+
 // String returns a log/debug-friendly representation.
 func (fn *FuncNode) String() string {
 	str := nodeString(fn)
@@ -141,6 +206,10 @@ func (v *parseTreeVisitor) VisitFuncElement(ctx *slq.FuncElementContext) any {
 
 // VisitFunc implements slq.SLQVisitor.
 func (v *parseTreeVisitor) VisitFunc(ctx *slq.FuncContext) any {
+	if ctx.FuncName() == nil {
+		return v.visitFuncCast(ctx)
+	}
+
 	node := &FuncNode{fnName: ctx.FuncName().GetText()}
 	if node.fnName[0] == '_' {
 		node.fnName = node.fnName[1:]
@@ -166,6 +235,36 @@ func (v *parseTreeVisitor) VisitFunc(ctx *slq.FuncContext) any {
 	return v.cur.AddChild(node)
 }
 
+// visitFuncCast handles the cast() form of slq.FuncContext, i.e.
+// "cast(expr, kind)".
+func (v *parseTreeVisitor) visitFuncCast(ctx *slq.FuncContext) any {
+	node := &FuncNode{fnName: FuncNameCast}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+	if err := node.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	if ctx.ID() == nil || len(ctx.AllExpr()) != 1 {
+		return errorf("invalid cast(): expected cast(expr, kind): %s", node.text)
+	}
+
+	knd := kind.Unknown
+	if err := knd.UnmarshalText([]byte(ctx.ID().GetText())); err != nil || knd == kind.Unknown || knd == kind.Null {
+		return errorf("invalid cast(): unsupported kind {%s}: %s", ctx.ID().GetText(), node.text)
+	}
+	node.castKind = knd
+
+	if err := v.using(node, func() any {
+		return v.VisitExpr(ctx.Expr(0).(*slq.ExprContext))
+	}); err != nil {
+		return err
+	}
+
+	node.alias = ctx.GetText()
+	return v.cur.AddChild(node)
+}
+
 // VisitCountFunc implements antlr.ParseTreeVisitor.
 // Although the "count" func has special handling in the grammar (because
 // it has a no-arg form, e.g. ".actor | count"), a regular FuncNode is
@@ -185,7 +284,7 @@ func (v *parseTreeVisitor) VisitCountFunc(ctx *slq.CountFuncContext) any {
 		return err
 	}
 
-	if len(node.Children()) == 0 && ctx.Alias() == nil {
+	if len(node.Args()) == 0 && ctx.Over() == nil && ctx.Alias() == nil {
 		// If there's no children, and no alias, we explicitly set the
 		// alias to "count".
 		node.alias = "count"
@@ -50,10 +50,15 @@ func (in *Inspector) FindNodes(typ reflect.Type) []Node {
 	return nodes
 }
 
-// FindHandles returns all handles mentioned in the AST.
+// FindHandles returns all handles mentioned in the AST, including those
+// mentioned by its let statements.
 func (in *Inspector) FindHandles() []string {
 	var handles []string
 
+	for _, let := range in.ast.lets {
+		handles = append(handles, NewInspector(let.query).FindHandles()...)
+	}
+
 	if err := walkWith(in.ast, typeHandleNode, func(_ *Walker, node Node) error {
 		handles = append(handles, node.Text())
 		return nil
@@ -195,7 +200,7 @@ func (in *Inspector) FindHavingNode() (*HavingNode, error) {
 }
 
 // FindTableSegments returns the segments that have at least one child
-// that is a ast.TblSelectorNode.
+// that is a ast.TblSelectorNode, or a derived table ast.SubqueryNode.
 func (in *Inspector) FindTableSegments() []*SegmentNode {
 	segs := in.ast.Segments()
 	selSegs := make([]*SegmentNode, 0, 2)
@@ -206,12 +211,31 @@ func (in *Inspector) FindTableSegments() []*SegmentNode {
 				selSegs = append(selSegs, seg)
 				break
 			}
+			if _, ok := child.(*SubqueryNode); ok {
+				// A derived table.
+				selSegs = append(selSegs, seg)
+				break
+			}
 		}
 	}
 
 	return selSegs
 }
 
+// FindDerivedTable returns the top-level (child of a segment) SubqueryNode,
+// i.e. the query's derived table, or nil if not found.
+func (in *Inspector) FindDerivedTable() *SubqueryNode {
+	for _, seg := range in.ast.Segments() {
+		for _, child := range seg.Children() {
+			if sub, ok := child.(*SubqueryNode); ok {
+				return sub
+			}
+		}
+	}
+
+	return nil
+}
+
 // FindFirstHandle returns the first handle mentioned in the query,
 // or returns empty string.
 func (in *Inspector) FindFirstHandle() (handle string) {
@@ -280,6 +304,47 @@ func (in *Inspector) FindJoins() ([]*JoinNode, error) {
 	return joinNodes, nil
 }
 
+// FindSubqueries returns the SubqueryNode instances nested in the
+// expressions of the query, e.g. in a where() clause. The query's
+// derived table, if any, is not included (see FindDerivedTable), nor
+// are any subqueries nested in the returned subqueries.
+func (in *Inspector) FindSubqueries() ([]*SubqueryNode, error) {
+	nodes := in.FindNodes(typeSubqueryNode)
+	subs := make([]*SubqueryNode, 0, len(nodes))
+	for i := range nodes {
+		sub, ok := nodes[i].(*SubqueryNode)
+		if !ok {
+			return nil, errz.Errorf("expected %T but got %T", (*SubqueryNode)(nil), nodes[i])
+		}
+		if !sub.IsDerivedTable() {
+			subs = append(subs, sub)
+		}
+	}
+
+	return subs, nil
+}
+
+// FindSetOps returns the top-level SetOpNode instances, in the order
+// that they appear in the query. Any SetOpNode nested in the operand
+// of another SetOpNode is not returned.
+func (in *Inspector) FindSetOps() ([]*SetOpNode, error) {
+	var setOps []*SetOpNode
+	for _, seg := range in.ast.Segments() {
+		nodes := nodesWithType(seg.Children(), typeSetOpNode)
+		if len(nodes) == 0 {
+			continue
+		}
+
+		if len(seg.Children()) != 1 {
+			return nil, errorf("set operation must be the only element in its segment: %s", seg.Text())
+		}
+
+		setOps = append(setOps, nodes[0].(*SetOpNode))
+	}
+
+	return setOps, nil
+}
+
 // FindUniqueNode returns any UniqueNode, or nil.
 func (in *Inspector) FindUniqueNode() (*UniqueNode, error) {
 	nodes := in.FindNodes(typeUniqueNode)
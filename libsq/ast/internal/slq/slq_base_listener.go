@@ -26,6 +26,18 @@ func (s *BaseSLQListener) EnterStmtList(ctx *StmtListContext) {}
 // ExitStmtList is called when production stmtList is exited.
 func (s *BaseSLQListener) ExitStmtList(ctx *StmtListContext) {}
 
+// EnterStmt is called when production stmt is entered.
+func (s *BaseSLQListener) EnterStmt(ctx *StmtContext) {}
+
+// ExitStmt is called when production stmt is exited.
+func (s *BaseSLQListener) ExitStmt(ctx *StmtContext) {}
+
+// EnterLet is called when production let is entered.
+func (s *BaseSLQListener) EnterLet(ctx *LetContext) {}
+
+// ExitLet is called when production let is exited.
+func (s *BaseSLQListener) ExitLet(ctx *LetContext) {}
+
 // EnterQuery is called when production query is entered.
 func (s *BaseSLQListener) EnterQuery(ctx *QueryContext) {}
 
@@ -56,6 +68,18 @@ func (s *BaseSLQListener) EnterFunc(ctx *FuncContext) {}
 // ExitFunc is called when production func is exited.
 func (s *BaseSLQListener) ExitFunc(ctx *FuncContext) {}
 
+// EnterOver is called when production over is entered.
+func (s *BaseSLQListener) EnterOver(ctx *OverContext) {}
+
+// ExitOver is called when production over is exited.
+func (s *BaseSLQListener) ExitOver(ctx *OverContext) {}
+
+// EnterPartitionBy is called when production partitionBy is entered.
+func (s *BaseSLQListener) EnterPartitionBy(ctx *PartitionByContext) {}
+
+// ExitPartitionBy is called when production partitionBy is exited.
+func (s *BaseSLQListener) ExitPartitionBy(ctx *PartitionByContext) {}
+
 // EnterFuncName is called when production funcName is entered.
 func (s *BaseSLQListener) EnterFuncName(ctx *FuncNameContext) {}
 
@@ -74,6 +98,12 @@ func (s *BaseSLQListener) EnterJoinTable(ctx *JoinTableContext) {}
 // ExitJoinTable is called when production joinTable is exited.
 func (s *BaseSLQListener) ExitJoinTable(ctx *JoinTableContext) {}
 
+// EnterSetOp is called when production setOp is entered.
+func (s *BaseSLQListener) EnterSetOp(ctx *SetOpContext) {}
+
+// ExitSetOp is called when production setOp is exited.
+func (s *BaseSLQListener) ExitSetOp(ctx *SetOpContext) {}
+
 // EnterUniqueFunc is called when production uniqueFunc is entered.
 func (s *BaseSLQListener) EnterUniqueFunc(ctx *UniqueFuncContext) {}
 
@@ -140,6 +170,12 @@ func (s *BaseSLQListener) EnterAlias(ctx *AliasContext) {}
 // ExitAlias is called when production alias is exited.
 func (s *BaseSLQListener) ExitAlias(ctx *AliasContext) {}
 
+// EnterAliasKeyword is called when production aliasKeyword is entered.
+func (s *BaseSLQListener) EnterAliasKeyword(ctx *AliasKeywordContext) {}
+
+// ExitAliasKeyword is called when production aliasKeyword is exited.
+func (s *BaseSLQListener) ExitAliasKeyword(ctx *AliasKeywordContext) {}
+
 // EnterArg is called when production arg is entered.
 func (s *BaseSLQListener) EnterArg(ctx *ArgContext) {}
 
@@ -176,6 +212,30 @@ func (s *BaseSLQListener) EnterExpr(ctx *ExprContext) {}
 // ExitExpr is called when production expr is exited.
 func (s *BaseSLQListener) ExitExpr(ctx *ExprContext) {}
 
+// EnterInOperator is called when production inOperator is entered.
+func (s *BaseSLQListener) EnterInOperator(ctx *InOperatorContext) {}
+
+// ExitInOperator is called when production inOperator is exited.
+func (s *BaseSLQListener) ExitInOperator(ctx *InOperatorContext) {}
+
+// EnterNullOperator is called when production nullOperator is entered.
+func (s *BaseSLQListener) EnterNullOperator(ctx *NullOperatorContext) {}
+
+// ExitNullOperator is called when production nullOperator is exited.
+func (s *BaseSLQListener) ExitNullOperator(ctx *NullOperatorContext) {}
+
+// EnterExprList is called when production exprList is entered.
+func (s *BaseSLQListener) EnterExprList(ctx *ExprListContext) {}
+
+// ExitExprList is called when production exprList is exited.
+func (s *BaseSLQListener) ExitExprList(ctx *ExprListContext) {}
+
+// EnterSubquery is called when production subquery is entered.
+func (s *BaseSLQListener) EnterSubquery(ctx *SubqueryContext) {}
+
+// ExitSubquery is called when production subquery is exited.
+func (s *BaseSLQListener) ExitSubquery(ctx *SubqueryContext) {}
+
 // EnterLiteral is called when production literal is entered.
 func (s *BaseSLQListener) EnterLiteral(ctx *LiteralContext) {}
 
@@ -11,6 +11,14 @@ func (v *BaseSLQVisitor) VisitStmtList(ctx *StmtListContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitStmt(ctx *StmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitLet(ctx *LetContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitQuery(ctx *QueryContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -31,6 +39,14 @@ func (v *BaseSLQVisitor) VisitFunc(ctx *FuncContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitOver(ctx *OverContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitPartitionBy(ctx *PartitionByContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitFuncName(ctx *FuncNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -43,6 +59,10 @@ func (v *BaseSLQVisitor) VisitJoinTable(ctx *JoinTableContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitSetOp(ctx *SetOpContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitUniqueFunc(ctx *UniqueFuncContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -87,6 +107,10 @@ func (v *BaseSLQVisitor) VisitAlias(ctx *AliasContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitAliasKeyword(ctx *AliasKeywordContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitArg(ctx *ArgContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -111,6 +135,22 @@ func (v *BaseSLQVisitor) VisitExpr(ctx *ExprContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSLQVisitor) VisitInOperator(ctx *InOperatorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitNullOperator(ctx *NullOperatorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitExprList(ctx *ExprListContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSLQVisitor) VisitSubquery(ctx *SubqueryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSLQVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -43,18 +43,25 @@ func slqlexerLexerInit() {
 		"DEFAULT_MODE",
 	}
 	staticData.LiteralNames = []string{
-		"", "';'", "'*'", "'sum'", "'avg'", "'max'", "'min'", "'schema'", "'catalog'",
-		"'rownum'", "'contains'", "'startswith'", "'endswith'", "'icontains'",
-		"'istartswith'", "'iendswith'", "'like'", "'ilike'", "'unique'", "'uniq'",
-		"'count'", "'+'", "'-'", "'.['", "'||'", "'/'", "'%'", "'<<'", "'>>'",
-		"'&'", "'&&'", "'~'", "'!'", "", "", "", "", "'having'", "", "", "",
-		"", "'null'", "", "", "", "'('", "')'", "'['", "']'", "','", "'|'",
-		"':'", "", "", "", "'<='", "'<'", "'>='", "'>'", "'!='", "'=='",
+		"", "';'", "'let'", "'='", "'*'", "'cast'", "'over'", "'partition_by'",
+		"'sum'", "'avg'", "'max'", "'min'", "'schema'", "'catalog'", "'rownum'",
+		"'contains'", "'startswith'", "'endswith'", "'icontains'", "'istartswith'",
+		"'iendswith'", "'like'", "'ilike'", "'case'", "'rank'", "'dense_rank'",
+		"'row_number'", "'ntile'", "'lag'", "'lead'", "'first_value'", "'last_value'",
+		"'now'", "'date_trunc'", "'date_part'", "'date_add'", "'lower'", "'upper'",
+		"'trim'", "'substr'", "'replace'", "'length'", "'concat'", "'coalesce'",
+		"'nullif'", "'unique'", "'uniq'", "'count'", "'+'", "'-'", "'in'", "'not'",
+		"'is'", "'.['", "'||'", "'/'", "'%'", "'<<'", "'>>'", "'&'", "'&&'",
+		"'~'", "'!'", "", "", "", "", "", "'having'", "", "", "", "", "'null'",
+		"", "", "", "'('", "')'", "'['", "']'", "','", "'|'", "':'", "", "",
+		"", "'<='", "'<'", "'>='", "'>'", "'!='", "'=='",
 	}
 	staticData.SymbolicNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "PROPRIETARY_FUNC_NAME",
-		"JOIN_TYPE", "WHERE", "GROUP_BY", "HAVING", "ORDER_BY", "ALIAS_RESERVED",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "PROPRIETARY_FUNC_NAME",
+		"JOIN_TYPE", "SET_OP", "WHERE", "GROUP_BY", "HAVING", "ORDER_BY", "ALIAS_RESERVED",
 		"ARG", "BOOL", "NULL", "ID", "IDNUM", "WS", "LPAR", "RPAR", "LBRA",
 		"RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER", "DIGITS", "LT_EQ",
 		"LT", "GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE", "STRING", "LINECOMMENT",
@@ -63,8 +70,12 @@ func slqlexerLexerInit() {
 		"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
 		"T__9", "T__10", "T__11", "T__12", "T__13", "T__14", "T__15", "T__16",
 		"T__17", "T__18", "T__19", "T__20", "T__21", "T__22", "T__23", "T__24",
-		"T__25", "T__26", "T__27", "T__28", "T__29", "T__30", "T__31", "PROPRIETARY_FUNC_NAME",
-		"JOIN_TYPE", "WHERE", "GROUP_BY", "HAVING", "ORDER_BY", "ALIAS_RESERVED",
+		"T__25", "T__26", "T__27", "T__28", "T__29", "T__30", "T__31", "T__32",
+		"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
+		"T__41", "T__42", "T__43", "T__44", "T__45", "T__46", "T__47", "T__48",
+		"T__49", "T__50", "T__51", "T__52", "T__53", "T__54", "T__55", "T__56",
+		"T__57", "T__58", "T__59", "T__60", "T__61", "PROPRIETARY_FUNC_NAME",
+		"JOIN_TYPE", "SET_OP", "WHERE", "GROUP_BY", "HAVING", "ORDER_BY", "ALIAS_RESERVED",
 		"ARG", "BOOL", "NULL", "ID", "IDNUM", "WS", "LPAR", "RPAR", "LBRA",
 		"RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER", "INTF", "DIGITS",
 		"EXP", "LT_EQ", "LT", "GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE",
@@ -74,375 +85,509 @@ func slqlexerLexerInit() {
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 65, 821, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
-		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
-		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
-		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
-		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
-		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
-		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
-		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
-		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
-		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
-		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
-		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
-		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
-		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
-		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78,
-		7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7,
-		83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88,
-		2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2,
-		94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1,
-		2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1,
-		5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1,
-		7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1,
-		8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10,
-		1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1,
-		11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12,
-		1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1,
-		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14,
-		1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1,
-		15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17,
-		1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 22,
-		1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 25, 1, 25, 1, 26, 1,
-		26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 30,
-		1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
-		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
-		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
-		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
-		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
-		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
-		1, 33, 1, 33, 3, 33, 482, 8, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1,
-		34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 3, 34, 495, 8, 34, 1, 35, 1, 35,
-		1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35, 507, 8,
-		35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37,
-		1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1,
-		37, 1, 37, 1, 37, 1, 37, 3, 37, 533, 8, 37, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
-		38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
-		38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 3,
-		38, 591, 8, 38, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40,
-		1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 605, 8, 40, 1, 41, 1, 41, 1, 41, 1,
-		41, 1, 41, 1, 42, 1, 42, 5, 42, 614, 8, 42, 10, 42, 12, 42, 617, 9, 42,
-		1, 43, 4, 43, 620, 8, 43, 11, 43, 12, 43, 621, 1, 43, 1, 43, 5, 43, 626,
-		8, 43, 10, 43, 12, 43, 629, 9, 43, 1, 44, 4, 44, 632, 8, 44, 11, 44, 12,
-		44, 633, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 1, 47, 1, 48,
-		1, 48, 1, 49, 1, 49, 1, 50, 1, 50, 1, 51, 1, 51, 1, 52, 1, 52, 1, 53, 1,
-		53, 3, 53, 656, 8, 53, 1, 53, 1, 53, 1, 53, 4, 53, 661, 8, 53, 11, 53,
-		12, 53, 662, 1, 53, 3, 53, 666, 8, 53, 1, 53, 3, 53, 669, 8, 53, 1, 53,
-		1, 53, 1, 53, 1, 53, 3, 53, 675, 8, 53, 1, 53, 3, 53, 678, 8, 53, 1, 54,
-		1, 54, 1, 54, 5, 54, 683, 8, 54, 10, 54, 12, 54, 686, 9, 54, 3, 54, 688,
-		8, 54, 1, 55, 4, 55, 691, 8, 55, 11, 55, 12, 55, 692, 1, 56, 1, 56, 3,
-		56, 697, 8, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 59,
-		1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1,
-		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 63, 723, 8, 63, 1, 64, 1, 64,
-		1, 64, 1, 64, 5, 64, 729, 8, 64, 10, 64, 12, 64, 732, 9, 64, 1, 65, 1,
-		65, 1, 65, 5, 65, 737, 8, 65, 10, 65, 12, 65, 740, 9, 65, 1, 65, 1, 65,
-		1, 66, 1, 66, 1, 66, 3, 66, 747, 8, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1,
-		67, 1, 67, 1, 68, 1, 68, 1, 69, 1, 69, 1, 70, 1, 70, 1, 71, 1, 71, 1, 72,
-		1, 72, 1, 73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 76, 1, 76, 1, 77, 1,
-		77, 1, 78, 1, 78, 1, 79, 1, 79, 1, 80, 1, 80, 1, 81, 1, 81, 1, 82, 1, 82,
-		1, 83, 1, 83, 1, 84, 1, 84, 1, 85, 1, 85, 1, 86, 1, 86, 1, 87, 1, 87, 1,
-		88, 1, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 1, 93,
-		1, 93, 1, 94, 1, 94, 1, 95, 1, 95, 1, 96, 1, 96, 5, 96, 813, 8, 96, 10,
-		96, 12, 96, 816, 9, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 814, 0, 97, 1, 1,
-		3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15, 8, 17, 9, 19, 10, 21, 11, 23,
-		12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17, 35, 18, 37, 19, 39, 20, 41,
-		21, 43, 22, 45, 23, 47, 24, 49, 25, 51, 26, 53, 27, 55, 28, 57, 29, 59,
-		30, 61, 31, 63, 32, 65, 33, 67, 34, 69, 35, 71, 36, 73, 37, 75, 38, 77,
-		39, 79, 40, 81, 41, 83, 42, 85, 43, 87, 44, 89, 45, 91, 46, 93, 47, 95,
-		48, 97, 49, 99, 50, 101, 51, 103, 52, 105, 53, 107, 54, 109, 0, 111, 55,
-		113, 0, 115, 56, 117, 57, 119, 58, 121, 59, 123, 60, 125, 61, 127, 62,
-		129, 63, 131, 64, 133, 0, 135, 0, 137, 0, 139, 0, 141, 0, 143, 0, 145,
-		0, 147, 0, 149, 0, 151, 0, 153, 0, 155, 0, 157, 0, 159, 0, 161, 0, 163,
-		0, 165, 0, 167, 0, 169, 0, 171, 0, 173, 0, 175, 0, 177, 0, 179, 0, 181,
-		0, 183, 0, 185, 0, 187, 0, 189, 0, 191, 0, 193, 65, 1, 0, 35, 3, 0, 65,
-		90, 95, 95, 97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97, 122, 1, 0, 48, 57,
-		3, 0, 9, 10, 13, 13, 32, 32, 1, 0, 49, 57, 2, 0, 69, 69, 101, 101, 2, 0,
-		43, 43, 45, 45, 2, 0, 34, 34, 92, 92, 8, 0, 34, 34, 47, 47, 92, 92, 98,
-		98, 102, 102, 110, 110, 114, 114, 116, 116, 3, 0, 48, 57, 65, 70, 97, 102,
-		2, 0, 65, 65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0,
-		68, 68, 100, 100, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103, 103, 2, 0,
-		72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106, 106, 2, 0,
-		75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109, 109, 2, 0,
-		78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112, 112, 2, 0,
-		81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115, 115, 2, 0,
-		84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118, 118, 2, 0,
-		87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121, 121, 2, 0,
-		90, 90, 122, 122, 838, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0,
-		0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0, 0, 13, 1,
-		0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21,
-		1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0,
-		29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0,
-		0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0,
-		0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0,
-		0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1,
-		0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67,
-		1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0,
-		75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0,
-		0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0,
-		0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0,
-		0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105,
-		1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0,
-		0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1,
-		0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0,
-		131, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 1, 195, 1, 0, 0, 0, 3, 197, 1, 0,
-		0, 0, 5, 199, 1, 0, 0, 0, 7, 203, 1, 0, 0, 0, 9, 207, 1, 0, 0, 0, 11, 211,
-		1, 0, 0, 0, 13, 215, 1, 0, 0, 0, 15, 222, 1, 0, 0, 0, 17, 230, 1, 0, 0,
-		0, 19, 237, 1, 0, 0, 0, 21, 246, 1, 0, 0, 0, 23, 257, 1, 0, 0, 0, 25, 266,
-		1, 0, 0, 0, 27, 276, 1, 0, 0, 0, 29, 288, 1, 0, 0, 0, 31, 298, 1, 0, 0,
-		0, 33, 303, 1, 0, 0, 0, 35, 309, 1, 0, 0, 0, 37, 316, 1, 0, 0, 0, 39, 321,
-		1, 0, 0, 0, 41, 327, 1, 0, 0, 0, 43, 329, 1, 0, 0, 0, 45, 331, 1, 0, 0,
-		0, 47, 334, 1, 0, 0, 0, 49, 337, 1, 0, 0, 0, 51, 339, 1, 0, 0, 0, 53, 341,
-		1, 0, 0, 0, 55, 344, 1, 0, 0, 0, 57, 347, 1, 0, 0, 0, 59, 349, 1, 0, 0,
-		0, 61, 352, 1, 0, 0, 0, 63, 354, 1, 0, 0, 0, 65, 356, 1, 0, 0, 0, 67, 481,
-		1, 0, 0, 0, 69, 494, 1, 0, 0, 0, 71, 506, 1, 0, 0, 0, 73, 508, 1, 0, 0,
-		0, 75, 532, 1, 0, 0, 0, 77, 590, 1, 0, 0, 0, 79, 592, 1, 0, 0, 0, 81, 604,
-		1, 0, 0, 0, 83, 606, 1, 0, 0, 0, 85, 611, 1, 0, 0, 0, 87, 619, 1, 0, 0,
-		0, 89, 631, 1, 0, 0, 0, 91, 637, 1, 0, 0, 0, 93, 639, 1, 0, 0, 0, 95, 641,
-		1, 0, 0, 0, 97, 643, 1, 0, 0, 0, 99, 645, 1, 0, 0, 0, 101, 647, 1, 0, 0,
-		0, 103, 649, 1, 0, 0, 0, 105, 651, 1, 0, 0, 0, 107, 677, 1, 0, 0, 0, 109,
-		687, 1, 0, 0, 0, 111, 690, 1, 0, 0, 0, 113, 694, 1, 0, 0, 0, 115, 700,
-		1, 0, 0, 0, 117, 703, 1, 0, 0, 0, 119, 705, 1, 0, 0, 0, 121, 708, 1, 0,
-		0, 0, 123, 710, 1, 0, 0, 0, 125, 713, 1, 0, 0, 0, 127, 716, 1, 0, 0, 0,
-		129, 724, 1, 0, 0, 0, 131, 733, 1, 0, 0, 0, 133, 743, 1, 0, 0, 0, 135,
-		748, 1, 0, 0, 0, 137, 754, 1, 0, 0, 0, 139, 756, 1, 0, 0, 0, 141, 758,
-		1, 0, 0, 0, 143, 760, 1, 0, 0, 0, 145, 762, 1, 0, 0, 0, 147, 764, 1, 0,
-		0, 0, 149, 766, 1, 0, 0, 0, 151, 768, 1, 0, 0, 0, 153, 770, 1, 0, 0, 0,
-		155, 772, 1, 0, 0, 0, 157, 774, 1, 0, 0, 0, 159, 776, 1, 0, 0, 0, 161,
-		778, 1, 0, 0, 0, 163, 780, 1, 0, 0, 0, 165, 782, 1, 0, 0, 0, 167, 784,
-		1, 0, 0, 0, 169, 786, 1, 0, 0, 0, 171, 788, 1, 0, 0, 0, 173, 790, 1, 0,
-		0, 0, 175, 792, 1, 0, 0, 0, 177, 794, 1, 0, 0, 0, 179, 796, 1, 0, 0, 0,
-		181, 798, 1, 0, 0, 0, 183, 800, 1, 0, 0, 0, 185, 802, 1, 0, 0, 0, 187,
-		804, 1, 0, 0, 0, 189, 806, 1, 0, 0, 0, 191, 808, 1, 0, 0, 0, 193, 810,
-		1, 0, 0, 0, 195, 196, 5, 59, 0, 0, 196, 2, 1, 0, 0, 0, 197, 198, 5, 42,
-		0, 0, 198, 4, 1, 0, 0, 0, 199, 200, 5, 115, 0, 0, 200, 201, 5, 117, 0,
-		0, 201, 202, 5, 109, 0, 0, 202, 6, 1, 0, 0, 0, 203, 204, 5, 97, 0, 0, 204,
-		205, 5, 118, 0, 0, 205, 206, 5, 103, 0, 0, 206, 8, 1, 0, 0, 0, 207, 208,
-		5, 109, 0, 0, 208, 209, 5, 97, 0, 0, 209, 210, 5, 120, 0, 0, 210, 10, 1,
-		0, 0, 0, 211, 212, 5, 109, 0, 0, 212, 213, 5, 105, 0, 0, 213, 214, 5, 110,
-		0, 0, 214, 12, 1, 0, 0, 0, 215, 216, 5, 115, 0, 0, 216, 217, 5, 99, 0,
-		0, 217, 218, 5, 104, 0, 0, 218, 219, 5, 101, 0, 0, 219, 220, 5, 109, 0,
-		0, 220, 221, 5, 97, 0, 0, 221, 14, 1, 0, 0, 0, 222, 223, 5, 99, 0, 0, 223,
-		224, 5, 97, 0, 0, 224, 225, 5, 116, 0, 0, 225, 226, 5, 97, 0, 0, 226, 227,
-		5, 108, 0, 0, 227, 228, 5, 111, 0, 0, 228, 229, 5, 103, 0, 0, 229, 16,
-		1, 0, 0, 0, 230, 231, 5, 114, 0, 0, 231, 232, 5, 111, 0, 0, 232, 233, 5,
-		119, 0, 0, 233, 234, 5, 110, 0, 0, 234, 235, 5, 117, 0, 0, 235, 236, 5,
-		109, 0, 0, 236, 18, 1, 0, 0, 0, 237, 238, 5, 99, 0, 0, 238, 239, 5, 111,
-		0, 0, 239, 240, 5, 110, 0, 0, 240, 241, 5, 116, 0, 0, 241, 242, 5, 97,
-		0, 0, 242, 243, 5, 105, 0, 0, 243, 244, 5, 110, 0, 0, 244, 245, 5, 115,
-		0, 0, 245, 20, 1, 0, 0, 0, 246, 247, 5, 115, 0, 0, 247, 248, 5, 116, 0,
-		0, 248, 249, 5, 97, 0, 0, 249, 250, 5, 114, 0, 0, 250, 251, 5, 116, 0,
-		0, 251, 252, 5, 115, 0, 0, 252, 253, 5, 119, 0, 0, 253, 254, 5, 105, 0,
-		0, 254, 255, 5, 116, 0, 0, 255, 256, 5, 104, 0, 0, 256, 22, 1, 0, 0, 0,
-		257, 258, 5, 101, 0, 0, 258, 259, 5, 110, 0, 0, 259, 260, 5, 100, 0, 0,
-		260, 261, 5, 115, 0, 0, 261, 262, 5, 119, 0, 0, 262, 263, 5, 105, 0, 0,
-		263, 264, 5, 116, 0, 0, 264, 265, 5, 104, 0, 0, 265, 24, 1, 0, 0, 0, 266,
-		267, 5, 105, 0, 0, 267, 268, 5, 99, 0, 0, 268, 269, 5, 111, 0, 0, 269,
-		270, 5, 110, 0, 0, 270, 271, 5, 116, 0, 0, 271, 272, 5, 97, 0, 0, 272,
-		273, 5, 105, 0, 0, 273, 274, 5, 110, 0, 0, 274, 275, 5, 115, 0, 0, 275,
-		26, 1, 0, 0, 0, 276, 277, 5, 105, 0, 0, 277, 278, 5, 115, 0, 0, 278, 279,
-		5, 116, 0, 0, 279, 280, 5, 97, 0, 0, 280, 281, 5, 114, 0, 0, 281, 282,
-		5, 116, 0, 0, 282, 283, 5, 115, 0, 0, 283, 284, 5, 119, 0, 0, 284, 285,
-		5, 105, 0, 0, 285, 286, 5, 116, 0, 0, 286, 287, 5, 104, 0, 0, 287, 28,
-		1, 0, 0, 0, 288, 289, 5, 105, 0, 0, 289, 290, 5, 101, 0, 0, 290, 291, 5,
-		110, 0, 0, 291, 292, 5, 100, 0, 0, 292, 293, 5, 115, 0, 0, 293, 294, 5,
-		119, 0, 0, 294, 295, 5, 105, 0, 0, 295, 296, 5, 116, 0, 0, 296, 297, 5,
-		104, 0, 0, 297, 30, 1, 0, 0, 0, 298, 299, 5, 108, 0, 0, 299, 300, 5, 105,
-		0, 0, 300, 301, 5, 107, 0, 0, 301, 302, 5, 101, 0, 0, 302, 32, 1, 0, 0,
-		0, 303, 304, 5, 105, 0, 0, 304, 305, 5, 108, 0, 0, 305, 306, 5, 105, 0,
-		0, 306, 307, 5, 107, 0, 0, 307, 308, 5, 101, 0, 0, 308, 34, 1, 0, 0, 0,
-		309, 310, 5, 117, 0, 0, 310, 311, 5, 110, 0, 0, 311, 312, 5, 105, 0, 0,
-		312, 313, 5, 113, 0, 0, 313, 314, 5, 117, 0, 0, 314, 315, 5, 101, 0, 0,
-		315, 36, 1, 0, 0, 0, 316, 317, 5, 117, 0, 0, 317, 318, 5, 110, 0, 0, 318,
-		319, 5, 105, 0, 0, 319, 320, 5, 113, 0, 0, 320, 38, 1, 0, 0, 0, 321, 322,
-		5, 99, 0, 0, 322, 323, 5, 111, 0, 0, 323, 324, 5, 117, 0, 0, 324, 325,
-		5, 110, 0, 0, 325, 326, 5, 116, 0, 0, 326, 40, 1, 0, 0, 0, 327, 328, 5,
-		43, 0, 0, 328, 42, 1, 0, 0, 0, 329, 330, 5, 45, 0, 0, 330, 44, 1, 0, 0,
-		0, 331, 332, 5, 46, 0, 0, 332, 333, 5, 91, 0, 0, 333, 46, 1, 0, 0, 0, 334,
-		335, 5, 124, 0, 0, 335, 336, 5, 124, 0, 0, 336, 48, 1, 0, 0, 0, 337, 338,
-		5, 47, 0, 0, 338, 50, 1, 0, 0, 0, 339, 340, 5, 37, 0, 0, 340, 52, 1, 0,
-		0, 0, 341, 342, 5, 60, 0, 0, 342, 343, 5, 60, 0, 0, 343, 54, 1, 0, 0, 0,
-		344, 345, 5, 62, 0, 0, 345, 346, 5, 62, 0, 0, 346, 56, 1, 0, 0, 0, 347,
-		348, 5, 38, 0, 0, 348, 58, 1, 0, 0, 0, 349, 350, 5, 38, 0, 0, 350, 351,
-		5, 38, 0, 0, 351, 60, 1, 0, 0, 0, 352, 353, 5, 126, 0, 0, 353, 62, 1, 0,
-		0, 0, 354, 355, 5, 33, 0, 0, 355, 64, 1, 0, 0, 0, 356, 357, 5, 95, 0, 0,
-		357, 358, 3, 85, 42, 0, 358, 66, 1, 0, 0, 0, 359, 360, 5, 106, 0, 0, 360,
-		361, 5, 111, 0, 0, 361, 362, 5, 105, 0, 0, 362, 482, 5, 110, 0, 0, 363,
-		364, 5, 105, 0, 0, 364, 365, 5, 110, 0, 0, 365, 366, 5, 110, 0, 0, 366,
-		367, 5, 101, 0, 0, 367, 368, 5, 114, 0, 0, 368, 369, 5, 95, 0, 0, 369,
-		370, 5, 106, 0, 0, 370, 371, 5, 111, 0, 0, 371, 372, 5, 105, 0, 0, 372,
-		482, 5, 110, 0, 0, 373, 374, 5, 108, 0, 0, 374, 375, 5, 101, 0, 0, 375,
-		376, 5, 102, 0, 0, 376, 377, 5, 116, 0, 0, 377, 378, 5, 95, 0, 0, 378,
-		379, 5, 106, 0, 0, 379, 380, 5, 111, 0, 0, 380, 381, 5, 105, 0, 0, 381,
-		482, 5, 110, 0, 0, 382, 383, 5, 108, 0, 0, 383, 384, 5, 106, 0, 0, 384,
-		385, 5, 111, 0, 0, 385, 386, 5, 105, 0, 0, 386, 482, 5, 110, 0, 0, 387,
-		388, 5, 108, 0, 0, 388, 389, 5, 101, 0, 0, 389, 390, 5, 102, 0, 0, 390,
-		391, 5, 116, 0, 0, 391, 392, 5, 95, 0, 0, 392, 393, 5, 111, 0, 0, 393,
-		394, 5, 117, 0, 0, 394, 395, 5, 116, 0, 0, 395, 396, 5, 101, 0, 0, 396,
-		397, 5, 114, 0, 0, 397, 398, 5, 95, 0, 0, 398, 399, 5, 106, 0, 0, 399,
-		400, 5, 111, 0, 0, 400, 401, 5, 105, 0, 0, 401, 482, 5, 110, 0, 0, 402,
-		403, 5, 108, 0, 0, 403, 404, 5, 111, 0, 0, 404, 405, 5, 106, 0, 0, 405,
-		406, 5, 111, 0, 0, 406, 407, 5, 105, 0, 0, 407, 482, 5, 110, 0, 0, 408,
-		409, 5, 114, 0, 0, 409, 410, 5, 105, 0, 0, 410, 411, 5, 103, 0, 0, 411,
-		412, 5, 104, 0, 0, 412, 413, 5, 116, 0, 0, 413, 414, 5, 95, 0, 0, 414,
-		415, 5, 106, 0, 0, 415, 416, 5, 111, 0, 0, 416, 417, 5, 105, 0, 0, 417,
-		482, 5, 110, 0, 0, 418, 419, 5, 114, 0, 0, 419, 420, 5, 106, 0, 0, 420,
-		421, 5, 111, 0, 0, 421, 422, 5, 105, 0, 0, 422, 482, 5, 110, 0, 0, 423,
-		424, 5, 114, 0, 0, 424, 425, 5, 105, 0, 0, 425, 426, 5, 103, 0, 0, 426,
-		427, 5, 104, 0, 0, 427, 428, 5, 116, 0, 0, 428, 429, 5, 95, 0, 0, 429,
-		430, 5, 111, 0, 0, 430, 431, 5, 117, 0, 0, 431, 432, 5, 116, 0, 0, 432,
-		433, 5, 101, 0, 0, 433, 434, 5, 114, 0, 0, 434, 435, 5, 95, 0, 0, 435,
-		436, 5, 106, 0, 0, 436, 437, 5, 111, 0, 0, 437, 438, 5, 105, 0, 0, 438,
-		482, 5, 110, 0, 0, 439, 440, 5, 114, 0, 0, 440, 441, 5, 111, 0, 0, 441,
-		442, 5, 106, 0, 0, 442, 443, 5, 111, 0, 0, 443, 444, 5, 105, 0, 0, 444,
-		482, 5, 110, 0, 0, 445, 446, 5, 102, 0, 0, 446, 447, 5, 117, 0, 0, 447,
-		448, 5, 108, 0, 0, 448, 449, 5, 108, 0, 0, 449, 450, 5, 95, 0, 0, 450,
-		451, 5, 111, 0, 0, 451, 452, 5, 117, 0, 0, 452, 453, 5, 116, 0, 0, 453,
-		454, 5, 101, 0, 0, 454, 455, 5, 114, 0, 0, 455, 456, 5, 95, 0, 0, 456,
-		457, 5, 106, 0, 0, 457, 458, 5, 111, 0, 0, 458, 459, 5, 105, 0, 0, 459,
-		482, 5, 110, 0, 0, 460, 461, 5, 102, 0, 0, 461, 462, 5, 111, 0, 0, 462,
-		463, 5, 106, 0, 0, 463, 464, 5, 111, 0, 0, 464, 465, 5, 105, 0, 0, 465,
-		482, 5, 110, 0, 0, 466, 467, 5, 99, 0, 0, 467, 468, 5, 114, 0, 0, 468,
-		469, 5, 111, 0, 0, 469, 470, 5, 115, 0, 0, 470, 471, 5, 115, 0, 0, 471,
-		472, 5, 95, 0, 0, 472, 473, 5, 106, 0, 0, 473, 474, 5, 111, 0, 0, 474,
-		475, 5, 105, 0, 0, 475, 482, 5, 110, 0, 0, 476, 477, 5, 120, 0, 0, 477,
-		478, 5, 106, 0, 0, 478, 479, 5, 111, 0, 0, 479, 480, 5, 105, 0, 0, 480,
-		482, 5, 110, 0, 0, 481, 359, 1, 0, 0, 0, 481, 363, 1, 0, 0, 0, 481, 373,
-		1, 0, 0, 0, 481, 382, 1, 0, 0, 0, 481, 387, 1, 0, 0, 0, 481, 402, 1, 0,
-		0, 0, 481, 408, 1, 0, 0, 0, 481, 418, 1, 0, 0, 0, 481, 423, 1, 0, 0, 0,
-		481, 439, 1, 0, 0, 0, 481, 445, 1, 0, 0, 0, 481, 460, 1, 0, 0, 0, 481,
-		466, 1, 0, 0, 0, 481, 476, 1, 0, 0, 0, 482, 68, 1, 0, 0, 0, 483, 484, 5,
-		119, 0, 0, 484, 485, 5, 104, 0, 0, 485, 486, 5, 101, 0, 0, 486, 487, 5,
-		114, 0, 0, 487, 495, 5, 101, 0, 0, 488, 489, 5, 115, 0, 0, 489, 490, 5,
-		101, 0, 0, 490, 491, 5, 108, 0, 0, 491, 492, 5, 101, 0, 0, 492, 493, 5,
-		99, 0, 0, 493, 495, 5, 116, 0, 0, 494, 483, 1, 0, 0, 0, 494, 488, 1, 0,
-		0, 0, 495, 70, 1, 0, 0, 0, 496, 497, 5, 103, 0, 0, 497, 498, 5, 114, 0,
-		0, 498, 499, 5, 111, 0, 0, 499, 500, 5, 117, 0, 0, 500, 501, 5, 112, 0,
-		0, 501, 502, 5, 95, 0, 0, 502, 503, 5, 98, 0, 0, 503, 507, 5, 121, 0, 0,
-		504, 505, 5, 103, 0, 0, 505, 507, 5, 98, 0, 0, 506, 496, 1, 0, 0, 0, 506,
-		504, 1, 0, 0, 0, 507, 72, 1, 0, 0, 0, 508, 509, 5, 104, 0, 0, 509, 510,
-		5, 97, 0, 0, 510, 511, 5, 118, 0, 0, 511, 512, 5, 105, 0, 0, 512, 513,
-		5, 110, 0, 0, 513, 514, 5, 103, 0, 0, 514, 74, 1, 0, 0, 0, 515, 516, 5,
-		111, 0, 0, 516, 517, 5, 114, 0, 0, 517, 518, 5, 100, 0, 0, 518, 519, 5,
-		101, 0, 0, 519, 520, 5, 114, 0, 0, 520, 521, 5, 95, 0, 0, 521, 522, 5,
-		98, 0, 0, 522, 533, 5, 121, 0, 0, 523, 524, 5, 115, 0, 0, 524, 525, 5,
-		111, 0, 0, 525, 526, 5, 114, 0, 0, 526, 527, 5, 116, 0, 0, 527, 528, 5,
-		95, 0, 0, 528, 529, 5, 98, 0, 0, 529, 533, 5, 121, 0, 0, 530, 531, 5, 111,
-		0, 0, 531, 533, 5, 98, 0, 0, 532, 515, 1, 0, 0, 0, 532, 523, 1, 0, 0, 0,
-		532, 530, 1, 0, 0, 0, 533, 76, 1, 0, 0, 0, 534, 535, 5, 58, 0, 0, 535,
-		536, 5, 99, 0, 0, 536, 537, 5, 111, 0, 0, 537, 538, 5, 117, 0, 0, 538,
-		539, 5, 110, 0, 0, 539, 591, 5, 116, 0, 0, 540, 541, 5, 58, 0, 0, 541,
-		542, 5, 99, 0, 0, 542, 543, 5, 111, 0, 0, 543, 544, 5, 117, 0, 0, 544,
-		545, 5, 110, 0, 0, 545, 546, 5, 116, 0, 0, 546, 547, 5, 95, 0, 0, 547,
-		548, 5, 117, 0, 0, 548, 549, 5, 110, 0, 0, 549, 550, 5, 105, 0, 0, 550,
-		551, 5, 113, 0, 0, 551, 552, 5, 117, 0, 0, 552, 591, 5, 101, 0, 0, 553,
-		554, 5, 58, 0, 0, 554, 555, 5, 97, 0, 0, 555, 556, 5, 118, 0, 0, 556, 591,
-		5, 103, 0, 0, 557, 558, 5, 58, 0, 0, 558, 559, 5, 103, 0, 0, 559, 560,
-		5, 114, 0, 0, 560, 561, 5, 111, 0, 0, 561, 562, 5, 117, 0, 0, 562, 563,
-		5, 112, 0, 0, 563, 564, 5, 95, 0, 0, 564, 565, 5, 98, 0, 0, 565, 591, 5,
-		121, 0, 0, 566, 567, 5, 58, 0, 0, 567, 568, 5, 109, 0, 0, 568, 569, 5,
-		97, 0, 0, 569, 591, 5, 120, 0, 0, 570, 571, 5, 58, 0, 0, 571, 572, 5, 109,
-		0, 0, 572, 573, 5, 105, 0, 0, 573, 591, 5, 110, 0, 0, 574, 575, 5, 58,
-		0, 0, 575, 576, 5, 111, 0, 0, 576, 577, 5, 114, 0, 0, 577, 578, 5, 100,
-		0, 0, 578, 579, 5, 101, 0, 0, 579, 580, 5, 114, 0, 0, 580, 581, 5, 95,
-		0, 0, 581, 582, 5, 98, 0, 0, 582, 591, 5, 121, 0, 0, 583, 584, 5, 58, 0,
-		0, 584, 585, 5, 117, 0, 0, 585, 586, 5, 110, 0, 0, 586, 587, 5, 105, 0,
-		0, 587, 588, 5, 113, 0, 0, 588, 589, 5, 117, 0, 0, 589, 591, 5, 101, 0,
-		0, 590, 534, 1, 0, 0, 0, 590, 540, 1, 0, 0, 0, 590, 553, 1, 0, 0, 0, 590,
-		557, 1, 0, 0, 0, 590, 566, 1, 0, 0, 0, 590, 570, 1, 0, 0, 0, 590, 574,
-		1, 0, 0, 0, 590, 583, 1, 0, 0, 0, 591, 78, 1, 0, 0, 0, 592, 593, 5, 36,
-		0, 0, 593, 594, 3, 85, 42, 0, 594, 80, 1, 0, 0, 0, 595, 596, 5, 116, 0,
-		0, 596, 597, 5, 114, 0, 0, 597, 598, 5, 117, 0, 0, 598, 605, 5, 101, 0,
-		0, 599, 600, 5, 102, 0, 0, 600, 601, 5, 97, 0, 0, 601, 602, 5, 108, 0,
-		0, 602, 603, 5, 115, 0, 0, 603, 605, 5, 101, 0, 0, 604, 595, 1, 0, 0, 0,
-		604, 599, 1, 0, 0, 0, 605, 82, 1, 0, 0, 0, 606, 607, 5, 110, 0, 0, 607,
-		608, 5, 117, 0, 0, 608, 609, 5, 108, 0, 0, 609, 610, 5, 108, 0, 0, 610,
-		84, 1, 0, 0, 0, 611, 615, 7, 0, 0, 0, 612, 614, 7, 1, 0, 0, 613, 612, 1,
-		0, 0, 0, 614, 617, 1, 0, 0, 0, 615, 613, 1, 0, 0, 0, 615, 616, 1, 0, 0,
-		0, 616, 86, 1, 0, 0, 0, 617, 615, 1, 0, 0, 0, 618, 620, 7, 2, 0, 0, 619,
-		618, 1, 0, 0, 0, 620, 621, 1, 0, 0, 0, 621, 619, 1, 0, 0, 0, 621, 622,
-		1, 0, 0, 0, 622, 623, 1, 0, 0, 0, 623, 627, 7, 0, 0, 0, 624, 626, 7, 1,
-		0, 0, 625, 624, 1, 0, 0, 0, 626, 629, 1, 0, 0, 0, 627, 625, 1, 0, 0, 0,
-		627, 628, 1, 0, 0, 0, 628, 88, 1, 0, 0, 0, 629, 627, 1, 0, 0, 0, 630, 632,
-		7, 3, 0, 0, 631, 630, 1, 0, 0, 0, 632, 633, 1, 0, 0, 0, 633, 631, 1, 0,
-		0, 0, 633, 634, 1, 0, 0, 0, 634, 635, 1, 0, 0, 0, 635, 636, 6, 44, 0, 0,
-		636, 90, 1, 0, 0, 0, 637, 638, 5, 40, 0, 0, 638, 92, 1, 0, 0, 0, 639, 640,
-		5, 41, 0, 0, 640, 94, 1, 0, 0, 0, 641, 642, 5, 91, 0, 0, 642, 96, 1, 0,
-		0, 0, 643, 644, 5, 93, 0, 0, 644, 98, 1, 0, 0, 0, 645, 646, 5, 44, 0, 0,
-		646, 100, 1, 0, 0, 0, 647, 648, 5, 124, 0, 0, 648, 102, 1, 0, 0, 0, 649,
-		650, 5, 58, 0, 0, 650, 104, 1, 0, 0, 0, 651, 652, 3, 109, 54, 0, 652, 106,
-		1, 0, 0, 0, 653, 678, 3, 105, 52, 0, 654, 656, 5, 45, 0, 0, 655, 654, 1,
-		0, 0, 0, 655, 656, 1, 0, 0, 0, 656, 657, 1, 0, 0, 0, 657, 658, 3, 109,
-		54, 0, 658, 660, 5, 46, 0, 0, 659, 661, 7, 2, 0, 0, 660, 659, 1, 0, 0,
-		0, 661, 662, 1, 0, 0, 0, 662, 660, 1, 0, 0, 0, 662, 663, 1, 0, 0, 0, 663,
-		665, 1, 0, 0, 0, 664, 666, 3, 113, 56, 0, 665, 664, 1, 0, 0, 0, 665, 666,
-		1, 0, 0, 0, 666, 678, 1, 0, 0, 0, 667, 669, 5, 45, 0, 0, 668, 667, 1, 0,
-		0, 0, 668, 669, 1, 0, 0, 0, 669, 670, 1, 0, 0, 0, 670, 671, 3, 109, 54,
-		0, 671, 672, 3, 113, 56, 0, 672, 678, 1, 0, 0, 0, 673, 675, 5, 45, 0, 0,
-		674, 673, 1, 0, 0, 0, 674, 675, 1, 0, 0, 0, 675, 676, 1, 0, 0, 0, 676,
-		678, 3, 109, 54, 0, 677, 653, 1, 0, 0, 0, 677, 655, 1, 0, 0, 0, 677, 668,
-		1, 0, 0, 0, 677, 674, 1, 0, 0, 0, 678, 108, 1, 0, 0, 0, 679, 688, 5, 48,
-		0, 0, 680, 684, 7, 4, 0, 0, 681, 683, 7, 2, 0, 0, 682, 681, 1, 0, 0, 0,
-		683, 686, 1, 0, 0, 0, 684, 682, 1, 0, 0, 0, 684, 685, 1, 0, 0, 0, 685,
-		688, 1, 0, 0, 0, 686, 684, 1, 0, 0, 0, 687, 679, 1, 0, 0, 0, 687, 680,
-		1, 0, 0, 0, 688, 110, 1, 0, 0, 0, 689, 691, 7, 2, 0, 0, 690, 689, 1, 0,
-		0, 0, 691, 692, 1, 0, 0, 0, 692, 690, 1, 0, 0, 0, 692, 693, 1, 0, 0, 0,
-		693, 112, 1, 0, 0, 0, 694, 696, 7, 5, 0, 0, 695, 697, 7, 6, 0, 0, 696,
-		695, 1, 0, 0, 0, 696, 697, 1, 0, 0, 0, 697, 698, 1, 0, 0, 0, 698, 699,
-		3, 109, 54, 0, 699, 114, 1, 0, 0, 0, 700, 701, 5, 60, 0, 0, 701, 702, 5,
-		61, 0, 0, 702, 116, 1, 0, 0, 0, 703, 704, 5, 60, 0, 0, 704, 118, 1, 0,
-		0, 0, 705, 706, 5, 62, 0, 0, 706, 707, 5, 61, 0, 0, 707, 120, 1, 0, 0,
-		0, 708, 709, 5, 62, 0, 0, 709, 122, 1, 0, 0, 0, 710, 711, 5, 33, 0, 0,
-		711, 712, 5, 61, 0, 0, 712, 124, 1, 0, 0, 0, 713, 714, 5, 61, 0, 0, 714,
-		715, 5, 61, 0, 0, 715, 126, 1, 0, 0, 0, 716, 722, 5, 46, 0, 0, 717, 723,
-		3, 79, 39, 0, 718, 723, 3, 85, 42, 0, 719, 723, 3, 131, 65, 0, 720, 723,
-		3, 111, 55, 0, 721, 723, 3, 87, 43, 0, 722, 717, 1, 0, 0, 0, 722, 718,
-		1, 0, 0, 0, 722, 719, 1, 0, 0, 0, 722, 720, 1, 0, 0, 0, 722, 721, 1, 0,
-		0, 0, 723, 128, 1, 0, 0, 0, 724, 725, 5, 64, 0, 0, 725, 730, 3, 85, 42,
-		0, 726, 727, 5, 47, 0, 0, 727, 729, 3, 85, 42, 0, 728, 726, 1, 0, 0, 0,
-		729, 732, 1, 0, 0, 0, 730, 728, 1, 0, 0, 0, 730, 731, 1, 0, 0, 0, 731,
-		130, 1, 0, 0, 0, 732, 730, 1, 0, 0, 0, 733, 738, 5, 34, 0, 0, 734, 737,
-		3, 133, 66, 0, 735, 737, 8, 7, 0, 0, 736, 734, 1, 0, 0, 0, 736, 735, 1,
-		0, 0, 0, 737, 740, 1, 0, 0, 0, 738, 736, 1, 0, 0, 0, 738, 739, 1, 0, 0,
-		0, 739, 741, 1, 0, 0, 0, 740, 738, 1, 0, 0, 0, 741, 742, 5, 34, 0, 0, 742,
-		132, 1, 0, 0, 0, 743, 746, 5, 92, 0, 0, 744, 747, 7, 8, 0, 0, 745, 747,
-		3, 135, 67, 0, 746, 744, 1, 0, 0, 0, 746, 745, 1, 0, 0, 0, 747, 134, 1,
-		0, 0, 0, 748, 749, 5, 117, 0, 0, 749, 750, 3, 137, 68, 0, 750, 751, 3,
-		137, 68, 0, 751, 752, 3, 137, 68, 0, 752, 753, 3, 137, 68, 0, 753, 136,
-		1, 0, 0, 0, 754, 755, 7, 9, 0, 0, 755, 138, 1, 0, 0, 0, 756, 757, 7, 2,
-		0, 0, 757, 140, 1, 0, 0, 0, 758, 759, 7, 10, 0, 0, 759, 142, 1, 0, 0, 0,
-		760, 761, 7, 11, 0, 0, 761, 144, 1, 0, 0, 0, 762, 763, 7, 12, 0, 0, 763,
-		146, 1, 0, 0, 0, 764, 765, 7, 13, 0, 0, 765, 148, 1, 0, 0, 0, 766, 767,
-		7, 5, 0, 0, 767, 150, 1, 0, 0, 0, 768, 769, 7, 14, 0, 0, 769, 152, 1, 0,
-		0, 0, 770, 771, 7, 15, 0, 0, 771, 154, 1, 0, 0, 0, 772, 773, 7, 16, 0,
-		0, 773, 156, 1, 0, 0, 0, 774, 775, 7, 17, 0, 0, 775, 158, 1, 0, 0, 0, 776,
-		777, 7, 18, 0, 0, 777, 160, 1, 0, 0, 0, 778, 779, 7, 19, 0, 0, 779, 162,
-		1, 0, 0, 0, 780, 781, 7, 20, 0, 0, 781, 164, 1, 0, 0, 0, 782, 783, 7, 21,
-		0, 0, 783, 166, 1, 0, 0, 0, 784, 785, 7, 22, 0, 0, 785, 168, 1, 0, 0, 0,
-		786, 787, 7, 23, 0, 0, 787, 170, 1, 0, 0, 0, 788, 789, 7, 24, 0, 0, 789,
-		172, 1, 0, 0, 0, 790, 791, 7, 25, 0, 0, 791, 174, 1, 0, 0, 0, 792, 793,
-		7, 26, 0, 0, 793, 176, 1, 0, 0, 0, 794, 795, 7, 27, 0, 0, 795, 178, 1,
-		0, 0, 0, 796, 797, 7, 28, 0, 0, 797, 180, 1, 0, 0, 0, 798, 799, 7, 29,
-		0, 0, 799, 182, 1, 0, 0, 0, 800, 801, 7, 30, 0, 0, 801, 184, 1, 0, 0, 0,
-		802, 803, 7, 31, 0, 0, 803, 186, 1, 0, 0, 0, 804, 805, 7, 32, 0, 0, 805,
-		188, 1, 0, 0, 0, 806, 807, 7, 33, 0, 0, 807, 190, 1, 0, 0, 0, 808, 809,
-		7, 34, 0, 0, 809, 192, 1, 0, 0, 0, 810, 814, 5, 35, 0, 0, 811, 813, 9,
-		0, 0, 0, 812, 811, 1, 0, 0, 0, 813, 816, 1, 0, 0, 0, 814, 815, 1, 0, 0,
-		0, 814, 812, 1, 0, 0, 0, 815, 817, 1, 0, 0, 0, 816, 814, 1, 0, 0, 0, 817,
-		818, 5, 10, 0, 0, 818, 819, 1, 0, 0, 0, 819, 820, 6, 96, 0, 0, 820, 194,
-		1, 0, 0, 0, 27, 0, 481, 494, 506, 532, 590, 604, 615, 621, 627, 633, 655,
-		662, 665, 668, 674, 677, 684, 687, 692, 696, 722, 730, 736, 738, 746, 814,
-		1, 6, 0, 0,
+		4, 0, 96, 1119, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3,
+		2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9,
+		2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2,
+		15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20,
+		7, 20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7,
+		25, 2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30,
+		2, 31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2,
+		36, 7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41,
+		7, 41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7,
+		46, 2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51,
+		2, 52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2,
+		57, 7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62,
+		7, 62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7,
+		67, 2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72,
+		2, 73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2,
+		78, 7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83,
+		7, 83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7,
+		88, 2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93,
+		2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2,
+		99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
+		2, 104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108,
+		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112,
+		2, 113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117,
+		7, 117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121, 7, 121,
+		2, 122, 7, 122, 2, 123, 7, 123, 2, 124, 7, 124, 2, 125, 7, 125, 2, 126,
+		7, 126, 2, 127, 7, 127, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2,
+		1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5,
+		1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6,
+		1, 6, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9,
+		1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1,
+		11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13,
+		1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1,
+		14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17,
+		1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1,
+		18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
+		1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1,
+		21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23,
+		1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1,
+		24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1,
+		26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29,
+		1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1,
+		29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30,
+		1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1,
+		32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1,
+		34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36,
+		1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1,
+		38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39,
+		1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42,
+		1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1,
+		43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1,
+		47, 1, 47, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50,
+		1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 54, 1,
+		54, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58,
+		1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 63, 749, 8, 63, 1, 64, 1,
+		64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64,
+		1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1,
+		64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 3, 64, 780, 8, 64, 1, 65,
+		1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 3,
+		65, 793, 8, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66,
+		1, 66, 1, 66, 3, 66, 805, 8, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1,
+		67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68,
+		1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 3, 68, 831, 8,
+		68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69,
+		1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1,
+		69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69,
+		1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1,
+		69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69,
+		1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 889, 8, 69, 1, 70, 1, 70, 1, 70, 1,
+		71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 903,
+		8, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 5, 73, 912, 8,
+		73, 10, 73, 12, 73, 915, 9, 73, 1, 74, 4, 74, 918, 8, 74, 11, 74, 12, 74,
+		919, 1, 74, 1, 74, 5, 74, 924, 8, 74, 10, 74, 12, 74, 927, 9, 74, 1, 75,
+		4, 75, 930, 8, 75, 11, 75, 12, 75, 931, 1, 75, 1, 75, 1, 76, 1, 76, 1,
+		77, 1, 77, 1, 78, 1, 78, 1, 79, 1, 79, 1, 80, 1, 80, 1, 81, 1, 81, 1, 82,
+		1, 82, 1, 83, 1, 83, 1, 84, 1, 84, 3, 84, 954, 8, 84, 1, 84, 1, 84, 1,
+		84, 4, 84, 959, 8, 84, 11, 84, 12, 84, 960, 1, 84, 3, 84, 964, 8, 84, 1,
+		84, 3, 84, 967, 8, 84, 1, 84, 1, 84, 1, 84, 1, 84, 3, 84, 973, 8, 84, 1,
+		84, 3, 84, 976, 8, 84, 1, 85, 1, 85, 1, 85, 5, 85, 981, 8, 85, 10, 85,
+		12, 85, 984, 9, 85, 3, 85, 986, 8, 85, 1, 86, 4, 86, 989, 8, 86, 11, 86,
+		12, 86, 990, 1, 87, 1, 87, 3, 87, 995, 8, 87, 1, 87, 1, 87, 1, 88, 1, 88,
+		1, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 1,
+		92, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 3, 94,
+		1021, 8, 94, 1, 95, 1, 95, 1, 95, 1, 95, 5, 95, 1027, 8, 95, 10, 95, 12,
+		95, 1030, 9, 95, 1, 96, 1, 96, 1, 96, 5, 96, 1035, 8, 96, 10, 96, 12, 96,
+		1038, 9, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 3, 97, 1045, 8, 97, 1,
+		98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 100, 1, 100, 1,
+		101, 1, 101, 1, 102, 1, 102, 1, 103, 1, 103, 1, 104, 1, 104, 1, 105, 1,
+		105, 1, 106, 1, 106, 1, 107, 1, 107, 1, 108, 1, 108, 1, 109, 1, 109, 1,
+		110, 1, 110, 1, 111, 1, 111, 1, 112, 1, 112, 1, 113, 1, 113, 1, 114, 1,
+		114, 1, 115, 1, 115, 1, 116, 1, 116, 1, 117, 1, 117, 1, 118, 1, 118, 1,
+		119, 1, 119, 1, 120, 1, 120, 1, 121, 1, 121, 1, 122, 1, 122, 1, 123, 1,
+		123, 1, 124, 1, 124, 1, 125, 1, 125, 1, 126, 1, 126, 1, 127, 1, 127, 5,
+		127, 1111, 8, 127, 10, 127, 12, 127, 1114, 9, 127, 1, 127, 1, 127, 1, 127,
+		1, 127, 1, 1112, 0, 128, 1, 1, 3, 2, 5, 3, 7, 4, 9, 5, 11, 6, 13, 7, 15,
+		8, 17, 9, 19, 10, 21, 11, 23, 12, 25, 13, 27, 14, 29, 15, 31, 16, 33, 17,
+		35, 18, 37, 19, 39, 20, 41, 21, 43, 22, 45, 23, 47, 24, 49, 25, 51, 26,
+		53, 27, 55, 28, 57, 29, 59, 30, 61, 31, 63, 32, 65, 33, 67, 34, 69, 35,
+		71, 36, 73, 37, 75, 38, 77, 39, 79, 40, 81, 41, 83, 42, 85, 43, 87, 44,
+		89, 45, 91, 46, 93, 47, 95, 48, 97, 49, 99, 50, 101, 51, 103, 52, 105,
+		53, 107, 54, 109, 55, 111, 56, 113, 57, 115, 58, 117, 59, 119, 60, 121,
+		61, 123, 62, 125, 63, 127, 64, 129, 65, 131, 66, 133, 67, 135, 68, 137,
+		69, 139, 70, 141, 71, 143, 72, 145, 73, 147, 74, 149, 75, 151, 76, 153,
+		77, 155, 78, 157, 79, 159, 80, 161, 81, 163, 82, 165, 83, 167, 84, 169,
+		85, 171, 0, 173, 86, 175, 0, 177, 87, 179, 88, 181, 89, 183, 90, 185, 91,
+		187, 92, 189, 93, 191, 94, 193, 95, 195, 0, 197, 0, 199, 0, 201, 0, 203,
+		0, 205, 0, 207, 0, 209, 0, 211, 0, 213, 0, 215, 0, 217, 0, 219, 0, 221,
+		0, 223, 0, 225, 0, 227, 0, 229, 0, 231, 0, 233, 0, 235, 0, 237, 0, 239,
+		0, 241, 0, 243, 0, 245, 0, 247, 0, 249, 0, 251, 0, 253, 0, 255, 96, 1,
+		0, 35, 3, 0, 65, 90, 95, 95, 97, 122, 4, 0, 48, 57, 65, 90, 95, 95, 97,
+		122, 1, 0, 48, 57, 3, 0, 9, 10, 13, 13, 32, 32, 1, 0, 49, 57, 2, 0, 69,
+		69, 101, 101, 2, 0, 43, 43, 45, 45, 2, 0, 34, 34, 92, 92, 8, 0, 34, 34,
+		47, 47, 92, 92, 98, 98, 102, 102, 110, 110, 114, 114, 116, 116, 3, 0, 48,
+		57, 65, 70, 97, 102, 2, 0, 65, 65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0,
+		67, 67, 99, 99, 2, 0, 68, 68, 100, 100, 2, 0, 70, 70, 102, 102, 2, 0, 71,
+		71, 103, 103, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74,
+		74, 106, 106, 2, 0, 75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77,
+		77, 109, 109, 2, 0, 78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80,
+		80, 112, 112, 2, 0, 81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83,
+		83, 115, 115, 2, 0, 84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86,
+		86, 118, 118, 2, 0, 87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89,
+		89, 121, 121, 2, 0, 90, 90, 122, 122, 1139, 0, 1, 1, 0, 0, 0, 0, 3, 1,
+		0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1,
+		0, 0, 0, 0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19,
+		1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0,
+		27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0,
+		0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0,
+		0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0,
+		0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1,
+		0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65,
+		1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0,
+		73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0,
+		0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0,
+		0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0,
+		0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103,
+		1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0,
+		0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1,
+		0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0,
+		125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0,
+		0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139,
+		1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0,
+		0, 147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1,
+		0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0,
+		161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0,
+		0, 0, 0, 169, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179,
+		1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 185, 1, 0, 0, 0,
+		0, 187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0, 0, 193, 1,
+		0, 0, 0, 0, 255, 1, 0, 0, 0, 1, 257, 1, 0, 0, 0, 3, 259, 1, 0, 0, 0, 5,
+		263, 1, 0, 0, 0, 7, 265, 1, 0, 0, 0, 9, 267, 1, 0, 0, 0, 11, 272, 1, 0,
+		0, 0, 13, 277, 1, 0, 0, 0, 15, 290, 1, 0, 0, 0, 17, 294, 1, 0, 0, 0, 19,
+		298, 1, 0, 0, 0, 21, 302, 1, 0, 0, 0, 23, 306, 1, 0, 0, 0, 25, 313, 1,
+		0, 0, 0, 27, 321, 1, 0, 0, 0, 29, 328, 1, 0, 0, 0, 31, 337, 1, 0, 0, 0,
+		33, 348, 1, 0, 0, 0, 35, 357, 1, 0, 0, 0, 37, 367, 1, 0, 0, 0, 39, 379,
+		1, 0, 0, 0, 41, 389, 1, 0, 0, 0, 43, 394, 1, 0, 0, 0, 45, 400, 1, 0, 0,
+		0, 47, 405, 1, 0, 0, 0, 49, 410, 1, 0, 0, 0, 51, 421, 1, 0, 0, 0, 53, 432,
+		1, 0, 0, 0, 55, 438, 1, 0, 0, 0, 57, 442, 1, 0, 0, 0, 59, 447, 1, 0, 0,
+		0, 61, 459, 1, 0, 0, 0, 63, 470, 1, 0, 0, 0, 65, 474, 1, 0, 0, 0, 67, 485,
+		1, 0, 0, 0, 69, 495, 1, 0, 0, 0, 71, 504, 1, 0, 0, 0, 73, 510, 1, 0, 0,
+		0, 75, 516, 1, 0, 0, 0, 77, 521, 1, 0, 0, 0, 79, 528, 1, 0, 0, 0, 81, 536,
+		1, 0, 0, 0, 83, 543, 1, 0, 0, 0, 85, 550, 1, 0, 0, 0, 87, 559, 1, 0, 0,
+		0, 89, 566, 1, 0, 0, 0, 91, 573, 1, 0, 0, 0, 93, 578, 1, 0, 0, 0, 95, 584,
+		1, 0, 0, 0, 97, 586, 1, 0, 0, 0, 99, 588, 1, 0, 0, 0, 101, 591, 1, 0, 0,
+		0, 103, 595, 1, 0, 0, 0, 105, 598, 1, 0, 0, 0, 107, 601, 1, 0, 0, 0, 109,
+		604, 1, 0, 0, 0, 111, 606, 1, 0, 0, 0, 113, 608, 1, 0, 0, 0, 115, 611,
+		1, 0, 0, 0, 117, 614, 1, 0, 0, 0, 119, 616, 1, 0, 0, 0, 121, 619, 1, 0,
+		0, 0, 123, 621, 1, 0, 0, 0, 125, 623, 1, 0, 0, 0, 127, 748, 1, 0, 0, 0,
+		129, 779, 1, 0, 0, 0, 131, 792, 1, 0, 0, 0, 133, 804, 1, 0, 0, 0, 135,
+		806, 1, 0, 0, 0, 137, 830, 1, 0, 0, 0, 139, 888, 1, 0, 0, 0, 141, 890,
+		1, 0, 0, 0, 143, 902, 1, 0, 0, 0, 145, 904, 1, 0, 0, 0, 147, 909, 1, 0,
+		0, 0, 149, 917, 1, 0, 0, 0, 151, 929, 1, 0, 0, 0, 153, 935, 1, 0, 0, 0,
+		155, 937, 1, 0, 0, 0, 157, 939, 1, 0, 0, 0, 159, 941, 1, 0, 0, 0, 161,
+		943, 1, 0, 0, 0, 163, 945, 1, 0, 0, 0, 165, 947, 1, 0, 0, 0, 167, 949,
+		1, 0, 0, 0, 169, 975, 1, 0, 0, 0, 171, 985, 1, 0, 0, 0, 173, 988, 1, 0,
+		0, 0, 175, 992, 1, 0, 0, 0, 177, 998, 1, 0, 0, 0, 179, 1001, 1, 0, 0, 0,
+		181, 1003, 1, 0, 0, 0, 183, 1006, 1, 0, 0, 0, 185, 1008, 1, 0, 0, 0, 187,
+		1011, 1, 0, 0, 0, 189, 1014, 1, 0, 0, 0, 191, 1022, 1, 0, 0, 0, 193, 1031,
+		1, 0, 0, 0, 195, 1041, 1, 0, 0, 0, 197, 1046, 1, 0, 0, 0, 199, 1052, 1,
+		0, 0, 0, 201, 1054, 1, 0, 0, 0, 203, 1056, 1, 0, 0, 0, 205, 1058, 1, 0,
+		0, 0, 207, 1060, 1, 0, 0, 0, 209, 1062, 1, 0, 0, 0, 211, 1064, 1, 0, 0,
+		0, 213, 1066, 1, 0, 0, 0, 215, 1068, 1, 0, 0, 0, 217, 1070, 1, 0, 0, 0,
+		219, 1072, 1, 0, 0, 0, 221, 1074, 1, 0, 0, 0, 223, 1076, 1, 0, 0, 0, 225,
+		1078, 1, 0, 0, 0, 227, 1080, 1, 0, 0, 0, 229, 1082, 1, 0, 0, 0, 231, 1084,
+		1, 0, 0, 0, 233, 1086, 1, 0, 0, 0, 235, 1088, 1, 0, 0, 0, 237, 1090, 1,
+		0, 0, 0, 239, 1092, 1, 0, 0, 0, 241, 1094, 1, 0, 0, 0, 243, 1096, 1, 0,
+		0, 0, 245, 1098, 1, 0, 0, 0, 247, 1100, 1, 0, 0, 0, 249, 1102, 1, 0, 0,
+		0, 251, 1104, 1, 0, 0, 0, 253, 1106, 1, 0, 0, 0, 255, 1108, 1, 0, 0, 0,
+		257, 258, 5, 59, 0, 0, 258, 2, 1, 0, 0, 0, 259, 260, 5, 108, 0, 0, 260,
+		261, 5, 101, 0, 0, 261, 262, 5, 116, 0, 0, 262, 4, 1, 0, 0, 0, 263, 264,
+		5, 61, 0, 0, 264, 6, 1, 0, 0, 0, 265, 266, 5, 42, 0, 0, 266, 8, 1, 0, 0,
+		0, 267, 268, 5, 99, 0, 0, 268, 269, 5, 97, 0, 0, 269, 270, 5, 115, 0, 0,
+		270, 271, 5, 116, 0, 0, 271, 10, 1, 0, 0, 0, 272, 273, 5, 111, 0, 0, 273,
+		274, 5, 118, 0, 0, 274, 275, 5, 101, 0, 0, 275, 276, 5, 114, 0, 0, 276,
+		12, 1, 0, 0, 0, 277, 278, 5, 112, 0, 0, 278, 279, 5, 97, 0, 0, 279, 280,
+		5, 114, 0, 0, 280, 281, 5, 116, 0, 0, 281, 282, 5, 105, 0, 0, 282, 283,
+		5, 116, 0, 0, 283, 284, 5, 105, 0, 0, 284, 285, 5, 111, 0, 0, 285, 286,
+		5, 110, 0, 0, 286, 287, 5, 95, 0, 0, 287, 288, 5, 98, 0, 0, 288, 289, 5,
+		121, 0, 0, 289, 14, 1, 0, 0, 0, 290, 291, 5, 115, 0, 0, 291, 292, 5, 117,
+		0, 0, 292, 293, 5, 109, 0, 0, 293, 16, 1, 0, 0, 0, 294, 295, 5, 97, 0,
+		0, 295, 296, 5, 118, 0, 0, 296, 297, 5, 103, 0, 0, 297, 18, 1, 0, 0, 0,
+		298, 299, 5, 109, 0, 0, 299, 300, 5, 97, 0, 0, 300, 301, 5, 120, 0, 0,
+		301, 20, 1, 0, 0, 0, 302, 303, 5, 109, 0, 0, 303, 304, 5, 105, 0, 0, 304,
+		305, 5, 110, 0, 0, 305, 22, 1, 0, 0, 0, 306, 307, 5, 115, 0, 0, 307, 308,
+		5, 99, 0, 0, 308, 309, 5, 104, 0, 0, 309, 310, 5, 101, 0, 0, 310, 311,
+		5, 109, 0, 0, 311, 312, 5, 97, 0, 0, 312, 24, 1, 0, 0, 0, 313, 314, 5,
+		99, 0, 0, 314, 315, 5, 97, 0, 0, 315, 316, 5, 116, 0, 0, 316, 317, 5, 97,
+		0, 0, 317, 318, 5, 108, 0, 0, 318, 319, 5, 111, 0, 0, 319, 320, 5, 103,
+		0, 0, 320, 26, 1, 0, 0, 0, 321, 322, 5, 114, 0, 0, 322, 323, 5, 111, 0,
+		0, 323, 324, 5, 119, 0, 0, 324, 325, 5, 110, 0, 0, 325, 326, 5, 117, 0,
+		0, 326, 327, 5, 109, 0, 0, 327, 28, 1, 0, 0, 0, 328, 329, 5, 99, 0, 0,
+		329, 330, 5, 111, 0, 0, 330, 331, 5, 110, 0, 0, 331, 332, 5, 116, 0, 0,
+		332, 333, 5, 97, 0, 0, 333, 334, 5, 105, 0, 0, 334, 335, 5, 110, 0, 0,
+		335, 336, 5, 115, 0, 0, 336, 30, 1, 0, 0, 0, 337, 338, 5, 115, 0, 0, 338,
+		339, 5, 116, 0, 0, 339, 340, 5, 97, 0, 0, 340, 341, 5, 114, 0, 0, 341,
+		342, 5, 116, 0, 0, 342, 343, 5, 115, 0, 0, 343, 344, 5, 119, 0, 0, 344,
+		345, 5, 105, 0, 0, 345, 346, 5, 116, 0, 0, 346, 347, 5, 104, 0, 0, 347,
+		32, 1, 0, 0, 0, 348, 349, 5, 101, 0, 0, 349, 350, 5, 110, 0, 0, 350, 351,
+		5, 100, 0, 0, 351, 352, 5, 115, 0, 0, 352, 353, 5, 119, 0, 0, 353, 354,
+		5, 105, 0, 0, 354, 355, 5, 116, 0, 0, 355, 356, 5, 104, 0, 0, 356, 34,
+		1, 0, 0, 0, 357, 358, 5, 105, 0, 0, 358, 359, 5, 99, 0, 0, 359, 360, 5,
+		111, 0, 0, 360, 361, 5, 110, 0, 0, 361, 362, 5, 116, 0, 0, 362, 363, 5,
+		97, 0, 0, 363, 364, 5, 105, 0, 0, 364, 365, 5, 110, 0, 0, 365, 366, 5,
+		115, 0, 0, 366, 36, 1, 0, 0, 0, 367, 368, 5, 105, 0, 0, 368, 369, 5, 115,
+		0, 0, 369, 370, 5, 116, 0, 0, 370, 371, 5, 97, 0, 0, 371, 372, 5, 114,
+		0, 0, 372, 373, 5, 116, 0, 0, 373, 374, 5, 115, 0, 0, 374, 375, 5, 119,
+		0, 0, 375, 376, 5, 105, 0, 0, 376, 377, 5, 116, 0, 0, 377, 378, 5, 104,
+		0, 0, 378, 38, 1, 0, 0, 0, 379, 380, 5, 105, 0, 0, 380, 381, 5, 101, 0,
+		0, 381, 382, 5, 110, 0, 0, 382, 383, 5, 100, 0, 0, 383, 384, 5, 115, 0,
+		0, 384, 385, 5, 119, 0, 0, 385, 386, 5, 105, 0, 0, 386, 387, 5, 116, 0,
+		0, 387, 388, 5, 104, 0, 0, 388, 40, 1, 0, 0, 0, 389, 390, 5, 108, 0, 0,
+		390, 391, 5, 105, 0, 0, 391, 392, 5, 107, 0, 0, 392, 393, 5, 101, 0, 0,
+		393, 42, 1, 0, 0, 0, 394, 395, 5, 105, 0, 0, 395, 396, 5, 108, 0, 0, 396,
+		397, 5, 105, 0, 0, 397, 398, 5, 107, 0, 0, 398, 399, 5, 101, 0, 0, 399,
+		44, 1, 0, 0, 0, 400, 401, 5, 99, 0, 0, 401, 402, 5, 97, 0, 0, 402, 403,
+		5, 115, 0, 0, 403, 404, 5, 101, 0, 0, 404, 46, 1, 0, 0, 0, 405, 406, 5,
+		114, 0, 0, 406, 407, 5, 97, 0, 0, 407, 408, 5, 110, 0, 0, 408, 409, 5,
+		107, 0, 0, 409, 48, 1, 0, 0, 0, 410, 411, 5, 100, 0, 0, 411, 412, 5, 101,
+		0, 0, 412, 413, 5, 110, 0, 0, 413, 414, 5, 115, 0, 0, 414, 415, 5, 101,
+		0, 0, 415, 416, 5, 95, 0, 0, 416, 417, 5, 114, 0, 0, 417, 418, 5, 97, 0,
+		0, 418, 419, 5, 110, 0, 0, 419, 420, 5, 107, 0, 0, 420, 50, 1, 0, 0, 0,
+		421, 422, 5, 114, 0, 0, 422, 423, 5, 111, 0, 0, 423, 424, 5, 119, 0, 0,
+		424, 425, 5, 95, 0, 0, 425, 426, 5, 110, 0, 0, 426, 427, 5, 117, 0, 0,
+		427, 428, 5, 109, 0, 0, 428, 429, 5, 98, 0, 0, 429, 430, 5, 101, 0, 0,
+		430, 431, 5, 114, 0, 0, 431, 52, 1, 0, 0, 0, 432, 433, 5, 110, 0, 0, 433,
+		434, 5, 116, 0, 0, 434, 435, 5, 105, 0, 0, 435, 436, 5, 108, 0, 0, 436,
+		437, 5, 101, 0, 0, 437, 54, 1, 0, 0, 0, 438, 439, 5, 108, 0, 0, 439, 440,
+		5, 97, 0, 0, 440, 441, 5, 103, 0, 0, 441, 56, 1, 0, 0, 0, 442, 443, 5,
+		108, 0, 0, 443, 444, 5, 101, 0, 0, 444, 445, 5, 97, 0, 0, 445, 446, 5,
+		100, 0, 0, 446, 58, 1, 0, 0, 0, 447, 448, 5, 102, 0, 0, 448, 449, 5, 105,
+		0, 0, 449, 450, 5, 114, 0, 0, 450, 451, 5, 115, 0, 0, 451, 452, 5, 116,
+		0, 0, 452, 453, 5, 95, 0, 0, 453, 454, 5, 118, 0, 0, 454, 455, 5, 97, 0,
+		0, 455, 456, 5, 108, 0, 0, 456, 457, 5, 117, 0, 0, 457, 458, 5, 101, 0,
+		0, 458, 60, 1, 0, 0, 0, 459, 460, 5, 108, 0, 0, 460, 461, 5, 97, 0, 0,
+		461, 462, 5, 115, 0, 0, 462, 463, 5, 116, 0, 0, 463, 464, 5, 95, 0, 0,
+		464, 465, 5, 118, 0, 0, 465, 466, 5, 97, 0, 0, 466, 467, 5, 108, 0, 0,
+		467, 468, 5, 117, 0, 0, 468, 469, 5, 101, 0, 0, 469, 62, 1, 0, 0, 0, 470,
+		471, 5, 110, 0, 0, 471, 472, 5, 111, 0, 0, 472, 473, 5, 119, 0, 0, 473,
+		64, 1, 0, 0, 0, 474, 475, 5, 100, 0, 0, 475, 476, 5, 97, 0, 0, 476, 477,
+		5, 116, 0, 0, 477, 478, 5, 101, 0, 0, 478, 479, 5, 95, 0, 0, 479, 480,
+		5, 116, 0, 0, 480, 481, 5, 114, 0, 0, 481, 482, 5, 117, 0, 0, 482, 483,
+		5, 110, 0, 0, 483, 484, 5, 99, 0, 0, 484, 66, 1, 0, 0, 0, 485, 486, 5,
+		100, 0, 0, 486, 487, 5, 97, 0, 0, 487, 488, 5, 116, 0, 0, 488, 489, 5,
+		101, 0, 0, 489, 490, 5, 95, 0, 0, 490, 491, 5, 112, 0, 0, 491, 492, 5,
+		97, 0, 0, 492, 493, 5, 114, 0, 0, 493, 494, 5, 116, 0, 0, 494, 68, 1, 0,
+		0, 0, 495, 496, 5, 100, 0, 0, 496, 497, 5, 97, 0, 0, 497, 498, 5, 116,
+		0, 0, 498, 499, 5, 101, 0, 0, 499, 500, 5, 95, 0, 0, 500, 501, 5, 97, 0,
+		0, 501, 502, 5, 100, 0, 0, 502, 503, 5, 100, 0, 0, 503, 70, 1, 0, 0, 0,
+		504, 505, 5, 108, 0, 0, 505, 506, 5, 111, 0, 0, 506, 507, 5, 119, 0, 0,
+		507, 508, 5, 101, 0, 0, 508, 509, 5, 114, 0, 0, 509, 72, 1, 0, 0, 0, 510,
+		511, 5, 117, 0, 0, 511, 512, 5, 112, 0, 0, 512, 513, 5, 112, 0, 0, 513,
+		514, 5, 101, 0, 0, 514, 515, 5, 114, 0, 0, 515, 74, 1, 0, 0, 0, 516, 517,
+		5, 116, 0, 0, 517, 518, 5, 114, 0, 0, 518, 519, 5, 105, 0, 0, 519, 520,
+		5, 109, 0, 0, 520, 76, 1, 0, 0, 0, 521, 522, 5, 115, 0, 0, 522, 523, 5,
+		117, 0, 0, 523, 524, 5, 98, 0, 0, 524, 525, 5, 115, 0, 0, 525, 526, 5,
+		116, 0, 0, 526, 527, 5, 114, 0, 0, 527, 78, 1, 0, 0, 0, 528, 529, 5, 114,
+		0, 0, 529, 530, 5, 101, 0, 0, 530, 531, 5, 112, 0, 0, 531, 532, 5, 108,
+		0, 0, 532, 533, 5, 97, 0, 0, 533, 534, 5, 99, 0, 0, 534, 535, 5, 101, 0,
+		0, 535, 80, 1, 0, 0, 0, 536, 537, 5, 108, 0, 0, 537, 538, 5, 101, 0, 0,
+		538, 539, 5, 110, 0, 0, 539, 540, 5, 103, 0, 0, 540, 541, 5, 116, 0, 0,
+		541, 542, 5, 104, 0, 0, 542, 82, 1, 0, 0, 0, 543, 544, 5, 99, 0, 0, 544,
+		545, 5, 111, 0, 0, 545, 546, 5, 110, 0, 0, 546, 547, 5, 99, 0, 0, 547,
+		548, 5, 97, 0, 0, 548, 549, 5, 116, 0, 0, 549, 84, 1, 0, 0, 0, 550, 551,
+		5, 99, 0, 0, 551, 552, 5, 111, 0, 0, 552, 553, 5, 97, 0, 0, 553, 554, 5,
+		108, 0, 0, 554, 555, 5, 101, 0, 0, 555, 556, 5, 115, 0, 0, 556, 557, 5,
+		99, 0, 0, 557, 558, 5, 101, 0, 0, 558, 86, 1, 0, 0, 0, 559, 560, 5, 110,
+		0, 0, 560, 561, 5, 117, 0, 0, 561, 562, 5, 108, 0, 0, 562, 563, 5, 108,
+		0, 0, 563, 564, 5, 105, 0, 0, 564, 565, 5, 102, 0, 0, 565, 88, 1, 0, 0,
+		0, 566, 567, 5, 117, 0, 0, 567, 568, 5, 110, 0, 0, 568, 569, 5, 105, 0,
+		0, 569, 570, 5, 113, 0, 0, 570, 571, 5, 117, 0, 0, 571, 572, 5, 101, 0,
+		0, 572, 90, 1, 0, 0, 0, 573, 574, 5, 117, 0, 0, 574, 575, 5, 110, 0, 0,
+		575, 576, 5, 105, 0, 0, 576, 577, 5, 113, 0, 0, 577, 92, 1, 0, 0, 0, 578,
+		579, 5, 99, 0, 0, 579, 580, 5, 111, 0, 0, 580, 581, 5, 117, 0, 0, 581,
+		582, 5, 110, 0, 0, 582, 583, 5, 116, 0, 0, 583, 94, 1, 0, 0, 0, 584, 585,
+		5, 43, 0, 0, 585, 96, 1, 0, 0, 0, 586, 587, 5, 45, 0, 0, 587, 98, 1, 0,
+		0, 0, 588, 589, 5, 105, 0, 0, 589, 590, 5, 110, 0, 0, 590, 100, 1, 0, 0,
+		0, 591, 592, 5, 110, 0, 0, 592, 593, 5, 111, 0, 0, 593, 594, 5, 116, 0,
+		0, 594, 102, 1, 0, 0, 0, 595, 596, 5, 105, 0, 0, 596, 597, 5, 115, 0, 0,
+		597, 104, 1, 0, 0, 0, 598, 599, 5, 46, 0, 0, 599, 600, 5, 91, 0, 0, 600,
+		106, 1, 0, 0, 0, 601, 602, 5, 124, 0, 0, 602, 603, 5, 124, 0, 0, 603, 108,
+		1, 0, 0, 0, 604, 605, 5, 47, 0, 0, 605, 110, 1, 0, 0, 0, 606, 607, 5, 37,
+		0, 0, 607, 112, 1, 0, 0, 0, 608, 609, 5, 60, 0, 0, 609, 610, 5, 60, 0,
+		0, 610, 114, 1, 0, 0, 0, 611, 612, 5, 62, 0, 0, 612, 613, 5, 62, 0, 0,
+		613, 116, 1, 0, 0, 0, 614, 615, 5, 38, 0, 0, 615, 118, 1, 0, 0, 0, 616,
+		617, 5, 38, 0, 0, 617, 618, 5, 38, 0, 0, 618, 120, 1, 0, 0, 0, 619, 620,
+		5, 126, 0, 0, 620, 122, 1, 0, 0, 0, 621, 622, 5, 33, 0, 0, 622, 124, 1,
+		0, 0, 0, 623, 624, 5, 95, 0, 0, 624, 625, 3, 147, 73, 0, 625, 126, 1, 0,
+		0, 0, 626, 627, 5, 106, 0, 0, 627, 628, 5, 111, 0, 0, 628, 629, 5, 105,
+		0, 0, 629, 749, 5, 110, 0, 0, 630, 631, 5, 105, 0, 0, 631, 632, 5, 110,
+		0, 0, 632, 633, 5, 110, 0, 0, 633, 634, 5, 101, 0, 0, 634, 635, 5, 114,
+		0, 0, 635, 636, 5, 95, 0, 0, 636, 637, 5, 106, 0, 0, 637, 638, 5, 111,
+		0, 0, 638, 639, 5, 105, 0, 0, 639, 749, 5, 110, 0, 0, 640, 641, 5, 108,
+		0, 0, 641, 642, 5, 101, 0, 0, 642, 643, 5, 102, 0, 0, 643, 644, 5, 116,
+		0, 0, 644, 645, 5, 95, 0, 0, 645, 646, 5, 106, 0, 0, 646, 647, 5, 111,
+		0, 0, 647, 648, 5, 105, 0, 0, 648, 749, 5, 110, 0, 0, 649, 650, 5, 108,
+		0, 0, 650, 651, 5, 106, 0, 0, 651, 652, 5, 111, 0, 0, 652, 653, 5, 105,
+		0, 0, 653, 749, 5, 110, 0, 0, 654, 655, 5, 108, 0, 0, 655, 656, 5, 101,
+		0, 0, 656, 657, 5, 102, 0, 0, 657, 658, 5, 116, 0, 0, 658, 659, 5, 95,
+		0, 0, 659, 660, 5, 111, 0, 0, 660, 661, 5, 117, 0, 0, 661, 662, 5, 116,
+		0, 0, 662, 663, 5, 101, 0, 0, 663, 664, 5, 114, 0, 0, 664, 665, 5, 95,
+		0, 0, 665, 666, 5, 106, 0, 0, 666, 667, 5, 111, 0, 0, 667, 668, 5, 105,
+		0, 0, 668, 749, 5, 110, 0, 0, 669, 670, 5, 108, 0, 0, 670, 671, 5, 111,
+		0, 0, 671, 672, 5, 106, 0, 0, 672, 673, 5, 111, 0, 0, 673, 674, 5, 105,
+		0, 0, 674, 749, 5, 110, 0, 0, 675, 676, 5, 114, 0, 0, 676, 677, 5, 105,
+		0, 0, 677, 678, 5, 103, 0, 0, 678, 679, 5, 104, 0, 0, 679, 680, 5, 116,
+		0, 0, 680, 681, 5, 95, 0, 0, 681, 682, 5, 106, 0, 0, 682, 683, 5, 111,
+		0, 0, 683, 684, 5, 105, 0, 0, 684, 749, 5, 110, 0, 0, 685, 686, 5, 114,
+		0, 0, 686, 687, 5, 106, 0, 0, 687, 688, 5, 111, 0, 0, 688, 689, 5, 105,
+		0, 0, 689, 749, 5, 110, 0, 0, 690, 691, 5, 114, 0, 0, 691, 692, 5, 105,
+		0, 0, 692, 693, 5, 103, 0, 0, 693, 694, 5, 104, 0, 0, 694, 695, 5, 116,
+		0, 0, 695, 696, 5, 95, 0, 0, 696, 697, 5, 111, 0, 0, 697, 698, 5, 117,
+		0, 0, 698, 699, 5, 116, 0, 0, 699, 700, 5, 101, 0, 0, 700, 701, 5, 114,
+		0, 0, 701, 702, 5, 95, 0, 0, 702, 703, 5, 106, 0, 0, 703, 704, 5, 111,
+		0, 0, 704, 705, 5, 105, 0, 0, 705, 749, 5, 110, 0, 0, 706, 707, 5, 114,
+		0, 0, 707, 708, 5, 111, 0, 0, 708, 709, 5, 106, 0, 0, 709, 710, 5, 111,
+		0, 0, 710, 711, 5, 105, 0, 0, 711, 749, 5, 110, 0, 0, 712, 713, 5, 102,
+		0, 0, 713, 714, 5, 117, 0, 0, 714, 715, 5, 108, 0, 0, 715, 716, 5, 108,
+		0, 0, 716, 717, 5, 95, 0, 0, 717, 718, 5, 111, 0, 0, 718, 719, 5, 117,
+		0, 0, 719, 720, 5, 116, 0, 0, 720, 721, 5, 101, 0, 0, 721, 722, 5, 114,
+		0, 0, 722, 723, 5, 95, 0, 0, 723, 724, 5, 106, 0, 0, 724, 725, 5, 111,
+		0, 0, 725, 726, 5, 105, 0, 0, 726, 749, 5, 110, 0, 0, 727, 728, 5, 102,
+		0, 0, 728, 729, 5, 111, 0, 0, 729, 730, 5, 106, 0, 0, 730, 731, 5, 111,
+		0, 0, 731, 732, 5, 105, 0, 0, 732, 749, 5, 110, 0, 0, 733, 734, 5, 99,
+		0, 0, 734, 735, 5, 114, 0, 0, 735, 736, 5, 111, 0, 0, 736, 737, 5, 115,
+		0, 0, 737, 738, 5, 115, 0, 0, 738, 739, 5, 95, 0, 0, 739, 740, 5, 106,
+		0, 0, 740, 741, 5, 111, 0, 0, 741, 742, 5, 105, 0, 0, 742, 749, 5, 110,
+		0, 0, 743, 744, 5, 120, 0, 0, 744, 745, 5, 106, 0, 0, 745, 746, 5, 111,
+		0, 0, 746, 747, 5, 105, 0, 0, 747, 749, 5, 110, 0, 0, 748, 626, 1, 0, 0,
+		0, 748, 630, 1, 0, 0, 0, 748, 640, 1, 0, 0, 0, 748, 649, 1, 0, 0, 0, 748,
+		654, 1, 0, 0, 0, 748, 669, 1, 0, 0, 0, 748, 675, 1, 0, 0, 0, 748, 685,
+		1, 0, 0, 0, 748, 690, 1, 0, 0, 0, 748, 706, 1, 0, 0, 0, 748, 712, 1, 0,
+		0, 0, 748, 727, 1, 0, 0, 0, 748, 733, 1, 0, 0, 0, 748, 743, 1, 0, 0, 0,
+		749, 128, 1, 0, 0, 0, 750, 751, 5, 117, 0, 0, 751, 752, 5, 110, 0, 0, 752,
+		753, 5, 105, 0, 0, 753, 754, 5, 111, 0, 0, 754, 780, 5, 110, 0, 0, 755,
+		756, 5, 117, 0, 0, 756, 757, 5, 110, 0, 0, 757, 758, 5, 105, 0, 0, 758,
+		759, 5, 111, 0, 0, 759, 760, 5, 110, 0, 0, 760, 761, 5, 95, 0, 0, 761,
+		762, 5, 97, 0, 0, 762, 763, 5, 108, 0, 0, 763, 780, 5, 108, 0, 0, 764,
+		765, 5, 105, 0, 0, 765, 766, 5, 110, 0, 0, 766, 767, 5, 116, 0, 0, 767,
+		768, 5, 101, 0, 0, 768, 769, 5, 114, 0, 0, 769, 770, 5, 115, 0, 0, 770,
+		771, 5, 101, 0, 0, 771, 772, 5, 99, 0, 0, 772, 780, 5, 116, 0, 0, 773,
+		774, 5, 101, 0, 0, 774, 775, 5, 120, 0, 0, 775, 776, 5, 99, 0, 0, 776,
+		777, 5, 101, 0, 0, 777, 778, 5, 112, 0, 0, 778, 780, 5, 116, 0, 0, 779,
+		750, 1, 0, 0, 0, 779, 755, 1, 0, 0, 0, 779, 764, 1, 0, 0, 0, 779, 773,
+		1, 0, 0, 0, 780, 130, 1, 0, 0, 0, 781, 782, 5, 119, 0, 0, 782, 783, 5,
+		104, 0, 0, 783, 784, 5, 101, 0, 0, 784, 785, 5, 114, 0, 0, 785, 793, 5,
+		101, 0, 0, 786, 787, 5, 115, 0, 0, 787, 788, 5, 101, 0, 0, 788, 789, 5,
+		108, 0, 0, 789, 790, 5, 101, 0, 0, 790, 791, 5, 99, 0, 0, 791, 793, 5,
+		116, 0, 0, 792, 781, 1, 0, 0, 0, 792, 786, 1, 0, 0, 0, 793, 132, 1, 0,
+		0, 0, 794, 795, 5, 103, 0, 0, 795, 796, 5, 114, 0, 0, 796, 797, 5, 111,
+		0, 0, 797, 798, 5, 117, 0, 0, 798, 799, 5, 112, 0, 0, 799, 800, 5, 95,
+		0, 0, 800, 801, 5, 98, 0, 0, 801, 805, 5, 121, 0, 0, 802, 803, 5, 103,
+		0, 0, 803, 805, 5, 98, 0, 0, 804, 794, 1, 0, 0, 0, 804, 802, 1, 0, 0, 0,
+		805, 134, 1, 0, 0, 0, 806, 807, 5, 104, 0, 0, 807, 808, 5, 97, 0, 0, 808,
+		809, 5, 118, 0, 0, 809, 810, 5, 105, 0, 0, 810, 811, 5, 110, 0, 0, 811,
+		812, 5, 103, 0, 0, 812, 136, 1, 0, 0, 0, 813, 814, 5, 111, 0, 0, 814, 815,
+		5, 114, 0, 0, 815, 816, 5, 100, 0, 0, 816, 817, 5, 101, 0, 0, 817, 818,
+		5, 114, 0, 0, 818, 819, 5, 95, 0, 0, 819, 820, 5, 98, 0, 0, 820, 831, 5,
+		121, 0, 0, 821, 822, 5, 115, 0, 0, 822, 823, 5, 111, 0, 0, 823, 824, 5,
+		114, 0, 0, 824, 825, 5, 116, 0, 0, 825, 826, 5, 95, 0, 0, 826, 827, 5,
+		98, 0, 0, 827, 831, 5, 121, 0, 0, 828, 829, 5, 111, 0, 0, 829, 831, 5,
+		98, 0, 0, 830, 813, 1, 0, 0, 0, 830, 821, 1, 0, 0, 0, 830, 828, 1, 0, 0,
+		0, 831, 138, 1, 0, 0, 0, 832, 833, 5, 58, 0, 0, 833, 834, 5, 99, 0, 0,
+		834, 835, 5, 111, 0, 0, 835, 836, 5, 117, 0, 0, 836, 837, 5, 110, 0, 0,
+		837, 889, 5, 116, 0, 0, 838, 839, 5, 58, 0, 0, 839, 840, 5, 99, 0, 0, 840,
+		841, 5, 111, 0, 0, 841, 842, 5, 117, 0, 0, 842, 843, 5, 110, 0, 0, 843,
+		844, 5, 116, 0, 0, 844, 845, 5, 95, 0, 0, 845, 846, 5, 117, 0, 0, 846,
+		847, 5, 110, 0, 0, 847, 848, 5, 105, 0, 0, 848, 849, 5, 113, 0, 0, 849,
+		850, 5, 117, 0, 0, 850, 889, 5, 101, 0, 0, 851, 852, 5, 58, 0, 0, 852,
+		853, 5, 97, 0, 0, 853, 854, 5, 118, 0, 0, 854, 889, 5, 103, 0, 0, 855,
+		856, 5, 58, 0, 0, 856, 857, 5, 103, 0, 0, 857, 858, 5, 114, 0, 0, 858,
+		859, 5, 111, 0, 0, 859, 860, 5, 117, 0, 0, 860, 861, 5, 112, 0, 0, 861,
+		862, 5, 95, 0, 0, 862, 863, 5, 98, 0, 0, 863, 889, 5, 121, 0, 0, 864, 865,
+		5, 58, 0, 0, 865, 866, 5, 109, 0, 0, 866, 867, 5, 97, 0, 0, 867, 889, 5,
+		120, 0, 0, 868, 869, 5, 58, 0, 0, 869, 870, 5, 109, 0, 0, 870, 871, 5,
+		105, 0, 0, 871, 889, 5, 110, 0, 0, 872, 873, 5, 58, 0, 0, 873, 874, 5,
+		111, 0, 0, 874, 875, 5, 114, 0, 0, 875, 876, 5, 100, 0, 0, 876, 877, 5,
+		101, 0, 0, 877, 878, 5, 114, 0, 0, 878, 879, 5, 95, 0, 0, 879, 880, 5,
+		98, 0, 0, 880, 889, 5, 121, 0, 0, 881, 882, 5, 58, 0, 0, 882, 883, 5, 117,
+		0, 0, 883, 884, 5, 110, 0, 0, 884, 885, 5, 105, 0, 0, 885, 886, 5, 113,
+		0, 0, 886, 887, 5, 117, 0, 0, 887, 889, 5, 101, 0, 0, 888, 832, 1, 0, 0,
+		0, 888, 838, 1, 0, 0, 0, 888, 851, 1, 0, 0, 0, 888, 855, 1, 0, 0, 0, 888,
+		864, 1, 0, 0, 0, 888, 868, 1, 0, 0, 0, 888, 872, 1, 0, 0, 0, 888, 881,
+		1, 0, 0, 0, 889, 140, 1, 0, 0, 0, 890, 891, 5, 36, 0, 0, 891, 892, 3, 147,
+		73, 0, 892, 142, 1, 0, 0, 0, 893, 894, 5, 116, 0, 0, 894, 895, 5, 114,
+		0, 0, 895, 896, 5, 117, 0, 0, 896, 903, 5, 101, 0, 0, 897, 898, 5, 102,
+		0, 0, 898, 899, 5, 97, 0, 0, 899, 900, 5, 108, 0, 0, 900, 901, 5, 115,
+		0, 0, 901, 903, 5, 101, 0, 0, 902, 893, 1, 0, 0, 0, 902, 897, 1, 0, 0,
+		0, 903, 144, 1, 0, 0, 0, 904, 905, 5, 110, 0, 0, 905, 906, 5, 117, 0, 0,
+		906, 907, 5, 108, 0, 0, 907, 908, 5, 108, 0, 0, 908, 146, 1, 0, 0, 0, 909,
+		913, 7, 0, 0, 0, 910, 912, 7, 1, 0, 0, 911, 910, 1, 0, 0, 0, 912, 915,
+		1, 0, 0, 0, 913, 911, 1, 0, 0, 0, 913, 914, 1, 0, 0, 0, 914, 148, 1, 0,
+		0, 0, 915, 913, 1, 0, 0, 0, 916, 918, 7, 2, 0, 0, 917, 916, 1, 0, 0, 0,
+		918, 919, 1, 0, 0, 0, 919, 917, 1, 0, 0, 0, 919, 920, 1, 0, 0, 0, 920,
+		921, 1, 0, 0, 0, 921, 925, 7, 0, 0, 0, 922, 924, 7, 1, 0, 0, 923, 922,
+		1, 0, 0, 0, 924, 927, 1, 0, 0, 0, 925, 923, 1, 0, 0, 0, 925, 926, 1, 0,
+		0, 0, 926, 150, 1, 0, 0, 0, 927, 925, 1, 0, 0, 0, 928, 930, 7, 3, 0, 0,
+		929, 928, 1, 0, 0, 0, 930, 931, 1, 0, 0, 0, 931, 929, 1, 0, 0, 0, 931,
+		932, 1, 0, 0, 0, 932, 933, 1, 0, 0, 0, 933, 934, 6, 75, 0, 0, 934, 152,
+		1, 0, 0, 0, 935, 936, 5, 40, 0, 0, 936, 154, 1, 0, 0, 0, 937, 938, 5, 41,
+		0, 0, 938, 156, 1, 0, 0, 0, 939, 940, 5, 91, 0, 0, 940, 158, 1, 0, 0, 0,
+		941, 942, 5, 93, 0, 0, 942, 160, 1, 0, 0, 0, 943, 944, 5, 44, 0, 0, 944,
+		162, 1, 0, 0, 0, 945, 946, 5, 124, 0, 0, 946, 164, 1, 0, 0, 0, 947, 948,
+		5, 58, 0, 0, 948, 166, 1, 0, 0, 0, 949, 950, 3, 171, 85, 0, 950, 168, 1,
+		0, 0, 0, 951, 976, 3, 167, 83, 0, 952, 954, 5, 45, 0, 0, 953, 952, 1, 0,
+		0, 0, 953, 954, 1, 0, 0, 0, 954, 955, 1, 0, 0, 0, 955, 956, 3, 171, 85,
+		0, 956, 958, 5, 46, 0, 0, 957, 959, 7, 2, 0, 0, 958, 957, 1, 0, 0, 0, 959,
+		960, 1, 0, 0, 0, 960, 958, 1, 0, 0, 0, 960, 961, 1, 0, 0, 0, 961, 963,
+		1, 0, 0, 0, 962, 964, 3, 175, 87, 0, 963, 962, 1, 0, 0, 0, 963, 964, 1,
+		0, 0, 0, 964, 976, 1, 0, 0, 0, 965, 967, 5, 45, 0, 0, 966, 965, 1, 0, 0,
+		0, 966, 967, 1, 0, 0, 0, 967, 968, 1, 0, 0, 0, 968, 969, 3, 171, 85, 0,
+		969, 970, 3, 175, 87, 0, 970, 976, 1, 0, 0, 0, 971, 973, 5, 45, 0, 0, 972,
+		971, 1, 0, 0, 0, 972, 973, 1, 0, 0, 0, 973, 974, 1, 0, 0, 0, 974, 976,
+		3, 171, 85, 0, 975, 951, 1, 0, 0, 0, 975, 953, 1, 0, 0, 0, 975, 966, 1,
+		0, 0, 0, 975, 972, 1, 0, 0, 0, 976, 170, 1, 0, 0, 0, 977, 986, 5, 48, 0,
+		0, 978, 982, 7, 4, 0, 0, 979, 981, 7, 2, 0, 0, 980, 979, 1, 0, 0, 0, 981,
+		984, 1, 0, 0, 0, 982, 980, 1, 0, 0, 0, 982, 983, 1, 0, 0, 0, 983, 986,
+		1, 0, 0, 0, 984, 982, 1, 0, 0, 0, 985, 977, 1, 0, 0, 0, 985, 978, 1, 0,
+		0, 0, 986, 172, 1, 0, 0, 0, 987, 989, 7, 2, 0, 0, 988, 987, 1, 0, 0, 0,
+		989, 990, 1, 0, 0, 0, 990, 988, 1, 0, 0, 0, 990, 991, 1, 0, 0, 0, 991,
+		174, 1, 0, 0, 0, 992, 994, 7, 5, 0, 0, 993, 995, 7, 6, 0, 0, 994, 993,
+		1, 0, 0, 0, 994, 995, 1, 0, 0, 0, 995, 996, 1, 0, 0, 0, 996, 997, 3, 171,
+		85, 0, 997, 176, 1, 0, 0, 0, 998, 999, 5, 60, 0, 0, 999, 1000, 5, 61, 0,
+		0, 1000, 178, 1, 0, 0, 0, 1001, 1002, 5, 60, 0, 0, 1002, 180, 1, 0, 0,
+		0, 1003, 1004, 5, 62, 0, 0, 1004, 1005, 5, 61, 0, 0, 1005, 182, 1, 0, 0,
+		0, 1006, 1007, 5, 62, 0, 0, 1007, 184, 1, 0, 0, 0, 1008, 1009, 5, 33, 0,
+		0, 1009, 1010, 5, 61, 0, 0, 1010, 186, 1, 0, 0, 0, 1011, 1012, 5, 61, 0,
+		0, 1012, 1013, 5, 61, 0, 0, 1013, 188, 1, 0, 0, 0, 1014, 1020, 5, 46, 0,
+		0, 1015, 1021, 3, 141, 70, 0, 1016, 1021, 3, 147, 73, 0, 1017, 1021, 3,
+		193, 96, 0, 1018, 1021, 3, 173, 86, 0, 1019, 1021, 3, 149, 74, 0, 1020,
+		1015, 1, 0, 0, 0, 1020, 1016, 1, 0, 0, 0, 1020, 1017, 1, 0, 0, 0, 1020,
+		1018, 1, 0, 0, 0, 1020, 1019, 1, 0, 0, 0, 1021, 190, 1, 0, 0, 0, 1022,
+		1023, 5, 64, 0, 0, 1023, 1028, 3, 147, 73, 0, 1024, 1025, 5, 47, 0, 0,
+		1025, 1027, 3, 147, 73, 0, 1026, 1024, 1, 0, 0, 0, 1027, 1030, 1, 0, 0,
+		0, 1028, 1026, 1, 0, 0, 0, 1028, 1029, 1, 0, 0, 0, 1029, 192, 1, 0, 0,
+		0, 1030, 1028, 1, 0, 0, 0, 1031, 1036, 5, 34, 0, 0, 1032, 1035, 3, 195,
+		97, 0, 1033, 1035, 8, 7, 0, 0, 1034, 1032, 1, 0, 0, 0, 1034, 1033, 1, 0,
+		0, 0, 1035, 1038, 1, 0, 0, 0, 1036, 1034, 1, 0, 0, 0, 1036, 1037, 1, 0,
+		0, 0, 1037, 1039, 1, 0, 0, 0, 1038, 1036, 1, 0, 0, 0, 1039, 1040, 5, 34,
+		0, 0, 1040, 194, 1, 0, 0, 0, 1041, 1044, 5, 92, 0, 0, 1042, 1045, 7, 8,
+		0, 0, 1043, 1045, 3, 197, 98, 0, 1044, 1042, 1, 0, 0, 0, 1044, 1043, 1,
+		0, 0, 0, 1045, 196, 1, 0, 0, 0, 1046, 1047, 5, 117, 0, 0, 1047, 1048, 3,
+		199, 99, 0, 1048, 1049, 3, 199, 99, 0, 1049, 1050, 3, 199, 99, 0, 1050,
+		1051, 3, 199, 99, 0, 1051, 198, 1, 0, 0, 0, 1052, 1053, 7, 9, 0, 0, 1053,
+		200, 1, 0, 0, 0, 1054, 1055, 7, 2, 0, 0, 1055, 202, 1, 0, 0, 0, 1056, 1057,
+		7, 10, 0, 0, 1057, 204, 1, 0, 0, 0, 1058, 1059, 7, 11, 0, 0, 1059, 206,
+		1, 0, 0, 0, 1060, 1061, 7, 12, 0, 0, 1061, 208, 1, 0, 0, 0, 1062, 1063,
+		7, 13, 0, 0, 1063, 210, 1, 0, 0, 0, 1064, 1065, 7, 5, 0, 0, 1065, 212,
+		1, 0, 0, 0, 1066, 1067, 7, 14, 0, 0, 1067, 214, 1, 0, 0, 0, 1068, 1069,
+		7, 15, 0, 0, 1069, 216, 1, 0, 0, 0, 1070, 1071, 7, 16, 0, 0, 1071, 218,
+		1, 0, 0, 0, 1072, 1073, 7, 17, 0, 0, 1073, 220, 1, 0, 0, 0, 1074, 1075,
+		7, 18, 0, 0, 1075, 222, 1, 0, 0, 0, 1076, 1077, 7, 19, 0, 0, 1077, 224,
+		1, 0, 0, 0, 1078, 1079, 7, 20, 0, 0, 1079, 226, 1, 0, 0, 0, 1080, 1081,
+		7, 21, 0, 0, 1081, 228, 1, 0, 0, 0, 1082, 1083, 7, 22, 0, 0, 1083, 230,
+		1, 0, 0, 0, 1084, 1085, 7, 23, 0, 0, 1085, 232, 1, 0, 0, 0, 1086, 1087,
+		7, 24, 0, 0, 1087, 234, 1, 0, 0, 0, 1088, 1089, 7, 25, 0, 0, 1089, 236,
+		1, 0, 0, 0, 1090, 1091, 7, 26, 0, 0, 1091, 238, 1, 0, 0, 0, 1092, 1093,
+		7, 27, 0, 0, 1093, 240, 1, 0, 0, 0, 1094, 1095, 7, 28, 0, 0, 1095, 242,
+		1, 0, 0, 0, 1096, 1097, 7, 29, 0, 0, 1097, 244, 1, 0, 0, 0, 1098, 1099,
+		7, 30, 0, 0, 1099, 246, 1, 0, 0, 0, 1100, 1101, 7, 31, 0, 0, 1101, 248,
+		1, 0, 0, 0, 1102, 1103, 7, 32, 0, 0, 1103, 250, 1, 0, 0, 0, 1104, 1105,
+		7, 33, 0, 0, 1105, 252, 1, 0, 0, 0, 1106, 1107, 7, 34, 0, 0, 1107, 254,
+		1, 0, 0, 0, 1108, 1112, 5, 35, 0, 0, 1109, 1111, 9, 0, 0, 0, 1110, 1109,
+		1, 0, 0, 0, 1111, 1114, 1, 0, 0, 0, 1112, 1113, 1, 0, 0, 0, 1112, 1110,
+		1, 0, 0, 0, 1113, 1115, 1, 0, 0, 0, 1114, 1112, 1, 0, 0, 0, 1115, 1116,
+		5, 10, 0, 0, 1116, 1117, 1, 0, 0, 0, 1117, 1118, 6, 127, 0, 0, 1118, 256,
+		1, 0, 0, 0, 28, 0, 748, 779, 792, 804, 830, 888, 902, 913, 919, 925, 931,
+		953, 960, 963, 966, 972, 975, 982, 985, 990, 994, 1020, 1028, 1034, 1036,
+		1044, 1112, 1, 6, 0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -515,37 +660,68 @@ const (
 	SLQLexerT__29                 = 30
 	SLQLexerT__30                 = 31
 	SLQLexerT__31                 = 32
-	SLQLexerPROPRIETARY_FUNC_NAME = 33
-	SLQLexerJOIN_TYPE             = 34
-	SLQLexerWHERE                 = 35
-	SLQLexerGROUP_BY              = 36
-	SLQLexerHAVING                = 37
-	SLQLexerORDER_BY              = 38
-	SLQLexerALIAS_RESERVED        = 39
-	SLQLexerARG                   = 40
-	SLQLexerBOOL                  = 41
-	SLQLexerNULL                  = 42
-	SLQLexerID                    = 43
-	SLQLexerIDNUM                 = 44
-	SLQLexerWS                    = 45
-	SLQLexerLPAR                  = 46
-	SLQLexerRPAR                  = 47
-	SLQLexerLBRA                  = 48
-	SLQLexerRBRA                  = 49
-	SLQLexerCOMMA                 = 50
-	SLQLexerPIPE                  = 51
-	SLQLexerCOLON                 = 52
-	SLQLexerNN                    = 53
-	SLQLexerNUMBER                = 54
-	SLQLexerDIGITS                = 55
-	SLQLexerLT_EQ                 = 56
-	SLQLexerLT                    = 57
-	SLQLexerGT_EQ                 = 58
-	SLQLexerGT                    = 59
-	SLQLexerNEQ                   = 60
-	SLQLexerEQ                    = 61
-	SLQLexerNAME                  = 62
-	SLQLexerHANDLE                = 63
-	SLQLexerSTRING                = 64
-	SLQLexerLINECOMMENT           = 65
+	SLQLexerT__32                 = 33
+	SLQLexerT__33                 = 34
+	SLQLexerT__34                 = 35
+	SLQLexerT__35                 = 36
+	SLQLexerT__36                 = 37
+	SLQLexerT__37                 = 38
+	SLQLexerT__38                 = 39
+	SLQLexerT__39                 = 40
+	SLQLexerT__40                 = 41
+	SLQLexerT__41                 = 42
+	SLQLexerT__42                 = 43
+	SLQLexerT__43                 = 44
+	SLQLexerT__44                 = 45
+	SLQLexerT__45                 = 46
+	SLQLexerT__46                 = 47
+	SLQLexerT__47                 = 48
+	SLQLexerT__48                 = 49
+	SLQLexerT__49                 = 50
+	SLQLexerT__50                 = 51
+	SLQLexerT__51                 = 52
+	SLQLexerT__52                 = 53
+	SLQLexerT__53                 = 54
+	SLQLexerT__54                 = 55
+	SLQLexerT__55                 = 56
+	SLQLexerT__56                 = 57
+	SLQLexerT__57                 = 58
+	SLQLexerT__58                 = 59
+	SLQLexerT__59                 = 60
+	SLQLexerT__60                 = 61
+	SLQLexerT__61                 = 62
+	SLQLexerPROPRIETARY_FUNC_NAME = 63
+	SLQLexerJOIN_TYPE             = 64
+	SLQLexerSET_OP                = 65
+	SLQLexerWHERE                 = 66
+	SLQLexerGROUP_BY              = 67
+	SLQLexerHAVING                = 68
+	SLQLexerORDER_BY              = 69
+	SLQLexerALIAS_RESERVED        = 70
+	SLQLexerARG                   = 71
+	SLQLexerBOOL                  = 72
+	SLQLexerNULL                  = 73
+	SLQLexerID                    = 74
+	SLQLexerIDNUM                 = 75
+	SLQLexerWS                    = 76
+	SLQLexerLPAR                  = 77
+	SLQLexerRPAR                  = 78
+	SLQLexerLBRA                  = 79
+	SLQLexerRBRA                  = 80
+	SLQLexerCOMMA                 = 81
+	SLQLexerPIPE                  = 82
+	SLQLexerCOLON                 = 83
+	SLQLexerNN                    = 84
+	SLQLexerNUMBER                = 85
+	SLQLexerDIGITS                = 86
+	SLQLexerLT_EQ                 = 87
+	SLQLexerLT                    = 88
+	SLQLexerGT_EQ                 = 89
+	SLQLexerGT                    = 90
+	SLQLexerNEQ                   = 91
+	SLQLexerEQ                    = 92
+	SLQLexerNAME                  = 93
+	SLQLexerHANDLE                = 94
+	SLQLexerSTRING                = 95
+	SLQLexerLINECOMMENT           = 96
 )
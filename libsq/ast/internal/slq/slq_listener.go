@@ -10,6 +10,12 @@ type SLQListener interface {
 	// EnterStmtList is called when entering the stmtList production.
 	EnterStmtList(c *StmtListContext)
 
+	// EnterStmt is called when entering the stmt production.
+	EnterStmt(c *StmtContext)
+
+	// EnterLet is called when entering the let production.
+	EnterLet(c *LetContext)
+
 	// EnterQuery is called when entering the query production.
 	EnterQuery(c *QueryContext)
 
@@ -25,6 +31,12 @@ type SLQListener interface {
 	// EnterFunc is called when entering the func production.
 	EnterFunc(c *FuncContext)
 
+	// EnterOver is called when entering the over production.
+	EnterOver(c *OverContext)
+
+	// EnterPartitionBy is called when entering the partitionBy production.
+	EnterPartitionBy(c *PartitionByContext)
+
 	// EnterFuncName is called when entering the funcName production.
 	EnterFuncName(c *FuncNameContext)
 
@@ -34,6 +46,9 @@ type SLQListener interface {
 	// EnterJoinTable is called when entering the joinTable production.
 	EnterJoinTable(c *JoinTableContext)
 
+	// EnterSetOp is called when entering the setOp production.
+	EnterSetOp(c *SetOpContext)
+
 	// EnterUniqueFunc is called when entering the uniqueFunc production.
 	EnterUniqueFunc(c *UniqueFuncContext)
 
@@ -67,6 +82,9 @@ type SLQListener interface {
 	// EnterAlias is called when entering the alias production.
 	EnterAlias(c *AliasContext)
 
+	// EnterAliasKeyword is called when entering the aliasKeyword production.
+	EnterAliasKeyword(c *AliasKeywordContext)
+
 	// EnterArg is called when entering the arg production.
 	EnterArg(c *ArgContext)
 
@@ -85,6 +103,18 @@ type SLQListener interface {
 	// EnterExpr is called when entering the expr production.
 	EnterExpr(c *ExprContext)
 
+	// EnterInOperator is called when entering the inOperator production.
+	EnterInOperator(c *InOperatorContext)
+
+	// EnterNullOperator is called when entering the nullOperator production.
+	EnterNullOperator(c *NullOperatorContext)
+
+	// EnterExprList is called when entering the exprList production.
+	EnterExprList(c *ExprListContext)
+
+	// EnterSubquery is called when entering the subquery production.
+	EnterSubquery(c *SubqueryContext)
+
 	// EnterLiteral is called when entering the literal production.
 	EnterLiteral(c *LiteralContext)
 
@@ -94,6 +124,12 @@ type SLQListener interface {
 	// ExitStmtList is called when exiting the stmtList production.
 	ExitStmtList(c *StmtListContext)
 
+	// ExitStmt is called when exiting the stmt production.
+	ExitStmt(c *StmtContext)
+
+	// ExitLet is called when exiting the let production.
+	ExitLet(c *LetContext)
+
 	// ExitQuery is called when exiting the query production.
 	ExitQuery(c *QueryContext)
 
@@ -109,6 +145,12 @@ type SLQListener interface {
 	// ExitFunc is called when exiting the func production.
 	ExitFunc(c *FuncContext)
 
+	// ExitOver is called when exiting the over production.
+	ExitOver(c *OverContext)
+
+	// ExitPartitionBy is called when exiting the partitionBy production.
+	ExitPartitionBy(c *PartitionByContext)
+
 	// ExitFuncName is called when exiting the funcName production.
 	ExitFuncName(c *FuncNameContext)
 
@@ -118,6 +160,9 @@ type SLQListener interface {
 	// ExitJoinTable is called when exiting the joinTable production.
 	ExitJoinTable(c *JoinTableContext)
 
+	// ExitSetOp is called when exiting the setOp production.
+	ExitSetOp(c *SetOpContext)
+
 	// ExitUniqueFunc is called when exiting the uniqueFunc production.
 	ExitUniqueFunc(c *UniqueFuncContext)
 
@@ -151,6 +196,9 @@ type SLQListener interface {
 	// ExitAlias is called when exiting the alias production.
 	ExitAlias(c *AliasContext)
 
+	// ExitAliasKeyword is called when exiting the aliasKeyword production.
+	ExitAliasKeyword(c *AliasKeywordContext)
+
 	// ExitArg is called when exiting the arg production.
 	ExitArg(c *ArgContext)
 
@@ -169,6 +217,18 @@ type SLQListener interface {
 	// ExitExpr is called when exiting the expr production.
 	ExitExpr(c *ExprContext)
 
+	// ExitInOperator is called when exiting the inOperator production.
+	ExitInOperator(c *InOperatorContext)
+
+	// ExitNullOperator is called when exiting the nullOperator production.
+	ExitNullOperator(c *NullOperatorContext)
+
+	// ExitExprList is called when exiting the exprList production.
+	ExitExprList(c *ExprListContext)
+
+	// ExitSubquery is called when exiting the subquery production.
+	ExitSubquery(c *SubqueryContext)
+
 	// ExitLiteral is called when exiting the literal production.
 	ExitLiteral(c *LiteralContext)
 
@@ -32,165 +32,230 @@ var SLQParserStaticData struct {
 func slqParserInit() {
 	staticData := &SLQParserStaticData
 	staticData.LiteralNames = []string{
-		"", "';'", "'*'", "'sum'", "'avg'", "'max'", "'min'", "'schema'", "'catalog'",
-		"'rownum'", "'contains'", "'startswith'", "'endswith'", "'icontains'",
-		"'istartswith'", "'iendswith'", "'like'", "'ilike'", "'unique'", "'uniq'",
-		"'count'", "'+'", "'-'", "'.['", "'||'", "'/'", "'%'", "'<<'", "'>>'",
-		"'&'", "'&&'", "'~'", "'!'", "", "", "", "", "'having'", "", "", "",
-		"", "'null'", "", "", "", "'('", "')'", "'['", "']'", "','", "'|'",
-		"':'", "", "", "", "'<='", "'<'", "'>='", "'>'", "'!='", "'=='",
+		"", "';'", "'let'", "'='", "'*'", "'cast'", "'over'", "'partition_by'",
+		"'sum'", "'avg'", "'max'", "'min'", "'schema'", "'catalog'", "'rownum'",
+		"'contains'", "'startswith'", "'endswith'", "'icontains'", "'istartswith'",
+		"'iendswith'", "'like'", "'ilike'", "'case'", "'rank'", "'dense_rank'",
+		"'row_number'", "'ntile'", "'lag'", "'lead'", "'first_value'", "'last_value'",
+		"'now'", "'date_trunc'", "'date_part'", "'date_add'", "'lower'", "'upper'",
+		"'trim'", "'substr'", "'replace'", "'length'", "'concat'", "'coalesce'",
+		"'nullif'", "'unique'", "'uniq'", "'count'", "'+'", "'-'", "'in'", "'not'",
+		"'is'", "'.['", "'||'", "'/'", "'%'", "'<<'", "'>>'", "'&'", "'&&'",
+		"'~'", "'!'", "", "", "", "", "", "'having'", "", "", "", "", "'null'",
+		"", "", "", "'('", "')'", "'['", "']'", "','", "'|'", "':'", "", "",
+		"", "'<='", "'<'", "'>='", "'>'", "'!='", "'=='",
 	}
 	staticData.SymbolicNames = []string{
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "PROPRIETARY_FUNC_NAME",
-		"JOIN_TYPE", "WHERE", "GROUP_BY", "HAVING", "ORDER_BY", "ALIAS_RESERVED",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "PROPRIETARY_FUNC_NAME",
+		"JOIN_TYPE", "SET_OP", "WHERE", "GROUP_BY", "HAVING", "ORDER_BY", "ALIAS_RESERVED",
 		"ARG", "BOOL", "NULL", "ID", "IDNUM", "WS", "LPAR", "RPAR", "LBRA",
 		"RBRA", "COMMA", "PIPE", "COLON", "NN", "NUMBER", "DIGITS", "LT_EQ",
 		"LT", "GT_EQ", "GT", "NEQ", "EQ", "NAME", "HANDLE", "STRING", "LINECOMMENT",
 	}
 	staticData.RuleNames = []string{
-		"stmtList", "query", "segment", "element", "funcElement", "func", "funcName",
-		"join", "joinTable", "uniqueFunc", "countFunc", "where", "groupByTerm",
-		"groupBy", "having", "orderByTerm", "orderBy", "selector", "selectorElement",
-		"alias", "arg", "handleTable", "handle", "rowRange", "exprElement",
-		"expr", "literal", "unaryOperator",
+		"stmtList", "stmt", "let", "query", "segment", "element", "funcElement",
+		"func", "over", "partitionBy", "funcName", "join", "joinTable", "setOp",
+		"uniqueFunc", "countFunc", "where", "groupByTerm", "groupBy", "having",
+		"orderByTerm", "orderBy", "selector", "selectorElement", "alias", "aliasKeyword",
+		"arg", "handleTable", "handle", "rowRange", "exprElement", "expr", "inOperator",
+		"nullOperator", "exprList", "subquery", "literal", "unaryOperator",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 65, 293, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 96, 417, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
 		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 1, 0, 5, 0, 58, 8, 0, 10, 0, 12, 0, 61, 9, 0, 1, 0,
-		1, 0, 4, 0, 65, 8, 0, 11, 0, 12, 0, 66, 1, 0, 5, 0, 70, 8, 0, 10, 0, 12,
-		0, 73, 9, 0, 1, 0, 5, 0, 76, 8, 0, 10, 0, 12, 0, 79, 9, 0, 1, 1, 1, 1,
-		1, 1, 5, 1, 84, 8, 1, 10, 1, 12, 1, 87, 9, 1, 1, 2, 1, 2, 1, 2, 5, 2, 92,
-		8, 2, 10, 2, 12, 2, 95, 9, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
-		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 110, 8, 3, 1, 4, 1, 4, 3, 4,
-		114, 8, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 5, 5, 121, 8, 5, 10, 5, 12, 5,
-		124, 9, 5, 1, 5, 3, 5, 127, 8, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1,
-		7, 1, 7, 1, 7, 3, 7, 138, 8, 7, 1, 7, 1, 7, 1, 8, 3, 8, 143, 8, 8, 1, 8,
-		1, 8, 3, 8, 147, 8, 8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 3, 10, 154, 8,
-		10, 1, 10, 3, 10, 157, 8, 10, 1, 10, 3, 10, 160, 8, 10, 1, 11, 1, 11, 1,
-		11, 3, 11, 165, 8, 11, 1, 11, 1, 11, 1, 12, 1, 12, 3, 12, 171, 8, 12, 1,
-		13, 1, 13, 1, 13, 1, 13, 1, 13, 5, 13, 178, 8, 13, 10, 13, 12, 13, 181,
-		9, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 3,
-		15, 192, 8, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 5, 16, 199, 8, 16, 10,
-		16, 12, 16, 202, 9, 16, 1, 16, 1, 16, 1, 17, 1, 17, 3, 17, 208, 8, 17,
-		1, 18, 1, 18, 3, 18, 212, 8, 18, 1, 19, 1, 19, 1, 19, 3, 19, 217, 8, 19,
-		1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 3, 21, 224, 8, 21, 1, 22, 1, 22, 1,
-		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 237,
-		8, 23, 1, 23, 1, 23, 1, 24, 1, 24, 3, 24, 243, 8, 24, 1, 25, 1, 25, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25,
-		257, 8, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
-		3, 25, 278, 8, 25, 1, 25, 1, 25, 1, 25, 1, 25, 5, 25, 284, 8, 25, 10, 25,
-		12, 25, 287, 9, 25, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 0, 1, 50, 28, 0,
-		2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38,
-		40, 42, 44, 46, 48, 50, 52, 54, 0, 9, 2, 0, 3, 17, 33, 33, 1, 0, 18, 19,
-		1, 0, 21, 22, 3, 0, 40, 40, 43, 43, 64, 64, 2, 0, 2, 2, 25, 26, 1, 0, 27,
-		29, 1, 0, 56, 59, 3, 0, 41, 42, 53, 54, 64, 64, 2, 0, 21, 22, 31, 32, 320,
-		0, 59, 1, 0, 0, 0, 2, 80, 1, 0, 0, 0, 4, 88, 1, 0, 0, 0, 6, 109, 1, 0,
-		0, 0, 8, 111, 1, 0, 0, 0, 10, 115, 1, 0, 0, 0, 12, 130, 1, 0, 0, 0, 14,
-		132, 1, 0, 0, 0, 16, 142, 1, 0, 0, 0, 18, 148, 1, 0, 0, 0, 20, 150, 1,
-		0, 0, 0, 22, 161, 1, 0, 0, 0, 24, 170, 1, 0, 0, 0, 26, 172, 1, 0, 0, 0,
-		28, 184, 1, 0, 0, 0, 30, 189, 1, 0, 0, 0, 32, 193, 1, 0, 0, 0, 34, 205,
-		1, 0, 0, 0, 36, 209, 1, 0, 0, 0, 38, 216, 1, 0, 0, 0, 40, 218, 1, 0, 0,
-		0, 42, 220, 1, 0, 0, 0, 44, 225, 1, 0, 0, 0, 46, 227, 1, 0, 0, 0, 48, 240,
-		1, 0, 0, 0, 50, 256, 1, 0, 0, 0, 52, 288, 1, 0, 0, 0, 54, 290, 1, 0, 0,
-		0, 56, 58, 5, 1, 0, 0, 57, 56, 1, 0, 0, 0, 58, 61, 1, 0, 0, 0, 59, 57,
-		1, 0, 0, 0, 59, 60, 1, 0, 0, 0, 60, 62, 1, 0, 0, 0, 61, 59, 1, 0, 0, 0,
-		62, 71, 3, 2, 1, 0, 63, 65, 5, 1, 0, 0, 64, 63, 1, 0, 0, 0, 65, 66, 1,
-		0, 0, 0, 66, 64, 1, 0, 0, 0, 66, 67, 1, 0, 0, 0, 67, 68, 1, 0, 0, 0, 68,
-		70, 3, 2, 1, 0, 69, 64, 1, 0, 0, 0, 70, 73, 1, 0, 0, 0, 71, 69, 1, 0, 0,
-		0, 71, 72, 1, 0, 0, 0, 72, 77, 1, 0, 0, 0, 73, 71, 1, 0, 0, 0, 74, 76,
-		5, 1, 0, 0, 75, 74, 1, 0, 0, 0, 76, 79, 1, 0, 0, 0, 77, 75, 1, 0, 0, 0,
-		77, 78, 1, 0, 0, 0, 78, 1, 1, 0, 0, 0, 79, 77, 1, 0, 0, 0, 80, 85, 3, 4,
-		2, 0, 81, 82, 5, 51, 0, 0, 82, 84, 3, 4, 2, 0, 83, 81, 1, 0, 0, 0, 84,
-		87, 1, 0, 0, 0, 85, 83, 1, 0, 0, 0, 85, 86, 1, 0, 0, 0, 86, 3, 1, 0, 0,
-		0, 87, 85, 1, 0, 0, 0, 88, 93, 3, 6, 3, 0, 89, 90, 5, 50, 0, 0, 90, 92,
-		3, 6, 3, 0, 91, 89, 1, 0, 0, 0, 92, 95, 1, 0, 0, 0, 93, 91, 1, 0, 0, 0,
-		93, 94, 1, 0, 0, 0, 94, 5, 1, 0, 0, 0, 95, 93, 1, 0, 0, 0, 96, 110, 3,
-		42, 21, 0, 97, 110, 3, 44, 22, 0, 98, 110, 3, 36, 18, 0, 99, 110, 3, 14,
-		7, 0, 100, 110, 3, 26, 13, 0, 101, 110, 3, 28, 14, 0, 102, 110, 3, 32,
-		16, 0, 103, 110, 3, 46, 23, 0, 104, 110, 3, 18, 9, 0, 105, 110, 3, 20,
-		10, 0, 106, 110, 3, 22, 11, 0, 107, 110, 3, 8, 4, 0, 108, 110, 3, 48, 24,
-		0, 109, 96, 1, 0, 0, 0, 109, 97, 1, 0, 0, 0, 109, 98, 1, 0, 0, 0, 109,
-		99, 1, 0, 0, 0, 109, 100, 1, 0, 0, 0, 109, 101, 1, 0, 0, 0, 109, 102, 1,
-		0, 0, 0, 109, 103, 1, 0, 0, 0, 109, 104, 1, 0, 0, 0, 109, 105, 1, 0, 0,
-		0, 109, 106, 1, 0, 0, 0, 109, 107, 1, 0, 0, 0, 109, 108, 1, 0, 0, 0, 110,
-		7, 1, 0, 0, 0, 111, 113, 3, 10, 5, 0, 112, 114, 3, 38, 19, 0, 113, 112,
-		1, 0, 0, 0, 113, 114, 1, 0, 0, 0, 114, 9, 1, 0, 0, 0, 115, 116, 3, 12,
-		6, 0, 116, 126, 5, 46, 0, 0, 117, 122, 3, 50, 25, 0, 118, 119, 5, 50, 0,
-		0, 119, 121, 3, 50, 25, 0, 120, 118, 1, 0, 0, 0, 121, 124, 1, 0, 0, 0,
-		122, 120, 1, 0, 0, 0, 122, 123, 1, 0, 0, 0, 123, 127, 1, 0, 0, 0, 124,
-		122, 1, 0, 0, 0, 125, 127, 5, 2, 0, 0, 126, 117, 1, 0, 0, 0, 126, 125,
-		1, 0, 0, 0, 126, 127, 1, 0, 0, 0, 127, 128, 1, 0, 0, 0, 128, 129, 5, 47,
-		0, 0, 129, 11, 1, 0, 0, 0, 130, 131, 7, 0, 0, 0, 131, 13, 1, 0, 0, 0, 132,
-		133, 5, 34, 0, 0, 133, 134, 5, 46, 0, 0, 134, 137, 3, 16, 8, 0, 135, 136,
-		5, 50, 0, 0, 136, 138, 3, 50, 25, 0, 137, 135, 1, 0, 0, 0, 137, 138, 1,
-		0, 0, 0, 138, 139, 1, 0, 0, 0, 139, 140, 5, 47, 0, 0, 140, 15, 1, 0, 0,
-		0, 141, 143, 5, 63, 0, 0, 142, 141, 1, 0, 0, 0, 142, 143, 1, 0, 0, 0, 143,
-		144, 1, 0, 0, 0, 144, 146, 5, 62, 0, 0, 145, 147, 3, 38, 19, 0, 146, 145,
-		1, 0, 0, 0, 146, 147, 1, 0, 0, 0, 147, 17, 1, 0, 0, 0, 148, 149, 7, 1,
-		0, 0, 149, 19, 1, 0, 0, 0, 150, 156, 5, 20, 0, 0, 151, 153, 5, 46, 0, 0,
-		152, 154, 3, 34, 17, 0, 153, 152, 1, 0, 0, 0, 153, 154, 1, 0, 0, 0, 154,
-		155, 1, 0, 0, 0, 155, 157, 5, 47, 0, 0, 156, 151, 1, 0, 0, 0, 156, 157,
-		1, 0, 0, 0, 157, 159, 1, 0, 0, 0, 158, 160, 3, 38, 19, 0, 159, 158, 1,
-		0, 0, 0, 159, 160, 1, 0, 0, 0, 160, 21, 1, 0, 0, 0, 161, 162, 5, 35, 0,
-		0, 162, 164, 5, 46, 0, 0, 163, 165, 3, 50, 25, 0, 164, 163, 1, 0, 0, 0,
-		164, 165, 1, 0, 0, 0, 165, 166, 1, 0, 0, 0, 166, 167, 5, 47, 0, 0, 167,
-		23, 1, 0, 0, 0, 168, 171, 3, 34, 17, 0, 169, 171, 3, 10, 5, 0, 170, 168,
-		1, 0, 0, 0, 170, 169, 1, 0, 0, 0, 171, 25, 1, 0, 0, 0, 172, 173, 5, 36,
-		0, 0, 173, 174, 5, 46, 0, 0, 174, 179, 3, 24, 12, 0, 175, 176, 5, 50, 0,
-		0, 176, 178, 3, 24, 12, 0, 177, 175, 1, 0, 0, 0, 178, 181, 1, 0, 0, 0,
-		179, 177, 1, 0, 0, 0, 179, 180, 1, 0, 0, 0, 180, 182, 1, 0, 0, 0, 181,
-		179, 1, 0, 0, 0, 182, 183, 5, 47, 0, 0, 183, 27, 1, 0, 0, 0, 184, 185,
-		5, 37, 0, 0, 185, 186, 5, 46, 0, 0, 186, 187, 3, 50, 25, 0, 187, 188, 5,
-		47, 0, 0, 188, 29, 1, 0, 0, 0, 189, 191, 3, 34, 17, 0, 190, 192, 7, 2,
-		0, 0, 191, 190, 1, 0, 0, 0, 191, 192, 1, 0, 0, 0, 192, 31, 1, 0, 0, 0,
-		193, 194, 5, 38, 0, 0, 194, 195, 5, 46, 0, 0, 195, 200, 3, 30, 15, 0, 196,
-		197, 5, 50, 0, 0, 197, 199, 3, 30, 15, 0, 198, 196, 1, 0, 0, 0, 199, 202,
-		1, 0, 0, 0, 200, 198, 1, 0, 0, 0, 200, 201, 1, 0, 0, 0, 201, 203, 1, 0,
-		0, 0, 202, 200, 1, 0, 0, 0, 203, 204, 5, 47, 0, 0, 204, 33, 1, 0, 0, 0,
-		205, 207, 5, 62, 0, 0, 206, 208, 5, 62, 0, 0, 207, 206, 1, 0, 0, 0, 207,
-		208, 1, 0, 0, 0, 208, 35, 1, 0, 0, 0, 209, 211, 3, 34, 17, 0, 210, 212,
-		3, 38, 19, 0, 211, 210, 1, 0, 0, 0, 211, 212, 1, 0, 0, 0, 212, 37, 1, 0,
-		0, 0, 213, 217, 5, 39, 0, 0, 214, 215, 5, 52, 0, 0, 215, 217, 7, 3, 0,
-		0, 216, 213, 1, 0, 0, 0, 216, 214, 1, 0, 0, 0, 217, 39, 1, 0, 0, 0, 218,
-		219, 5, 40, 0, 0, 219, 41, 1, 0, 0, 0, 220, 221, 5, 63, 0, 0, 221, 223,
-		5, 62, 0, 0, 222, 224, 3, 38, 19, 0, 223, 222, 1, 0, 0, 0, 223, 224, 1,
-		0, 0, 0, 224, 43, 1, 0, 0, 0, 225, 226, 5, 63, 0, 0, 226, 45, 1, 0, 0,
-		0, 227, 236, 5, 23, 0, 0, 228, 229, 5, 53, 0, 0, 229, 230, 5, 52, 0, 0,
-		230, 237, 5, 53, 0, 0, 231, 232, 5, 53, 0, 0, 232, 237, 5, 52, 0, 0, 233,
-		234, 5, 52, 0, 0, 234, 237, 5, 53, 0, 0, 235, 237, 5, 53, 0, 0, 236, 228,
-		1, 0, 0, 0, 236, 231, 1, 0, 0, 0, 236, 233, 1, 0, 0, 0, 236, 235, 1, 0,
-		0, 0, 236, 237, 1, 0, 0, 0, 237, 238, 1, 0, 0, 0, 238, 239, 5, 49, 0, 0,
-		239, 47, 1, 0, 0, 0, 240, 242, 3, 50, 25, 0, 241, 243, 3, 38, 19, 0, 242,
-		241, 1, 0, 0, 0, 242, 243, 1, 0, 0, 0, 243, 49, 1, 0, 0, 0, 244, 245, 6,
-		25, -1, 0, 245, 246, 5, 46, 0, 0, 246, 247, 3, 50, 25, 0, 247, 248, 5,
-		47, 0, 0, 248, 257, 1, 0, 0, 0, 249, 257, 3, 34, 17, 0, 250, 257, 3, 52,
-		26, 0, 251, 257, 3, 40, 20, 0, 252, 253, 3, 54, 27, 0, 253, 254, 3, 50,
-		25, 9, 254, 257, 1, 0, 0, 0, 255, 257, 3, 10, 5, 0, 256, 244, 1, 0, 0,
-		0, 256, 249, 1, 0, 0, 0, 256, 250, 1, 0, 0, 0, 256, 251, 1, 0, 0, 0, 256,
-		252, 1, 0, 0, 0, 256, 255, 1, 0, 0, 0, 257, 285, 1, 0, 0, 0, 258, 259,
-		10, 8, 0, 0, 259, 260, 5, 24, 0, 0, 260, 284, 3, 50, 25, 9, 261, 262, 10,
-		7, 0, 0, 262, 263, 7, 4, 0, 0, 263, 284, 3, 50, 25, 8, 264, 265, 10, 6,
-		0, 0, 265, 266, 7, 2, 0, 0, 266, 284, 3, 50, 25, 7, 267, 268, 10, 5, 0,
-		0, 268, 269, 7, 5, 0, 0, 269, 284, 3, 50, 25, 6, 270, 271, 10, 4, 0, 0,
-		271, 272, 7, 6, 0, 0, 272, 284, 3, 50, 25, 5, 273, 277, 10, 3, 0, 0, 274,
-		278, 5, 61, 0, 0, 275, 278, 5, 60, 0, 0, 276, 278, 1, 0, 0, 0, 277, 274,
-		1, 0, 0, 0, 277, 275, 1, 0, 0, 0, 277, 276, 1, 0, 0, 0, 278, 279, 1, 0,
-		0, 0, 279, 284, 3, 50, 25, 4, 280, 281, 10, 2, 0, 0, 281, 282, 5, 30, 0,
-		0, 282, 284, 3, 50, 25, 3, 283, 258, 1, 0, 0, 0, 283, 261, 1, 0, 0, 0,
-		283, 264, 1, 0, 0, 0, 283, 267, 1, 0, 0, 0, 283, 270, 1, 0, 0, 0, 283,
-		273, 1, 0, 0, 0, 283, 280, 1, 0, 0, 0, 284, 287, 1, 0, 0, 0, 285, 283,
-		1, 0, 0, 0, 285, 286, 1, 0, 0, 0, 286, 51, 1, 0, 0, 0, 287, 285, 1, 0,
-		0, 0, 288, 289, 7, 7, 0, 0, 289, 53, 1, 0, 0, 0, 290, 291, 7, 8, 0, 0,
-		291, 55, 1, 0, 0, 0, 31, 59, 66, 71, 77, 85, 93, 109, 113, 122, 126, 137,
-		142, 146, 153, 156, 159, 164, 170, 179, 191, 200, 207, 211, 216, 223, 236,
-		242, 256, 277, 283, 285,
+		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
+		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
+		2, 37, 7, 37, 1, 0, 5, 0, 78, 8, 0, 10, 0, 12, 0, 81, 9, 0, 1, 0, 1, 0,
+		4, 0, 85, 8, 0, 11, 0, 12, 0, 86, 1, 0, 5, 0, 90, 8, 0, 10, 0, 12, 0, 93,
+		9, 0, 1, 0, 5, 0, 96, 8, 0, 10, 0, 12, 0, 99, 9, 0, 1, 1, 1, 1, 3, 1, 103,
+		8, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 5, 3, 113, 8, 3,
+		10, 3, 12, 3, 116, 9, 3, 1, 4, 1, 4, 1, 4, 5, 4, 121, 8, 4, 10, 4, 12,
+		4, 124, 9, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1,
+		5, 1, 5, 1, 5, 1, 5, 1, 5, 3, 5, 140, 8, 5, 1, 6, 1, 6, 3, 6, 144, 8, 6,
+		1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 5, 7, 151, 8, 7, 10, 7, 12, 7, 154, 9, 7,
+		1, 7, 3, 7, 157, 8, 7, 1, 7, 1, 7, 3, 7, 161, 8, 7, 1, 7, 1, 7, 1, 7, 1,
+		7, 1, 7, 1, 7, 1, 7, 3, 7, 170, 8, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 3,
+		8, 177, 8, 8, 1, 8, 3, 8, 180, 8, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9,
+		1, 9, 5, 9, 189, 8, 9, 10, 9, 12, 9, 192, 9, 9, 1, 9, 1, 9, 1, 10, 1, 10,
+		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 203, 8, 11, 1, 11, 1, 11, 1,
+		12, 3, 12, 208, 8, 12, 1, 12, 1, 12, 3, 12, 212, 8, 12, 1, 13, 1, 13, 1,
+		13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 3, 15, 224, 8, 15,
+		1, 15, 1, 15, 3, 15, 228, 8, 15, 3, 15, 230, 8, 15, 1, 15, 3, 15, 233,
+		8, 15, 1, 16, 1, 16, 1, 16, 3, 16, 238, 8, 16, 1, 16, 1, 16, 1, 17, 1,
+		17, 3, 17, 244, 8, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 5, 18, 251, 8,
+		18, 10, 18, 12, 18, 254, 9, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19,
+		1, 19, 1, 20, 1, 20, 3, 20, 265, 8, 20, 1, 20, 3, 20, 268, 8, 20, 1, 21,
+		1, 21, 1, 21, 1, 21, 1, 21, 5, 21, 275, 8, 21, 10, 21, 12, 21, 278, 9,
+		21, 1, 21, 1, 21, 1, 22, 1, 22, 3, 22, 284, 8, 22, 1, 23, 1, 23, 3, 23,
+		288, 8, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 296, 8, 24,
+		3, 24, 298, 8, 24, 1, 25, 1, 25, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 3,
+		27, 307, 8, 27, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29,
+		1, 29, 1, 29, 1, 29, 3, 29, 320, 8, 29, 1, 29, 1, 29, 1, 30, 1, 30, 3,
+		30, 326, 8, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31,
+		1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 3, 31, 341, 8, 31, 1, 31, 1, 31, 1,
+		31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31,
+		1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 3, 31, 362, 8, 31, 1, 31, 1,
+		31, 1, 31, 1, 31, 1, 31, 3, 31, 369, 8, 31, 1, 31, 1, 31, 1, 31, 1, 31,
+		1, 31, 5, 31, 376, 8, 31, 10, 31, 12, 31, 379, 9, 31, 1, 32, 3, 32, 382,
+		8, 32, 1, 32, 1, 32, 1, 33, 1, 33, 3, 33, 388, 8, 33, 1, 33, 1, 33, 1,
+		34, 1, 34, 1, 34, 1, 34, 5, 34, 396, 8, 34, 10, 34, 12, 34, 399, 9, 34,
+		1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 4, 35, 407, 8, 35, 11, 35, 12,
+		35, 408, 1, 35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 0, 1, 62, 38,
+		0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36,
+		38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72,
+		74, 0, 9, 2, 0, 8, 44, 63, 63, 1, 0, 45, 46, 1, 0, 48, 49, 5, 0, 2, 2,
+		5, 7, 23, 44, 50, 52, 65, 65, 2, 0, 4, 4, 55, 56, 1, 0, 57, 59, 1, 0, 87,
+		90, 3, 0, 72, 73, 84, 85, 95, 95, 2, 0, 48, 49, 61, 62, 455, 0, 79, 1,
+		0, 0, 0, 2, 102, 1, 0, 0, 0, 4, 104, 1, 0, 0, 0, 6, 109, 1, 0, 0, 0, 8,
+		117, 1, 0, 0, 0, 10, 139, 1, 0, 0, 0, 12, 141, 1, 0, 0, 0, 14, 169, 1,
+		0, 0, 0, 16, 171, 1, 0, 0, 0, 18, 183, 1, 0, 0, 0, 20, 195, 1, 0, 0, 0,
+		22, 197, 1, 0, 0, 0, 24, 207, 1, 0, 0, 0, 26, 213, 1, 0, 0, 0, 28, 218,
+		1, 0, 0, 0, 30, 220, 1, 0, 0, 0, 32, 234, 1, 0, 0, 0, 34, 243, 1, 0, 0,
+		0, 36, 245, 1, 0, 0, 0, 38, 257, 1, 0, 0, 0, 40, 264, 1, 0, 0, 0, 42, 269,
+		1, 0, 0, 0, 44, 281, 1, 0, 0, 0, 46, 285, 1, 0, 0, 0, 48, 297, 1, 0, 0,
+		0, 50, 299, 1, 0, 0, 0, 52, 301, 1, 0, 0, 0, 54, 303, 1, 0, 0, 0, 56, 308,
+		1, 0, 0, 0, 58, 310, 1, 0, 0, 0, 60, 323, 1, 0, 0, 0, 62, 340, 1, 0, 0,
+		0, 64, 381, 1, 0, 0, 0, 66, 385, 1, 0, 0, 0, 68, 391, 1, 0, 0, 0, 70, 402,
+		1, 0, 0, 0, 72, 412, 1, 0, 0, 0, 74, 414, 1, 0, 0, 0, 76, 78, 5, 1, 0,
+		0, 77, 76, 1, 0, 0, 0, 78, 81, 1, 0, 0, 0, 79, 77, 1, 0, 0, 0, 79, 80,
+		1, 0, 0, 0, 80, 82, 1, 0, 0, 0, 81, 79, 1, 0, 0, 0, 82, 91, 3, 2, 1, 0,
+		83, 85, 5, 1, 0, 0, 84, 83, 1, 0, 0, 0, 85, 86, 1, 0, 0, 0, 86, 84, 1,
+		0, 0, 0, 86, 87, 1, 0, 0, 0, 87, 88, 1, 0, 0, 0, 88, 90, 3, 2, 1, 0, 89,
+		84, 1, 0, 0, 0, 90, 93, 1, 0, 0, 0, 91, 89, 1, 0, 0, 0, 91, 92, 1, 0, 0,
+		0, 92, 97, 1, 0, 0, 0, 93, 91, 1, 0, 0, 0, 94, 96, 5, 1, 0, 0, 95, 94,
+		1, 0, 0, 0, 96, 99, 1, 0, 0, 0, 97, 95, 1, 0, 0, 0, 97, 98, 1, 0, 0, 0,
+		98, 1, 1, 0, 0, 0, 99, 97, 1, 0, 0, 0, 100, 103, 3, 4, 2, 0, 101, 103,
+		3, 6, 3, 0, 102, 100, 1, 0, 0, 0, 102, 101, 1, 0, 0, 0, 103, 3, 1, 0, 0,
+		0, 104, 105, 5, 2, 0, 0, 105, 106, 5, 74, 0, 0, 106, 107, 5, 3, 0, 0, 107,
+		108, 3, 6, 3, 0, 108, 5, 1, 0, 0, 0, 109, 114, 3, 8, 4, 0, 110, 111, 5,
+		82, 0, 0, 111, 113, 3, 8, 4, 0, 112, 110, 1, 0, 0, 0, 113, 116, 1, 0, 0,
+		0, 114, 112, 1, 0, 0, 0, 114, 115, 1, 0, 0, 0, 115, 7, 1, 0, 0, 0, 116,
+		114, 1, 0, 0, 0, 117, 122, 3, 10, 5, 0, 118, 119, 5, 81, 0, 0, 119, 121,
+		3, 10, 5, 0, 120, 118, 1, 0, 0, 0, 121, 124, 1, 0, 0, 0, 122, 120, 1, 0,
+		0, 0, 122, 123, 1, 0, 0, 0, 123, 9, 1, 0, 0, 0, 124, 122, 1, 0, 0, 0, 125,
+		140, 3, 54, 27, 0, 126, 140, 3, 56, 28, 0, 127, 140, 3, 46, 23, 0, 128,
+		140, 3, 22, 11, 0, 129, 140, 3, 26, 13, 0, 130, 140, 3, 36, 18, 0, 131,
+		140, 3, 38, 19, 0, 132, 140, 3, 42, 21, 0, 133, 140, 3, 58, 29, 0, 134,
+		140, 3, 28, 14, 0, 135, 140, 3, 30, 15, 0, 136, 140, 3, 32, 16, 0, 137,
+		140, 3, 12, 6, 0, 138, 140, 3, 60, 30, 0, 139, 125, 1, 0, 0, 0, 139, 126,
+		1, 0, 0, 0, 139, 127, 1, 0, 0, 0, 139, 128, 1, 0, 0, 0, 139, 129, 1, 0,
+		0, 0, 139, 130, 1, 0, 0, 0, 139, 131, 1, 0, 0, 0, 139, 132, 1, 0, 0, 0,
+		139, 133, 1, 0, 0, 0, 139, 134, 1, 0, 0, 0, 139, 135, 1, 0, 0, 0, 139,
+		136, 1, 0, 0, 0, 139, 137, 1, 0, 0, 0, 139, 138, 1, 0, 0, 0, 140, 11, 1,
+		0, 0, 0, 141, 143, 3, 14, 7, 0, 142, 144, 3, 48, 24, 0, 143, 142, 1, 0,
+		0, 0, 143, 144, 1, 0, 0, 0, 144, 13, 1, 0, 0, 0, 145, 146, 3, 20, 10, 0,
+		146, 156, 5, 77, 0, 0, 147, 152, 3, 62, 31, 0, 148, 149, 5, 81, 0, 0, 149,
+		151, 3, 62, 31, 0, 150, 148, 1, 0, 0, 0, 151, 154, 1, 0, 0, 0, 152, 150,
+		1, 0, 0, 0, 152, 153, 1, 0, 0, 0, 153, 157, 1, 0, 0, 0, 154, 152, 1, 0,
+		0, 0, 155, 157, 5, 4, 0, 0, 156, 147, 1, 0, 0, 0, 156, 155, 1, 0, 0, 0,
+		156, 157, 1, 0, 0, 0, 157, 158, 1, 0, 0, 0, 158, 160, 5, 78, 0, 0, 159,
+		161, 3, 16, 8, 0, 160, 159, 1, 0, 0, 0, 160, 161, 1, 0, 0, 0, 161, 170,
+		1, 0, 0, 0, 162, 163, 5, 5, 0, 0, 163, 164, 5, 77, 0, 0, 164, 165, 3, 62,
+		31, 0, 165, 166, 5, 81, 0, 0, 166, 167, 5, 74, 0, 0, 167, 168, 5, 78, 0,
+		0, 168, 170, 1, 0, 0, 0, 169, 145, 1, 0, 0, 0, 169, 162, 1, 0, 0, 0, 170,
+		15, 1, 0, 0, 0, 171, 172, 5, 6, 0, 0, 172, 179, 5, 77, 0, 0, 173, 176,
+		3, 18, 9, 0, 174, 175, 5, 81, 0, 0, 175, 177, 3, 42, 21, 0, 176, 174, 1,
+		0, 0, 0, 176, 177, 1, 0, 0, 0, 177, 180, 1, 0, 0, 0, 178, 180, 3, 42, 21,
+		0, 179, 173, 1, 0, 0, 0, 179, 178, 1, 0, 0, 0, 179, 180, 1, 0, 0, 0, 180,
+		181, 1, 0, 0, 0, 181, 182, 5, 78, 0, 0, 182, 17, 1, 0, 0, 0, 183, 184,
+		5, 7, 0, 0, 184, 185, 5, 77, 0, 0, 185, 190, 3, 34, 17, 0, 186, 187, 5,
+		81, 0, 0, 187, 189, 3, 34, 17, 0, 188, 186, 1, 0, 0, 0, 189, 192, 1, 0,
+		0, 0, 190, 188, 1, 0, 0, 0, 190, 191, 1, 0, 0, 0, 191, 193, 1, 0, 0, 0,
+		192, 190, 1, 0, 0, 0, 193, 194, 5, 78, 0, 0, 194, 19, 1, 0, 0, 0, 195,
+		196, 7, 0, 0, 0, 196, 21, 1, 0, 0, 0, 197, 198, 5, 64, 0, 0, 198, 199,
+		5, 77, 0, 0, 199, 202, 3, 24, 12, 0, 200, 201, 5, 81, 0, 0, 201, 203, 3,
+		62, 31, 0, 202, 200, 1, 0, 0, 0, 202, 203, 1, 0, 0, 0, 203, 204, 1, 0,
+		0, 0, 204, 205, 5, 78, 0, 0, 205, 23, 1, 0, 0, 0, 206, 208, 5, 94, 0, 0,
+		207, 206, 1, 0, 0, 0, 207, 208, 1, 0, 0, 0, 208, 209, 1, 0, 0, 0, 209,
+		211, 5, 93, 0, 0, 210, 212, 3, 48, 24, 0, 211, 210, 1, 0, 0, 0, 211, 212,
+		1, 0, 0, 0, 212, 25, 1, 0, 0, 0, 213, 214, 5, 65, 0, 0, 214, 215, 5, 77,
+		0, 0, 215, 216, 3, 6, 3, 0, 216, 217, 5, 78, 0, 0, 217, 27, 1, 0, 0, 0,
+		218, 219, 7, 1, 0, 0, 219, 29, 1, 0, 0, 0, 220, 229, 5, 47, 0, 0, 221,
+		223, 5, 77, 0, 0, 222, 224, 3, 44, 22, 0, 223, 222, 1, 0, 0, 0, 223, 224,
+		1, 0, 0, 0, 224, 225, 1, 0, 0, 0, 225, 227, 5, 78, 0, 0, 226, 228, 3, 16,
+		8, 0, 227, 226, 1, 0, 0, 0, 227, 228, 1, 0, 0, 0, 228, 230, 1, 0, 0, 0,
+		229, 221, 1, 0, 0, 0, 229, 230, 1, 0, 0, 0, 230, 232, 1, 0, 0, 0, 231,
+		233, 3, 48, 24, 0, 232, 231, 1, 0, 0, 0, 232, 233, 1, 0, 0, 0, 233, 31,
+		1, 0, 0, 0, 234, 235, 5, 66, 0, 0, 235, 237, 5, 77, 0, 0, 236, 238, 3,
+		62, 31, 0, 237, 236, 1, 0, 0, 0, 237, 238, 1, 0, 0, 0, 238, 239, 1, 0,
+		0, 0, 239, 240, 5, 78, 0, 0, 240, 33, 1, 0, 0, 0, 241, 244, 3, 44, 22,
+		0, 242, 244, 3, 14, 7, 0, 243, 241, 1, 0, 0, 0, 243, 242, 1, 0, 0, 0, 244,
+		35, 1, 0, 0, 0, 245, 246, 5, 67, 0, 0, 246, 247, 5, 77, 0, 0, 247, 252,
+		3, 34, 17, 0, 248, 249, 5, 81, 0, 0, 249, 251, 3, 34, 17, 0, 250, 248,
+		1, 0, 0, 0, 251, 254, 1, 0, 0, 0, 252, 250, 1, 0, 0, 0, 252, 253, 1, 0,
+		0, 0, 253, 255, 1, 0, 0, 0, 254, 252, 1, 0, 0, 0, 255, 256, 5, 78, 0, 0,
+		256, 37, 1, 0, 0, 0, 257, 258, 5, 68, 0, 0, 258, 259, 5, 77, 0, 0, 259,
+		260, 3, 62, 31, 0, 260, 261, 5, 78, 0, 0, 261, 39, 1, 0, 0, 0, 262, 265,
+		3, 44, 22, 0, 263, 265, 3, 14, 7, 0, 264, 262, 1, 0, 0, 0, 264, 263, 1,
+		0, 0, 0, 265, 267, 1, 0, 0, 0, 266, 268, 7, 2, 0, 0, 267, 266, 1, 0, 0,
+		0, 267, 268, 1, 0, 0, 0, 268, 41, 1, 0, 0, 0, 269, 270, 5, 69, 0, 0, 270,
+		271, 5, 77, 0, 0, 271, 276, 3, 40, 20, 0, 272, 273, 5, 81, 0, 0, 273, 275,
+		3, 40, 20, 0, 274, 272, 1, 0, 0, 0, 275, 278, 1, 0, 0, 0, 276, 274, 1,
+		0, 0, 0, 276, 277, 1, 0, 0, 0, 277, 279, 1, 0, 0, 0, 278, 276, 1, 0, 0,
+		0, 279, 280, 5, 78, 0, 0, 280, 43, 1, 0, 0, 0, 281, 283, 5, 93, 0, 0, 282,
+		284, 5, 93, 0, 0, 283, 282, 1, 0, 0, 0, 283, 284, 1, 0, 0, 0, 284, 45,
+		1, 0, 0, 0, 285, 287, 3, 44, 22, 0, 286, 288, 3, 48, 24, 0, 287, 286, 1,
+		0, 0, 0, 287, 288, 1, 0, 0, 0, 288, 47, 1, 0, 0, 0, 289, 298, 5, 70, 0,
+		0, 290, 295, 5, 83, 0, 0, 291, 296, 5, 71, 0, 0, 292, 296, 5, 74, 0, 0,
+		293, 296, 5, 95, 0, 0, 294, 296, 3, 50, 25, 0, 295, 291, 1, 0, 0, 0, 295,
+		292, 1, 0, 0, 0, 295, 293, 1, 0, 0, 0, 295, 294, 1, 0, 0, 0, 296, 298,
+		1, 0, 0, 0, 297, 289, 1, 0, 0, 0, 297, 290, 1, 0, 0, 0, 298, 49, 1, 0,
+		0, 0, 299, 300, 7, 3, 0, 0, 300, 51, 1, 0, 0, 0, 301, 302, 5, 71, 0, 0,
+		302, 53, 1, 0, 0, 0, 303, 304, 5, 94, 0, 0, 304, 306, 5, 93, 0, 0, 305,
+		307, 3, 48, 24, 0, 306, 305, 1, 0, 0, 0, 306, 307, 1, 0, 0, 0, 307, 55,
+		1, 0, 0, 0, 308, 309, 5, 94, 0, 0, 309, 57, 1, 0, 0, 0, 310, 319, 5, 53,
+		0, 0, 311, 312, 5, 84, 0, 0, 312, 313, 5, 83, 0, 0, 313, 320, 5, 84, 0,
+		0, 314, 315, 5, 84, 0, 0, 315, 320, 5, 83, 0, 0, 316, 317, 5, 83, 0, 0,
+		317, 320, 5, 84, 0, 0, 318, 320, 5, 84, 0, 0, 319, 311, 1, 0, 0, 0, 319,
+		314, 1, 0, 0, 0, 319, 316, 1, 0, 0, 0, 319, 318, 1, 0, 0, 0, 319, 320,
+		1, 0, 0, 0, 320, 321, 1, 0, 0, 0, 321, 322, 5, 80, 0, 0, 322, 59, 1, 0,
+		0, 0, 323, 325, 3, 62, 31, 0, 324, 326, 3, 48, 24, 0, 325, 324, 1, 0, 0,
+		0, 325, 326, 1, 0, 0, 0, 326, 61, 1, 0, 0, 0, 327, 328, 6, 31, -1, 0, 328,
+		329, 5, 77, 0, 0, 329, 330, 3, 62, 31, 0, 330, 331, 5, 78, 0, 0, 331, 341,
+		1, 0, 0, 0, 332, 341, 3, 70, 35, 0, 333, 341, 3, 44, 22, 0, 334, 341, 3,
+		72, 36, 0, 335, 341, 3, 52, 26, 0, 336, 337, 3, 74, 37, 0, 337, 338, 3,
+		62, 31, 11, 338, 341, 1, 0, 0, 0, 339, 341, 3, 14, 7, 0, 340, 327, 1, 0,
+		0, 0, 340, 332, 1, 0, 0, 0, 340, 333, 1, 0, 0, 0, 340, 334, 1, 0, 0, 0,
+		340, 335, 1, 0, 0, 0, 340, 336, 1, 0, 0, 0, 340, 339, 1, 0, 0, 0, 341,
+		377, 1, 0, 0, 0, 342, 343, 10, 10, 0, 0, 343, 344, 5, 54, 0, 0, 344, 376,
+		3, 62, 31, 11, 345, 346, 10, 9, 0, 0, 346, 347, 7, 4, 0, 0, 347, 376, 3,
+		62, 31, 10, 348, 349, 10, 8, 0, 0, 349, 350, 7, 2, 0, 0, 350, 376, 3, 62,
+		31, 9, 351, 352, 10, 7, 0, 0, 352, 353, 7, 5, 0, 0, 353, 376, 3, 62, 31,
+		8, 354, 355, 10, 6, 0, 0, 355, 356, 7, 6, 0, 0, 356, 376, 3, 62, 31, 7,
+		357, 361, 10, 5, 0, 0, 358, 362, 5, 92, 0, 0, 359, 362, 5, 91, 0, 0, 360,
+		362, 1, 0, 0, 0, 361, 358, 1, 0, 0, 0, 361, 359, 1, 0, 0, 0, 361, 360,
+		1, 0, 0, 0, 362, 363, 1, 0, 0, 0, 363, 376, 3, 62, 31, 6, 364, 365, 10,
+		4, 0, 0, 365, 368, 3, 64, 32, 0, 366, 369, 3, 68, 34, 0, 367, 369, 3, 70,
+		35, 0, 368, 366, 1, 0, 0, 0, 368, 367, 1, 0, 0, 0, 369, 376, 1, 0, 0, 0,
+		370, 371, 10, 3, 0, 0, 371, 376, 3, 66, 33, 0, 372, 373, 10, 2, 0, 0, 373,
+		374, 5, 60, 0, 0, 374, 376, 3, 62, 31, 3, 375, 342, 1, 0, 0, 0, 375, 345,
+		1, 0, 0, 0, 375, 348, 1, 0, 0, 0, 375, 351, 1, 0, 0, 0, 375, 354, 1, 0,
+		0, 0, 375, 357, 1, 0, 0, 0, 375, 364, 1, 0, 0, 0, 375, 370, 1, 0, 0, 0,
+		375, 372, 1, 0, 0, 0, 376, 379, 1, 0, 0, 0, 377, 375, 1, 0, 0, 0, 377,
+		378, 1, 0, 0, 0, 378, 63, 1, 0, 0, 0, 379, 377, 1, 0, 0, 0, 380, 382, 5,
+		51, 0, 0, 381, 380, 1, 0, 0, 0, 381, 382, 1, 0, 0, 0, 382, 383, 1, 0, 0,
+		0, 383, 384, 5, 50, 0, 0, 384, 65, 1, 0, 0, 0, 385, 387, 5, 52, 0, 0, 386,
+		388, 5, 51, 0, 0, 387, 386, 1, 0, 0, 0, 387, 388, 1, 0, 0, 0, 388, 389,
+		1, 0, 0, 0, 389, 390, 5, 73, 0, 0, 390, 67, 1, 0, 0, 0, 391, 392, 5, 77,
+		0, 0, 392, 397, 3, 62, 31, 0, 393, 394, 5, 81, 0, 0, 394, 396, 3, 62, 31,
+		0, 395, 393, 1, 0, 0, 0, 396, 399, 1, 0, 0, 0, 397, 395, 1, 0, 0, 0, 397,
+		398, 1, 0, 0, 0, 398, 400, 1, 0, 0, 0, 399, 397, 1, 0, 0, 0, 400, 401,
+		5, 78, 0, 0, 401, 69, 1, 0, 0, 0, 402, 403, 5, 77, 0, 0, 403, 406, 3, 8,
+		4, 0, 404, 405, 5, 82, 0, 0, 405, 407, 3, 8, 4, 0, 406, 404, 1, 0, 0, 0,
+		407, 408, 1, 0, 0, 0, 408, 406, 1, 0, 0, 0, 408, 409, 1, 0, 0, 0, 409,
+		410, 1, 0, 0, 0, 410, 411, 5, 78, 0, 0, 411, 71, 1, 0, 0, 0, 412, 413,
+		7, 7, 0, 0, 413, 73, 1, 0, 0, 0, 414, 415, 7, 8, 0, 0, 415, 75, 1, 0, 0,
+		0, 45, 79, 86, 91, 97, 102, 114, 122, 139, 143, 152, 156, 160, 169, 176,
+		179, 190, 202, 207, 211, 223, 227, 229, 232, 237, 243, 252, 264, 267, 276,
+		283, 287, 295, 297, 306, 319, 325, 340, 361, 368, 375, 377, 381, 387, 397,
+		408,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -261,71 +326,112 @@ const (
 	SLQParserT__29                 = 30
 	SLQParserT__30                 = 31
 	SLQParserT__31                 = 32
-	SLQParserPROPRIETARY_FUNC_NAME = 33
-	SLQParserJOIN_TYPE             = 34
-	SLQParserWHERE                 = 35
-	SLQParserGROUP_BY              = 36
-	SLQParserHAVING                = 37
-	SLQParserORDER_BY              = 38
-	SLQParserALIAS_RESERVED        = 39
-	SLQParserARG                   = 40
-	SLQParserBOOL                  = 41
-	SLQParserNULL                  = 42
-	SLQParserID                    = 43
-	SLQParserIDNUM                 = 44
-	SLQParserWS                    = 45
-	SLQParserLPAR                  = 46
-	SLQParserRPAR                  = 47
-	SLQParserLBRA                  = 48
-	SLQParserRBRA                  = 49
-	SLQParserCOMMA                 = 50
-	SLQParserPIPE                  = 51
-	SLQParserCOLON                 = 52
-	SLQParserNN                    = 53
-	SLQParserNUMBER                = 54
-	SLQParserDIGITS                = 55
-	SLQParserLT_EQ                 = 56
-	SLQParserLT                    = 57
-	SLQParserGT_EQ                 = 58
-	SLQParserGT                    = 59
-	SLQParserNEQ                   = 60
-	SLQParserEQ                    = 61
-	SLQParserNAME                  = 62
-	SLQParserHANDLE                = 63
-	SLQParserSTRING                = 64
-	SLQParserLINECOMMENT           = 65
+	SLQParserT__32                 = 33
+	SLQParserT__33                 = 34
+	SLQParserT__34                 = 35
+	SLQParserT__35                 = 36
+	SLQParserT__36                 = 37
+	SLQParserT__37                 = 38
+	SLQParserT__38                 = 39
+	SLQParserT__39                 = 40
+	SLQParserT__40                 = 41
+	SLQParserT__41                 = 42
+	SLQParserT__42                 = 43
+	SLQParserT__43                 = 44
+	SLQParserT__44                 = 45
+	SLQParserT__45                 = 46
+	SLQParserT__46                 = 47
+	SLQParserT__47                 = 48
+	SLQParserT__48                 = 49
+	SLQParserT__49                 = 50
+	SLQParserT__50                 = 51
+	SLQParserT__51                 = 52
+	SLQParserT__52                 = 53
+	SLQParserT__53                 = 54
+	SLQParserT__54                 = 55
+	SLQParserT__55                 = 56
+	SLQParserT__56                 = 57
+	SLQParserT__57                 = 58
+	SLQParserT__58                 = 59
+	SLQParserT__59                 = 60
+	SLQParserT__60                 = 61
+	SLQParserT__61                 = 62
+	SLQParserPROPRIETARY_FUNC_NAME = 63
+	SLQParserJOIN_TYPE             = 64
+	SLQParserSET_OP                = 65
+	SLQParserWHERE                 = 66
+	SLQParserGROUP_BY              = 67
+	SLQParserHAVING                = 68
+	SLQParserORDER_BY              = 69
+	SLQParserALIAS_RESERVED        = 70
+	SLQParserARG                   = 71
+	SLQParserBOOL                  = 72
+	SLQParserNULL                  = 73
+	SLQParserID                    = 74
+	SLQParserIDNUM                 = 75
+	SLQParserWS                    = 76
+	SLQParserLPAR                  = 77
+	SLQParserRPAR                  = 78
+	SLQParserLBRA                  = 79
+	SLQParserRBRA                  = 80
+	SLQParserCOMMA                 = 81
+	SLQParserPIPE                  = 82
+	SLQParserCOLON                 = 83
+	SLQParserNN                    = 84
+	SLQParserNUMBER                = 85
+	SLQParserDIGITS                = 86
+	SLQParserLT_EQ                 = 87
+	SLQParserLT                    = 88
+	SLQParserGT_EQ                 = 89
+	SLQParserGT                    = 90
+	SLQParserNEQ                   = 91
+	SLQParserEQ                    = 92
+	SLQParserNAME                  = 93
+	SLQParserHANDLE                = 94
+	SLQParserSTRING                = 95
+	SLQParserLINECOMMENT           = 96
 )
 
 // SLQParser rules.
 const (
 	SLQParserRULE_stmtList        = 0
-	SLQParserRULE_query           = 1
-	SLQParserRULE_segment         = 2
-	SLQParserRULE_element         = 3
-	SLQParserRULE_funcElement     = 4
-	SLQParserRULE_func            = 5
-	SLQParserRULE_funcName        = 6
-	SLQParserRULE_join            = 7
-	SLQParserRULE_joinTable       = 8
-	SLQParserRULE_uniqueFunc      = 9
-	SLQParserRULE_countFunc       = 10
-	SLQParserRULE_where           = 11
-	SLQParserRULE_groupByTerm     = 12
-	SLQParserRULE_groupBy         = 13
-	SLQParserRULE_having          = 14
-	SLQParserRULE_orderByTerm     = 15
-	SLQParserRULE_orderBy         = 16
-	SLQParserRULE_selector        = 17
-	SLQParserRULE_selectorElement = 18
-	SLQParserRULE_alias           = 19
-	SLQParserRULE_arg             = 20
-	SLQParserRULE_handleTable     = 21
-	SLQParserRULE_handle          = 22
-	SLQParserRULE_rowRange        = 23
-	SLQParserRULE_exprElement     = 24
-	SLQParserRULE_expr            = 25
-	SLQParserRULE_literal         = 26
-	SLQParserRULE_unaryOperator   = 27
+	SLQParserRULE_stmt            = 1
+	SLQParserRULE_let             = 2
+	SLQParserRULE_query           = 3
+	SLQParserRULE_segment         = 4
+	SLQParserRULE_element         = 5
+	SLQParserRULE_funcElement     = 6
+	SLQParserRULE_func            = 7
+	SLQParserRULE_over            = 8
+	SLQParserRULE_partitionBy     = 9
+	SLQParserRULE_funcName        = 10
+	SLQParserRULE_join            = 11
+	SLQParserRULE_joinTable       = 12
+	SLQParserRULE_setOp           = 13
+	SLQParserRULE_uniqueFunc      = 14
+	SLQParserRULE_countFunc       = 15
+	SLQParserRULE_where           = 16
+	SLQParserRULE_groupByTerm     = 17
+	SLQParserRULE_groupBy         = 18
+	SLQParserRULE_having          = 19
+	SLQParserRULE_orderByTerm     = 20
+	SLQParserRULE_orderBy         = 21
+	SLQParserRULE_selector        = 22
+	SLQParserRULE_selectorElement = 23
+	SLQParserRULE_alias           = 24
+	SLQParserRULE_aliasKeyword    = 25
+	SLQParserRULE_arg             = 26
+	SLQParserRULE_handleTable     = 27
+	SLQParserRULE_handle          = 28
+	SLQParserRULE_rowRange        = 29
+	SLQParserRULE_exprElement     = 30
+	SLQParserRULE_expr            = 31
+	SLQParserRULE_inOperator      = 32
+	SLQParserRULE_nullOperator    = 33
+	SLQParserRULE_exprList        = 34
+	SLQParserRULE_subquery        = 35
+	SLQParserRULE_literal         = 36
+	SLQParserRULE_unaryOperator   = 37
 )
 
 // IStmtListContext is an interface to support dynamic dispatch.
@@ -336,8 +442,8 @@ type IStmtListContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllQuery() []IQueryContext
-	Query(i int) IQueryContext
+	AllStmt() []IStmtContext
+	Stmt(i int) IStmtContext
 
 	// IsStmtListContext differentiates from other interfaces.
 	IsStmtListContext()
@@ -375,20 +481,20 @@ func NewStmtListContext(parser antlr.Parser, parent antlr.ParserRuleContext, inv
 
 func (s *StmtListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StmtListContext) AllQuery() []IQueryContext {
+func (s *StmtListContext) AllStmt() []IStmtContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IQueryContext); ok {
+		if _, ok := ctx.(IStmtContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IQueryContext, len)
+	tst := make([]IStmtContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IQueryContext); ok {
-			tst[i] = t.(IQueryContext)
+		if t, ok := ctx.(IStmtContext); ok {
+			tst[i] = t.(IStmtContext)
 			i++
 		}
 	}
@@ -396,11 +502,11 @@ func (s *StmtListContext) AllQuery() []IQueryContext {
 	return tst
 }
 
-func (s *StmtListContext) Query(i int) IQueryContext {
+func (s *StmtListContext) Stmt(i int) IStmtContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IQueryContext); ok {
+		if _, ok := ctx.(IStmtContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -413,7 +519,7 @@ func (s *StmtListContext) Query(i int) IQueryContext {
 		return nil
 	}
 
-	return t.(IQueryContext)
+	return t.(IStmtContext)
 }
 
 func (s *StmtListContext) GetRuleContext() antlr.RuleContext {
@@ -454,7 +560,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(59)
+	p.SetState(79)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -463,7 +569,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 
 	for _la == SLQParserT__0 {
 		{
-			p.SetState(56)
+			p.SetState(76)
 			p.Match(SLQParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -471,7 +577,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 			}
 		}
 
-		p.SetState(61)
+		p.SetState(81)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -479,10 +585,10 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(62)
-		p.Query()
+		p.SetState(82)
+		p.Stmt()
 	}
-	p.SetState(71)
+	p.SetState(91)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -493,7 +599,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(64)
+			p.SetState(84)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -502,7 +608,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 
 			for ok := true; ok; ok = _la == SLQParserT__0 {
 				{
-					p.SetState(63)
+					p.SetState(83)
 					p.Match(SLQParserT__0)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -510,7 +616,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 					}
 				}
 
-				p.SetState(66)
+				p.SetState(86)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -518,12 +624,12 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(68)
-				p.Query()
+				p.SetState(88)
+				p.Stmt()
 			}
 
 		}
-		p.SetState(73)
+		p.SetState(93)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -533,7 +639,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 			goto errorExit
 		}
 	}
-	p.SetState(77)
+	p.SetState(97)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -542,7 +648,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 
 	for _la == SLQParserT__0 {
 		{
-			p.SetState(74)
+			p.SetState(94)
 			p.Match(SLQParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -550,7 +656,7 @@ func (p *SLQParser) StmtList() (localctx IStmtListContext) {
 			}
 		}
 
-		p.SetState(79)
+		p.SetState(99)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -571,6 +677,300 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IStmtContext is an interface to support dynamic dispatch.
+type IStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Let() ILetContext
+	Query() IQueryContext
+
+	// IsStmtContext differentiates from other interfaces.
+	IsStmtContext()
+}
+
+type StmtContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyStmtContext() *StmtContext {
+	var p = new(StmtContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_stmt
+	return p
+}
+
+func InitEmptyStmtContext(p *StmtContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_stmt
+}
+
+func (*StmtContext) IsStmtContext() {}
+
+func NewStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StmtContext {
+	var p = new(StmtContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_stmt
+
+	return p
+}
+
+func (s *StmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StmtContext) Let() ILetContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILetContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILetContext)
+}
+
+func (s *StmtContext) Query() IQueryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryContext)
+}
+
+func (s *StmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterStmt(s)
+	}
+}
+
+func (s *StmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitStmt(s)
+	}
+}
+
+func (s *StmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Stmt() (localctx IStmtContext) {
+	localctx = NewStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, SLQParserRULE_stmt)
+	p.SetState(102)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserT__1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(100)
+			p.Let()
+		}
+
+	case SLQParserT__4, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserT__44, SLQParserT__45, SLQParserT__46, SLQParserT__47, SLQParserT__48, SLQParserT__52, SLQParserT__60, SLQParserT__61, SLQParserPROPRIETARY_FUNC_NAME, SLQParserJOIN_TYPE, SLQParserSET_OP, SLQParserWHERE, SLQParserGROUP_BY, SLQParserHAVING, SLQParserORDER_BY, SLQParserARG, SLQParserBOOL, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserHANDLE, SLQParserSTRING:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(101)
+			p.Query()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ILetContext is an interface to support dynamic dispatch.
+type ILetContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ID() antlr.TerminalNode
+	Query() IQueryContext
+
+	// IsLetContext differentiates from other interfaces.
+	IsLetContext()
+}
+
+type LetContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyLetContext() *LetContext {
+	var p = new(LetContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_let
+	return p
+}
+
+func InitEmptyLetContext(p *LetContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_let
+}
+
+func (*LetContext) IsLetContext() {}
+
+func NewLetContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LetContext {
+	var p = new(LetContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_let
+
+	return p
+}
+
+func (s *LetContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LetContext) ID() antlr.TerminalNode {
+	return s.GetToken(SLQParserID, 0)
+}
+
+func (s *LetContext) Query() IQueryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryContext)
+}
+
+func (s *LetContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LetContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LetContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterLet(s)
+	}
+}
+
+func (s *LetContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitLet(s)
+	}
+}
+
+func (s *LetContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitLet(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Let() (localctx ILetContext) {
+	localctx = NewLetContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, SLQParserRULE_let)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(104)
+		p.Match(SLQParserT__1)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(105)
+		p.Match(SLQParserID)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(106)
+		p.Match(SLQParserT__2)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(107)
+		p.Query()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 // IQueryContext is an interface to support dynamic dispatch.
 type IQueryContext interface {
 	antlr.ParserRuleContext
@@ -701,15 +1101,15 @@ func (s *QueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Query() (localctx IQueryContext) {
 	localctx = NewQueryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 2, SLQParserRULE_query)
+	p.EnterRule(localctx, 6, SLQParserRULE_query)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(80)
+		p.SetState(109)
 		p.Segment()
 	}
-	p.SetState(85)
+	p.SetState(114)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -718,7 +1118,7 @@ func (p *SLQParser) Query() (localctx IQueryContext) {
 
 	for _la == SLQParserPIPE {
 		{
-			p.SetState(81)
+			p.SetState(110)
 			p.Match(SLQParserPIPE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -726,11 +1126,11 @@ func (p *SLQParser) Query() (localctx IQueryContext) {
 			}
 		}
 		{
-			p.SetState(82)
+			p.SetState(111)
 			p.Segment()
 		}
 
-		p.SetState(87)
+		p.SetState(116)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -881,16 +1281,16 @@ func (s *SegmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Segment() (localctx ISegmentContext) {
 	localctx = NewSegmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 4, SLQParserRULE_segment)
+	p.EnterRule(localctx, 8, SLQParserRULE_segment)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(88)
+		p.SetState(117)
 		p.Element()
 	}
 
-	p.SetState(93)
+	p.SetState(122)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -899,7 +1299,7 @@ func (p *SLQParser) Segment() (localctx ISegmentContext) {
 
 	for _la == SLQParserCOMMA {
 		{
-			p.SetState(89)
+			p.SetState(118)
 			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -907,11 +1307,11 @@ func (p *SLQParser) Segment() (localctx ISegmentContext) {
 			}
 		}
 		{
-			p.SetState(90)
+			p.SetState(119)
 			p.Element()
 		}
 
-		p.SetState(95)
+		p.SetState(124)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -944,6 +1344,7 @@ type IElementContext interface {
 	Handle() IHandleContext
 	SelectorElement() ISelectorElementContext
 	Join() IJoinContext
+	SetOp() ISetOpContext
 	GroupBy() IGroupByContext
 	Having() IHavingContext
 	OrderBy() IOrderByContext
@@ -1054,6 +1455,22 @@ func (s *ElementContext) Join() IJoinContext {
 	return t.(IJoinContext)
 }
 
+func (s *ElementContext) SetOp() ISetOpContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISetOpContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISetOpContext)
+}
+
 func (s *ElementContext) GroupBy() IGroupByContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1230,102 +1647,109 @@ func (s *ElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Element() (localctx IElementContext) {
 	localctx = NewElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, SLQParserRULE_element)
-	p.SetState(109)
+	p.EnterRule(localctx, 10, SLQParserRULE_element)
+	p.SetState(139)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 6, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(96)
+			p.SetState(125)
 			p.HandleTable()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(97)
+			p.SetState(126)
 			p.Handle()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(98)
+			p.SetState(127)
 			p.SelectorElement()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(99)
+			p.SetState(128)
 			p.Join()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(100)
-			p.GroupBy()
+			p.SetState(129)
+			p.SetOp()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(101)
-			p.Having()
+			p.SetState(130)
+			p.GroupBy()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(102)
-			p.OrderBy()
+			p.SetState(131)
+			p.Having()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(103)
-			p.RowRange()
+			p.SetState(132)
+			p.OrderBy()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(104)
-			p.UniqueFunc()
+			p.SetState(133)
+			p.RowRange()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(105)
-			p.CountFunc()
+			p.SetState(134)
+			p.UniqueFunc()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(106)
-			p.Where()
+			p.SetState(135)
+			p.CountFunc()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(107)
-			p.FuncElement()
+			p.SetState(136)
+			p.Where()
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(108)
+			p.SetState(137)
+			p.FuncElement()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(138)
 			p.ExprElement()
 		}
 
@@ -1457,15 +1881,15 @@ func (s *FuncElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SLQParser) FuncElement() (localctx IFuncElementContext) {
 	localctx = NewFuncElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, SLQParserRULE_funcElement)
+	p.EnterRule(localctx, 12, SLQParserRULE_funcElement)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(111)
+		p.SetState(141)
 		p.Func_()
 	}
-	p.SetState(113)
+	p.SetState(143)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1474,7 +1898,7 @@ func (p *SLQParser) FuncElement() (localctx IFuncElementContext) {
 
 	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
 		{
-			p.SetState(112)
+			p.SetState(142)
 			p.Alias()
 		}
 
@@ -1506,8 +1930,10 @@ type IFuncContext interface {
 	RPAR() antlr.TerminalNode
 	AllExpr() []IExprContext
 	Expr(i int) IExprContext
+	Over() IOverContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
+	ID() antlr.TerminalNode
 
 	// IsFuncContext differentiates from other interfaces.
 	IsFuncContext()
@@ -1610,6 +2036,22 @@ func (s *FuncContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
+func (s *FuncContext) Over() IOverContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOverContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOverContext)
+}
+
 func (s *FuncContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(SLQParserCOMMA)
 }
@@ -1618,6 +2060,10 @@ func (s *FuncContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(SLQParserCOMMA, i)
 }
 
+func (s *FuncContext) ID() antlr.TerminalNode {
+	return s.GetToken(SLQParserID, 0)
+}
+
 func (s *FuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1650,83 +2096,155 @@ func (s *FuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Func_() (localctx IFuncContext) {
 	localctx = NewFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, SLQParserRULE_func)
+	p.EnterRule(localctx, 14, SLQParserRULE_func)
 	var _la int
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(115)
-		p.FuncName()
-	}
-	{
-		p.SetState(116)
-		p.Match(SLQParserLPAR)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(126)
+	p.SetState(169)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+
 	switch p.GetTokenStream().LA(1) {
-	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__20, SLQParserT__21, SLQParserT__30, SLQParserT__31, SLQParserPROPRIETARY_FUNC_NAME, SLQParserARG, SLQParserBOOL, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
+	case SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserPROPRIETARY_FUNC_NAME:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(117)
-			p.expr(0)
+			p.SetState(145)
+			p.FuncName()
+		}
+		{
+			p.SetState(146)
+			p.Match(SLQParserLPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-		p.SetState(122)
+		p.SetState(156)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
+		switch p.GetTokenStream().LA(1) {
+		case SLQParserT__4, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserT__47, SLQParserT__48, SLQParserT__60, SLQParserT__61, SLQParserPROPRIETARY_FUNC_NAME, SLQParserARG, SLQParserBOOL, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
+			{
+				p.SetState(147)
+				p.expr(0)
+			}
+			p.SetState(152)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == SLQParserCOMMA {
+				{
+					p.SetState(148)
+					p.Match(SLQParserCOMMA)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(149)
+					p.expr(0)
+				}
+
+				p.SetState(154)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+				_la = p.GetTokenStream().LA(1)
+			}
 
-		for _la == SLQParserCOMMA {
+		case SLQParserT__3:
 			{
-				p.SetState(118)
-				p.Match(SLQParserCOMMA)
+				p.SetState(155)
+				p.Match(SLQParserT__3)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
+
+		case SLQParserRPAR:
+
+		default:
+		}
+		{
+			p.SetState(158)
+			p.Match(SLQParserRPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(160)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(119)
-				p.expr(0)
+				p.SetState(159)
+				p.Over()
 			}
 
-			p.SetState(124)
-			p.GetErrorHandler().Sync(p)
+		} else if p.HasError() { // JIM
+			goto errorExit
+		}
+
+	case SLQParserT__4:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(162)
+			p.Match(SLQParserT__4)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(163)
+			p.Match(SLQParserLPAR)
 			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
-			_la = p.GetTokenStream().LA(1)
 		}
-
-	case SLQParserT__1:
 		{
-			p.SetState(125)
-			p.Match(SLQParserT__1)
+			p.SetState(164)
+			p.expr(0)
+		}
+		{
+			p.SetState(165)
+			p.Match(SLQParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(166)
+			p.Match(SLQParserID)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(167)
+			p.Match(SLQParserRPAR)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case SLQParserRPAR:
 
 	default:
-	}
-	{
-		p.SetState(128)
-		p.Match(SLQParserRPAR)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -1742,182 +2260,304 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFuncNameContext is an interface to support dynamic dispatch.
-type IFuncNameContext interface {
+// IOverContext is an interface to support dynamic dispatch.
+type IOverContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PROPRIETARY_FUNC_NAME() antlr.TerminalNode
+	LPAR() antlr.TerminalNode
+	RPAR() antlr.TerminalNode
+	PartitionBy() IPartitionByContext
+	OrderBy() IOrderByContext
+	COMMA() antlr.TerminalNode
 
-	// IsFuncNameContext differentiates from other interfaces.
-	IsFuncNameContext()
+	// IsOverContext differentiates from other interfaces.
+	IsOverContext()
 }
 
-type FuncNameContext struct {
+type OverContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyFuncNameContext() *FuncNameContext {
-	var p = new(FuncNameContext)
+func NewEmptyOverContext() *OverContext {
+	var p = new(OverContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_funcName
+	p.RuleIndex = SLQParserRULE_over
 	return p
 }
 
-func InitEmptyFuncNameContext(p *FuncNameContext) {
+func InitEmptyOverContext(p *OverContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_funcName
+	p.RuleIndex = SLQParserRULE_over
 }
 
-func (*FuncNameContext) IsFuncNameContext() {}
+func (*OverContext) IsOverContext() {}
 
-func NewFuncNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FuncNameContext {
-	var p = new(FuncNameContext)
+func NewOverContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OverContext {
+	var p = new(OverContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_funcName
+	p.RuleIndex = SLQParserRULE_over
 
 	return p
 }
 
-func (s *FuncNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *OverContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FuncNameContext) PROPRIETARY_FUNC_NAME() antlr.TerminalNode {
-	return s.GetToken(SLQParserPROPRIETARY_FUNC_NAME, 0)
+func (s *OverContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *FuncNameContext) GetRuleContext() antlr.RuleContext {
+func (s *OverContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *OverContext) PartitionBy() IPartitionByContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPartitionByContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPartitionByContext)
+}
+
+func (s *OverContext) OrderBy() IOrderByContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrderByContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderByContext)
+}
+
+func (s *OverContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, 0)
+}
+
+func (s *OverContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FuncNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OverContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FuncNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OverContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterFuncName(s)
+		listenerT.EnterOver(s)
 	}
 }
 
-func (s *FuncNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OverContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitFuncName(s)
+		listenerT.ExitOver(s)
 	}
 }
 
-func (s *FuncNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OverContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitFuncName(s)
+		return t.VisitOver(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) FuncName() (localctx IFuncNameContext) {
-	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, SLQParserRULE_funcName)
+func (p *SLQParser) Over() (localctx IOverContext) {
+	localctx = NewOverContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, SLQParserRULE_over)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(130)
-		_la = p.GetTokenStream().LA(1)
-
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&8590196728) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		p.SetState(171)
+		p.Match(SLQParserT__5)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
-
-errorExit:
+	{
+		p.SetState(172)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(179)
+	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
-		v := p.GetError()
-		localctx.SetException(v)
-		p.GetErrorHandler().ReportError(p, v)
-		p.GetErrorHandler().Recover(p, v)
-		p.SetError(nil)
+		goto errorExit
 	}
-	p.ExitRule()
-	return localctx
-	goto errorExit // Trick to prevent compiler error if the label is not used
-}
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserT__6:
+		{
+			p.SetState(173)
+			p.PartitionBy()
+		}
+		p.SetState(176)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 
-// IJoinContext is an interface to support dynamic dispatch.
-type IJoinContext interface {
-	antlr.ParserRuleContext
+		if _la == SLQParserCOMMA {
+			{
+				p.SetState(174)
+				p.Match(SLQParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(175)
+				p.OrderBy()
+			}
 
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+		}
+
+	case SLQParserORDER_BY:
+		{
+			p.SetState(178)
+			p.OrderBy()
+		}
+
+	case SLQParserRPAR:
+
+	default:
+	}
+	{
+		p.SetState(181)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IPartitionByContext is an interface to support dynamic dispatch.
+type IPartitionByContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
 	// Getter signatures
-	JOIN_TYPE() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
-	JoinTable() IJoinTableContext
+	AllGroupByTerm() []IGroupByTermContext
+	GroupByTerm(i int) IGroupByTermContext
 	RPAR() antlr.TerminalNode
-	COMMA() antlr.TerminalNode
-	Expr() IExprContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsJoinContext differentiates from other interfaces.
-	IsJoinContext()
+	// IsPartitionByContext differentiates from other interfaces.
+	IsPartitionByContext()
 }
 
-type JoinContext struct {
+type PartitionByContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyJoinContext() *JoinContext {
-	var p = new(JoinContext)
+func NewEmptyPartitionByContext() *PartitionByContext {
+	var p = new(PartitionByContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_join
+	p.RuleIndex = SLQParserRULE_partitionBy
 	return p
 }
 
-func InitEmptyJoinContext(p *JoinContext) {
+func InitEmptyPartitionByContext(p *PartitionByContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_join
+	p.RuleIndex = SLQParserRULE_partitionBy
 }
 
-func (*JoinContext) IsJoinContext() {}
+func (*PartitionByContext) IsPartitionByContext() {}
 
-func NewJoinContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinContext {
-	var p = new(JoinContext)
+func NewPartitionByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionByContext {
+	var p = new(PartitionByContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_join
+	p.RuleIndex = SLQParserRULE_partitionBy
 
 	return p
 }
 
-func (s *JoinContext) GetParser() antlr.Parser { return s.parser }
+func (s *PartitionByContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *JoinContext) JOIN_TYPE() antlr.TerminalNode {
-	return s.GetToken(SLQParserJOIN_TYPE, 0)
+func (s *PartitionByContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *JoinContext) LPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserLPAR, 0)
+func (s *PartitionByContext) AllGroupByTerm() []IGroupByTermContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGroupByTermContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IGroupByTermContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGroupByTermContext); ok {
+			tst[i] = t.(IGroupByTermContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *JoinContext) JoinTable() IJoinTableContext {
+func (s *PartitionByContext) GroupByTerm(i int) IGroupByTermContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJoinTableContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IGroupByTermContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -1925,79 +2565,67 @@ func (s *JoinContext) JoinTable() IJoinTableContext {
 		return nil
 	}
 
-	return t.(IJoinTableContext)
+	return t.(IGroupByTermContext)
 }
 
-func (s *JoinContext) RPAR() antlr.TerminalNode {
+func (s *PartitionByContext) RPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserRPAR, 0)
 }
 
-func (s *JoinContext) COMMA() antlr.TerminalNode {
-	return s.GetToken(SLQParserCOMMA, 0)
+func (s *PartitionByContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
 }
 
-func (s *JoinContext) Expr() IExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
+func (s *PartitionByContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
 }
 
-func (s *JoinContext) GetRuleContext() antlr.RuleContext {
+func (s *PartitionByContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *JoinContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PartitionByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *JoinContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PartitionByContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterJoin(s)
+		listenerT.EnterPartitionBy(s)
 	}
 }
 
-func (s *JoinContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PartitionByContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitJoin(s)
+		listenerT.ExitPartitionBy(s)
 	}
 }
 
-func (s *JoinContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PartitionByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitJoin(s)
+		return t.VisitPartitionBy(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Join() (localctx IJoinContext) {
-	localctx = NewJoinContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SLQParserRULE_join)
+func (p *SLQParser) PartitionBy() (localctx IPartitionByContext) {
+	localctx = NewPartitionByContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, SLQParserRULE_partitionBy)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(132)
-		p.Match(SLQParserJOIN_TYPE)
+		p.SetState(183)
+		p.Match(SLQParserT__6)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(133)
+		p.SetState(184)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2005,19 +2633,19 @@ func (p *SLQParser) Join() (localctx IJoinContext) {
 		}
 	}
 	{
-		p.SetState(134)
-		p.JoinTable()
+		p.SetState(185)
+		p.GroupByTerm()
 	}
-	p.SetState(137)
+	p.SetState(190)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserCOMMA {
+	for _la == SLQParserCOMMA {
 		{
-			p.SetState(135)
+			p.SetState(186)
 			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2025,13 +2653,19 @@ func (p *SLQParser) Join() (localctx IJoinContext) {
 			}
 		}
 		{
-			p.SetState(136)
-			p.expr(0)
+			p.SetState(187)
+			p.GroupByTerm()
 		}
 
+		p.SetState(192)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(139)
+		p.SetState(193)
 		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2052,153 +2686,102 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IJoinTableContext is an interface to support dynamic dispatch.
-type IJoinTableContext interface {
+// IFuncNameContext is an interface to support dynamic dispatch.
+type IFuncNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NAME() antlr.TerminalNode
-	HANDLE() antlr.TerminalNode
-	Alias() IAliasContext
+	PROPRIETARY_FUNC_NAME() antlr.TerminalNode
 
-	// IsJoinTableContext differentiates from other interfaces.
-	IsJoinTableContext()
+	// IsFuncNameContext differentiates from other interfaces.
+	IsFuncNameContext()
 }
 
-type JoinTableContext struct {
+type FuncNameContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyJoinTableContext() *JoinTableContext {
-	var p = new(JoinTableContext)
+func NewEmptyFuncNameContext() *FuncNameContext {
+	var p = new(FuncNameContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_joinTable
+	p.RuleIndex = SLQParserRULE_funcName
 	return p
 }
 
-func InitEmptyJoinTableContext(p *JoinTableContext) {
+func InitEmptyFuncNameContext(p *FuncNameContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_joinTable
+	p.RuleIndex = SLQParserRULE_funcName
 }
 
-func (*JoinTableContext) IsJoinTableContext() {}
+func (*FuncNameContext) IsFuncNameContext() {}
 
-func NewJoinTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinTableContext {
-	var p = new(JoinTableContext)
+func NewFuncNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FuncNameContext {
+	var p = new(FuncNameContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_joinTable
+	p.RuleIndex = SLQParserRULE_funcName
 
 	return p
 }
 
-func (s *JoinTableContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *JoinTableContext) NAME() antlr.TerminalNode {
-	return s.GetToken(SLQParserNAME, 0)
-}
-
-func (s *JoinTableContext) HANDLE() antlr.TerminalNode {
-	return s.GetToken(SLQParserHANDLE, 0)
-}
-
-func (s *JoinTableContext) Alias() IAliasContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *FuncNameContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IAliasContext)
+func (s *FuncNameContext) PROPRIETARY_FUNC_NAME() antlr.TerminalNode {
+	return s.GetToken(SLQParserPROPRIETARY_FUNC_NAME, 0)
 }
 
-func (s *JoinTableContext) GetRuleContext() antlr.RuleContext {
+func (s *FuncNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *JoinTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FuncNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *JoinTableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FuncNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterJoinTable(s)
+		listenerT.EnterFuncName(s)
 	}
 }
 
-func (s *JoinTableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FuncNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitJoinTable(s)
+		listenerT.ExitFuncName(s)
 	}
 }
 
-func (s *JoinTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FuncNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitJoinTable(s)
+		return t.VisitFuncName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) JoinTable() (localctx IJoinTableContext) {
-	localctx = NewJoinTableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SLQParserRULE_joinTable)
+func (p *SLQParser) FuncName() (localctx IFuncNameContext) {
+	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, SLQParserRULE_funcName)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(142)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SLQParserHANDLE {
-		{
-			p.SetState(141)
-			p.Match(SLQParserHANDLE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	}
 	{
-		p.SetState(144)
-		p.Match(SLQParserNAME)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(146)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
+		p.SetState(195)
+		_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
-		{
-			p.SetState(145)
-			p.Alias()
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9223336852482687232) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
 	}
 
 errorExit:
@@ -2214,92 +2797,189 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IUniqueFuncContext is an interface to support dynamic dispatch.
-type IUniqueFuncContext interface {
+// IJoinContext is an interface to support dynamic dispatch.
+type IJoinContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-	// IsUniqueFuncContext differentiates from other interfaces.
-	IsUniqueFuncContext()
+
+	// Getter signatures
+	JOIN_TYPE() antlr.TerminalNode
+	LPAR() antlr.TerminalNode
+	JoinTable() IJoinTableContext
+	RPAR() antlr.TerminalNode
+	COMMA() antlr.TerminalNode
+	Expr() IExprContext
+
+	// IsJoinContext differentiates from other interfaces.
+	IsJoinContext()
 }
 
-type UniqueFuncContext struct {
+type JoinContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyUniqueFuncContext() *UniqueFuncContext {
-	var p = new(UniqueFuncContext)
+func NewEmptyJoinContext() *JoinContext {
+	var p = new(JoinContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_uniqueFunc
+	p.RuleIndex = SLQParserRULE_join
 	return p
 }
 
-func InitEmptyUniqueFuncContext(p *UniqueFuncContext) {
+func InitEmptyJoinContext(p *JoinContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_uniqueFunc
+	p.RuleIndex = SLQParserRULE_join
 }
 
-func (*UniqueFuncContext) IsUniqueFuncContext() {}
+func (*JoinContext) IsJoinContext() {}
 
-func NewUniqueFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UniqueFuncContext {
-	var p = new(UniqueFuncContext)
+func NewJoinContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinContext {
+	var p = new(JoinContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_uniqueFunc
+	p.RuleIndex = SLQParserRULE_join
 
 	return p
 }
 
-func (s *UniqueFuncContext) GetParser() antlr.Parser { return s.parser }
-func (s *UniqueFuncContext) GetRuleContext() antlr.RuleContext {
+func (s *JoinContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *JoinContext) JOIN_TYPE() antlr.TerminalNode {
+	return s.GetToken(SLQParserJOIN_TYPE, 0)
+}
+
+func (s *JoinContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *JoinContext) JoinTable() IJoinTableContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJoinTableContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJoinTableContext)
+}
+
+func (s *JoinContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *JoinContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, 0)
+}
+
+func (s *JoinContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *JoinContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *UniqueFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *JoinContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *UniqueFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *JoinContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterUniqueFunc(s)
+		listenerT.EnterJoin(s)
 	}
 }
 
-func (s *UniqueFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *JoinContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitUniqueFunc(s)
+		listenerT.ExitJoin(s)
 	}
 }
 
-func (s *UniqueFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *JoinContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitUniqueFunc(s)
+		return t.VisitJoin(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) UniqueFunc() (localctx IUniqueFuncContext) {
-	localctx = NewUniqueFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SLQParserRULE_uniqueFunc)
+func (p *SLQParser) Join() (localctx IJoinContext) {
+	localctx = NewJoinContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SLQParserRULE_join)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(148)
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(197)
+		p.Match(SLQParserJOIN_TYPE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(198)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(199)
+		p.JoinTable()
+	}
+	p.SetState(202)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SLQParserT__17 || _la == SLQParserT__18) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	if _la == SLQParserCOMMA {
+		{
+			p.SetState(200)
+			p.Match(SLQParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(201)
+			p.expr(0)
+		}
+
+	}
+	{
+		p.SetState(204)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
 	}
 
@@ -2316,64 +2996,63 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICountFuncContext is an interface to support dynamic dispatch.
-type ICountFuncContext interface {
+// IJoinTableContext is an interface to support dynamic dispatch.
+type IJoinTableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	LPAR() antlr.TerminalNode
-	RPAR() antlr.TerminalNode
+	NAME() antlr.TerminalNode
+	HANDLE() antlr.TerminalNode
 	Alias() IAliasContext
-	Selector() ISelectorContext
 
-	// IsCountFuncContext differentiates from other interfaces.
-	IsCountFuncContext()
+	// IsJoinTableContext differentiates from other interfaces.
+	IsJoinTableContext()
 }
 
-type CountFuncContext struct {
+type JoinTableContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyCountFuncContext() *CountFuncContext {
-	var p = new(CountFuncContext)
+func NewEmptyJoinTableContext() *JoinTableContext {
+	var p = new(JoinTableContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_countFunc
+	p.RuleIndex = SLQParserRULE_joinTable
 	return p
 }
 
-func InitEmptyCountFuncContext(p *CountFuncContext) {
+func InitEmptyJoinTableContext(p *JoinTableContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_countFunc
+	p.RuleIndex = SLQParserRULE_joinTable
 }
 
-func (*CountFuncContext) IsCountFuncContext() {}
+func (*JoinTableContext) IsJoinTableContext() {}
 
-func NewCountFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CountFuncContext {
-	var p = new(CountFuncContext)
+func NewJoinTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinTableContext {
+	var p = new(JoinTableContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_countFunc
+	p.RuleIndex = SLQParserRULE_joinTable
 
 	return p
 }
 
-func (s *CountFuncContext) GetParser() antlr.Parser { return s.parser }
+func (s *JoinTableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CountFuncContext) LPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserLPAR, 0)
+func (s *JoinTableContext) NAME() antlr.TerminalNode {
+	return s.GetToken(SLQParserNAME, 0)
 }
 
-func (s *CountFuncContext) RPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserRPAR, 0)
+func (s *JoinTableContext) HANDLE() antlr.TerminalNode {
+	return s.GetToken(SLQParserHANDLE, 0)
 }
 
-func (s *CountFuncContext) Alias() IAliasContext {
+func (s *JoinTableContext) Alias() IAliasContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IAliasContext); ok {
@@ -2389,107 +3068,69 @@ func (s *CountFuncContext) Alias() IAliasContext {
 	return t.(IAliasContext)
 }
 
-func (s *CountFuncContext) Selector() ISelectorContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectorContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISelectorContext)
-}
-
-func (s *CountFuncContext) GetRuleContext() antlr.RuleContext {
+func (s *JoinTableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CountFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *JoinTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CountFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *JoinTableContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterCountFunc(s)
+		listenerT.EnterJoinTable(s)
 	}
 }
 
-func (s *CountFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *JoinTableContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitCountFunc(s)
+		listenerT.ExitJoinTable(s)
 	}
 }
 
-func (s *CountFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *JoinTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitCountFunc(s)
+		return t.VisitJoinTable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) CountFunc() (localctx ICountFuncContext) {
-	localctx = NewCountFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SLQParserRULE_countFunc)
+func (p *SLQParser) JoinTable() (localctx IJoinTableContext) {
+	localctx = NewJoinTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, SLQParserRULE_joinTable)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(150)
-		p.Match(SLQParserT__19)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(156)
+	p.SetState(207)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserLPAR {
+	if _la == SLQParserHANDLE {
 		{
-			p.SetState(151)
-			p.Match(SLQParserLPAR)
+			p.SetState(206)
+			p.Match(SLQParserHANDLE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(153)
-		p.GetErrorHandler().Sync(p)
+
+	}
+	{
+		p.SetState(209)
+		p.Match(SLQParserNAME)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == SLQParserNAME {
-			{
-				p.SetState(152)
-				p.Selector()
-			}
-
-		}
-		{
-			p.SetState(155)
-			p.Match(SLQParserRPAR)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
 	}
-	p.SetState(159)
+	p.SetState(211)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2498,7 +3139,7 @@ func (p *SLQParser) CountFunc() (localctx ICountFuncContext) {
 
 	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
 		{
-			p.SetState(158)
+			p.SetState(210)
 			p.Alias()
 		}
 
@@ -2517,71 +3158,67 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IWhereContext is an interface to support dynamic dispatch.
-type IWhereContext interface {
+// ISetOpContext is an interface to support dynamic dispatch.
+type ISetOpContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	WHERE() antlr.TerminalNode
+	SET_OP() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
+	Query() IQueryContext
 	RPAR() antlr.TerminalNode
-	Expr() IExprContext
 
-	// IsWhereContext differentiates from other interfaces.
-	IsWhereContext()
+	// IsSetOpContext differentiates from other interfaces.
+	IsSetOpContext()
 }
 
-type WhereContext struct {
+type SetOpContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyWhereContext() *WhereContext {
-	var p = new(WhereContext)
+func NewEmptySetOpContext() *SetOpContext {
+	var p = new(SetOpContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_where
+	p.RuleIndex = SLQParserRULE_setOp
 	return p
 }
 
-func InitEmptyWhereContext(p *WhereContext) {
+func InitEmptySetOpContext(p *SetOpContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_where
+	p.RuleIndex = SLQParserRULE_setOp
 }
 
-func (*WhereContext) IsWhereContext() {}
+func (*SetOpContext) IsSetOpContext() {}
 
-func NewWhereContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhereContext {
-	var p = new(WhereContext)
+func NewSetOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetOpContext {
+	var p = new(SetOpContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_where
+	p.RuleIndex = SLQParserRULE_setOp
 
 	return p
 }
 
-func (s *WhereContext) GetParser() antlr.Parser { return s.parser }
+func (s *SetOpContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WhereContext) WHERE() antlr.TerminalNode {
-	return s.GetToken(SLQParserWHERE, 0)
+func (s *SetOpContext) SET_OP() antlr.TerminalNode {
+	return s.GetToken(SLQParserSET_OP, 0)
 }
 
-func (s *WhereContext) LPAR() antlr.TerminalNode {
+func (s *SetOpContext) LPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *WhereContext) RPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserRPAR, 0)
-}
-
-func (s *WhereContext) Expr() IExprContext {
+func (s *SetOpContext) Query() IQueryContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExprContext); ok {
+		if _, ok := ctx.(IQueryContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2591,77 +3228,69 @@ func (s *WhereContext) Expr() IExprContext {
 		return nil
 	}
 
-	return t.(IExprContext)
+	return t.(IQueryContext)
 }
 
-func (s *WhereContext) GetRuleContext() antlr.RuleContext {
+func (s *SetOpContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *SetOpContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WhereContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SetOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WhereContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SetOpContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterWhere(s)
+		listenerT.EnterSetOp(s)
 	}
 }
 
-func (s *WhereContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SetOpContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitWhere(s)
+		listenerT.ExitSetOp(s)
 	}
 }
 
-func (s *WhereContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SetOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitWhere(s)
+		return t.VisitSetOp(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Where() (localctx IWhereContext) {
-	localctx = NewWhereContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SLQParserRULE_where)
-	var _la int
-
+func (p *SLQParser) SetOp() (localctx ISetOpContext) {
+	localctx = NewSetOpContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, SLQParserRULE_setOp)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(161)
-		p.Match(SLQParserWHERE)
+		p.SetState(213)
+		p.Match(SLQParserSET_OP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(162)
+		p.SetState(214)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(164)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if (int64((_la-3)) & ^0x3f) == 0 && ((int64(1)<<(_la-3))&2885691221283209215) != 0 {
-		{
-			p.SetState(163)
-			p.expr(0)
-		}
-
+	{
+		p.SetState(215)
+		p.Query()
 	}
 	{
-		p.SetState(166)
+		p.SetState(216)
 		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2682,142 +3311,93 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGroupByTermContext is an interface to support dynamic dispatch.
-type IGroupByTermContext interface {
+// IUniqueFuncContext is an interface to support dynamic dispatch.
+type IUniqueFuncContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-
-	// Getter signatures
-	Selector() ISelectorContext
-	Func_() IFuncContext
-
-	// IsGroupByTermContext differentiates from other interfaces.
-	IsGroupByTermContext()
+	// IsUniqueFuncContext differentiates from other interfaces.
+	IsUniqueFuncContext()
 }
 
-type GroupByTermContext struct {
+type UniqueFuncContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyGroupByTermContext() *GroupByTermContext {
-	var p = new(GroupByTermContext)
+func NewEmptyUniqueFuncContext() *UniqueFuncContext {
+	var p = new(UniqueFuncContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupByTerm
+	p.RuleIndex = SLQParserRULE_uniqueFunc
 	return p
 }
 
-func InitEmptyGroupByTermContext(p *GroupByTermContext) {
+func InitEmptyUniqueFuncContext(p *UniqueFuncContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupByTerm
+	p.RuleIndex = SLQParserRULE_uniqueFunc
 }
 
-func (*GroupByTermContext) IsGroupByTermContext() {}
+func (*UniqueFuncContext) IsUniqueFuncContext() {}
 
-func NewGroupByTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByTermContext {
-	var p = new(GroupByTermContext)
+func NewUniqueFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UniqueFuncContext {
+	var p = new(UniqueFuncContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_groupByTerm
+	p.RuleIndex = SLQParserRULE_uniqueFunc
 
 	return p
 }
 
-func (s *GroupByTermContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *GroupByTermContext) Selector() ISelectorContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectorContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISelectorContext)
-}
-
-func (s *GroupByTermContext) Func_() IFuncContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFuncContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFuncContext)
-}
-
-func (s *GroupByTermContext) GetRuleContext() antlr.RuleContext {
+func (s *UniqueFuncContext) GetParser() antlr.Parser { return s.parser }
+func (s *UniqueFuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UniqueFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByTermContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UniqueFuncContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterGroupByTerm(s)
+		listenerT.EnterUniqueFunc(s)
 	}
 }
 
-func (s *GroupByTermContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UniqueFuncContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitGroupByTerm(s)
+		listenerT.ExitUniqueFunc(s)
 	}
 }
 
-func (s *GroupByTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UniqueFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitGroupByTerm(s)
+		return t.VisitUniqueFunc(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) GroupByTerm() (localctx IGroupByTermContext) {
-	localctx = NewGroupByTermContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SLQParserRULE_groupByTerm)
-	p.SetState(170)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
+func (p *SLQParser) UniqueFunc() (localctx IUniqueFuncContext) {
+	localctx = NewUniqueFuncContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, SLQParserRULE_uniqueFunc)
+	var _la int
 
-	switch p.GetTokenStream().LA(1) {
-	case SLQParserNAME:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(168)
-			p.Selector()
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(218)
+		_la = p.GetTokenStream().LA(1)
 
-	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserPROPRIETARY_FUNC_NAME:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(169)
-			p.Func_()
+		if !(_la == SLQParserT__44 || _la == SLQParserT__45) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -2833,97 +3413,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGroupByContext is an interface to support dynamic dispatch.
-type IGroupByContext interface {
+// ICountFuncContext is an interface to support dynamic dispatch.
+type ICountFuncContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	GROUP_BY() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
-	AllGroupByTerm() []IGroupByTermContext
-	GroupByTerm(i int) IGroupByTermContext
 	RPAR() antlr.TerminalNode
-	AllCOMMA() []antlr.TerminalNode
-	COMMA(i int) antlr.TerminalNode
+	Alias() IAliasContext
+	Selector() ISelectorContext
+	Over() IOverContext
 
-	// IsGroupByContext differentiates from other interfaces.
-	IsGroupByContext()
+	// IsCountFuncContext differentiates from other interfaces.
+	IsCountFuncContext()
 }
 
-type GroupByContext struct {
+type CountFuncContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyGroupByContext() *GroupByContext {
-	var p = new(GroupByContext)
+func NewEmptyCountFuncContext() *CountFuncContext {
+	var p = new(CountFuncContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupBy
+	p.RuleIndex = SLQParserRULE_countFunc
 	return p
 }
 
-func InitEmptyGroupByContext(p *GroupByContext) {
+func InitEmptyCountFuncContext(p *CountFuncContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_groupBy
+	p.RuleIndex = SLQParserRULE_countFunc
 }
 
-func (*GroupByContext) IsGroupByContext() {}
+func (*CountFuncContext) IsCountFuncContext() {}
 
-func NewGroupByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByContext {
-	var p = new(GroupByContext)
+func NewCountFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CountFuncContext {
+	var p = new(CountFuncContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_groupBy
+	p.RuleIndex = SLQParserRULE_countFunc
 
 	return p
 }
 
-func (s *GroupByContext) GetParser() antlr.Parser { return s.parser }
+func (s *CountFuncContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GroupByContext) GROUP_BY() antlr.TerminalNode {
-	return s.GetToken(SLQParserGROUP_BY, 0)
+func (s *CountFuncContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *GroupByContext) LPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserLPAR, 0)
+func (s *CountFuncContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
 }
 
-func (s *GroupByContext) AllGroupByTerm() []IGroupByTermContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IGroupByTermContext); ok {
-			len++
+func (s *CountFuncContext) Alias() IAliasContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IGroupByTermContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IGroupByTermContext); ok {
-			tst[i] = t.(IGroupByTermContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IAliasContext)
 }
 
-func (s *GroupByContext) GroupByTerm(i int) IGroupByTermContext {
+func (s *CountFuncContext) Selector() ISelectorContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByTermContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(ISelectorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -2931,112 +3500,136 @@ func (s *GroupByContext) GroupByTerm(i int) IGroupByTermContext {
 		return nil
 	}
 
-	return t.(IGroupByTermContext)
+	return t.(ISelectorContext)
 }
 
-func (s *GroupByContext) RPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserRPAR, 0)
-}
+func (s *CountFuncContext) Over() IOverContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOverContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *GroupByContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(SLQParserCOMMA)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *GroupByContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SLQParserCOMMA, i)
+	return t.(IOverContext)
 }
 
-func (s *GroupByContext) GetRuleContext() antlr.RuleContext {
+func (s *CountFuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CountFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CountFuncContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterGroupBy(s)
+		listenerT.EnterCountFunc(s)
 	}
 }
 
-func (s *GroupByContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CountFuncContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitGroupBy(s)
+		listenerT.ExitCountFunc(s)
 	}
 }
 
-func (s *GroupByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CountFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitGroupBy(s)
+		return t.VisitCountFunc(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) GroupBy() (localctx IGroupByContext) {
-	localctx = NewGroupByContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SLQParserRULE_groupBy)
+func (p *SLQParser) CountFunc() (localctx ICountFuncContext) {
+	localctx = NewCountFuncContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, SLQParserRULE_countFunc)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(172)
-		p.Match(SLQParserGROUP_BY)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(173)
-		p.Match(SLQParserLPAR)
+		p.SetState(220)
+		p.Match(SLQParserT__46)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(174)
-		p.GroupByTerm()
-	}
-	p.SetState(179)
+	p.SetState(229)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == SLQParserCOMMA {
+	if _la == SLQParserLPAR {
 		{
-			p.SetState(175)
-			p.Match(SLQParserCOMMA)
+			p.SetState(221)
+			p.Match(SLQParserLPAR)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		{
-			p.SetState(176)
-			p.GroupByTerm()
+		p.SetState(223)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 
-		p.SetState(181)
+		if _la == SLQParserNAME {
+			{
+				p.SetState(222)
+				p.Selector()
+			}
+
+		}
+		{
+			p.SetState(225)
+			p.Match(SLQParserRPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(227)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
+
+		if _la == SLQParserT__5 {
+			{
+				p.SetState(226)
+				p.Over()
+			}
+
+		}
+
 	}
-	{
-		p.SetState(182)
-		p.Match(SLQParserRPAR)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	p.SetState(232)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
+		{
+			p.SetState(231)
+			p.Alias()
 		}
+
 	}
 
 errorExit:
@@ -3052,64 +3645,68 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IHavingContext is an interface to support dynamic dispatch.
-type IHavingContext interface {
+// IWhereContext is an interface to support dynamic dispatch.
+type IWhereContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	HAVING() antlr.TerminalNode
+	WHERE() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
-	Expr() IExprContext
 	RPAR() antlr.TerminalNode
+	Expr() IExprContext
 
-	// IsHavingContext differentiates from other interfaces.
-	IsHavingContext()
+	// IsWhereContext differentiates from other interfaces.
+	IsWhereContext()
 }
 
-type HavingContext struct {
+type WhereContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyHavingContext() *HavingContext {
-	var p = new(HavingContext)
+func NewEmptyWhereContext() *WhereContext {
+	var p = new(WhereContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_having
+	p.RuleIndex = SLQParserRULE_where
 	return p
 }
 
-func InitEmptyHavingContext(p *HavingContext) {
+func InitEmptyWhereContext(p *WhereContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_having
+	p.RuleIndex = SLQParserRULE_where
 }
 
-func (*HavingContext) IsHavingContext() {}
+func (*WhereContext) IsWhereContext() {}
 
-func NewHavingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HavingContext {
-	var p = new(HavingContext)
+func NewWhereContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhereContext {
+	var p = new(WhereContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_having
+	p.RuleIndex = SLQParserRULE_where
 
 	return p
 }
 
-func (s *HavingContext) GetParser() antlr.Parser { return s.parser }
+func (s *WhereContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *HavingContext) HAVING() antlr.TerminalNode {
-	return s.GetToken(SLQParserHAVING, 0)
+func (s *WhereContext) WHERE() antlr.TerminalNode {
+	return s.GetToken(SLQParserWHERE, 0)
 }
 
-func (s *HavingContext) LPAR() antlr.TerminalNode {
+func (s *WhereContext) LPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *HavingContext) Expr() IExprContext {
+func (s *WhereContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *WhereContext) Expr() IExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IExprContext); ok {
@@ -3125,66 +3722,74 @@ func (s *HavingContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *HavingContext) RPAR() antlr.TerminalNode {
-	return s.GetToken(SLQParserRPAR, 0)
-}
-
-func (s *HavingContext) GetRuleContext() antlr.RuleContext {
+func (s *WhereContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *HavingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WhereContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *HavingContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WhereContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterHaving(s)
+		listenerT.EnterWhere(s)
 	}
 }
 
-func (s *HavingContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WhereContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitHaving(s)
+		listenerT.ExitWhere(s)
 	}
 }
 
-func (s *HavingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WhereContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitHaving(s)
+		return t.VisitWhere(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Having() (localctx IHavingContext) {
-	localctx = NewHavingContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SLQParserRULE_having)
+func (p *SLQParser) Where() (localctx IWhereContext) {
+	localctx = NewWhereContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, SLQParserRULE_where)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(184)
-		p.Match(SLQParserHAVING)
+		p.SetState(234)
+		p.Match(SLQParserWHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(185)
+		p.SetState(235)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(186)
-		p.expr(0)
+	p.SetState(237)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2304963399911473376) != 0) || ((int64((_la-71)) & ^0x3f) == 0 && ((int64(1)<<(_la-71))&20996167) != 0) {
+		{
+			p.SetState(236)
+			p.expr(0)
+		}
+
 	}
 	{
-		p.SetState(187)
+		p.SetState(239)
 		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3205,8 +3810,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOrderByTermContext is an interface to support dynamic dispatch.
-type IOrderByTermContext interface {
+// IGroupByTermContext is an interface to support dynamic dispatch.
+type IGroupByTermContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -3214,44 +3819,45 @@ type IOrderByTermContext interface {
 
 	// Getter signatures
 	Selector() ISelectorContext
+	Func_() IFuncContext
 
-	// IsOrderByTermContext differentiates from other interfaces.
-	IsOrderByTermContext()
+	// IsGroupByTermContext differentiates from other interfaces.
+	IsGroupByTermContext()
 }
 
-type OrderByTermContext struct {
+type GroupByTermContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyOrderByTermContext() *OrderByTermContext {
-	var p = new(OrderByTermContext)
+func NewEmptyGroupByTermContext() *GroupByTermContext {
+	var p = new(GroupByTermContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_orderByTerm
+	p.RuleIndex = SLQParserRULE_groupByTerm
 	return p
 }
 
-func InitEmptyOrderByTermContext(p *OrderByTermContext) {
+func InitEmptyGroupByTermContext(p *GroupByTermContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_orderByTerm
+	p.RuleIndex = SLQParserRULE_groupByTerm
 }
 
-func (*OrderByTermContext) IsOrderByTermContext() {}
+func (*GroupByTermContext) IsGroupByTermContext() {}
 
-func NewOrderByTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderByTermContext {
-	var p = new(OrderByTermContext)
+func NewGroupByTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByTermContext {
+	var p = new(GroupByTermContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_orderByTerm
+	p.RuleIndex = SLQParserRULE_groupByTerm
 
 	return p
 }
 
-func (s *OrderByTermContext) GetParser() antlr.Parser { return s.parser }
+func (s *GroupByTermContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrderByTermContext) Selector() ISelectorContext {
+func (s *GroupByTermContext) Selector() ISelectorContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ISelectorContext); ok {
@@ -3267,66 +3873,79 @@ func (s *OrderByTermContext) Selector() ISelectorContext {
 	return t.(ISelectorContext)
 }
 
-func (s *OrderByTermContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupByTermContext) Func_() IFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuncContext)
+}
+
+func (s *GroupByTermContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OrderByTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupByTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OrderByTermContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupByTermContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterOrderByTerm(s)
+		listenerT.EnterGroupByTerm(s)
 	}
 }
 
-func (s *OrderByTermContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupByTermContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitOrderByTerm(s)
+		listenerT.ExitGroupByTerm(s)
 	}
 }
 
-func (s *OrderByTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupByTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitOrderByTerm(s)
+		return t.VisitGroupByTerm(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) OrderByTerm() (localctx IOrderByTermContext) {
-	localctx = NewOrderByTermContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SLQParserRULE_orderByTerm)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(189)
-		p.Selector()
-	}
-	p.SetState(191)
+func (p *SLQParser) GroupByTerm() (localctx IGroupByTermContext) {
+	localctx = NewGroupByTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SLQParserRULE_groupByTerm)
+	p.SetState(243)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserT__20 || _la == SLQParserT__21 {
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserNAME:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(190)
-			_la = p.GetTokenStream().LA(1)
+			p.SetState(241)
+			p.Selector()
+		}
 
-			if !(_la == SLQParserT__20 || _la == SLQParserT__21) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
+	case SLQParserT__4, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserPROPRIETARY_FUNC_NAME:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(242)
+			p.Func_()
 		}
 
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -3342,80 +3961,80 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOrderByContext is an interface to support dynamic dispatch.
-type IOrderByContext interface {
+// IGroupByContext is an interface to support dynamic dispatch.
+type IGroupByContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ORDER_BY() antlr.TerminalNode
+	GROUP_BY() antlr.TerminalNode
 	LPAR() antlr.TerminalNode
-	AllOrderByTerm() []IOrderByTermContext
-	OrderByTerm(i int) IOrderByTermContext
+	AllGroupByTerm() []IGroupByTermContext
+	GroupByTerm(i int) IGroupByTermContext
 	RPAR() antlr.TerminalNode
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
-	// IsOrderByContext differentiates from other interfaces.
-	IsOrderByContext()
+	// IsGroupByContext differentiates from other interfaces.
+	IsGroupByContext()
 }
 
-type OrderByContext struct {
+type GroupByContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyOrderByContext() *OrderByContext {
-	var p = new(OrderByContext)
+func NewEmptyGroupByContext() *GroupByContext {
+	var p = new(GroupByContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_orderBy
+	p.RuleIndex = SLQParserRULE_groupBy
 	return p
 }
 
-func InitEmptyOrderByContext(p *OrderByContext) {
+func InitEmptyGroupByContext(p *GroupByContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_orderBy
+	p.RuleIndex = SLQParserRULE_groupBy
 }
 
-func (*OrderByContext) IsOrderByContext() {}
+func (*GroupByContext) IsGroupByContext() {}
 
-func NewOrderByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderByContext {
-	var p = new(OrderByContext)
+func NewGroupByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByContext {
+	var p = new(GroupByContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_orderBy
+	p.RuleIndex = SLQParserRULE_groupBy
 
 	return p
 }
 
-func (s *OrderByContext) GetParser() antlr.Parser { return s.parser }
+func (s *GroupByContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrderByContext) ORDER_BY() antlr.TerminalNode {
-	return s.GetToken(SLQParserORDER_BY, 0)
+func (s *GroupByContext) GROUP_BY() antlr.TerminalNode {
+	return s.GetToken(SLQParserGROUP_BY, 0)
 }
 
-func (s *OrderByContext) LPAR() antlr.TerminalNode {
+func (s *GroupByContext) LPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *OrderByContext) AllOrderByTerm() []IOrderByTermContext {
+func (s *GroupByContext) AllGroupByTerm() []IGroupByTermContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IOrderByTermContext); ok {
+		if _, ok := ctx.(IGroupByTermContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IOrderByTermContext, len)
+	tst := make([]IGroupByTermContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IOrderByTermContext); ok {
-			tst[i] = t.(IOrderByTermContext)
+		if t, ok := ctx.(IGroupByTermContext); ok {
+			tst[i] = t.(IGroupByTermContext)
 			i++
 		}
 	}
@@ -3423,11 +4042,11 @@ func (s *OrderByContext) AllOrderByTerm() []IOrderByTermContext {
 	return tst
 }
 
-func (s *OrderByContext) OrderByTerm(i int) IOrderByTermContext {
+func (s *GroupByContext) GroupByTerm(i int) IGroupByTermContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOrderByTermContext); ok {
+		if _, ok := ctx.(IGroupByTermContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -3440,67 +4059,67 @@ func (s *OrderByContext) OrderByTerm(i int) IOrderByTermContext {
 		return nil
 	}
 
-	return t.(IOrderByTermContext)
+	return t.(IGroupByTermContext)
 }
 
-func (s *OrderByContext) RPAR() antlr.TerminalNode {
+func (s *GroupByContext) RPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserRPAR, 0)
 }
 
-func (s *OrderByContext) AllCOMMA() []antlr.TerminalNode {
+func (s *GroupByContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(SLQParserCOMMA)
 }
 
-func (s *OrderByContext) COMMA(i int) antlr.TerminalNode {
+func (s *GroupByContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(SLQParserCOMMA, i)
 }
 
-func (s *OrderByContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupByContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OrderByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OrderByContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupByContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterOrderBy(s)
+		listenerT.EnterGroupBy(s)
 	}
 }
 
-func (s *OrderByContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupByContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitOrderBy(s)
+		listenerT.ExitGroupBy(s)
 	}
 }
 
-func (s *OrderByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitOrderBy(s)
+		return t.VisitGroupBy(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
-	localctx = NewOrderByContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SLQParserRULE_orderBy)
+func (p *SLQParser) GroupBy() (localctx IGroupByContext) {
+	localctx = NewGroupByContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, SLQParserRULE_groupBy)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(193)
-		p.Match(SLQParserORDER_BY)
+		p.SetState(245)
+		p.Match(SLQParserGROUP_BY)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(194)
+		p.SetState(246)
 		p.Match(SLQParserLPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3508,10 +4127,10 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 		}
 	}
 	{
-		p.SetState(195)
-		p.OrderByTerm()
+		p.SetState(247)
+		p.GroupByTerm()
 	}
-	p.SetState(200)
+	p.SetState(252)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3520,7 +4139,7 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 
 	for _la == SLQParserCOMMA {
 		{
-			p.SetState(196)
+			p.SetState(248)
 			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3528,11 +4147,11 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 			}
 		}
 		{
-			p.SetState(197)
-			p.OrderByTerm()
+			p.SetState(249)
+			p.GroupByTerm()
 		}
 
-		p.SetState(202)
+		p.SetState(254)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3540,7 +4159,7 @@ func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(203)
+		p.SetState(255)
 		p.Match(SLQParserRPAR)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3561,118 +4180,144 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISelectorContext is an interface to support dynamic dispatch.
-type ISelectorContext interface {
+// IHavingContext is an interface to support dynamic dispatch.
+type IHavingContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllNAME() []antlr.TerminalNode
-	NAME(i int) antlr.TerminalNode
+	HAVING() antlr.TerminalNode
+	LPAR() antlr.TerminalNode
+	Expr() IExprContext
+	RPAR() antlr.TerminalNode
 
-	// IsSelectorContext differentiates from other interfaces.
-	IsSelectorContext()
+	// IsHavingContext differentiates from other interfaces.
+	IsHavingContext()
 }
 
-type SelectorContext struct {
+type HavingContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptySelectorContext() *SelectorContext {
-	var p = new(SelectorContext)
+func NewEmptyHavingContext() *HavingContext {
+	var p = new(HavingContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_selector
+	p.RuleIndex = SLQParserRULE_having
 	return p
 }
 
-func InitEmptySelectorContext(p *SelectorContext) {
+func InitEmptyHavingContext(p *HavingContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_selector
+	p.RuleIndex = SLQParserRULE_having
 }
 
-func (*SelectorContext) IsSelectorContext() {}
+func (*HavingContext) IsHavingContext() {}
 
-func NewSelectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectorContext {
-	var p = new(SelectorContext)
+func NewHavingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HavingContext {
+	var p = new(HavingContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_selector
+	p.RuleIndex = SLQParserRULE_having
 
 	return p
 }
 
-func (s *SelectorContext) GetParser() antlr.Parser { return s.parser }
+func (s *HavingContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectorContext) AllNAME() []antlr.TerminalNode {
-	return s.GetTokens(SLQParserNAME)
+func (s *HavingContext) HAVING() antlr.TerminalNode {
+	return s.GetToken(SLQParserHAVING, 0)
 }
 
-func (s *SelectorContext) NAME(i int) antlr.TerminalNode {
-	return s.GetToken(SLQParserNAME, i)
+func (s *HavingContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *SelectorContext) GetRuleContext() antlr.RuleContext {
+func (s *HavingContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *HavingContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *HavingContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *HavingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SelectorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *HavingContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterSelector(s)
+		listenerT.EnterHaving(s)
 	}
 }
 
-func (s *SelectorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *HavingContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitSelector(s)
+		listenerT.ExitHaving(s)
 	}
 }
 
-func (s *SelectorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *HavingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitSelector(s)
+		return t.VisitHaving(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Selector() (localctx ISelectorContext) {
-	localctx = NewSelectorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SLQParserRULE_selector)
+func (p *SLQParser) Having() (localctx IHavingContext) {
+	localctx = NewHavingContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, SLQParserRULE_having)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(205)
-		p.Match(SLQParserNAME)
+		p.SetState(257)
+		p.Match(SLQParserHAVING)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(207)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(206)
-			p.Match(SLQParserNAME)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+	{
+		p.SetState(258)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(259)
+		p.expr(0)
+	}
+	{
+		p.SetState(260)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	} else if p.HasError() { // JIM
-		goto errorExit
 	}
 
 errorExit:
@@ -3688,8 +4333,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISelectorElementContext is an interface to support dynamic dispatch.
-type ISelectorElementContext interface {
+// IOrderByTermContext is an interface to support dynamic dispatch.
+type IOrderByTermContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -3697,45 +4342,45 @@ type ISelectorElementContext interface {
 
 	// Getter signatures
 	Selector() ISelectorContext
-	Alias() IAliasContext
+	Func_() IFuncContext
 
-	// IsSelectorElementContext differentiates from other interfaces.
-	IsSelectorElementContext()
+	// IsOrderByTermContext differentiates from other interfaces.
+	IsOrderByTermContext()
 }
 
-type SelectorElementContext struct {
+type OrderByTermContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptySelectorElementContext() *SelectorElementContext {
-	var p = new(SelectorElementContext)
+func NewEmptyOrderByTermContext() *OrderByTermContext {
+	var p = new(OrderByTermContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_selectorElement
+	p.RuleIndex = SLQParserRULE_orderByTerm
 	return p
 }
 
-func InitEmptySelectorElementContext(p *SelectorElementContext) {
+func InitEmptyOrderByTermContext(p *OrderByTermContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_selectorElement
+	p.RuleIndex = SLQParserRULE_orderByTerm
 }
 
-func (*SelectorElementContext) IsSelectorElementContext() {}
+func (*OrderByTermContext) IsOrderByTermContext() {}
 
-func NewSelectorElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectorElementContext {
-	var p = new(SelectorElementContext)
+func NewOrderByTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderByTermContext {
+	var p = new(OrderByTermContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_selectorElement
+	p.RuleIndex = SLQParserRULE_orderByTerm
 
 	return p
 }
 
-func (s *SelectorElementContext) GetParser() antlr.Parser { return s.parser }
+func (s *OrderByTermContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectorElementContext) Selector() ISelectorContext {
+func (s *OrderByTermContext) Selector() ISelectorContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ISelectorContext); ok {
@@ -3751,10 +4396,10 @@ func (s *SelectorElementContext) Selector() ISelectorContext {
 	return t.(ISelectorContext)
 }
 
-func (s *SelectorElementContext) Alias() IAliasContext {
+func (s *OrderByTermContext) Func_() IFuncContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
+		if _, ok := ctx.(IFuncContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -3764,61 +4409,86 @@ func (s *SelectorElementContext) Alias() IAliasContext {
 		return nil
 	}
 
-	return t.(IAliasContext)
+	return t.(IFuncContext)
 }
 
-func (s *SelectorElementContext) GetRuleContext() antlr.RuleContext {
+func (s *OrderByTermContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectorElementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OrderByTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SelectorElementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OrderByTermContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterSelectorElement(s)
+		listenerT.EnterOrderByTerm(s)
 	}
 }
 
-func (s *SelectorElementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OrderByTermContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitSelectorElement(s)
+		listenerT.ExitOrderByTerm(s)
 	}
 }
 
-func (s *SelectorElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OrderByTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitSelectorElement(s)
+		return t.VisitOrderByTerm(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) SelectorElement() (localctx ISelectorElementContext) {
-	localctx = NewSelectorElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SLQParserRULE_selectorElement)
+func (p *SLQParser) OrderByTerm() (localctx IOrderByTermContext) {
+	localctx = NewOrderByTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, SLQParserRULE_orderByTerm)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(209)
-		p.Selector()
+	p.SetState(264)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
 
-	p.SetState(211)
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserNAME:
+		{
+			p.SetState(262)
+			p.Selector()
+		}
+
+	case SLQParserT__4, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserPROPRIETARY_FUNC_NAME:
+		{
+			p.SetState(263)
+			p.Func_()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+	p.SetState(267)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
+	if _la == SLQParserT__47 || _la == SLQParserT__48 {
 		{
-			p.SetState(210)
-			p.Alias()
+			p.SetState(266)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SLQParserT__47 || _la == SLQParserT__48) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
 
 	}
@@ -3836,154 +4506,210 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAliasContext is an interface to support dynamic dispatch.
-type IAliasContext interface {
+// IOrderByContext is an interface to support dynamic dispatch.
+type IOrderByContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ALIAS_RESERVED() antlr.TerminalNode
-	COLON() antlr.TerminalNode
-	ARG() antlr.TerminalNode
-	ID() antlr.TerminalNode
-	STRING() antlr.TerminalNode
+	ORDER_BY() antlr.TerminalNode
+	LPAR() antlr.TerminalNode
+	AllOrderByTerm() []IOrderByTermContext
+	OrderByTerm(i int) IOrderByTermContext
+	RPAR() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsAliasContext differentiates from other interfaces.
-	IsAliasContext()
+	// IsOrderByContext differentiates from other interfaces.
+	IsOrderByContext()
 }
 
-type AliasContext struct {
+type OrderByContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyAliasContext() *AliasContext {
-	var p = new(AliasContext)
+func NewEmptyOrderByContext() *OrderByContext {
+	var p = new(OrderByContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_alias
+	p.RuleIndex = SLQParserRULE_orderBy
 	return p
 }
 
-func InitEmptyAliasContext(p *AliasContext) {
+func InitEmptyOrderByContext(p *OrderByContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_alias
+	p.RuleIndex = SLQParserRULE_orderBy
 }
 
-func (*AliasContext) IsAliasContext() {}
+func (*OrderByContext) IsOrderByContext() {}
 
-func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
-	var p = new(AliasContext)
+func NewOrderByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrderByContext {
+	var p = new(OrderByContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_alias
+	p.RuleIndex = SLQParserRULE_orderBy
 
 	return p
 }
 
-func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+func (s *OrderByContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AliasContext) ALIAS_RESERVED() antlr.TerminalNode {
-	return s.GetToken(SLQParserALIAS_RESERVED, 0)
+func (s *OrderByContext) ORDER_BY() antlr.TerminalNode {
+	return s.GetToken(SLQParserORDER_BY, 0)
 }
 
-func (s *AliasContext) COLON() antlr.TerminalNode {
-	return s.GetToken(SLQParserCOLON, 0)
+func (s *OrderByContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *AliasContext) ARG() antlr.TerminalNode {
-	return s.GetToken(SLQParserARG, 0)
+func (s *OrderByContext) AllOrderByTerm() []IOrderByTermContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IOrderByTermContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IOrderByTermContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IOrderByTermContext); ok {
+			tst[i] = t.(IOrderByTermContext)
+			i++
+		}
+	}
+
+	return tst
 }
 
-func (s *AliasContext) ID() antlr.TerminalNode {
-	return s.GetToken(SLQParserID, 0)
+func (s *OrderByContext) OrderByTerm(i int) IOrderByTermContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrderByTermContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderByTermContext)
 }
 
-func (s *AliasContext) STRING() antlr.TerminalNode {
-	return s.GetToken(SLQParserSTRING, 0)
+func (s *OrderByContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
 }
 
-func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+func (s *OrderByContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
+}
+
+func (s *OrderByContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
+}
+
+func (s *OrderByContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OrderByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OrderByContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterAlias(s)
+		listenerT.EnterOrderBy(s)
 	}
 }
 
-func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OrderByContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitAlias(s)
+		listenerT.ExitOrderBy(s)
 	}
 }
 
-func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OrderByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitAlias(s)
+		return t.VisitOrderBy(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Alias() (localctx IAliasContext) {
-	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SLQParserRULE_alias)
+func (p *SLQParser) OrderBy() (localctx IOrderByContext) {
+	localctx = NewOrderByContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SLQParserRULE_orderBy)
 	var _la int
 
-	p.SetState(216)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(269)
+		p.Match(SLQParserORDER_BY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(270)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(271)
+		p.OrderByTerm()
+	}
+	p.SetState(276)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SLQParserALIAS_RESERVED:
-		p.EnterOuterAlt(localctx, 1)
+	for _la == SLQParserCOMMA {
 		{
-			p.SetState(213)
-			p.Match(SLQParserALIAS_RESERVED)
+			p.SetState(272)
+			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case SLQParserCOLON:
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(214)
-			p.Match(SLQParserCOLON)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(273)
+			p.OrderByTerm()
 		}
-		{
-			p.SetState(215)
-			_la = p.GetTokenStream().LA(1)
 
-			if !((int64((_la-40)) & ^0x3f) == 0 && ((int64(1)<<(_la-40))&16777225) != 0) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
+		p.SetState(278)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(279)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -3999,98 +4725,119 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IArgContext is an interface to support dynamic dispatch.
-type IArgContext interface {
+// ISelectorContext is an interface to support dynamic dispatch.
+type ISelectorContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	ARG() antlr.TerminalNode
+	AllNAME() []antlr.TerminalNode
+	NAME(i int) antlr.TerminalNode
 
-	// IsArgContext differentiates from other interfaces.
-	IsArgContext()
+	// IsSelectorContext differentiates from other interfaces.
+	IsSelectorContext()
 }
 
-type ArgContext struct {
+type SelectorContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyArgContext() *ArgContext {
-	var p = new(ArgContext)
+func NewEmptySelectorContext() *SelectorContext {
+	var p = new(SelectorContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_arg
+	p.RuleIndex = SLQParserRULE_selector
 	return p
 }
 
-func InitEmptyArgContext(p *ArgContext) {
+func InitEmptySelectorContext(p *SelectorContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_arg
+	p.RuleIndex = SLQParserRULE_selector
 }
 
-func (*ArgContext) IsArgContext() {}
+func (*SelectorContext) IsSelectorContext() {}
 
-func NewArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgContext {
-	var p = new(ArgContext)
+func NewSelectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectorContext {
+	var p = new(SelectorContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_arg
+	p.RuleIndex = SLQParserRULE_selector
 
 	return p
 }
 
-func (s *ArgContext) GetParser() antlr.Parser { return s.parser }
+func (s *SelectorContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArgContext) ARG() antlr.TerminalNode {
-	return s.GetToken(SLQParserARG, 0)
+func (s *SelectorContext) AllNAME() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserNAME)
 }
 
-func (s *ArgContext) GetRuleContext() antlr.RuleContext {
+func (s *SelectorContext) NAME(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserNAME, i)
+}
+
+func (s *SelectorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SelectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArgContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SelectorContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterArg(s)
+		listenerT.EnterSelector(s)
 	}
 }
 
-func (s *ArgContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SelectorContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitArg(s)
+		listenerT.ExitSelector(s)
 	}
 }
 
-func (s *ArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SelectorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitArg(s)
+		return t.VisitSelector(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Arg() (localctx IArgContext) {
-	localctx = NewArgContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SLQParserRULE_arg)
+func (p *SLQParser) Selector() (localctx ISelectorContext) {
+	localctx = NewSelectorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SLQParserRULE_selector)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(218)
-		p.Match(SLQParserARG)
+		p.SetState(281)
+		p.Match(SLQParserNAME)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(283)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(282)
+			p.Match(SLQParserNAME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
 
 errorExit:
 	if p.HasError() {
@@ -4105,63 +4852,70 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IHandleTableContext is an interface to support dynamic dispatch.
-type IHandleTableContext interface {
+// ISelectorElementContext is an interface to support dynamic dispatch.
+type ISelectorElementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	HANDLE() antlr.TerminalNode
-	NAME() antlr.TerminalNode
+	Selector() ISelectorContext
 	Alias() IAliasContext
 
-	// IsHandleTableContext differentiates from other interfaces.
-	IsHandleTableContext()
+	// IsSelectorElementContext differentiates from other interfaces.
+	IsSelectorElementContext()
 }
 
-type HandleTableContext struct {
+type SelectorElementContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyHandleTableContext() *HandleTableContext {
-	var p = new(HandleTableContext)
+func NewEmptySelectorElementContext() *SelectorElementContext {
+	var p = new(SelectorElementContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_handleTable
+	p.RuleIndex = SLQParserRULE_selectorElement
 	return p
 }
 
-func InitEmptyHandleTableContext(p *HandleTableContext) {
+func InitEmptySelectorElementContext(p *SelectorElementContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_handleTable
+	p.RuleIndex = SLQParserRULE_selectorElement
 }
 
-func (*HandleTableContext) IsHandleTableContext() {}
+func (*SelectorElementContext) IsSelectorElementContext() {}
 
-func NewHandleTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HandleTableContext {
-	var p = new(HandleTableContext)
+func NewSelectorElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectorElementContext {
+	var p = new(SelectorElementContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_handleTable
+	p.RuleIndex = SLQParserRULE_selectorElement
 
 	return p
 }
 
-func (s *HandleTableContext) GetParser() antlr.Parser { return s.parser }
+func (s *SelectorElementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *HandleTableContext) HANDLE() antlr.TerminalNode {
-	return s.GetToken(SLQParserHANDLE, 0)
-}
+func (s *SelectorElementContext) Selector() ISelectorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *HandleTableContext) NAME() antlr.TerminalNode {
-	return s.GetToken(SLQParserNAME, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
 }
 
-func (s *HandleTableContext) Alias() IAliasContext {
+func (s *SelectorElementContext) Alias() IAliasContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IAliasContext); ok {
@@ -4177,59 +4931,48 @@ func (s *HandleTableContext) Alias() IAliasContext {
 	return t.(IAliasContext)
 }
 
-func (s *HandleTableContext) GetRuleContext() antlr.RuleContext {
+func (s *SelectorElementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *HandleTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SelectorElementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *HandleTableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SelectorElementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterHandleTable(s)
+		listenerT.EnterSelectorElement(s)
 	}
 }
 
-func (s *HandleTableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SelectorElementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitHandleTable(s)
+		listenerT.ExitSelectorElement(s)
 	}
 }
 
-func (s *HandleTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SelectorElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitHandleTable(s)
+		return t.VisitSelectorElement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) HandleTable() (localctx IHandleTableContext) {
-	localctx = NewHandleTableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SLQParserRULE_handleTable)
+func (p *SLQParser) SelectorElement() (localctx ISelectorElementContext) {
+	localctx = NewSelectorElementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SLQParserRULE_selectorElement)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(220)
-		p.Match(SLQParserHANDLE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(221)
-		p.Match(SLQParserNAME)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(285)
+		p.Selector()
 	}
-	p.SetState(223)
+
+	p.SetState(287)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4238,7 +4981,7 @@ func (p *SLQParser) HandleTable() (localctx IHandleTableContext) {
 
 	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
 		{
-			p.SetState(222)
+			p.SetState(286)
 			p.Alias()
 		}
 
@@ -4257,94 +5000,419 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IHandleContext is an interface to support dynamic dispatch.
-type IHandleContext interface {
+// IAliasContext is an interface to support dynamic dispatch.
+type IAliasContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	HANDLE() antlr.TerminalNode
+	ALIAS_RESERVED() antlr.TerminalNode
+	COLON() antlr.TerminalNode
+	ARG() antlr.TerminalNode
+	ID() antlr.TerminalNode
+	STRING() antlr.TerminalNode
+	AliasKeyword() IAliasKeywordContext
 
-	// IsHandleContext differentiates from other interfaces.
-	IsHandleContext()
+	// IsAliasContext differentiates from other interfaces.
+	IsAliasContext()
 }
 
-type HandleContext struct {
+type AliasContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyHandleContext() *HandleContext {
-	var p = new(HandleContext)
+func NewEmptyAliasContext() *AliasContext {
+	var p = new(AliasContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_handle
+	p.RuleIndex = SLQParserRULE_alias
 	return p
 }
 
-func InitEmptyHandleContext(p *HandleContext) {
+func InitEmptyAliasContext(p *AliasContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_handle
+	p.RuleIndex = SLQParserRULE_alias
 }
 
-func (*HandleContext) IsHandleContext() {}
+func (*AliasContext) IsAliasContext() {}
 
-func NewHandleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HandleContext {
-	var p = new(HandleContext)
+func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
+	var p = new(AliasContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_handle
+	p.RuleIndex = SLQParserRULE_alias
 
 	return p
 }
 
-func (s *HandleContext) GetParser() antlr.Parser { return s.parser }
+func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *HandleContext) HANDLE() antlr.TerminalNode {
-	return s.GetToken(SLQParserHANDLE, 0)
+func (s *AliasContext) ALIAS_RESERVED() antlr.TerminalNode {
+	return s.GetToken(SLQParserALIAS_RESERVED, 0)
 }
 
-func (s *HandleContext) GetRuleContext() antlr.RuleContext {
+func (s *AliasContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOLON, 0)
+}
+
+func (s *AliasContext) ARG() antlr.TerminalNode {
+	return s.GetToken(SLQParserARG, 0)
+}
+
+func (s *AliasContext) ID() antlr.TerminalNode {
+	return s.GetToken(SLQParserID, 0)
+}
+
+func (s *AliasContext) STRING() antlr.TerminalNode {
+	return s.GetToken(SLQParserSTRING, 0)
+}
+
+func (s *AliasContext) AliasKeyword() IAliasKeywordContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasKeywordContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasKeywordContext)
+}
+
+func (s *AliasContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *HandleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *HandleContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterHandle(s)
+		listenerT.EnterAlias(s)
 	}
 }
 
-func (s *HandleContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitHandle(s)
+		listenerT.ExitAlias(s)
 	}
 }
 
-func (s *HandleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitHandle(s)
+		return t.VisitAlias(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Handle() (localctx IHandleContext) {
-	localctx = NewHandleContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SLQParserRULE_handle)
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(225)
-		p.Match(SLQParserHANDLE)
-		if p.HasError() {
+func (p *SLQParser) Alias() (localctx IAliasContext) {
+	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SLQParserRULE_alias)
+	p.SetState(297)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SLQParserALIAS_RESERVED:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(289)
+			p.Match(SLQParserALIAS_RESERVED)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SLQParserCOLON:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(290)
+			p.Match(SLQParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(295)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case SLQParserARG:
+			{
+				p.SetState(291)
+				p.Match(SLQParserARG)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SLQParserID:
+			{
+				p.SetState(292)
+				p.Match(SLQParserID)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SLQParserSTRING:
+			{
+				p.SetState(293)
+				p.Match(SLQParserSTRING)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SLQParserT__1, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserT__49, SLQParserT__50, SLQParserT__51, SLQParserSET_OP:
+			{
+				p.SetState(294)
+				p.AliasKeyword()
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAliasKeywordContext is an interface to support dynamic dispatch.
+type IAliasKeywordContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	SET_OP() antlr.TerminalNode
+
+	// IsAliasKeywordContext differentiates from other interfaces.
+	IsAliasKeywordContext()
+}
+
+type AliasKeywordContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyAliasKeywordContext() *AliasKeywordContext {
+	var p = new(AliasKeywordContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_aliasKeyword
+	return p
+}
+
+func InitEmptyAliasKeywordContext(p *AliasKeywordContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_aliasKeyword
+}
+
+func (*AliasKeywordContext) IsAliasKeywordContext() {}
+
+func NewAliasKeywordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasKeywordContext {
+	var p = new(AliasKeywordContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_aliasKeyword
+
+	return p
+}
+
+func (s *AliasKeywordContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AliasKeywordContext) SET_OP() antlr.TerminalNode {
+	return s.GetToken(SLQParserSET_OP, 0)
+}
+
+func (s *AliasKeywordContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AliasKeywordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AliasKeywordContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterAliasKeyword(s)
+	}
+}
+
+func (s *AliasKeywordContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitAliasKeyword(s)
+	}
+}
+
+func (s *AliasKeywordContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitAliasKeyword(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) AliasKeyword() (localctx IAliasKeywordContext) {
+	localctx = NewAliasKeywordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SLQParserRULE_aliasKeyword)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(299)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-2)) & ^0x3f) == 0 && ((int64(1)<<(_la-2))&-9221392915926876103) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IArgContext is an interface to support dynamic dispatch.
+type IArgContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	ARG() antlr.TerminalNode
+
+	// IsArgContext differentiates from other interfaces.
+	IsArgContext()
+}
+
+type ArgContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyArgContext() *ArgContext {
+	var p = new(ArgContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_arg
+	return p
+}
+
+func InitEmptyArgContext(p *ArgContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_arg
+}
+
+func (*ArgContext) IsArgContext() {}
+
+func NewArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgContext {
+	var p = new(ArgContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_arg
+
+	return p
+}
+
+func (s *ArgContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgContext) ARG() antlr.TerminalNode {
+	return s.GetToken(SLQParserARG, 0)
+}
+
+func (s *ArgContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterArg(s)
+	}
+}
+
+func (s *ArgContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitArg(s)
+	}
+}
+
+func (s *ArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitArg(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Arg() (localctx IArgContext) {
+	localctx = NewArgContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SLQParserRULE_arg)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(301)
+		p.Match(SLQParserARG)
+		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
@@ -4358,205 +5426,1421 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.ExitRule()
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IHandleTableContext is an interface to support dynamic dispatch.
+type IHandleTableContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	HANDLE() antlr.TerminalNode
+	NAME() antlr.TerminalNode
+	Alias() IAliasContext
+
+	// IsHandleTableContext differentiates from other interfaces.
+	IsHandleTableContext()
+}
+
+type HandleTableContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyHandleTableContext() *HandleTableContext {
+	var p = new(HandleTableContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_handleTable
+	return p
+}
+
+func InitEmptyHandleTableContext(p *HandleTableContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_handleTable
+}
+
+func (*HandleTableContext) IsHandleTableContext() {}
+
+func NewHandleTableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HandleTableContext {
+	var p = new(HandleTableContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_handleTable
+
+	return p
+}
+
+func (s *HandleTableContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *HandleTableContext) HANDLE() antlr.TerminalNode {
+	return s.GetToken(SLQParserHANDLE, 0)
+}
+
+func (s *HandleTableContext) NAME() antlr.TerminalNode {
+	return s.GetToken(SLQParserNAME, 0)
+}
+
+func (s *HandleTableContext) Alias() IAliasContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasContext)
+}
+
+func (s *HandleTableContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *HandleTableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *HandleTableContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterHandleTable(s)
+	}
+}
+
+func (s *HandleTableContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitHandleTable(s)
+	}
+}
+
+func (s *HandleTableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitHandleTable(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) HandleTable() (localctx IHandleTableContext) {
+	localctx = NewHandleTableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SLQParserRULE_handleTable)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(303)
+		p.Match(SLQParserHANDLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(304)
+		p.Match(SLQParserNAME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(306)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
+		{
+			p.SetState(305)
+			p.Alias()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IHandleContext is an interface to support dynamic dispatch.
+type IHandleContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	HANDLE() antlr.TerminalNode
+
+	// IsHandleContext differentiates from other interfaces.
+	IsHandleContext()
+}
+
+type HandleContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyHandleContext() *HandleContext {
+	var p = new(HandleContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_handle
+	return p
+}
+
+func InitEmptyHandleContext(p *HandleContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_handle
+}
+
+func (*HandleContext) IsHandleContext() {}
+
+func NewHandleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HandleContext {
+	var p = new(HandleContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_handle
+
+	return p
+}
+
+func (s *HandleContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *HandleContext) HANDLE() antlr.TerminalNode {
+	return s.GetToken(SLQParserHANDLE, 0)
+}
+
+func (s *HandleContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *HandleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *HandleContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterHandle(s)
+	}
+}
+
+func (s *HandleContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitHandle(s)
+	}
+}
+
+func (s *HandleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitHandle(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Handle() (localctx IHandleContext) {
+	localctx = NewHandleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SLQParserRULE_handle)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(308)
+		p.Match(SLQParserHANDLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IRowRangeContext is an interface to support dynamic dispatch.
+type IRowRangeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	RBRA() antlr.TerminalNode
+	AllNN() []antlr.TerminalNode
+	NN(i int) antlr.TerminalNode
+	COLON() antlr.TerminalNode
+
+	// IsRowRangeContext differentiates from other interfaces.
+	IsRowRangeContext()
+}
+
+type RowRangeContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyRowRangeContext() *RowRangeContext {
+	var p = new(RowRangeContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_rowRange
+	return p
+}
+
+func InitEmptyRowRangeContext(p *RowRangeContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_rowRange
+}
+
+func (*RowRangeContext) IsRowRangeContext() {}
+
+func NewRowRangeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RowRangeContext {
+	var p = new(RowRangeContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_rowRange
+
+	return p
+}
+
+func (s *RowRangeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RowRangeContext) RBRA() antlr.TerminalNode {
+	return s.GetToken(SLQParserRBRA, 0)
+}
+
+func (s *RowRangeContext) AllNN() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserNN)
+}
+
+func (s *RowRangeContext) NN(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserNN, i)
+}
+
+func (s *RowRangeContext) COLON() antlr.TerminalNode {
+	return s.GetToken(SLQParserCOLON, 0)
+}
+
+func (s *RowRangeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RowRangeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *RowRangeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterRowRange(s)
+	}
+}
+
+func (s *RowRangeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitRowRange(s)
+	}
+}
+
+func (s *RowRangeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitRowRange(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) RowRange() (localctx IRowRangeContext) {
+	localctx = NewRowRangeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SLQParserRULE_rowRange)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(310)
+		p.Match(SLQParserT__52)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(319)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(311)
+			p.Match(SLQParserNN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(312)
+			p.Match(SLQParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(313)
+			p.Match(SLQParserNN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) == 2 {
+		{
+			p.SetState(314)
+			p.Match(SLQParserNN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(315)
+			p.Match(SLQParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) == 3 {
+		{
+			p.SetState(316)
+			p.Match(SLQParserCOLON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(317)
+			p.Match(SLQParserNN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) == 4 {
+		{
+			p.SetState(318)
+			p.Match(SLQParserNN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(321)
+		p.Match(SLQParserRBRA)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExprElementContext is an interface to support dynamic dispatch.
+type IExprElementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Expr() IExprContext
+	Alias() IAliasContext
+
+	// IsExprElementContext differentiates from other interfaces.
+	IsExprElementContext()
+}
+
+type ExprElementContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyExprElementContext() *ExprElementContext {
+	var p = new(ExprElementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_exprElement
+	return p
+}
+
+func InitEmptyExprElementContext(p *ExprElementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_exprElement
+}
+
+func (*ExprElementContext) IsExprElementContext() {}
+
+func NewExprElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprElementContext {
+	var p = new(ExprElementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_exprElement
+
+	return p
+}
+
+func (s *ExprElementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprElementContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ExprElementContext) Alias() IAliasContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasContext)
+}
+
+func (s *ExprElementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprElementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExprElementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterExprElement(s)
+	}
+}
+
+func (s *ExprElementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitExprElement(s)
+	}
+}
+
+func (s *ExprElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitExprElement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) ExprElement() (localctx IExprElementContext) {
+	localctx = NewExprElementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SLQParserRULE_exprElement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(323)
+		p.expr(0)
+	}
+	p.SetState(325)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
+		{
+			p.SetState(324)
+			p.Alias()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IExprContext is an interface to support dynamic dispatch.
+type IExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	AllExpr() []IExprContext
+	Expr(i int) IExprContext
+	RPAR() antlr.TerminalNode
+	Subquery() ISubqueryContext
+	Selector() ISelectorContext
+	Literal() ILiteralContext
+	Arg() IArgContext
+	UnaryOperator() IUnaryOperatorContext
+	Func_() IFuncContext
+	LT() antlr.TerminalNode
+	LT_EQ() antlr.TerminalNode
+	GT() antlr.TerminalNode
+	GT_EQ() antlr.TerminalNode
+	EQ() antlr.TerminalNode
+	NEQ() antlr.TerminalNode
+	InOperator() IInOperatorContext
+	ExprList() IExprListContext
+	NullOperator() INullOperatorContext
+
+	// IsExprContext differentiates from other interfaces.
+	IsExprContext()
+}
+
+type ExprContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptyExprContext() *ExprContext {
+	var p = new(ExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_expr
+	return p
+}
+
+func InitEmptyExprContext(p *ExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_expr
+}
+
+func (*ExprContext) IsExprContext() {}
+
+func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
+	var p = new(ExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_expr
+
+	return p
+}
+
+func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
+
+func (s *ExprContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ExprContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ExprContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
+
+func (s *ExprContext) Subquery() ISubqueryContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubqueryContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubqueryContext)
+}
+
+func (s *ExprContext) Selector() ISelectorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectorContext)
+}
+
+func (s *ExprContext) Literal() ILiteralContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralContext)
+}
+
+func (s *ExprContext) Arg() IArgContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgContext)
+}
+
+func (s *ExprContext) UnaryOperator() IUnaryOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUnaryOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnaryOperatorContext)
+}
+
+func (s *ExprContext) Func_() IFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuncContext)
+}
+
+func (s *ExprContext) LT() antlr.TerminalNode {
+	return s.GetToken(SLQParserLT, 0)
+}
+
+func (s *ExprContext) LT_EQ() antlr.TerminalNode {
+	return s.GetToken(SLQParserLT_EQ, 0)
+}
+
+func (s *ExprContext) GT() antlr.TerminalNode {
+	return s.GetToken(SLQParserGT, 0)
+}
+
+func (s *ExprContext) GT_EQ() antlr.TerminalNode {
+	return s.GetToken(SLQParserGT_EQ, 0)
+}
+
+func (s *ExprContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SLQParserEQ, 0)
+}
+
+func (s *ExprContext) NEQ() antlr.TerminalNode {
+	return s.GetToken(SLQParserNEQ, 0)
+}
+
+func (s *ExprContext) InOperator() IInOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IInOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IInOperatorContext)
+}
+
+func (s *ExprContext) ExprList() IExprListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprListContext)
+}
+
+func (s *ExprContext) NullOperator() INullOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INullOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INullOperatorContext)
+}
+
+func (s *ExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterExpr(s)
+	}
+}
+
+func (s *ExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitExpr(s)
+	}
+}
+
+func (s *ExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Expr() (localctx IExprContext) {
+	return p.expr(0)
+}
+
+func (p *SLQParser) expr(_p int) (localctx IExprContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExprContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 62
+	p.EnterRecursionRule(localctx, 62, SLQParserRULE_expr, _p)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(340)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 36, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(328)
+			p.Match(SLQParserLPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(329)
+			p.expr(0)
+		}
+		{
+			p.SetState(330)
+			p.Match(SLQParserRPAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(332)
+			p.Subquery()
+		}
+
+	case 3:
+		{
+			p.SetState(333)
+			p.Selector()
+		}
+
+	case 4:
+		{
+			p.SetState(334)
+			p.Literal()
+		}
+
+	case 5:
+		{
+			p.SetState(335)
+			p.Arg()
+		}
+
+	case 6:
+		{
+			p.SetState(336)
+			p.UnaryOperator()
+		}
+		{
+			p.SetState(337)
+			p.expr(11)
+		}
+
+	case 7:
+		{
+			p.SetState(339)
+			p.Func_()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(377)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 40, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(375)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(342)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(343)
+					p.Match(SLQParserT__53)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(344)
+					p.expr(11)
+				}
+
+			case 2:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(345)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(346)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&108086391056891920) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(347)
+					p.expr(10)
+				}
+
+			case 3:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(348)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(349)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == SLQParserT__47 || _la == SLQParserT__48) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(350)
+					p.expr(9)
+				}
+
+			case 4:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(351)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(352)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1008806316530991104) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(353)
+					p.expr(8)
+				}
+
+			case 5:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(354)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(355)
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&15) != 0) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(356)
+					p.expr(7)
+				}
+
+			case 6:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(357)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+					goto errorExit
+				}
+				p.SetState(361)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+
+				switch p.GetTokenStream().LA(1) {
+				case SLQParserEQ:
+					{
+						p.SetState(358)
+						p.Match(SLQParserEQ)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				case SLQParserNEQ:
+					{
+						p.SetState(359)
+						p.Match(SLQParserNEQ)
+						if p.HasError() {
+							// Recognition error - abort rule
+							goto errorExit
+						}
+					}
+
+				case SLQParserT__4, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__17, SLQParserT__18, SLQParserT__19, SLQParserT__20, SLQParserT__21, SLQParserT__22, SLQParserT__23, SLQParserT__24, SLQParserT__25, SLQParserT__26, SLQParserT__27, SLQParserT__28, SLQParserT__29, SLQParserT__30, SLQParserT__31, SLQParserT__32, SLQParserT__33, SLQParserT__34, SLQParserT__35, SLQParserT__36, SLQParserT__37, SLQParserT__38, SLQParserT__39, SLQParserT__40, SLQParserT__41, SLQParserT__42, SLQParserT__43, SLQParserT__47, SLQParserT__48, SLQParserT__60, SLQParserT__61, SLQParserPROPRIETARY_FUNC_NAME, SLQParserARG, SLQParserBOOL, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
+
+				default:
+					p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+					goto errorExit
+				}
+				{
+					p.SetState(363)
+					p.expr(6)
+				}
+
+			case 7:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(364)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(365)
+					p.InOperator()
+				}
+				p.SetState(368)
+				p.GetErrorHandler().Sync(p)
+				if p.HasError() {
+					goto errorExit
+				}
+
+				switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext()) {
+				case 1:
+					{
+						p.SetState(366)
+						p.ExprList()
+					}
+
+				case 2:
+					{
+						p.SetState(367)
+						p.Subquery()
+					}
+
+				case antlr.ATNInvalidAltNumber:
+					goto errorExit
+				}
+
+			case 8:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(370)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(371)
+					p.NullOperator()
+				}
+
+			case 9:
+				localctx = NewExprContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
+				p.SetState(372)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
+					goto errorExit
+				}
+				{
+					p.SetState(373)
+					p.Match(SLQParserT__59)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+				{
+					p.SetState(374)
+					p.expr(3)
+				}
+
+			case antlr.ATNInvalidAltNumber:
+				goto errorExit
+			}
+
+		}
+		p.SetState(379)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 40, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.UnrollRecursionContexts(_parentctx)
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRowRangeContext is an interface to support dynamic dispatch.
-type IRowRangeContext interface {
+// IInOperatorContext is an interface to support dynamic dispatch.
+type IInOperatorContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
-
-	// Getter signatures
-	RBRA() antlr.TerminalNode
-	AllNN() []antlr.TerminalNode
-	NN(i int) antlr.TerminalNode
-	COLON() antlr.TerminalNode
-
-	// IsRowRangeContext differentiates from other interfaces.
-	IsRowRangeContext()
+	// IsInOperatorContext differentiates from other interfaces.
+	IsInOperatorContext()
 }
 
-type RowRangeContext struct {
+type InOperatorContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyRowRangeContext() *RowRangeContext {
-	var p = new(RowRangeContext)
+func NewEmptyInOperatorContext() *InOperatorContext {
+	var p = new(InOperatorContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_rowRange
+	p.RuleIndex = SLQParserRULE_inOperator
 	return p
 }
 
-func InitEmptyRowRangeContext(p *RowRangeContext) {
+func InitEmptyInOperatorContext(p *InOperatorContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_rowRange
+	p.RuleIndex = SLQParserRULE_inOperator
 }
 
-func (*RowRangeContext) IsRowRangeContext() {}
+func (*InOperatorContext) IsInOperatorContext() {}
 
-func NewRowRangeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RowRangeContext {
-	var p = new(RowRangeContext)
+func NewInOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InOperatorContext {
+	var p = new(InOperatorContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_rowRange
+	p.RuleIndex = SLQParserRULE_inOperator
 
 	return p
 }
 
-func (s *RowRangeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *RowRangeContext) RBRA() antlr.TerminalNode {
-	return s.GetToken(SLQParserRBRA, 0)
-}
-
-func (s *RowRangeContext) AllNN() []antlr.TerminalNode {
-	return s.GetTokens(SLQParserNN)
-}
-
-func (s *RowRangeContext) NN(i int) antlr.TerminalNode {
-	return s.GetToken(SLQParserNN, i)
-}
-
-func (s *RowRangeContext) COLON() antlr.TerminalNode {
-	return s.GetToken(SLQParserCOLON, 0)
-}
-
-func (s *RowRangeContext) GetRuleContext() antlr.RuleContext {
+func (s *InOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *InOperatorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RowRangeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RowRangeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterRowRange(s)
+		listenerT.EnterInOperator(s)
 	}
 }
 
-func (s *RowRangeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitRowRange(s)
+		listenerT.ExitInOperator(s)
 	}
 }
 
-func (s *RowRangeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *InOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitRowRange(s)
+		return t.VisitInOperator(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) RowRange() (localctx IRowRangeContext) {
-	localctx = NewRowRangeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SLQParserRULE_rowRange)
+func (p *SLQParser) InOperator() (localctx IInOperatorContext) {
+	localctx = NewInOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SLQParserRULE_inOperator)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(227)
-		p.Match(SLQParserT__22)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	p.SetState(236)
+	p.SetState(381)
 	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 25, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(228)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(229)
-			p.Match(SLQParserCOLON)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(230)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	} else if p.HasError() { // JIM
-		goto errorExit
-	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 25, p.GetParserRuleContext()) == 2 {
-		{
-			p.SetState(231)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(232)
-			p.Match(SLQParserCOLON)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	} else if p.HasError() { // JIM
+	if p.HasError() {
 		goto errorExit
-	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 25, p.GetParserRuleContext()) == 3 {
-		{
-			p.SetState(233)
-			p.Match(SLQParserCOLON)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(234)
-			p.Match(SLQParserNN)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	} else if p.HasError() { // JIM
-		goto errorExit
-	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 25, p.GetParserRuleContext()) == 4 {
+	if _la == SLQParserT__50 {
 		{
-			p.SetState(235)
-			p.Match(SLQParserNN)
+			p.SetState(380)
+			p.Match(SLQParserT__50)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 
-	} else if p.HasError() { // JIM
-		goto errorExit
 	}
 	{
-		p.SetState(238)
-		p.Match(SLQParserRBRA)
+		p.SetState(383)
+		p.Match(SLQParserT__49)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
@@ -4576,139 +6860,126 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IExprElementContext is an interface to support dynamic dispatch.
-type IExprElementContext interface {
+// INullOperatorContext is an interface to support dynamic dispatch.
+type INullOperatorContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Expr() IExprContext
-	Alias() IAliasContext
+	NULL() antlr.TerminalNode
 
-	// IsExprElementContext differentiates from other interfaces.
-	IsExprElementContext()
+	// IsNullOperatorContext differentiates from other interfaces.
+	IsNullOperatorContext()
 }
 
-type ExprElementContext struct {
+type NullOperatorContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyExprElementContext() *ExprElementContext {
-	var p = new(ExprElementContext)
+func NewEmptyNullOperatorContext() *NullOperatorContext {
+	var p = new(NullOperatorContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_exprElement
+	p.RuleIndex = SLQParserRULE_nullOperator
 	return p
 }
 
-func InitEmptyExprElementContext(p *ExprElementContext) {
+func InitEmptyNullOperatorContext(p *NullOperatorContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_exprElement
+	p.RuleIndex = SLQParserRULE_nullOperator
 }
 
-func (*ExprElementContext) IsExprElementContext() {}
+func (*NullOperatorContext) IsNullOperatorContext() {}
 
-func NewExprElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprElementContext {
-	var p = new(ExprElementContext)
+func NewNullOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NullOperatorContext {
+	var p = new(NullOperatorContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_exprElement
+	p.RuleIndex = SLQParserRULE_nullOperator
 
 	return p
 }
 
-func (s *ExprElementContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExprElementContext) Expr() IExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
-}
-
-func (s *ExprElementContext) Alias() IAliasContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *NullOperatorContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IAliasContext)
+func (s *NullOperatorContext) NULL() antlr.TerminalNode {
+	return s.GetToken(SLQParserNULL, 0)
 }
 
-func (s *ExprElementContext) GetRuleContext() antlr.RuleContext {
+func (s *NullOperatorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprElementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NullOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExprElementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NullOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterExprElement(s)
+		listenerT.EnterNullOperator(s)
 	}
 }
 
-func (s *ExprElementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NullOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitExprElement(s)
+		listenerT.ExitNullOperator(s)
 	}
 }
 
-func (s *ExprElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NullOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitExprElement(s)
+		return t.VisitNullOperator(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) ExprElement() (localctx IExprElementContext) {
-	localctx = NewExprElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SLQParserRULE_exprElement)
+func (p *SLQParser) NullOperator() (localctx INullOperatorContext) {
+	localctx = NewNullOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SLQParserRULE_nullOperator)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(240)
-		p.expr(0)
+		p.SetState(385)
+		p.Match(SLQParserT__51)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	p.SetState(242)
+	p.SetState(387)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SLQParserALIAS_RESERVED || _la == SLQParserCOLON {
+	if _la == SLQParserT__50 {
 		{
-			p.SetState(241)
-			p.Alias()
+			p.SetState(386)
+			p.Match(SLQParserT__50)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
 	}
+	{
+		p.SetState(389)
+		p.Match(SLQParserNULL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
 
 errorExit:
 	if p.HasError() {
@@ -4722,9 +6993,9 @@ errorExit:
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
-
-// IExprContext is an interface to support dynamic dispatch.
-type IExprContext interface {
+
+// IExprListContext is an interface to support dynamic dispatch.
+type IExprListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -4735,59 +7006,50 @@ type IExprContext interface {
 	AllExpr() []IExprContext
 	Expr(i int) IExprContext
 	RPAR() antlr.TerminalNode
-	Selector() ISelectorContext
-	Literal() ILiteralContext
-	Arg() IArgContext
-	UnaryOperator() IUnaryOperatorContext
-	Func_() IFuncContext
-	LT() antlr.TerminalNode
-	LT_EQ() antlr.TerminalNode
-	GT() antlr.TerminalNode
-	GT_EQ() antlr.TerminalNode
-	EQ() antlr.TerminalNode
-	NEQ() antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
 
-	// IsExprContext differentiates from other interfaces.
-	IsExprContext()
+	// IsExprListContext differentiates from other interfaces.
+	IsExprListContext()
 }
 
-type ExprContext struct {
+type ExprListContext struct {
 	parser antlr.Parser
 	antlr.BaseParserRuleContext
 }
 
-func NewEmptyExprContext() *ExprContext {
-	var p = new(ExprContext)
+func NewEmptyExprListContext() *ExprListContext {
+	var p = new(ExprListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_expr
+	p.RuleIndex = SLQParserRULE_exprList
 	return p
 }
 
-func InitEmptyExprContext(p *ExprContext) {
+func InitEmptyExprListContext(p *ExprListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SLQParserRULE_expr
+	p.RuleIndex = SLQParserRULE_exprList
 }
 
-func (*ExprContext) IsExprContext() {}
+func (*ExprListContext) IsExprListContext() {}
 
-func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
-	var p = new(ExprContext)
+func NewExprListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprListContext {
+	var p = new(ExprListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SLQParserRULE_expr
+	p.RuleIndex = SLQParserRULE_exprList
 
 	return p
 }
 
-func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExprListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExprContext) LPAR() antlr.TerminalNode {
+func (s *ExprListContext) LPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserLPAR, 0)
 }
 
-func (s *ExprContext) AllExpr() []IExprContext {
+func (s *ExprListContext) AllExpr() []IExprContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
@@ -4808,7 +7070,7 @@ func (s *ExprContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *ExprContext) Expr(i int) IExprContext {
+func (s *ExprListContext) Expr(i int) IExprContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
@@ -4828,455 +7090,305 @@ func (s *ExprContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *ExprContext) RPAR() antlr.TerminalNode {
+func (s *ExprListContext) RPAR() antlr.TerminalNode {
 	return s.GetToken(SLQParserRPAR, 0)
 }
 
-func (s *ExprContext) Selector() ISelectorContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectorContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISelectorContext)
-}
-
-func (s *ExprContext) Literal() ILiteralContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILiteralContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ILiteralContext)
-}
-
-func (s *ExprContext) Arg() IArgContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IArgContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IArgContext)
-}
-
-func (s *ExprContext) UnaryOperator() IUnaryOperatorContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IUnaryOperatorContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IUnaryOperatorContext)
-}
-
-func (s *ExprContext) Func_() IFuncContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFuncContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFuncContext)
-}
-
-func (s *ExprContext) LT() antlr.TerminalNode {
-	return s.GetToken(SLQParserLT, 0)
-}
-
-func (s *ExprContext) LT_EQ() antlr.TerminalNode {
-	return s.GetToken(SLQParserLT_EQ, 0)
-}
-
-func (s *ExprContext) GT() antlr.TerminalNode {
-	return s.GetToken(SLQParserGT, 0)
-}
-
-func (s *ExprContext) GT_EQ() antlr.TerminalNode {
-	return s.GetToken(SLQParserGT_EQ, 0)
-}
-
-func (s *ExprContext) EQ() antlr.TerminalNode {
-	return s.GetToken(SLQParserEQ, 0)
+func (s *ExprListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserCOMMA)
 }
 
-func (s *ExprContext) NEQ() antlr.TerminalNode {
-	return s.GetToken(SLQParserNEQ, 0)
+func (s *ExprListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserCOMMA, i)
 }
 
-func (s *ExprContext) GetRuleContext() antlr.RuleContext {
+func (s *ExprListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExprListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExprListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.EnterExpr(s)
+		listenerT.EnterExprList(s)
 	}
 }
 
-func (s *ExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExprListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SLQListener); ok {
-		listenerT.ExitExpr(s)
+		listenerT.ExitExprList(s)
 	}
 }
 
-func (s *ExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExprListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SLQVisitor:
-		return t.VisitExpr(s)
+		return t.VisitExprList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SLQParser) Expr() (localctx IExprContext) {
-	return p.expr(0)
-}
-
-func (p *SLQParser) expr(_p int) (localctx IExprContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-
-	_parentState := p.GetState()
-	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExprContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 50
-	p.EnterRecursionRule(localctx, 50, SLQParserRULE_expr, _p)
+func (p *SLQParser) ExprList() (localctx IExprListContext) {
+	localctx = NewExprListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SLQParserRULE_exprList)
 	var _la int
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(256)
+	{
+		p.SetState(391)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(392)
+		p.expr(0)
+	}
+	p.SetState(397)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SLQParserLPAR:
+	for _la == SLQParserCOMMA {
 		{
-			p.SetState(245)
-			p.Match(SLQParserLPAR)
+			p.SetState(393)
+			p.Match(SLQParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(246)
+			p.SetState(394)
 			p.expr(0)
 		}
-		{
-			p.SetState(247)
-			p.Match(SLQParserRPAR)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
 
-	case SLQParserNAME:
-		{
-			p.SetState(249)
-			p.Selector()
+		p.SetState(399)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	case SLQParserBOOL, SLQParserNULL, SLQParserNN, SLQParserNUMBER, SLQParserSTRING:
-		{
-			p.SetState(250)
-			p.Literal()
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(400)
+		p.Match(SLQParserRPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
+	}
 
-	case SLQParserARG:
-		{
-			p.SetState(251)
-			p.Arg()
-		}
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	case SLQParserT__20, SLQParserT__21, SLQParserT__30, SLQParserT__31:
-		{
-			p.SetState(252)
-			p.UnaryOperator()
-		}
-		{
-			p.SetState(253)
-			p.expr(9)
-		}
+// ISubqueryContext is an interface to support dynamic dispatch.
+type ISubqueryContext interface {
+	antlr.ParserRuleContext
 
-	case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserPROPRIETARY_FUNC_NAME:
-		{
-			p.SetState(255)
-			p.Func_()
-		}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
-	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(285)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
-	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(283)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
+	// Getter signatures
+	LPAR() antlr.TerminalNode
+	AllSegment() []ISegmentContext
+	Segment(i int) ISegmentContext
+	RPAR() antlr.TerminalNode
+	AllPIPE() []antlr.TerminalNode
+	PIPE(i int) antlr.TerminalNode
+
+	// IsSubqueryContext differentiates from other interfaces.
+	IsSubqueryContext()
+}
+
+type SubqueryContext struct {
+	parser antlr.Parser
+	antlr.BaseParserRuleContext
+}
+
+func NewEmptySubqueryContext() *SubqueryContext {
+	var p = new(SubqueryContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_subquery
+	return p
+}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(258)
+func InitEmptySubqueryContext(p *SubqueryContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SLQParserRULE_subquery
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(259)
-					p.Match(SLQParserT__23)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(260)
-					p.expr(9)
-				}
+func (*SubqueryContext) IsSubqueryContext() {}
 
-			case 2:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(261)
+func NewSubqueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubqueryContext {
+	var p = new(SubqueryContext)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(262)
-					_la = p.GetTokenStream().LA(1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&100663300) != 0) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(263)
-					p.expr(8)
-				}
+	p.parser = parser
+	p.RuleIndex = SLQParserRULE_subquery
 
-			case 3:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(264)
+	return p
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(265)
-					_la = p.GetTokenStream().LA(1)
+func (s *SubqueryContext) GetParser() antlr.Parser { return s.parser }
 
-					if !(_la == SLQParserT__20 || _la == SLQParserT__21) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(266)
-					p.expr(7)
-				}
+func (s *SubqueryContext) LPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserLPAR, 0)
+}
 
-			case 4:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(267)
+func (s *SubqueryContext) AllSegment() []ISegmentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISegmentContext); ok {
+			len++
+		}
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(268)
-					_la = p.GetTokenStream().LA(1)
+	tst := make([]ISegmentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISegmentContext); ok {
+			tst[i] = t.(ISegmentContext)
+			i++
+		}
+	}
 
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&939524096) != 0) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(269)
-					p.expr(6)
-				}
+	return tst
+}
 
-			case 5:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(270)
+func (s *SubqueryContext) Segment(i int) ISegmentContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISegmentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(271)
-					_la = p.GetTokenStream().LA(1)
+	if t == nil {
+		return nil
+	}
 
-					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1080863910568919040) != 0) {
-						p.GetErrorHandler().RecoverInline(p)
-					} else {
-						p.GetErrorHandler().ReportMatch(p)
-						p.Consume()
-					}
-				}
-				{
-					p.SetState(272)
-					p.expr(5)
-				}
+	return t.(ISegmentContext)
+}
 
-			case 6:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(273)
+func (s *SubqueryContext) RPAR() antlr.TerminalNode {
+	return s.GetToken(SLQParserRPAR, 0)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
-					goto errorExit
-				}
-				p.SetState(277)
-				p.GetErrorHandler().Sync(p)
-				if p.HasError() {
-					goto errorExit
-				}
+func (s *SubqueryContext) AllPIPE() []antlr.TerminalNode {
+	return s.GetTokens(SLQParserPIPE)
+}
 
-				switch p.GetTokenStream().LA(1) {
-				case SLQParserEQ:
-					{
-						p.SetState(274)
-						p.Match(SLQParserEQ)
-						if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-						}
-					}
+func (s *SubqueryContext) PIPE(i int) antlr.TerminalNode {
+	return s.GetToken(SLQParserPIPE, i)
+}
 
-				case SLQParserNEQ:
-					{
-						p.SetState(275)
-						p.Match(SLQParserNEQ)
-						if p.HasError() {
-							// Recognition error - abort rule
-							goto errorExit
-						}
-					}
+func (s *SubqueryContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-				case SLQParserT__2, SLQParserT__3, SLQParserT__4, SLQParserT__5, SLQParserT__6, SLQParserT__7, SLQParserT__8, SLQParserT__9, SLQParserT__10, SLQParserT__11, SLQParserT__12, SLQParserT__13, SLQParserT__14, SLQParserT__15, SLQParserT__16, SLQParserT__20, SLQParserT__21, SLQParserT__30, SLQParserT__31, SLQParserPROPRIETARY_FUNC_NAME, SLQParserARG, SLQParserBOOL, SLQParserNULL, SLQParserLPAR, SLQParserNN, SLQParserNUMBER, SLQParserNAME, SLQParserSTRING:
+func (s *SubqueryContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-				default:
-					p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-					goto errorExit
-				}
-				{
-					p.SetState(279)
-					p.expr(4)
-				}
+func (s *SubqueryContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.EnterSubquery(s)
+	}
+}
 
-			case 7:
-				localctx = NewExprContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, SLQParserRULE_expr)
-				p.SetState(280)
+func (s *SubqueryContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SLQListener); ok {
+		listenerT.ExitSubquery(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
-					goto errorExit
-				}
-				{
-					p.SetState(281)
-					p.Match(SLQParserT__29)
-					if p.HasError() {
-						// Recognition error - abort rule
-						goto errorExit
-					}
-				}
-				{
-					p.SetState(282)
-					p.expr(3)
-				}
+func (s *SubqueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SLQVisitor:
+		return t.VisitSubquery(s)
 
-			case antlr.ATNInvalidAltNumber:
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SLQParser) Subquery() (localctx ISubqueryContext) {
+	localctx = NewSubqueryContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SLQParserRULE_subquery)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(402)
+		p.Match(SLQParserLPAR)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(403)
+		p.Segment()
+	}
+	p.SetState(406)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == SLQParserPIPE {
+		{
+			p.SetState(404)
+			p.Match(SLQParserPIPE)
+			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
-
 		}
-		p.SetState(287)
+		{
+			p.SetState(405)
+			p.Segment()
+		}
+
+		p.SetState(408)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext())
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(410)
+		p.Match(SLQParserRPAR)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
@@ -5289,7 +7401,7 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.UnrollRecursionContexts(_parentctx)
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
@@ -5396,15 +7508,15 @@ func (s *LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SLQParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SLQParserRULE_literal)
+	p.EnterRule(localctx, 72, SLQParserRULE_literal)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(288)
+		p.SetState(412)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-41)) & ^0x3f) == 0 && ((int64(1)<<(_la-41))&8400899) != 0) {
+		if !((int64((_la-72)) & ^0x3f) == 0 && ((int64(1)<<(_la-72))&8400899) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5498,15 +7610,15 @@ func (s *UnaryOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SLQParser) UnaryOperator() (localctx IUnaryOperatorContext) {
 	localctx = NewUnaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SLQParserRULE_unaryOperator)
+	p.EnterRule(localctx, 74, SLQParserRULE_unaryOperator)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(290)
+		p.SetState(414)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&6448742400) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&6918373452571213824) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5529,7 +7641,7 @@ errorExit:
 
 func (p *SLQParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 25:
+	case 31:
 		var t *ExprContext = nil
 		if localctx != nil {
 			t = localctx.(*ExprContext)
@@ -5544,24 +7656,30 @@ func (p *SLQParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int
 func (p *SLQParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 8)
+		return p.Precpred(p.GetParserRuleContext(), 10)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return p.Precpred(p.GetParserRuleContext(), 9)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 6)
+		return p.Precpred(p.GetParserRuleContext(), 8)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+		return p.Precpred(p.GetParserRuleContext(), 7)
 
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 6)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+		return p.Precpred(p.GetParserRuleContext(), 5)
 
 	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 7:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 8:
 		return p.Precpred(p.GetParserRuleContext(), 2)
 
 	default:
@@ -10,6 +10,12 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#stmtList.
 	VisitStmtList(ctx *StmtListContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#stmt.
+	VisitStmt(ctx *StmtContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#let.
+	VisitLet(ctx *LetContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#query.
 	VisitQuery(ctx *QueryContext) interface{}
 
@@ -25,6 +31,12 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#func.
 	VisitFunc(ctx *FuncContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#over.
+	VisitOver(ctx *OverContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#partitionBy.
+	VisitPartitionBy(ctx *PartitionByContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#funcName.
 	VisitFuncName(ctx *FuncNameContext) interface{}
 
@@ -34,6 +46,9 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#joinTable.
 	VisitJoinTable(ctx *JoinTableContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#setOp.
+	VisitSetOp(ctx *SetOpContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#uniqueFunc.
 	VisitUniqueFunc(ctx *UniqueFuncContext) interface{}
 
@@ -67,6 +82,9 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#alias.
 	VisitAlias(ctx *AliasContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#aliasKeyword.
+	VisitAliasKeyword(ctx *AliasKeywordContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#arg.
 	VisitArg(ctx *ArgContext) interface{}
 
@@ -85,6 +103,18 @@ type SLQVisitor interface {
 	// Visit a parse tree produced by SLQParser#expr.
 	VisitExpr(ctx *ExprContext) interface{}
 
+	// Visit a parse tree produced by SLQParser#inOperator.
+	VisitInOperator(ctx *InOperatorContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#nullOperator.
+	VisitNullOperator(ctx *NullOperatorContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#exprList.
+	VisitExprList(ctx *ExprListContext) interface{}
+
+	// Visit a parse tree produced by SLQParser#subquery.
+	VisitSubquery(ctx *SubqueryContext) interface{}
+
 	// Visit a parse tree produced by SLQParser#literal.
 	VisitLiteral(ctx *LiteralContext) interface{}
 
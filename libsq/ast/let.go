@@ -0,0 +1,192 @@
+package ast
+
+import (
+	"log/slog"
+
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var _ Node = (*LetNode)(nil)
+
+// LetNode models a let statement, which names the result of a query (a
+// common table expression) for use by the statements that follow it:
+//
+//	let big = .payment | where(.amount > 10); .big | .customer_id, .amount
+//
+// A table selector that doesn't specify a handle, and whose name is that
+// of a preceding let, references the let instead of a table of the source.
+// See TblSelectorNode.Let.
+//
+// The LetNode instances are attached to the AST of the final query (see
+// AST.Lets), but they are not its children. As with SubqueryNode, the
+// let's query is a complete, independent AST.
+type LetNode struct {
+	baseNode
+	name  string
+	query *AST
+}
+
+// Name returns the let's name, e.g. "big".
+func (n *LetNode) Name() string {
+	return n.name
+}
+
+// Query returns the AST of the let's query.
+func (n *LetNode) Query() *AST {
+	return n.query
+}
+
+// String returns a log/debug-friendly representation.
+func (n *LetNode) String() string {
+	return nodeString(n)
+}
+
+// VisitLet implements slq.SLQVisitor. Let statements are built by
+// buildStmtList, and not via the visitor.
+func (v *parseTreeVisitor) VisitLet(_ *slq.LetContext) any {
+	return nil
+}
+
+// VisitStmt implements slq.SLQVisitor. As with VisitLet, statements are
+// built by buildStmtList.
+func (v *parseTreeVisitor) VisitStmt(_ *slq.StmtContext) any {
+	return nil
+}
+
+// buildStmtList constructs the AST of the final query of stmts, with the
+// preceding let statements attached. Each statement other than the final
+// query must be a let.
+func buildStmtList(log *slog.Logger, stmts *slq.StmtListContext) (*AST, error) {
+	var (
+		all  = stmts.AllStmt()
+		lets []*LetNode
+	)
+
+	for i, stmt := range all {
+		stmtCtx, ok := stmt.(*slq.StmtContext)
+		if !ok {
+			return nil, errorf("unable to convert %T to *parser.StmtContext", stmt)
+		}
+		last := i == len(all)-1
+
+		if stmtCtx.Let() == nil {
+			if !last {
+				return nil, errorf("only the final statement can be a query: each preceding statement "+
+					"must be a let, but got: %s", stmtCtx.GetText())
+			}
+
+			a, err := buildAST(log, stmtCtx.Query())
+			if err != nil {
+				return nil, err
+			}
+			if err = resolveLets(a, lets); err != nil {
+				return nil, err
+			}
+			a.lets = lets
+			return a, nil
+		}
+
+		letCtx, ok := stmtCtx.Let().(*slq.LetContext)
+		if !ok {
+			return nil, errorf("unable to convert %T to *parser.LetContext", stmtCtx.Let())
+		}
+		if last {
+			return nil, errorf("let %s must be followed by a query", letCtx.ID().GetText())
+		}
+
+		let, err := buildLet(log, letCtx, lets)
+		if err != nil {
+			return nil, err
+		}
+		lets = append(lets, let)
+	}
+
+	return nil, errorf("no query")
+}
+
+// buildLet builds the LetNode for ctx. The lets preceding ctx may be
+// referenced by its query.
+func buildLet(log *slog.Logger, ctx *slq.LetContext, prev []*LetNode) (*LetNode, error) {
+	node := &LetNode{name: ctx.ID().GetText()}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	for _, let := range prev {
+		if let.name == node.name {
+			return nil, errorf("duplicate let name: %s", node.name)
+		}
+	}
+
+	query, err := buildAST(log, ctx.Query())
+	if err != nil {
+		return nil, err
+	}
+	if err = verify(query); err != nil {
+		return nil, err
+	}
+	if err = resolveLets(query, prev); err != nil {
+		return nil, err
+	}
+	node.query = query
+
+	return node, nil
+}
+
+// resolveLets sets the let of each table selector of a, and of the
+// queries nested in a, that references one of lets. A table selector
+// references a let if its table name is the let's name, and it doesn't
+// specify a catalog, schema, or handle. A handle in the preceding
+// segment, as in "@sakila | .big", doesn't count: but "@sakila.big"
+// references table "big" of @sakila.
+func resolveLets(a *AST, lets []*LetNode) error {
+	if len(lets) == 0 {
+		return nil
+	}
+
+	insp := NewInspector(a)
+	for _, node := range insp.FindNodes(typeTblSelectorNode) {
+		tbl, ok := node.(*TblSelectorNode)
+		if !ok {
+			return errorf("expected %T but got %T", tbl, node)
+		}
+		if tbl.tbl.Catalog != "" || tbl.tbl.Schema != "" {
+			continue
+		}
+		if tbl.handle != "" && !followsHandle(tbl) {
+			continue
+		}
+
+		for _, let := range lets {
+			if let.name == tbl.tbl.Table {
+				tbl.let = let
+				tbl.handle = ""
+				break
+			}
+		}
+	}
+
+	for _, node := range insp.FindNodes(typeSubqueryNode) {
+		if err := resolveLets(node.(*SubqueryNode).Query(), lets); err != nil {
+			return err
+		}
+	}
+	for _, node := range insp.FindNodes(typeSetOpNode) {
+		if err := resolveLets(node.(*SetOpNode).Query(), lets); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// followsHandle returns true if tbl is in the segment that follows a
+// handle segment, e.g. ".actor" in "@sakila | .actor".
+func followsHandle(tbl *TblSelectorNode) bool {
+	seg, ok := tbl.Parent().(*SegmentNode)
+	if !ok || seg.SegIndex() == 0 {
+		return false
+	}
+
+	prevType, err := seg.Prev().ChildType()
+	return err == nil && prevType == typeHandleNode
+}
@@ -450,6 +450,7 @@ func NodePrevSegmentChild[T Node](node Node) (T, error) {
 var (
 	typeAST                = reflect.TypeFor[*AST]()
 	typeColSelectorNode    = reflect.TypeFor[*ColSelectorNode]()
+	typeExprElementNode    = reflect.TypeFor[*ExprElementNode]()
 	typeExprNode           = reflect.TypeFor[*ExprNode]()
 	typeFuncNode           = reflect.TypeFor[*FuncNode]()
 	typeGroupByNode        = reflect.TypeFor[*GroupByNode]()
@@ -459,10 +460,13 @@ var (
 	typeNode               = reflect.TypeFor[Node]()
 	_                      = reflect.TypeFor[*OperatorNode]()
 	typeOrderByNode        = reflect.TypeFor[*OrderByNode]()
+	typeOrderByTermNode    = reflect.TypeFor[*OrderByTermNode]()
 	typeRowRangeNode       = reflect.TypeFor[*RowRangeNode]()
 	typeSegmentNode        = reflect.TypeFor[*SegmentNode]()
 	_                      = reflect.TypeFor[Selector]()
+	typeSetOpNode          = reflect.TypeFor[*SetOpNode]()
 	typeSelectorNode       = reflect.TypeFor[*SelectorNode]()
+	typeSubqueryNode       = reflect.TypeFor[*SubqueryNode]()
 	typeTblColSelectorNode = reflect.TypeFor[*TblColSelectorNode]()
 	typeTblSelectorNode    = reflect.TypeFor[*TblSelectorNode]()
 	typeUniqueNode         = reflect.TypeFor[*UniqueNode]()
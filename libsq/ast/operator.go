@@ -1,5 +1,18 @@
 package ast
 
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+// Operator text values for the null predicates. The text of the
+// OperatorNode produced for a nullOperator is normalized to one of these
+// values, regardless of the whitespace in the input. Unlike the other
+// operators, these are postfix: they have no right-hand operand.
+const (
+	OpIsNull    = "is null"
+	OpIsNotNull = "is not null"
+)
+
 // OperatorNode is a leaf node in an expression representing an operator such as ">" or "==".
 type OperatorNode struct {
 	baseNode
@@ -10,6 +23,15 @@ func (n *OperatorNode) String() string {
 	return nodeString(n)
 }
 
+// Text implements ast.Node. It returns the normalized operator text,
+// which may differ from the raw input text, e.g. "not in" vs "notin".
+func (n *OperatorNode) Text() string {
+	if n.text != "" {
+		return n.text
+	}
+	return n.baseNode.Text()
+}
+
 // isOperator returns true if the supplied string is a recognized operator, e.g. "!=" or ">".
 func isOperator(text string) bool {
 	switch text {
@@ -19,3 +41,24 @@ func isOperator(text string) bool {
 		return false
 	}
 }
+
+// IsPostfixOperator returns true if op is a postfix operator, i.e. one
+// that has no right-hand operand, such as "is null".
+func IsPostfixOperator(op string) bool {
+	return op == OpIsNull || op == OpIsNotNull
+}
+
+// VisitNullOperator implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitNullOperator(ctx *slq.NullOperatorContext) any {
+	op := &OperatorNode{}
+	op.ctx = ctx
+	op.text = OpIsNull
+	if ctx.GetChildCount() == 3 {
+		op.text = OpIsNotNull
+	}
+
+	if err := op.SetParent(v.cur); err != nil {
+		return err
+	}
+	return v.cur.AddChild(op)
+}
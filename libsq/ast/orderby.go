@@ -75,18 +75,19 @@ type OrderByTermNode struct {
 	baseNode
 }
 
-// AddChild accepts a single child of type *SelectorNode.
+// AddChild accepts a single child of type *SelectorNode or *FuncNode.
 func (n *OrderByTermNode) AddChild(child Node) error {
 	if len(n.children) > 0 {
 		return errorf("%T is only allowed a single child", n)
 	}
 
-	selNode, ok := child.(*SelectorNode)
-	if !ok {
+	switch child.(type) {
+	case *SelectorNode, *FuncNode:
+	default:
 		return errorf("illegal %T child type %T: %s", n, child, child)
 	}
 
-	n.addChild(selNode)
+	n.addChild(child)
 	return child.SetParent(n)
 }
 
@@ -96,7 +97,9 @@ func (n *OrderByTermNode) SetChildren(children []Node) error {
 	case 0:
 		// fallthrough
 	case 1:
-		if _, ok := children[0].(Selector); !ok {
+		switch children[0].(type) {
+		case Selector, *FuncNode:
+		default:
 			return errorf("illegal child type %T {%s} for %T", children[0], children[0], n)
 		}
 	default:
@@ -108,7 +111,9 @@ func (n *OrderByTermNode) SetChildren(children []Node) error {
 	return nil
 }
 
-// Selector returns the ordering term's selector.
+// Selector returns the ordering term's selector. If the term is
+// a function, e.g. "order_by(case(...))", the returned node is
+// of type *FuncNode.
 func (n *OrderByTermNode) Selector() Node {
 	return n.children[0]
 }
@@ -158,6 +163,15 @@ func (v *parseTreeVisitor) VisitOrderByTerm(ctx *slq.OrderByTermContext) any {
 		node.direction = OrderByDirectionDesc
 	}
 
+	if fnCtx, ok := ctx.Func_().(*slq.FuncContext); ok && fnCtx != nil {
+		if err := v.using(node, func() any {
+			return v.VisitFunc(fnCtx)
+		}); err != nil {
+			return err
+		}
+		return v.cur.AddChild(node)
+	}
+
 	selNode, err := newSelectorNode(node, ctx.Selector())
 	if err != nil {
 		return nil
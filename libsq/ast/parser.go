@@ -15,7 +15,7 @@ import (
 
 // parseSLQ processes SLQ input text and returns a parse tree. It
 // executes both lexer and parser phases.
-func parseSLQ(log *slog.Logger, input string) (*slq.QueryContext, error) {
+func parseSLQ(log *slog.Logger, input string) (*slq.StmtListContext, error) {
 	lex := slq.NewSLQLexer(antlr.NewInputStream(input))
 	lex.RemoveErrorListeners() // the generated lexer has default listeners we don't want
 	lexErrs := &antlrErrorListener{name: "lexer", log: log, input: input}
@@ -26,7 +26,7 @@ func parseSLQ(log *slog.Logger, input string) (*slq.QueryContext, error) {
 	parseErrs := &antlrErrorListener{name: "parser", log: log, input: input}
 	p.AddErrorListener(parseErrs)
 
-	qCtx := p.Query()
+	stmts := p.StmtList()
 	lexErrs.logDiagnostics()
 	parseErrs.logDiagnostics()
 	if err := lexErrs.error(); err != nil {
@@ -36,7 +36,7 @@ func parseSLQ(log *slog.Logger, input string) (*slq.QueryContext, error) {
 		return nil, errz.Err(err)
 	}
 
-	return qCtx.(*slq.QueryContext), nil
+	return stmts.(*slq.StmtListContext), nil
 }
 
 var _ antlr.ErrorListener = (*antlrErrorListener)(nil)
@@ -248,6 +248,10 @@ func (v *parseTreeVisitor) Visit(ctx antlr.ParseTree) any {
 		return v.VisitAlias(ctx)
 	case *slq.JoinTableContext:
 		return v.VisitJoinTable(ctx)
+	case *slq.SubqueryContext:
+		return v.VisitSubquery(ctx)
+	case *slq.SetOpContext:
+		return v.VisitSetOp(ctx)
 	case *slq.RowRangeContext:
 		return v.VisitRowRange(ctx)
 	case *slq.ExprElementContext:
@@ -278,6 +282,16 @@ func (v *parseTreeVisitor) Visit(ctx antlr.ParseTree) any {
 		return v.VisitWhere(ctx)
 	case *slq.ArgContext:
 		return v.VisitArg(ctx)
+	case *slq.InOperatorContext:
+		return v.VisitInOperator(ctx)
+	case *slq.NullOperatorContext:
+		return v.VisitNullOperator(ctx)
+	case *slq.ExprListContext:
+		return v.VisitExprList(ctx)
+	case *slq.OverContext:
+		return v.VisitOver(ctx)
+	case *slq.PartitionByContext:
+		return v.VisitPartitionBy(ctx)
 	}
 
 	// should never be reached
@@ -302,12 +316,18 @@ func (v *parseTreeVisitor) VisitChildren(ctx antlr.RuleNode) any {
 
 // VisitQuery implements slq.SLQVisitor.
 func (v *parseTreeVisitor) VisitQuery(ctx *slq.QueryContext) any {
+	return v.visitSegments(ctx, ctx.AllSegment())
+}
+
+// visitSegments builds v.ast from segs. The ctx arg is the parse tree node
+// that contains segs, e.g. *slq.QueryContext or *slq.SubqueryContext.
+func (v *parseTreeVisitor) visitSegments(ctx antlr.ParserRuleContext, segs []slq.ISegmentContext) any {
 	v.ast = &AST{}
 	v.ast.ctx = ctx
 	v.ast.text = ctx.GetText()
 	v.cur = v.ast
 
-	for _, seg := range ctx.AllSegment() {
+	for _, seg := range segs {
 		err := v.VisitSegment(seg.(*slq.SegmentContext))
 		if err != nil {
 			return err
@@ -322,9 +342,10 @@ func (v *parseTreeVisitor) VisitElement(ctx *slq.ElementContext) any {
 	return v.VisitChildren(ctx)
 }
 
-// VisitStmtList implements slq.SLQVisitor.
+// VisitStmtList implements slq.SLQVisitor. The statement list is
+// built by buildStmtList, and not via the visitor.
 func (v *parseTreeVisitor) VisitStmtList(_ *slq.StmtListContext) any {
-	return nil // not using StmtList just yet
+	return nil
 }
 
 // VisitUnaryOperator implements slq.SLQVisitor.
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+	"github.com/neilotoole/sq/libsq/core/kind"
 	"github.com/neilotoole/sq/libsq/core/lg/lgt"
 	"github.com/neilotoole/sq/testh/tu"
 )
@@ -79,6 +80,27 @@ func TestParseBuild(t *testing.T) {
 		{"select1", `@mydb1 | .user | .uid, .username`},
 		{"tbl datasource", `@mydb1.user | .uid, .username`},
 		{"count1", `@mydb1.user | count`},
+		{"in1", `@mydb1 | .user | where(.uid in (1, 2, 3))`},
+		{"not_in1", `@mydb1 | .user | where(.username not in ("alice", $name))`},
+		{"case1", `@mydb1 | .user | .uid, case(.uid < 10, "low", "high"):bucket`},
+		{"orderby_case1", `@mydb1 | .user | order_by(case(.uid == 1, 0, 1)-, .uid)`},
+		{"window_rank", `@mydb1 | .user | .uid, rank() over(order_by(.username-)):rnk`},
+		{"window_sum", `@mydb1 | .payment | sum(.amount) over(partition_by(.uid), order_by(.date))`},
+		{"window_count", `@mydb1 | .user | count() over(partition_by(.country)):n | order_by(.uid)`},
+		{"union1", `@mydb1 | .user | .uid | union(@mydb2.user | .uid)`},
+		{"union_all1", `@mydb1 | .user | union_all(.user | where(.uid > 10)) | order_by(.uid)`},
+		{"intersect_except1", `@mydb1 | .user | intersect(.admin) | except(@mydb2.banned)`},
+		{"subquery_in1", `@mydb1 | .user | where(.uid in (.admin | .uid))`},
+		{"subquery_scalar1", `@mydb1 | .user:u | where(.age > (.user | where(.dept == .u.dept) | avg(.age)))`},
+		{"derived_table1", `@mydb1 | (.user | group_by(.dept) | .dept, count):t | where(.count > 1)`},
+		{"datetime1", `@mydb1 | .payment | date_trunc("month", .ts):month, count | group_by(date_trunc("month", .ts))`},
+		{"datetime2", `@mydb1 | .payment | where(date_part("dow", .ts) == 0 && .ts > date_add(now(), -7, "day"))`},
+		{"string1", `@mydb1 | .user | where(length(trim(.name)) > 3) | concat(upper(.first), " ", lower(.last)):name`},
+		{"string2", `@mydb1 | .user | substr(.name, 1, 3), substr(.name, 2), replace(.name, "a", "b")`},
+		{"cast1", `@mydb1 | .user | where(cast(.uid, text) == "1") | cast(.age, float):age`},
+		{"let1", `let big = @mydb1.payment | where(.amount > 10); .big | .customer_id, .amount`},
+		{"null1", `@mydb1 | .user | where(.email is null && .name is not null) | coalesce(.nick, .name, "x"):nick`},
+		{"null2", `@mydb1 | .user | .uid, .total / nullif(.count, 0)`},
 	}
 
 	for i, tc := range testCases {
@@ -115,3 +137,241 @@ func TestInspector_FindWhereClauses(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, whereNodes, 1)
 }
+
+func TestParseInOperator(t *testing.T) {
+	testCases := []struct {
+		in         string
+		wantOp     string
+		wantLength int
+	}{
+		{in: `@my1 | .actor | where(.uid in (1))`, wantOp: OpIn, wantLength: 1},
+		{in: `@my1 | .actor | where(.uid in (1, 2, 3))`, wantOp: OpIn, wantLength: 3},
+		{in: `@my1 | .actor | where(.uid not in (1, 2))`, wantOp: OpNotIn, wantLength: 2},
+		{in: `@my1 | .actor | where(.uid   not   in ($a, "b"))`, wantOp: OpNotIn, wantLength: 2},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tu.Name(i, tc.in), func(t *testing.T) {
+			a := mustParse(t, tc.in)
+
+			list := FindFirstNode[*ExprListNode](a)
+			require.NotNil(t, list)
+			require.Len(t, list.Children(), tc.wantLength)
+
+			op, ok := NodePrevSibling(list).(*OperatorNode)
+			require.True(t, ok)
+			require.Equal(t, tc.wantOp, op.Text())
+		})
+	}
+}
+
+func TestParseNullOperator(t *testing.T) {
+	testCases := []struct {
+		in     string
+		wantOp string
+	}{
+		{in: `@my1 | .actor | where(.uid is null)`, wantOp: OpIsNull},
+		{in: `@my1 | .actor | where(.uid is not null)`, wantOp: OpIsNotNull},
+		{in: `@my1 | .actor | where(.uid   is   not   null)`, wantOp: OpIsNotNull},
+		{in: `@my1 | .actor | where(.uid is null && .name == "a")`, wantOp: OpIsNull},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tu.Name(i, tc.in), func(t *testing.T) {
+			a := mustParse(t, tc.in)
+
+			op := FindFirstNode[*OperatorNode](a)
+			require.NotNil(t, op)
+			require.Equal(t, tc.wantOp, op.Text())
+			require.True(t, IsPostfixOperator(op.Text()))
+			require.Nil(t, NodeNextSibling(op))
+		})
+	}
+}
+
+func TestParseWindow(t *testing.T) {
+	const input = `@my1 | .payment | sum(.amount) over(partition_by(.customer_id, .staff_id), order_by(.payment_date-)) | order_by(.payment_id)`
+	a := mustParse(t, input)
+
+	fn := FindFirstNode[*FuncNode](a)
+	require.NotNil(t, fn)
+	require.Len(t, fn.Args(), 1)
+
+	w := fn.Window()
+	require.NotNil(t, w)
+	require.Len(t, w.PartitionBy(), 2)
+	require.IsType(t, (*ColSelectorNode)(nil), w.PartitionBy()[0])
+	require.Len(t, w.OrderBy(), 1)
+	require.Equal(t, OrderByDirectionDesc, w.OrderBy()[0].Direction())
+
+	// The window's order_by must not be confused with the query's.
+	ob, err := NewInspector(a).FindOrderByNode()
+	require.NoError(t, err)
+	require.NotNil(t, ob)
+	require.Len(t, ob.Terms(), 1)
+}
+
+func TestParseSubquery(t *testing.T) {
+	const input = `@my1 | .actor | where(.actor_id in (.film_actor | where(.film_id == 1) | .actor_id)) | .first_name`
+	a := mustParse(t, input)
+
+	subs, err := NewInspector(a).FindSubqueries()
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.True(t, subs[0].IsInOperand())
+	require.False(t, subs[0].IsDerivedTable())
+	require.Nil(t, NewInspector(a).FindDerivedTable())
+
+	// The subquery is an independent AST: its nodes must not be found
+	// when inspecting the enclosing AST.
+	subInsp := NewInspector(subs[0].Query())
+	require.Equal(t, "film_actor", subInsp.FindFirstTableSelector().Table().Table)
+	whereClauses, err := subInsp.FindWhereClauses()
+	require.NoError(t, err)
+	require.Len(t, whereClauses, 1)
+	require.Equal(t, "actor", NewInspector(a).FindFirstTableSelector().Table().Table)
+}
+
+func TestParseCast(t *testing.T) {
+	testCases := []struct {
+		in       string
+		wantKind kind.Kind
+		wantErr  bool
+	}{
+		{in: `@my1 | .actor | cast(.actor_id, text)`, wantKind: kind.Text},
+		{in: `@my1 | .payment | cast(.amount * 100, int):cents`, wantKind: kind.Int},
+		{in: `@my1 | .payment | where(cast(.payment_date, date) == "2005-05-25")`, wantKind: kind.Date},
+		{in: `@my1 | .actor | cast(.actor_id, huge)`, wantErr: true},
+		{in: `@my1 | .actor | cast(.actor_id, null)`, wantErr: true},
+		{in: `@my1 | .actor | cast(.actor_id)`, wantErr: true},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tu.Name(i, tc.in), func(t *testing.T) {
+			a, err := Parse(lgt.New(t), tc.in)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			fn := FindFirstNode[*FuncNode](a)
+			require.NotNil(t, fn)
+			require.Equal(t, FuncNameCast, fn.FuncName())
+			require.Equal(t, tc.wantKind, fn.CastKind())
+			require.Len(t, fn.Args(), 1)
+		})
+	}
+}
+
+func TestParseDerivedTable(t *testing.T) {
+	testCases := []struct {
+		in        string
+		wantAlias string
+		wantErr   bool
+	}{
+		{in: `(.payment | .customer_id, .amount):t | where(.amount > 10)`, wantAlias: "t"},
+		{in: `@my1 | (.payment | .customer_id, .amount):p | .p.customer_id`, wantAlias: "p"},
+		{in: `@my1 | (.payment | .customer_id, .amount)`, wantErr: true},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tu.Name(i, tc.in), func(t *testing.T) {
+			a, err := Parse(lgt.New(t), tc.in)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			insp := NewInspector(a)
+			sub := insp.FindDerivedTable()
+			require.NotNil(t, sub)
+			require.True(t, sub.IsDerivedTable())
+			require.Equal(t, tc.wantAlias, sub.Alias())
+			require.Nil(t, insp.FindFirstTableSelector())
+
+			subs, err := insp.FindSubqueries()
+			require.NoError(t, err)
+			require.Empty(t, subs)
+		})
+	}
+}
+
+func TestParseSetOp(t *testing.T) {
+	const input = `@my1 | .actor | .first_name | union_all(@my2.actor | where(.actor_id > 10) | .first_name) | except(.actor) | order_by(.first_name)`
+	a := mustParse(t, input)
+
+	setOps, err := NewInspector(a).FindSetOps()
+	require.NoError(t, err)
+	require.Len(t, setOps, 2)
+	require.Equal(t, SetOpUnionAll, setOps[0].Op())
+	require.Equal(t, SetOpExcept, setOps[1].Op())
+
+	// The operand is an independent AST: its nodes must not be found when
+	// inspecting the enclosing AST.
+	opInsp := NewInspector(setOps[0].Query())
+	tbl := opInsp.FindFirstTableSelector()
+	require.NotNil(t, tbl)
+	require.Equal(t, "@my2", tbl.Handle())
+	whereClauses, err := opInsp.FindWhereClauses()
+	require.NoError(t, err)
+	require.Len(t, whereClauses, 1)
+
+	whereClauses, err = NewInspector(a).FindWhereClauses()
+	require.NoError(t, err)
+	require.Empty(t, whereClauses)
+	require.Equal(t, "actor", NewInspector(a).FindFirstTableSelector().Table().Table)
+}
+
+func TestParseLet(t *testing.T) {
+	const input = `let big = @my1 | .payment | where(.amount > 10);
+let top = .big | group_by(.customer_id) | .customer_id, count:n;
+@my1 | .top | join(.customer, .top.customer_id == .customer.customer_id) | where(.n > (.big | count))`
+	a := mustParse(t, input)
+
+	lets := a.Lets()
+	require.Len(t, lets, 2)
+	require.Equal(t, "big", lets[0].Name())
+	require.Equal(t, "top", lets[1].Name())
+
+	// The let's query is an independent AST, which can reference the
+	// preceding lets.
+	tbl := NewInspector(lets[0].Query()).FindFirstTableSelector()
+	require.Equal(t, "@my1", tbl.Handle())
+	require.Nil(t, tbl.Let())
+	tbl = NewInspector(lets[1].Query()).FindFirstTableSelector()
+	require.Equal(t, lets[0], tbl.Let())
+
+	// The handle preceding ".top" doesn't prevent it from referencing the
+	// let, but the joined table isn't a let.
+	tbl = NewInspector(a).FindFirstTableSelector()
+	require.Equal(t, lets[1], tbl.Let())
+	require.Empty(t, tbl.Handle())
+	joins, err := NewInspector(a).FindJoins()
+	require.NoError(t, err)
+	require.Len(t, joins, 1)
+	require.Nil(t, joins[0].Table().Let())
+
+	// The subquery references the let.
+	subs, err := NewInspector(a).FindSubqueries()
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, lets[0], NewInspector(subs[0].Query()).FindFirstTableSelector().Let())
+}
+
+func TestParseLet_errors(t *testing.T) {
+	testCases := []string{
+		`let big = @my1 | .payment`,
+		`let big = @my1 | .payment; let big = .big; .big`,
+		`@my1 | .payment; @my1 | .actor`,
+		`let = @my1 | .payment; .payment`,
+	}
+
+	for i, input := range testCases {
+		t.Run(tu.Name(i, input), func(t *testing.T) {
+			_, err := Parse(lgt.New(t), input)
+			require.Error(t, err)
+		})
+	}
+}
@@ -66,7 +66,7 @@ func narrowTblColSel(w *Walker, node Node) error {
 
 	parent := sel.Parent()
 	switch parent := parent.(type) {
-	case *FuncNode, *OrderByTermNode, *GroupByNode, *ExprNode:
+	case *FuncNode, *OrderByTermNode, *GroupByNode, *ExprNode, *ExprListNode, *WindowNode:
 		if sel.name1 == "" {
 			return nil
 		}
@@ -119,7 +119,7 @@ func narrowColSel(w *Walker, node Node) error {
 	parent := sel.Parent()
 
 	switch parent := parent.(type) {
-	case *FuncNode, *OrderByTermNode, *GroupByNode, *ExprNode:
+	case *FuncNode, *OrderByTermNode, *GroupByNode, *ExprNode, *ExprListNode, *WindowNode:
 		colSel, err := newColSelectorNode(sel)
 		if err != nil {
 			return err
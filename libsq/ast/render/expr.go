@@ -19,7 +19,7 @@ func doExpr(rc *Context, expr *ast.ExprNode) (string, error) {
 		sb.WriteRune('(')
 	}
 
-	for _, child := range expr.Children() {
+	for _, child := range nullComparisonOrder(expr.Children()) {
 		switch child := child.(type) {
 		case *ast.TblColSelectorNode, *ast.ColSelectorNode:
 			val, err := renderSelectorNode(rc.Dialect, child)
@@ -63,6 +63,18 @@ func doExpr(rc *Context, expr *ast.ExprNode) (string, error) {
 				return "", err
 			}
 			sb.WriteString(val)
+		case *ast.ExprListNode:
+			val, err := doExprList(rc, child)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(val)
+		case *ast.SubqueryNode:
+			val, err := r.Subquery(rc, child)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(val)
 		default:
 			// REVISIT: Should log a warning here?
 			// Shouldn't happen? Need to investigate.
@@ -76,3 +88,28 @@ func doExpr(rc *Context, expr *ast.ExprNode) (string, error) {
 
 	return sb.String(), nil
 }
+
+// doExprList renders a parenthesized list of expressions, such as
+// the right-hand side of the "in" operator, e.g. "(1, 2, 3)".
+func doExprList(rc *Context, list *ast.ExprListNode) (string, error) {
+	children := list.Children()
+	if len(children) == 0 {
+		return "", errz.Errorf("expression list is empty: %s", list.Text())
+	}
+
+	var sb strings.Builder
+	sb.WriteRune('(')
+	for i, child := range children {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		val, err := RenderFuncArg(rc, child)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(val)
+	}
+	sb.WriteRune(')')
+	return sb.String(), nil
+}
@@ -10,6 +10,24 @@ import (
 
 func doFunction(rc *Context, fn *ast.FuncNode) (string, error) {
 	fnName := strings.ToLower(fn.FuncName())
+	if fn.Window() != nil {
+		if rc.Renderer.Window == nil {
+			return "", errz.Errorf("window function %s() over(): not supported by %s",
+				fnName, rc.Dialect)
+		}
+		return rc.Renderer.Window(rc, fn)
+	}
+
+	if ast.IsWindowOnlyFunc(fnName) {
+		return "", errz.Errorf("%s() is a window function: it must be followed by over(...)", fnName)
+	}
+
+	if fn.IsProprietary() {
+		// A proprietary function, e.g. "_date_trunc", is passed through
+		// verbatim, even if its name matches a portable function.
+		return RenderFuncDefault(rc, fn)
+	}
+
 	if f, ok := rc.Renderer.FunctionOverrides[fnName]; ok {
 		return f(rc, fn)
 	}
@@ -29,7 +47,7 @@ func RenderFuncDefault(rc *Context, fn *ast.FuncNode) (string, error) {
 		// for this dialect.
 		fnName = f
 	}
-	children := fn.Children()
+	children := fn.Args()
 
 	if len(children) == 0 {
 		sb.WriteString(fnName)
@@ -118,3 +136,145 @@ func doFuncRowNum(rc *Context, fn *ast.FuncNode) (string, error) {
 	// is the correct approach, but it seems to work for SQLite and Postgres.
 	return "(row_number() OVER (ORDER BY 1))", nil
 }
+
+// RenderCastDefault renders the cast() function as a SQL CAST expression.
+// The target kind is translated to the DB's native type via
+// Renderer.DBTypeName. For example, for Postgres:
+//
+//	cast(.amount, int)  -->  CAST("amount" AS BIGINT)
+//
+// RenderCastDefault is the default Renderer.FunctionOverrides entry for
+// ast.FuncNameCast; a driver can wrap it to special-case some kinds.
+func RenderCastDefault(rc *Context, fn *ast.FuncNode) (string, error) {
+	args := fn.Args()
+	if len(args) != 1 {
+		return "", errz.Errorf("%s() requires 2 arguments (expr, kind), got %d", fn.FuncName(), len(args)+1)
+	}
+
+	if rc.Renderer.DBTypeName == nil {
+		return "", errz.Errorf("%s() not supported by %s", fn.FuncName(), rc.Dialect)
+	}
+
+	arg, err := RenderFuncArg(rc, args[0])
+	if err != nil {
+		return "", err
+	}
+
+	return "CAST(" + arg + " AS " + rc.Renderer.DBTypeName(fn.CastKind()) + ")", nil
+}
+
+// doFuncCase renders the case() function as a SQL CASE expression. The
+// arguments are (condition, value) pairs, optionally followed by a single
+// "else" value. For example:
+//
+//	case(.length < 60, "short", .length < 120, "medium", "long")
+//
+// renders as:
+//
+//	CASE WHEN "length" < 60 THEN 'short' WHEN "length" < 120 THEN 'medium' ELSE 'long' END
+func doFuncCase(rc *Context, fn *ast.FuncNode) (string, error) {
+	children := fn.Children()
+	if len(children) < 2 {
+		return "", errz.Errorf(
+			"%s() requires at least 2 arguments (condition, value), got %d",
+			fn.FuncName(), len(children),
+		)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("CASE")
+	for i := 0; i+1 < len(children); i += 2 {
+		cond, err := RenderFuncArg(rc, children[i])
+		if err != nil {
+			return "", err
+		}
+		val, err := RenderFuncArg(rc, children[i+1])
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(" WHEN ")
+		sb.WriteString(cond)
+		sb.WriteString(" THEN ")
+		sb.WriteString(val)
+	}
+
+	if len(children)%2 == 1 {
+		// The trailing odd argument is the ELSE value.
+		val, err := RenderFuncArg(rc, children[len(children)-1])
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(" ELSE ")
+		sb.WriteString(val)
+	}
+
+	sb.WriteString(" END")
+	return sb.String(), nil
+}
+
+// RenderWindowDefault is the default Renderer.Window impl. It renders a
+// window function, e.g.
+//
+//	sum("amount") OVER (PARTITION BY "customer_id" ORDER BY "payment_date")
+//
+// The function call itself is rendered by RenderFuncDefault, bypassing
+// Renderer.FunctionOverrides: an override may wrap the call (e.g. in a
+// CAST), and the OVER clause must directly follow the call. It's exported
+// so that a driver's Renderer.Window impl can wrap it, e.g. to first check
+// the server version.
+func RenderWindowDefault(rc *Context, fn *ast.FuncNode) (string, error) {
+	w := fn.Window()
+	if w == nil {
+		return "", errz.Errorf("function %s() has no over() clause", fn.FuncName())
+	}
+
+	call, err := RenderFuncDefault(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString(call)
+	sb.WriteString(" OVER (")
+
+	partition := w.PartitionBy()
+	for i, term := range partition {
+		if i == 0 {
+			sb.WriteString("PARTITION BY ")
+		} else {
+			sb.WriteString(", ")
+		}
+
+		var s string
+		if termFn, ok := term.(*ast.FuncNode); ok {
+			s, err = rc.Renderer.Function(rc, termFn)
+		} else {
+			s, err = renderSelectorNode(rc.Dialect, term)
+		}
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(s)
+	}
+
+	for i, term := range w.OrderBy() {
+		switch {
+		case i > 0:
+			sb.WriteString(", ")
+		case len(partition) > 0:
+			sb.WriteString(" ORDER BY ")
+		default:
+			sb.WriteString("ORDER BY ")
+		}
+
+		s, err := renderOrderByTerm(rc, term)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(s)
+	}
+
+	sb.WriteRune(')')
+	return sb.String(), nil
+}
@@ -0,0 +1,481 @@
+package render
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+)
+
+// This file implements the portable datetime functions: now, date_trunc,
+// date_part and date_add. The default renderings (registered by
+// NewDefaultRenderer) target Postgres and DuckDB. The other dialects spell
+// these functions differently; their renderings are registered by the
+// RegisterDatetimeFuncs* functions, which drivers invoke from Renderer().
+//
+//	now()                              current timestamp
+//	date_trunc("month", .payment_date) truncate to the start of the unit
+//	date_part("dow", .payment_date)    integer field, e.g. day of week
+//	date_add(.payment_date, 7, "day")  add (or subtract) n units
+
+// Datetime units. Not every unit is valid for every function: see
+// truncUnits, partUnits and addUnits.
+const (
+	unitYear    = "year"
+	unitQuarter = "quarter"
+	unitMonth   = "month"
+	unitWeek    = "week"
+	unitDay     = "day"
+	unitHour    = "hour"
+	unitMinute  = "minute"
+	unitSecond  = "second"
+
+	// unitDOW is the day of the week, from 0 (Sunday) to 6 (Saturday).
+	unitDOW = "dow"
+
+	// unitDOY is the day of the year, from 1 to 366.
+	unitDOY = "doy"
+)
+
+// truncUnits are the units accepted by date_trunc. A week starts on Monday.
+var truncUnits = []string{
+	unitYear, unitQuarter, unitMonth, unitWeek, unitDay, unitHour, unitMinute, unitSecond,
+}
+
+// partUnits are the units accepted by date_part.
+var partUnits = []string{
+	unitYear, unitQuarter, unitMonth, unitDay, unitHour, unitMinute, unitSecond, unitDOW, unitDOY,
+}
+
+// addUnits are the units accepted by date_add.
+var addUnits = []string{
+	unitYear, unitMonth, unitWeek, unitDay, unitHour, unitMinute, unitSecond,
+}
+
+// datetimeArgs validates the arguments of a datetime function. The arg at
+// unitIndex must be a string literal naming one of units. It returns the
+// lowercase unit, and the rendered SQL of the remaining args, in order.
+func datetimeArgs(rc *Context, fn *ast.FuncNode, nArgs, unitIndex int, units []string,
+) (unit string, args []string, err error) {
+	children := fn.Args()
+	if len(children) != nArgs {
+		return "", nil, errz.Errorf("%s() requires exactly %d arguments, got %d",
+			fn.FuncName(), nArgs, len(children))
+	}
+
+	for i, child := range children {
+		if i != unitIndex {
+			var arg string
+			if arg, err = RenderFuncArg(rc, child); err != nil {
+				return "", nil, err
+			}
+			if expr, ok := child.(*ast.ExprNode); ok && len(expr.Children()) > 1 {
+				// Parenthesize an expression operand, e.g. ".x + 1", so that
+				// it binds correctly within the rendered SQL.
+				arg = "(" + arg + ")"
+			}
+			args = append(args, arg)
+			continue
+		}
+
+		lit, ok := unwrapExpr(child).(*ast.LiteralNode)
+		if !ok || lit.LiteralType() != ast.LiteralString {
+			return "", nil, errz.Errorf("%s(): unit must be a string literal, e.g. %q: got %s",
+				fn.FuncName(), units[0], child.Text())
+		}
+
+		if unit, _, err = unquoteLiteral(lit.Text()); err != nil {
+			return "", nil, err
+		}
+		unit = strings.ToLower(unit)
+		if !slices.Contains(units, unit) {
+			return "", nil, errz.Errorf("%s(): invalid unit %q: must be one of: %s",
+				fn.FuncName(), unit, strings.Join(units, ", "))
+		}
+	}
+
+	return unit, args, nil
+}
+
+// truncArgs returns the unit and the rendered datetime operand of
+// date_trunc(unit, x).
+func truncArgs(rc *Context, fn *ast.FuncNode) (unit, x string, err error) {
+	unit, args, err := datetimeArgs(rc, fn, 2, 0, truncUnits)
+	if err != nil {
+		return "", "", err
+	}
+	return unit, args[0], nil
+}
+
+// partArgs returns the unit and the rendered datetime operand of
+// date_part(unit, x).
+func partArgs(rc *Context, fn *ast.FuncNode) (unit, x string, err error) {
+	unit, args, err := datetimeArgs(rc, fn, 2, 0, partUnits)
+	if err != nil {
+		return "", "", err
+	}
+	return unit, args[0], nil
+}
+
+// addArgs returns the unit, and the rendered datetime and amount operands
+// of date_add(x, n, unit).
+func addArgs(rc *Context, fn *ast.FuncNode) (unit, x, n string, err error) {
+	unit, args, err := datetimeArgs(rc, fn, 3, 2, addUnits)
+	if err != nil {
+		return "", "", "", err
+	}
+	return unit, args[0], args[1], nil
+}
+
+// funcOverrideNow returns a now() renderer that renders sql.
+func funcOverrideNow(sql string) func(rc *Context, fn *ast.FuncNode) (string, error) {
+	return func(_ *Context, fn *ast.FuncNode) (string, error) {
+		if len(fn.Args()) != 0 {
+			return "", errz.Errorf("%s() takes no arguments, got %d", fn.FuncName(), len(fn.Args()))
+		}
+		return sql, nil
+	}
+}
+
+// doFuncDateTrunc renders date_trunc(unit, x) as date_trunc('unit', x).
+func doFuncDateTrunc(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := truncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	return "date_trunc('" + unit + "', " + x + ")", nil
+}
+
+// doFuncDatePart renders date_part(unit, x) as an integer EXTRACT. The
+// seconds field can have a fractional part, so it's truncated.
+func doFuncDatePart(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := partArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	extract := "EXTRACT(" + strings.ToUpper(unit) + " FROM " + x + ")"
+	if unit == unitSecond {
+		extract = "FLOOR(" + extract + ")"
+	}
+	return "CAST(" + extract + " AS BIGINT)", nil
+}
+
+// doFuncDateAdd renders date_add(x, n, unit) as interval arithmetic.
+func doFuncDateAdd(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, n, err := addArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	return "(" + x + " + INTERVAL '1 " + unit + "' * " + n + ")", nil
+}
+
+// RegisterDatetimeFuncsSQLite registers the SQLite renderings of the
+// datetime functions on r. SQLite has no datetime type: datetime values
+// are text, manipulated via the date and time functions and their
+// modifiers. Thus the result kinds are pinned (see
+// Renderer.FunctionResultKinds).
+func RegisterDatetimeFuncsSQLite(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameNow] = funcOverrideNow("datetime('now')")
+	r.FunctionOverrides[ast.FuncNameDateTrunc] = doFuncDateTruncSQLite
+	r.FunctionOverrides[ast.FuncNameDatePart] = doFuncDatePartSQLite
+	r.FunctionOverrides[ast.FuncNameDateAdd] = doFuncDateAddSQLite
+	r.FunctionResultKinds[ast.FuncNameNow] = kind.Datetime
+	r.FunctionResultKinds[ast.FuncNameDateTrunc] = kind.Datetime
+	r.FunctionResultKinds[ast.FuncNameDatePart] = kind.Int
+	r.FunctionResultKinds[ast.FuncNameDateAdd] = kind.Datetime
+}
+
+func doFuncDateTruncSQLite(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := truncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	switch unit {
+	case unitYear, unitMonth, unitDay:
+		return "datetime(" + x + ", 'start of " + unit + "')", nil
+	case unitQuarter:
+		return "datetime(" + x + ", 'start of month', '-' || ((CAST(strftime('%m', " + x +
+			") AS INTEGER) - 1) % 3) || ' months')", nil
+	case unitWeek:
+		return "datetime(" + x + ", 'start of day', '-' || ((CAST(strftime('%w', " + x +
+			") AS INTEGER) + 6) % 7) || ' days')", nil
+	case unitHour:
+		return "strftime('%Y-%m-%d %H:00:00', " + x + ")", nil
+	case unitMinute:
+		return "strftime('%Y-%m-%d %H:%M:00', " + x + ")", nil
+	default: // unitSecond
+		return "strftime('%Y-%m-%d %H:%M:%S', " + x + ")", nil
+	}
+}
+
+func doFuncDatePartSQLite(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := partArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	if unit == unitQuarter {
+		return "((CAST(strftime('%m', " + x + ") AS INTEGER) + 2) / 3)", nil
+	}
+
+	formats := map[string]string{
+		unitYear:   "%Y",
+		unitMonth:  "%m",
+		unitDay:    "%d",
+		unitHour:   "%H",
+		unitMinute: "%M",
+		unitSecond: "%S",
+		unitDOW:    "%w",
+		unitDOY:    "%j",
+	}
+	return "CAST(strftime('" + formats[unit] + "', " + x + ") AS INTEGER)", nil
+}
+
+func doFuncDateAddSQLite(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, n, err := addArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	if unit == unitWeek {
+		// SQLite has no weeks modifier.
+		return "datetime(" + x + ", (" + n + " * 7) || ' days')", nil
+	}
+	return "datetime(" + x + ", " + n + " || ' " + unit + "s')", nil
+}
+
+// RegisterDatetimeFuncsMySQL registers the MySQL renderings of the datetime
+// functions on r. MySQL has no date_trunc, so truncation is implemented by
+// formatting the value with the lesser fields zeroed.
+func RegisterDatetimeFuncsMySQL(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameDateTrunc] = doFuncDateTruncMySQL
+	r.FunctionOverrides[ast.FuncNameDatePart] = doFuncDatePartMySQL
+	r.FunctionOverrides[ast.FuncNameDateAdd] = doFuncDateAddMySQL
+}
+
+func doFuncDateTruncMySQL(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := truncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	switch unit {
+	case unitQuarter:
+		return "CAST(MAKEDATE(YEAR(" + x + "), 1) + INTERVAL (QUARTER(" + x + ") - 1) QUARTER AS DATETIME)", nil
+	case unitWeek:
+		// WEEKDAY returns 0 for Monday.
+		return "CAST(DATE(" + x + ") - INTERVAL WEEKDAY(" + x + ") DAY AS DATETIME)", nil
+	default:
+	}
+
+	formats := map[string]string{
+		unitYear:   "%Y-01-01 00:00:00",
+		unitMonth:  "%Y-%m-01 00:00:00",
+		unitDay:    "%Y-%m-%d 00:00:00",
+		unitHour:   "%Y-%m-%d %H:00:00",
+		unitMinute: "%Y-%m-%d %H:%i:00",
+		unitSecond: "%Y-%m-%d %H:%i:%s",
+	}
+	return "CAST(DATE_FORMAT(" + x + ", '" + formats[unit] + "') AS DATETIME)", nil
+}
+
+func doFuncDatePartMySQL(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := partArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	switch unit {
+	case unitDOW:
+		// DAYOFWEEK returns 1 for Sunday.
+		return "(DAYOFWEEK(" + x + ") - 1)", nil
+	case unitDOY:
+		return "DAYOFYEAR(" + x + ")", nil
+	default:
+		return "EXTRACT(" + strings.ToUpper(unit) + " FROM " + x + ")", nil
+	}
+}
+
+func doFuncDateAddMySQL(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, n, err := addArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	return "DATE_ADD(" + x + ", INTERVAL " + n + " " + strings.ToUpper(unit) + ")", nil
+}
+
+// RegisterDatetimeFuncsSQLServer registers the SQL Server renderings of the
+// datetime functions on r. DATETRUNC was only added in SQL Server 2022, so
+// truncation uses the DATEADD/DATEDIFF idiom, counting whole units from
+// day zero (1900-01-01, a Monday).
+func RegisterDatetimeFuncsSQLServer(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameDateTrunc] = doFuncDateTruncSQLServer
+	r.FunctionOverrides[ast.FuncNameDatePart] = doFuncDatePartSQLServer
+	r.FunctionOverrides[ast.FuncNameDateAdd] = doFuncDateAddSQLServer
+}
+
+func doFuncDateTruncSQLServer(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := truncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	switch unit {
+	case unitWeek:
+		return "DATEADD(day, DATEDIFF(day, 0, " + x + ") / 7 * 7, 0)", nil
+	case unitSecond:
+		// DATEDIFF(second, 0, x) overflows int, so instead format the
+		// value as "yyyy-mm-dd hh:mi:ss" (style 120), dropping the fraction.
+		return "CONVERT(DATETIME, CONVERT(VARCHAR(19), " + x + ", 120))", nil
+	default:
+		return "DATEADD(" + unit + ", DATEDIFF(" + unit + ", 0, " + x + "), 0)", nil
+	}
+}
+
+func doFuncDatePartSQLServer(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := partArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	switch unit {
+	case unitDOW:
+		// DATEPART(weekday, x) depends on the DATEFIRST setting; adding
+		// @@DATEFIRST normalizes it so that Sunday is 0.
+		return "((DATEPART(weekday, " + x + ") + @@DATEFIRST - 1) % 7)", nil
+	case unitDOY:
+		return "DATEPART(dayofyear, " + x + ")", nil
+	default:
+		return "DATEPART(" + unit + ", " + x + ")", nil
+	}
+}
+
+func doFuncDateAddSQLServer(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, n, err := addArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	return "DATEADD(" + unit + ", " + n + ", " + x + ")", nil
+}
+
+// RegisterDatetimeFuncsOracle registers the Oracle renderings of the
+// datetime functions on r. Oracle reports the TO_NUMBER result of
+// date_part as a bare NUMBER, so its result kind is pinned to kind.Int.
+func RegisterDatetimeFuncsOracle(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameDateTrunc] = doFuncDateTruncOracle
+	r.FunctionOverrides[ast.FuncNameDatePart] = doFuncDatePartOracle
+	r.FunctionOverrides[ast.FuncNameDateAdd] = doFuncDateAddOracle
+	r.FunctionResultKinds[ast.FuncNameDatePart] = kind.Int
+}
+
+func doFuncDateTruncOracle(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := truncArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	if unit == unitSecond {
+		// An Oracle DATE has second precision.
+		return "CAST(" + x + " AS DATE)", nil
+	}
+
+	formats := map[string]string{
+		unitYear:    "YYYY",
+		unitQuarter: "Q",
+		unitMonth:   "MM",
+		unitWeek:    "IW",
+		unitDay:     "DD",
+		unitHour:    "HH24",
+		unitMinute:  "MI",
+	}
+	return "TRUNC(" + x + ", '" + formats[unit] + "')", nil
+}
+
+func doFuncDatePartOracle(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := partArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	if unit == unitDOW {
+		// TO_CHAR(x, 'D') depends on NLS_TERRITORY, so instead the day of
+		// the week is derived from the Julian day number.
+		return "MOD(TO_NUMBER(TO_CHAR(" + x + ", 'J')) + 1, 7)", nil
+	}
+
+	formats := map[string]string{
+		unitYear:    "YYYY",
+		unitQuarter: "Q",
+		unitMonth:   "MM",
+		unitDay:     "DD",
+		unitHour:    "HH24",
+		unitMinute:  "MI",
+		unitSecond:  "SS",
+		unitDOY:     "DDD",
+	}
+	return "TO_NUMBER(TO_CHAR(" + x + ", '" + formats[unit] + "'))", nil
+}
+
+func doFuncDateAddOracle(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, n, err := addArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	switch unit {
+	case unitYear:
+		// ADD_MONTHS clamps to the last day of the month, whereas adding
+		// a year-month interval errors if the day doesn't exist.
+		return "ADD_MONTHS(" + x + ", " + n + " * 12)", nil
+	case unitMonth:
+		return "ADD_MONTHS(" + x + ", " + n + ")", nil
+	case unitWeek:
+		return "(" + x + " + NUMTODSINTERVAL(" + n + " * 7, 'DAY'))", nil
+	default:
+		return "(" + x + " + NUMTODSINTERVAL(" + n + ", '" + strings.ToUpper(unit) + "'))", nil
+	}
+}
+
+// RegisterDatetimeFuncsClickHouse registers the ClickHouse renderings of
+// the datetime functions on r. ClickHouse supports the default
+// date_trunc('unit', x) rendering.
+func RegisterDatetimeFuncsClickHouse(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameNow] = funcOverrideNow("now()")
+	r.FunctionOverrides[ast.FuncNameDatePart] = doFuncDatePartClickHouse
+	r.FunctionOverrides[ast.FuncNameDateAdd] = doFuncDateAddClickHouse
+}
+
+func doFuncDatePartClickHouse(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, err := partArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+
+	if unit == unitDOW {
+		// toDayOfWeek returns 1 for Monday through 7 for Sunday.
+		return "(toDayOfWeek(" + x + ") % 7)", nil
+	}
+
+	funcs := map[string]string{
+		unitYear:    "toYear",
+		unitQuarter: "toQuarter",
+		unitMonth:   "toMonth",
+		unitDay:     "toDayOfMonth",
+		unitHour:    "toHour",
+		unitMinute:  "toMinute",
+		unitSecond:  "toSecond",
+		unitDOY:     "toDayOfYear",
+	}
+	return funcs[unit] + "(" + x + ")", nil
+}
+
+func doFuncDateAddClickHouse(rc *Context, fn *ast.FuncNode) (string, error) {
+	unit, x, n, err := addArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	// For example, "addDays".
+	return "add" + strings.ToUpper(unit[:1]) + unit[1:] + "s(" + x + ", " + n + ")", nil
+}
@@ -0,0 +1,33 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+)
+
+// This file implements the null-handling functions coalesce and nullif.
+// Each of the supported dialects provides same-named SQL functions, so
+// there are no dialect-specific renderings.
+//
+//	coalesce(.address2, .address, "n/a")   the first non-null arg
+//	nullif(.amount, 0)                      null if the args are equal
+
+// doFuncCoalesce renders coalesce(a, b, ...). At least two args are
+// required: SQL Server rejects COALESCE with a single arg.
+func doFuncCoalesce(rc *Context, fn *ast.FuncNode) (string, error) {
+	args, err := funcArgs(rc, fn, [2]int{2, -1})
+	if err != nil {
+		return "", err
+	}
+	return "coalesce(" + strings.Join(args, ", ") + ")", nil
+}
+
+// doFuncNullIf renders nullif(a, b).
+func doFuncNullIf(rc *Context, fn *ast.FuncNode) (string, error) {
+	args, err := funcArgs(rc, fn, [2]int{2, 2})
+	if err != nil {
+		return "", err
+	}
+	return "nullif(" + args[0] + ", " + args[1] + ")", nil
+}
@@ -0,0 +1,194 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// This file implements the portable string functions: lower, upper, trim,
+// substr, replace, length and concat. The default renderings (registered by
+// NewDefaultRenderer) target Postgres and DuckDB, and are the same-named SQL
+// functions. The other dialects spell some of these differently; their
+// renderings are registered by the RegisterStringFuncs* functions, which
+// drivers invoke from Renderer().
+//
+//	lower(.name), upper(.name), trim(.name)
+//	substr(.name, 2), substr(.name, 2, 3)   1-based start, optional length
+//	replace(.name, "a", "b")                replace all occurrences
+//	length(.name)                           length in characters, not bytes
+//	concat(.first_name, " ", .last_name)    NULL args are treated as ""
+
+// stringFuncArity holds the minimum and maximum number of arguments of
+// each string function. A maximum of -1 indicates no upper bound.
+var stringFuncArity = map[string][2]int{
+	ast.FuncNameLower:   {1, 1},
+	ast.FuncNameUpper:   {1, 1},
+	ast.FuncNameTrim:    {1, 1},
+	ast.FuncNameSubstr:  {2, 3},
+	ast.FuncNameReplace: {3, 3},
+	ast.FuncNameLength:  {1, 1},
+	ast.FuncNameConcat:  {2, -1},
+}
+
+// stringArgs validates the number of arguments of the string function fn,
+// and returns the rendered SQL of each arg.
+func stringArgs(rc *Context, fn *ast.FuncNode) ([]string, error) {
+	return funcArgs(rc, fn, stringFuncArity[fn.FuncName()])
+}
+
+// funcArgs validates the number of arguments of fn against arity, which
+// holds the minimum and maximum number of arguments (a maximum of -1
+// indicates no upper bound), and returns the rendered SQL of each arg.
+func funcArgs(rc *Context, fn *ast.FuncNode, arity [2]int) ([]string, error) {
+	children := fn.Args()
+	n := len(children)
+	switch {
+	case arity[1] == -1 && n < arity[0]:
+		return nil, errz.Errorf("%s() requires at least %d arguments, got %d",
+			fn.FuncName(), arity[0], n)
+	case arity[0] == arity[1] && n != arity[0]:
+		return nil, errz.Errorf("%s() requires exactly %d argument(s), got %d",
+			fn.FuncName(), arity[0], n)
+	case arity[1] != -1 && (n < arity[0] || n > arity[1]):
+		return nil, errz.Errorf("%s() requires %d or %d arguments, got %d",
+			fn.FuncName(), arity[0], arity[1], n)
+	}
+
+	args := make([]string, n)
+	for i, child := range children {
+		var err error
+		if args[i], err = RenderFuncArg(rc, child); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+// funcOverrideString returns a string function renderer that renders
+// fn as name(args...), after validating the args.
+func funcOverrideString(name string) func(rc *Context, fn *ast.FuncNode) (string, error) {
+	return func(rc *Context, fn *ast.FuncNode) (string, error) {
+		args, err := stringArgs(rc, fn)
+		if err != nil {
+			return "", err
+		}
+		return name + "(" + strings.Join(args, ", ") + ")", nil
+	}
+}
+
+// doFuncConcat renders concat(a, b, ...) as concat(a, b, ...). Both
+// Postgres and DuckDB ignore NULL args.
+func doFuncConcat(rc *Context, fn *ast.FuncNode) (string, error) {
+	return funcOverrideString("concat")(rc, fn)
+}
+
+// concatCoalesce renders concat(a, b, ...) as (a || b || ...), with each
+// arg wrapped via wrap.
+func concatCoalesce(rc *Context, fn *ast.FuncNode, wrap func(arg string) string) (string, error) {
+	args, err := stringArgs(rc, fn)
+	if err != nil {
+		return "", err
+	}
+	for i, child := range fn.Args() {
+		if expr, ok := child.(*ast.ExprNode); ok && len(expr.Children()) > 1 {
+			// Parenthesize an expression operand, e.g. ".x + 1", so that
+			// it binds correctly with the || operator.
+			args[i] = "(" + args[i] + ")"
+		}
+		args[i] = wrap(args[i])
+	}
+	return "(" + strings.Join(args, " || ") + ")", nil
+}
+
+// RegisterStringFuncsSQLite registers the SQLite renderings of the string
+// functions on r. SQLite's || operator returns NULL if either operand is
+// NULL, and older versions lack concat, so concat coalesces each arg.
+func RegisterStringFuncsSQLite(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameConcat] = func(rc *Context, fn *ast.FuncNode) (string, error) {
+		return concatCoalesce(rc, fn, func(arg string) string {
+			return "coalesce(" + arg + ", '')"
+		})
+	}
+}
+
+// RegisterStringFuncsMySQL registers the MySQL renderings of the string
+// functions on r. MySQL's LENGTH counts bytes, so CHAR_LENGTH is used
+// instead. MySQL's CONCAT returns NULL if any arg is NULL, but CONCAT_WS
+// skips NULL args.
+func RegisterStringFuncsMySQL(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameLength] = funcOverrideString("CHAR_LENGTH")
+	r.FunctionOverrides[ast.FuncNameConcat] = func(rc *Context, fn *ast.FuncNode) (string, error) {
+		args, err := stringArgs(rc, fn)
+		if err != nil {
+			return "", err
+		}
+		return "CONCAT_WS('', " + strings.Join(args, ", ") + ")", nil
+	}
+}
+
+// RegisterStringFuncsSQLServer registers the SQL Server renderings of the
+// string functions on r. TRIM only arrived in SQL Server 2017, so
+// LTRIM(RTRIM(x)) is used instead. LEN ignores trailing spaces, so a
+// sentinel char is appended before counting, and then subtracted. The arg
+// is cast to NVARCHAR(MAX) so that the concatenation is neither truncated
+// (e.g. for a full VARCHAR(8000) value) nor treated as numeric addition.
+func RegisterStringFuncsSQLServer(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameTrim] = func(rc *Context, fn *ast.FuncNode) (string, error) {
+		args, err := stringArgs(rc, fn)
+		if err != nil {
+			return "", err
+		}
+		return "LTRIM(RTRIM(" + args[0] + "))", nil
+	}
+	r.FunctionOverrides[ast.FuncNameLength] = func(rc *Context, fn *ast.FuncNode) (string, error) {
+		args, err := stringArgs(rc, fn)
+		if err != nil {
+			return "", err
+		}
+		return "(LEN(CAST(" + args[0] + " AS NVARCHAR(MAX)) + N'x') - 1)", nil
+	}
+	r.FunctionOverrides[ast.FuncNameSubstr] = func(rc *Context, fn *ast.FuncNode) (string, error) {
+		args, err := stringArgs(rc, fn)
+		if err != nil {
+			return "", err
+		}
+		if len(args) == 2 {
+			// SUBSTRING requires the length arg.
+			args = append(args, "2147483647")
+		}
+		return "SUBSTRING(" + strings.Join(args, ", ") + ")", nil
+	}
+}
+
+// RegisterStringFuncsOracle registers the Oracle renderings of the string
+// functions on r. Oracle's CONCAT takes exactly two args, so the ||
+// operator is used instead; it treats NULL as the empty string.
+func RegisterStringFuncsOracle(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameConcat] = func(rc *Context, fn *ast.FuncNode) (string, error) {
+		return concatCoalesce(rc, fn, func(arg string) string { return arg })
+	}
+}
+
+// RegisterStringFuncsClickHouse registers the ClickHouse renderings of the
+// string functions on r. ClickHouse's lower, upper, length and substring
+// operate on bytes; their UTF8 variants operate on code points. ClickHouse's
+// concat returns NULL if any arg is NULL, and requires string args.
+func RegisterStringFuncsClickHouse(r *Renderer) {
+	r.FunctionOverrides[ast.FuncNameLower] = funcOverrideString("lowerUTF8")
+	r.FunctionOverrides[ast.FuncNameUpper] = funcOverrideString("upperUTF8")
+	r.FunctionOverrides[ast.FuncNameLength] = funcOverrideString("lengthUTF8")
+	r.FunctionOverrides[ast.FuncNameSubstr] = funcOverrideString("substringUTF8")
+	r.FunctionOverrides[ast.FuncNameReplace] = funcOverrideString("replaceAll")
+	r.FunctionOverrides[ast.FuncNameConcat] = func(rc *Context, fn *ast.FuncNode) (string, error) {
+		args, err := stringArgs(rc, fn)
+		if err != nil {
+			return "", err
+		}
+		for i := range args {
+			args[i] = "coalesce(toString(" + args[i] + "), '')"
+		}
+		return "concat(" + strings.Join(args, ", ") + ")", nil
+	}
+}
@@ -16,9 +16,15 @@ func doOperator(rc *Context, op *ast.OperatorNode) (string, error) {
 		val = text
 	}
 
-	rhs := ast.NodeNextSibling(op)
-	if lit, ok := ast.NodeUnwrap[*ast.LiteralNode](rhs); ok && lit.Text() == "null" {
-		switch op.Text() {
+	if ast.IsPostfixOperator(text) {
+		// A postfix operator, e.g. "IS NULL", has no right-hand operand.
+		return " " + val, nil
+	}
+
+	// A comparison with null, e.g. ".x == null" or "null != .x", is
+	// rendered as the null predicate, because "x = NULL" is never true.
+	if isNullLiteral(ast.NodeNextSibling(op)) || isNullLiteral(ast.NodePrevSibling(op)) {
+		switch text {
 		case "==":
 			val = "IS"
 		case "!=":
@@ -34,6 +40,29 @@ func doOperator(rc *Context, op *ast.OperatorNode) (string, error) {
 	return val, nil
 }
 
+// isNullLiteral returns true if node is the null literal, possibly
+// wrapped in an expression.
+func isNullLiteral(node ast.Node) bool {
+	lit, ok := ast.NodeUnwrap[*ast.LiteralNode](node)
+	return ok && lit.Text() == "null"
+}
+
+// nullComparisonOrder returns children, the children of an expression,
+// with the operands swapped if the expression is a comparison whose
+// left-hand operand is null, e.g. "null == .x". SQL's IS predicate
+// requires NULL to be the right-hand operand.
+func nullComparisonOrder(children []ast.Node) []ast.Node {
+	if len(children) != 3 || !isNullLiteral(children[0]) || isNullLiteral(children[2]) {
+		return children
+	}
+
+	op, ok := children[1].(*ast.OperatorNode)
+	if !ok || (op.Text() != "==" && op.Text() != "!=") {
+		return children
+	}
+	return []ast.Node{children[2], op, children[0]}
+}
+
 func operatorHasSpace(op string) bool {
 	switch op {
 	case "-", "+", "*", "/":
@@ -24,20 +24,39 @@ func doOrderBy(rc *Context, ob *ast.OrderByNode) (string, error) {
 			sb.WriteString(", ")
 		}
 
-		sel, err := renderSelectorNode(rc.Dialect, terms[i].Selector())
+		term, err := renderOrderByTerm(rc, terms[i])
 		if err != nil {
 			return "", err
 		}
-
-		sb.WriteString(sel)
-		switch terms[i].Direction() { //nolint:exhaustive
-		case ast.OrderByDirectionAsc:
-			sb.WriteString(" ASC")
-		case ast.OrderByDirectionDesc:
-			sb.WriteString(" DESC")
-		default:
-		}
+		sb.WriteString(term)
 	}
 
 	return sb.String(), nil
 }
+
+// renderOrderByTerm renders a single ordering term, including its
+// direction, e.g. "first_name" DESC. It's used for both the query's
+// ORDER BY clause, and the ORDER BY of a window function.
+func renderOrderByTerm(rc *Context, term *ast.OrderByTermNode) (string, error) {
+	var (
+		s   string
+		err error
+	)
+	if fn, ok := term.Selector().(*ast.FuncNode); ok {
+		s, err = rc.Renderer.Function(rc, fn)
+	} else {
+		s, err = renderSelectorNode(rc.Dialect, term.Selector())
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch term.Direction() { //nolint:exhaustive
+	case ast.OrderByDirectionAsc:
+		s += " ASC"
+	case ast.OrderByDirectionDesc:
+		s += " DESC"
+	default:
+	}
+	return s, nil
+}
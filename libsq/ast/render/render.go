@@ -10,6 +10,7 @@ import (
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
 	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/schema"
 	"github.com/neilotoole/sq/libsq/driver/dialect"
 )
 
@@ -43,6 +44,16 @@ type Context struct {
 
 	// Dialect is the driver dialect.
 	Dialect dialect.Dialect
+
+	// SubquerySQL returns the SQL statement of the nested query sub, without
+	// enclosing parentheses. It's set by the pipeline, which holds the
+	// model of each nested query. If nil, subqueries are not supported.
+	SubquerySQL func(sub *ast.SubqueryNode) (string, error)
+
+	// LetSQL returns the SQL statement of the query of let, without
+	// enclosing parentheses. Like SubquerySQL, it's set by the pipeline.
+	// If nil, lets are not supported.
+	LetSQL func(let *ast.LetNode) (string, error)
 }
 
 // Renderer is a set of functions for rendering ast elements into SQL.
@@ -76,6 +87,12 @@ type Renderer struct {
 	// Function renders a function fragment.
 	Function func(rc *Context, fn *ast.FuncNode) (string, error)
 
+	// Window renders a window function, i.e. a function with an over()
+	// clause, such as "rank() over(order_by(.x))". If nil, the dialect
+	// doesn't support window functions, and an error is returned if
+	// the query contains one.
+	Window func(rc *Context, fn *ast.FuncNode) (string, error)
+
 	// FunctionNames is a map of SLQ function name to SQL function name.
 	// It can be used by the Renderer.Function impl. Note that FunctionOverrides
 	// has precedence over FunctionNames.
@@ -96,6 +113,23 @@ type Renderer struct {
 	// metadata. Empty by default. See issue #839.
 	FunctionResultKinds map[string]kind.Kind
 
+	// DBTypeName returns the DB's native type name for a kind.Kind, e.g.
+	// "BIGINT" for kind.Int. It's used to render the cast() function.
+	// Drivers typically set it to the kind-to-type mapping that they use
+	// for CREATE TABLE. If nil, cast() is not supported.
+	DBTypeName func(knd kind.Kind) string
+
+	// CreateTable returns a CREATE TABLE statement for tblDef. Drivers
+	// typically set it to the statement builder that they use for
+	// SQLDriver.CreateTable. It's used by the sql-insert output format.
+	// If nil, the dialect doesn't support rendering CREATE TABLE.
+	CreateTable func(tblDef *schema.Table) string
+
+	// ValueLiteral renders val, a record value of kind knd, as a SQL
+	// literal, e.g. for use in an INSERT statement. See record.Valid for
+	// the possible value types.
+	ValueLiteral func(rc *Context, knd kind.Kind, val any) (string, error)
+
 	// Literal renders a literal fragment.
 	Literal func(rc *Context, lit *ast.LiteralNode) (string, error)
 
@@ -108,6 +142,19 @@ type Renderer struct {
 	// Operator renders an operator fragment.
 	Operator func(rc *Context, op *ast.OperatorNode) (string, error)
 
+	// SetOp renders the keyword of a set operation, e.g. "UNION ALL".
+	// The operands themselves are rendered separately.
+	SetOp func(rc *Context, op *ast.SetOpNode) (string, error)
+
+	// Subquery renders a nested query, e.g. "(SELECT ...)". If the
+	// subquery is a derived table, the table alias is rendered also.
+	Subquery func(rc *Context, sub *ast.SubqueryNode) (string, error)
+
+	// With renders the WITH clause for the query's let statements, e.g.
+	// `WITH "big" AS (SELECT ...)`. It returns an empty string if lets is
+	// empty.
+	With func(rc *Context, lets []*ast.LetNode) (string, error)
+
 	// Distinct renders the DISTINCT fragment. Returns an
 	// empty string if n is nil.
 	Distinct func(rc *Context, n *ast.UniqueNode) (string, error)
@@ -133,6 +180,7 @@ func NewDefaultRenderer() *Renderer {
 		Having:     doHaving,
 		Join:       doJoin,
 		Function:   doFunction,
+		Window:     RenderWindowDefault,
 		FunctionOverrides: map[string]func(rc *Context, fn *ast.FuncNode) (string, error){
 			ast.FuncNameRowNum:      doFuncRowNum,
 			ast.FuncNameContains:    doFuncContains,
@@ -143,13 +191,32 @@ func NewDefaultRenderer() *Renderer {
 			ast.FuncNameIEndsWith:   doFuncIEndsWith,
 			ast.FuncNameLike:        doFuncLike,
 			ast.FuncNameILike:       doFuncILike,
+			ast.FuncNameCase:        doFuncCase,
+			ast.FuncNameCast:        RenderCastDefault,
+			ast.FuncNameNow:         funcOverrideNow("CURRENT_TIMESTAMP"),
+			ast.FuncNameDateTrunc:   doFuncDateTrunc,
+			ast.FuncNameDatePart:    doFuncDatePart,
+			ast.FuncNameDateAdd:     doFuncDateAdd,
+			ast.FuncNameLower:       funcOverrideString("lower"),
+			ast.FuncNameUpper:       funcOverrideString("upper"),
+			ast.FuncNameTrim:        funcOverrideString("trim"),
+			ast.FuncNameSubstr:      funcOverrideString("substr"),
+			ast.FuncNameReplace:     funcOverrideString("replace"),
+			ast.FuncNameLength:      funcOverrideString("length"),
+			ast.FuncNameConcat:      doFuncConcat,
+			ast.FuncNameCoalesce:    doFuncCoalesce,
+			ast.FuncNameNullIf:      doFuncNullIf,
 		},
 		FunctionNames:       map[string]string{},
 		FunctionResultKinds: map[string]kind.Kind{},
+		ValueLiteral:        RenderValueLiteralDefault,
 		Literal:             doLiteral,
 		Where:               doWhere,
 		Expr:                doExpr,
 		Operator:            doOperator,
+		SetOp:               RenderSetOpDefault,
+		Subquery:            RenderSubqueryDefault,
+		With:                RenderWithDefault,
 		Distinct:            doDistinct,
 		Render:              doRender,
 	}
@@ -158,6 +225,10 @@ func NewDefaultRenderer() *Renderer {
 // Fragments holds the fragments of a SQL query.
 // It is passed to Renderer.PreRender and Renderer.Render.
 type Fragments struct {
+	// With is the WITH clause, which precedes the SELECT, e.g.
+	// `WITH "big" AS (SELECT ...)`. It's empty unless the query has lets.
+	With string
+
 	Distinct string
 	Columns  string
 	From     string
@@ -166,6 +237,13 @@ type Fragments struct {
 	Having   string
 	OrderBy  string
 	Range    string
+
+	// SetOps holds the set operations (and their right operands) that
+	// follow the query, e.g. "UNION ALL SELECT * FROM actor". When
+	// non-empty, OrderBy and Range apply to the combined result, and
+	// so are rendered after SetOps.
+	SetOps string
+
 	// PreExecStmts are statements that are executed before the query.
 	// These can be used for edge-case behavior, such as setting up
 	// variables in the session.
@@ -183,6 +261,11 @@ type Fragments struct {
 func doRender(_ *Context, f *Fragments) (string, error) {
 	sb := strings.Builder{}
 
+	if f.With != "" {
+		sb.WriteString(f.With)
+		sb.WriteRune(sp)
+	}
+
 	sb.WriteString("SELECT")
 
 	if f.Distinct != "" {
@@ -213,6 +296,11 @@ func doRender(_ *Context, f *Fragments) (string, error) {
 		sb.WriteString(f.Having)
 	}
 
+	if f.SetOps != "" {
+		sb.WriteRune(sp)
+		sb.WriteString(f.SetOps)
+	}
+
 	if f.OrderBy != "" {
 		sb.WriteRune(sp)
 		sb.WriteString(f.OrderBy)
@@ -52,7 +52,16 @@ func doSelectCols(rc *Context, cols []ast.ResultColumn) (string, error) {
 			// not pinned, so on SQLite/rqlite such a result falls back to the
 			// scanned int/float kind; the cast-based drivers still get their type
 			// because the cast travels with the function rendering.
-			if knd, ok := rc.Renderer.FunctionResultKinds[strings.ToLower(col.FuncName())]; ok {
+			if knd, ok := rc.Renderer.FunctionResultKinds[strings.ToLower(col.FuncName())]; ok && !col.IsProprietary() {
+				if rc.ResultColumnKinds == nil {
+					rc.ResultColumnKinds = make(map[int]kind.Kind)
+				}
+				rc.ResultColumnKinds[i] = knd
+			}
+			// Likewise, the kind of a cast() result is known: it's the
+			// target kind. This matters for a backend such as SQLite, which
+			// reports no type for the CAST expression.
+			if knd := col.CastKind(); knd != kind.Unknown {
 				if rc.ResultColumnKinds == nil {
 					rc.ResultColumnKinds = make(map[int]kind.Kind)
 				}
@@ -0,0 +1,28 @@
+package render
+
+import (
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// RenderSetOpDefault renders the set operation keyword of op. It is the
+// default implementation of Renderer.SetOp.
+//
+//	union      -->  UNION
+//	union_all  -->  UNION ALL
+//	intersect  -->  INTERSECT
+//	except     -->  EXCEPT
+func RenderSetOpDefault(_ *Context, op *ast.SetOpNode) (string, error) {
+	switch op.Op() {
+	case ast.SetOpUnion:
+		return "UNION", nil
+	case ast.SetOpUnionAll:
+		return "UNION ALL", nil
+	case ast.SetOpIntersect:
+		return "INTERSECT", nil
+	case ast.SetOpExcept:
+		return "EXCEPT", nil
+	default:
+		return "", errz.Errorf("unknown set operation: %s", op.Op())
+	}
+}
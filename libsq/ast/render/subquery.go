@@ -0,0 +1,38 @@
+package render
+
+import (
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// RenderSubqueryDefault renders sub as a parenthesized SELECT statement.
+// It is the default implementation of Renderer.Subquery. If sub is a
+// derived table, its alias is appended.
+//
+//	.film | avg(.length)           -->  (SELECT avg("length") FROM "film")
+//	(.film | .title):t  [derived]  -->  (SELECT "title" FROM "film") AS "t"
+func RenderSubqueryDefault(rc *Context, sub *ast.SubqueryNode) (string, error) {
+	s, err := renderSubquerySQL(rc, sub)
+	if err != nil {
+		return "", err
+	}
+
+	if sub.IsDerivedTable() && sub.Alias() != "" {
+		s += " AS " + rc.Dialect.Enquote(sub.Alias())
+	}
+	return s, nil
+}
+
+// renderSubquerySQL renders sub's query, enclosed in parentheses,
+// via Context.SubquerySQL.
+func renderSubquerySQL(rc *Context, sub *ast.SubqueryNode) (string, error) {
+	if rc.SubquerySQL == nil {
+		return "", errz.Errorf("subquery not supported in this context: %s", sub.Text())
+	}
+
+	s, err := rc.SubquerySQL(sub)
+	if err != nil {
+		return "", err
+	}
+	return "(" + s + ")", nil
+}
@@ -0,0 +1,82 @@
+package render
+
+import (
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/stringz"
+)
+
+// RenderValueLiteralDefault is the default Renderer.ValueLiteral impl. It
+// renders bool values as TRUE or FALSE, or as 1 or 0 if the dialect is
+// IntBool; []byte values as a hex literal, e.g. X'CAFE'; and time.Time
+// values as a string formatted via FormatTimeValue. Non-finite float
+// values are rendered as a string, e.g. 'NaN', which some DBs accept.
+func RenderValueLiteralDefault(rc *Context, knd kind.Kind, val any) (string, error) {
+	switch val := val.(type) {
+	case nil:
+		return "NULL", nil
+	case int64:
+		return strconv.FormatInt(val, 10), nil
+	case float64:
+		switch {
+		case math.IsNaN(val):
+			return "'NaN'", nil
+		case math.IsInf(val, 1):
+			return "'Infinity'", nil
+		case math.IsInf(val, -1):
+			return "'-Infinity'", nil
+		}
+		return strconv.FormatFloat(val, 'g', -1, 64), nil
+	case decimal.Decimal:
+		return val.String(), nil
+	case bool:
+		if rc.Dialect.IntBool {
+			if val {
+				return "1", nil
+			}
+			return "0", nil
+		}
+		if val {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case string:
+		return stringz.SingleQuote(val), nil
+	case []byte:
+		return "X'" + strings.ToUpper(hex.EncodeToString(val)) + "'", nil
+	case time.Time:
+		return stringz.SingleQuote(FormatTimeValue(knd, val)), nil
+	default:
+		return "", errz.Errorf("unsupported value type %T", val)
+	}
+}
+
+// FormatTimeValue formats t, a value of kind knd, as text that is accepted
+// for the kind by most DBs: "2006-01-02" for kind.Date, "15:04:05.999999"
+// for kind.Time, and "2006-01-02 15:04:05.999999" otherwise. A kind.Datetime
+// value is first converted to UTC.
+func FormatTimeValue(knd kind.Kind, t time.Time) string {
+	switch knd { //nolint:exhaustive
+	case kind.Date:
+		return t.Format(time.DateOnly)
+	case kind.Time:
+		return t.Format("15:04:05.999999")
+	default:
+		return t.UTC().Format("2006-01-02 15:04:05.999999")
+	}
+}
+
+// SingleQuoteBackslash is like stringz.SingleQuote, but it also escapes
+// backslashes, for DBs such as MySQL that treat backslash as an escape
+// character in string literals.
+func SingleQuoteBackslash(s string) string {
+	return stringz.SingleQuote(strings.ReplaceAll(s, `\`, `\\`))
+}
@@ -0,0 +1,44 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/neilotoole/sq/libsq/ast"
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// RenderWithDefault renders lets as a WITH clause, i.e. a list of common
+// table expressions, in order. It is the default implementation of
+// Renderer.With. The SQL of each let's query is obtained via
+// Context.LetSQL.
+//
+//	let big = .payment | where(.amount > 10)
+//	  -->  WITH "big" AS (SELECT * FROM "payment" WHERE "amount" > 10)
+func RenderWithDefault(rc *Context, lets []*ast.LetNode) (string, error) {
+	if len(lets) == 0 {
+		return "", nil
+	}
+
+	if rc.LetSQL == nil {
+		return "", errz.Errorf("let not supported in this context: %s", lets[0].Text())
+	}
+
+	var sb strings.Builder
+	sb.WriteString("WITH ")
+	for i, let := range lets {
+		s, err := rc.LetSQL(let)
+		if err != nil {
+			return "", err
+		}
+
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(rc.Dialect.Enquote(let.Name()))
+		sb.WriteString(" AS (")
+		sb.WriteString(s)
+		sb.WriteRune(')')
+	}
+
+	return sb.String(), nil
+}
@@ -123,6 +123,9 @@ var _ Node = (*TblSelectorNode)(nil)
 type TblSelectorNode struct {
 	tbl tablefq.T
 
+	// let is the let statement that the selector references, if any.
+	let *LetNode
+
 	handle string
 	SelectorNode
 }
@@ -178,6 +181,16 @@ func (n *TblSelectorNode) SetAlias(alias string) {
 	n.alias = alias
 }
 
+// Let returns the let statement that n references, or nil if n
+// references a table of the source. For example, in:
+//
+//	let big = .payment | where(.amount > 10); .big | .amount
+//
+// the ".big" selector references the "big" let.
+func (n *TblSelectorNode) Let() *LetNode {
+	return n.let
+}
+
 // Handle returns the handle, which may be empty.
 func (n *TblSelectorNode) Handle() string {
 	return n.handle
@@ -0,0 +1,72 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+// Set operation names, as they appear in SLQ.
+const (
+	SetOpUnion     = "union"
+	SetOpUnionAll  = "union_all"
+	SetOpIntersect = "intersect"
+	SetOpExcept    = "except"
+)
+
+var _ Node = (*SetOpNode)(nil)
+
+// SetOpNode models a set operation (UNION, UNION ALL, INTERSECT, EXCEPT).
+// The left operand is the query preceding the node; the right operand is
+// the parenthesized sub-query, which is available via SetOpNode.Query.
+//
+//	@staging.actor | .first_name | union(@prod.actor | .first_name)
+//
+// Note that the operand AST is not a child of the SetOpNode: it is a
+// complete, independent AST, and thus isn't visited when walking the
+// enclosing AST.
+type SetOpNode struct {
+	baseNode
+	op    string
+	query *AST
+}
+
+// Op returns the set operation name, e.g. SetOpUnionAll.
+func (n *SetOpNode) Op() string {
+	return n.op
+}
+
+// Query returns the AST of the right operand.
+func (n *SetOpNode) Query() *AST {
+	return n.query
+}
+
+// String returns a log/debug-friendly representation.
+func (n *SetOpNode) String() string {
+	return nodeString(n)
+}
+
+// VisitSetOp implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitSetOp(ctx *slq.SetOpContext) any {
+	seg, ok := v.cur.(*SegmentNode)
+	if !ok {
+		return errorf("parent of %s() must be SegmentNode, but got: %T", ctx.SET_OP().GetText(), v.cur)
+	}
+
+	node := &SetOpNode{op: ctx.SET_OP().GetText()}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+
+	if ctx.Query() == nil {
+		return errorf("invalid %s(): query is nil: %s", node.op, node.text)
+	}
+
+	query, err := buildAST(v.log, ctx.Query())
+	if err != nil {
+		return err
+	}
+	if err = verify(query); err != nil {
+		return err
+	}
+	node.query = query
+
+	return seg.AddChild(node)
+}
@@ -0,0 +1,157 @@
+package ast
+
+import (
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var _ Node = (*SubqueryNode)(nil)
+
+// SubqueryNode models a nested query, i.e. a parenthesized query that
+// contains at least one pipe. A subquery can be an expression operand,
+// such as the right-hand side of the "in" operator, or a scalar value:
+//
+//	.customer | where(.customer_id in (.payment | where(.amount > 10) | .customer_id))
+//	.film | where(.length > (.film | avg(.length)))
+//
+// A subquery can reference tables of the enclosing query (a correlated
+// subquery), typically via a table alias:
+//
+//	.film:f | where(.length > (.film | where(.rating == .f.rating) | avg(.length)))
+//
+// When a subquery is the first element of a query (or follows a handle),
+// it's a derived table, and is the direct child of its SegmentNode.
+// A derived table must have an alias.
+//
+//	(.payment | group_by(.customer_id) | .customer_id, sum(.amount):total):t | where(.total > 150)
+//
+// Note that the subquery AST is not a child of the SubqueryNode: it is a
+// complete, independent AST, and thus isn't visited when walking the
+// enclosing AST.
+type SubqueryNode struct {
+	baseNode
+	query *AST
+	alias string
+}
+
+// Query returns the AST of the nested query.
+func (n *SubqueryNode) Query() *AST {
+	return n.query
+}
+
+// Alias returns the derived table alias, or empty string.
+func (n *SubqueryNode) Alias() string {
+	return n.alias
+}
+
+// IsDerivedTable returns true if n is a derived table, that is, if
+// n is the direct child of a SegmentNode.
+func (n *SubqueryNode) IsDerivedTable() bool {
+	_, ok := n.parent.(*SegmentNode)
+	return ok
+}
+
+// IsInOperand returns true if n is the right-hand side of the "in" or
+// "not in" operator, e.g. ".actor_id in (.film_actor | .actor_id)".
+func (n *SubqueryNode) IsInOperand() bool {
+	expr, ok := n.parent.(*ExprNode)
+	if !ok {
+		return false
+	}
+
+	i := nodeChildIndex(expr, n)
+	if i < 1 {
+		return false
+	}
+
+	op, ok := expr.Children()[i-1].(*OperatorNode)
+	return ok && (op.Text() == OpIn || op.Text() == OpNotIn)
+}
+
+// String returns a log/debug-friendly representation.
+func (n *SubqueryNode) String() string {
+	str := nodeString(n)
+	if n.alias != "" {
+		str += ":" + n.alias
+	}
+	return str
+}
+
+// VisitSubquery implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitSubquery(ctx *slq.SubqueryContext) any {
+	node := &SubqueryNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+	if err := node.SetParent(v.cur); err != nil {
+		return err
+	}
+
+	tree := &parseTreeVisitor{log: v.log}
+	if e := tree.visitSegments(ctx, ctx.AllSegment()); e != nil {
+		return e
+	}
+
+	query, err := narrowAST(tree.ast)
+	if err != nil {
+		return err
+	}
+	if err = verify(query); err != nil {
+		return err
+	}
+	node.query = query
+
+	return v.cur.AddChild(node)
+}
+
+// narrowDerivedTable converts an ExprElementNode that consists only of
+// a subquery, and that occupies the table position of a query (the first
+// segment, or the segment following a handle), into a SubqueryNode that
+// is the direct child of the segment. That is, it converts the subquery
+// into a derived table.
+func narrowDerivedTable(_ *Walker, node Node) error {
+	elem, ok := node.(*ExprElementNode)
+	if !ok {
+		return errorf("expected %T but got %T", elem, node)
+	}
+
+	seg, ok := elem.Parent().(*SegmentNode)
+	if !ok {
+		return nil
+	}
+
+	if seg.SegIndex() != 0 {
+		prevType, err := seg.Prev().ChildType()
+		if err != nil {
+			return err
+		}
+		if prevType != typeHandleNode {
+			return nil
+		}
+	}
+
+	expr := elem.ExprNode()
+	if expr == nil || expr.parens || len(expr.Children()) != 1 {
+		return nil
+	}
+
+	sub, ok := expr.Children()[0].(*SubqueryNode)
+	if !ok {
+		return nil
+	}
+
+	if elemCtx, ok := elem.ctx.(*slq.ExprElementContext); !ok || elemCtx.Alias() == nil {
+		return errorf("derived table must have an alias, e.g. %s:t", sub.Text())
+	}
+	sub.alias = elem.Alias()
+
+	index := nodeChildIndex(seg, elem)
+	if index < 0 {
+		return errorf("parent %T(%s) does not appear to have child %T(%s)", seg, seg.Text(), elem, elem.Text())
+	}
+
+	siblings := seg.Children()
+	siblings[index] = sub
+	if err := seg.SetChildren(siblings); err != nil {
+		return err
+	}
+	return sub.SetParent(seg)
+}
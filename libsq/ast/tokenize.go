@@ -164,6 +164,7 @@ func tokenKindOf(ttype int, literal string) TokenKind {
 		return TokenNull
 	case slq.SLQLexerPROPRIETARY_FUNC_NAME,
 		slq.SLQLexerJOIN_TYPE,
+		slq.SLQLexerSET_OP,
 		slq.SLQLexerWHERE,
 		slq.SLQLexerGROUP_BY,
 		slq.SLQLexerHAVING,
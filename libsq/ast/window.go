@@ -0,0 +1,123 @@
+package ast
+
+import (
+	"reflect"
+
+	"github.com/neilotoole/sq/libsq/ast/internal/slq"
+)
+
+var windowAllowedChildren = []reflect.Type{
+	typeSelectorNode,
+	typeColSelectorNode,
+	typeTblColSelectorNode,
+	typeFuncNode,
+	typeOrderByTermNode,
+}
+
+var _ Node = (*WindowNode)(nil)
+
+// WindowNode models the OVER clause of a window function. For example:
+//
+//	.payment | sum(.amount) over(partition_by(.customer_id), order_by(.payment_date))
+//
+// The WindowNode is always the final child of its parent FuncNode. Its own
+// children are the PARTITION BY terms (selectors or functions), followed by
+// the ORDER BY terms (*OrderByTermNode). Either set may be empty.
+type WindowNode struct {
+	baseNode
+}
+
+// PartitionBy returns the window's PARTITION BY terms.
+func (n *WindowNode) PartitionBy() []Node {
+	var terms []Node
+	for _, child := range n.children {
+		if _, ok := child.(*OrderByTermNode); !ok {
+			terms = append(terms, child)
+		}
+	}
+	return terms
+}
+
+// OrderBy returns the window's ORDER BY terms.
+func (n *WindowNode) OrderBy() []*OrderByTermNode {
+	var terms []*OrderByTermNode
+	for _, child := range n.children {
+		if term, ok := child.(*OrderByTermNode); ok {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// AddChild implements Node.
+func (n *WindowNode) AddChild(child Node) error {
+	if err := nodesAreOnlyOfType([]Node{child}, windowAllowedChildren...); err != nil {
+		return err
+	}
+
+	n.addChild(child)
+	return child.SetParent(n)
+}
+
+// SetChildren implements Node.
+func (n *WindowNode) SetChildren(children []Node) error {
+	if err := nodesAreOnlyOfType(children, windowAllowedChildren...); err != nil {
+		return err
+	}
+
+	n.doSetChildren(children)
+	return nil
+}
+
+// String returns a log/debug-friendly representation.
+func (n *WindowNode) String() string {
+	return nodeString(n)
+}
+
+// VisitOver implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitOver(ctx *slq.OverContext) any {
+	fn, ok := v.cur.(*FuncNode)
+	if !ok {
+		return errorf("over() must follow a function, but got %T: %s", v.cur, ctx.GetText())
+	}
+
+	node := &WindowNode{}
+	node.ctx = ctx
+	node.text = ctx.GetText()
+	if err := node.SetParent(fn); err != nil {
+		return err
+	}
+
+	if pbCtx, ok := ctx.PartitionBy().(*slq.PartitionByContext); ok && pbCtx != nil {
+		if e := v.using(node, func() any {
+			return v.VisitPartitionBy(pbCtx)
+		}); e != nil {
+			return e
+		}
+	}
+
+	if obCtx, ok := ctx.OrderBy().(*slq.OrderByContext); ok && obCtx != nil {
+		// Note that we don't invoke VisitOrderBy, because the window's
+		// ORDER BY is not the query's ORDER BY clause. Instead, the
+		// terms are added directly to the WindowNode.
+		for _, termCtx := range obCtx.AllOrderByTerm() {
+			if e := v.using(node, func() any {
+				return v.VisitOrderByTerm(termCtx.(*slq.OrderByTermContext))
+			}); e != nil {
+				return e
+			}
+		}
+	}
+
+	return fn.AddChild(node)
+}
+
+// VisitPartitionBy implements slq.SLQVisitor.
+func (v *parseTreeVisitor) VisitPartitionBy(ctx *slq.PartitionByContext) any {
+	for _, termCtx := range ctx.AllGroupByTerm() {
+		if e := v.VisitGroupByTerm(termCtx.(*slq.GroupByTermContext)); e != nil {
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+// Package arrowz contains supplemental Apache Arrow functionality, built
+// on the github.com/apache/arrow-go library. It converts between sq
+// records and Arrow record batches, for use by the Arrow and Parquet
+// output writers and drivers.
+package arrowz
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/shopspring/decimal"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+)
+
+const (
+	// DefaultDecimalPrecision and DefaultDecimalScale are used for a decimal
+	// column whose precision and scale aren't reported by the database. A
+	// decimal column has a fixed scale: values with greater scale are
+	// rounded.
+	DefaultDecimalPrecision = 38
+	DefaultDecimalScale     = 6
+)
+
+// NewSchema returns the Arrow schema for recMeta. Each field is nullable.
+// The Arrow type of each field is determined by its kind:
+//
+//	kind.Int       int64
+//	kind.Float     float64
+//	kind.Bool      bool
+//	kind.Bytes     binary
+//	kind.Decimal   decimal128, with the field's precision and scale
+//	kind.Datetime  timestamp[us, UTC]
+//	kind.Date      date32
+//	kind.Time      time64[us]
+//
+// Any other kind, including kind.Text, is utf8.
+func NewSchema(recMeta record.Meta) *arrow.Schema {
+	fields := make([]arrow.Field, len(recMeta))
+	for i, fm := range recMeta {
+		fields[i] = arrow.Field{Name: fm.MungedName(), Type: dataType(fm), Nullable: true}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+// dataType returns the Arrow data type for fm.
+func dataType(fm *record.FieldMeta) arrow.DataType {
+	switch fm.Kind() { //nolint:exhaustive
+	case kind.Int:
+		return arrow.PrimitiveTypes.Int64
+	case kind.Float:
+		return arrow.PrimitiveTypes.Float64
+	case kind.Bool:
+		return arrow.FixedWidthTypes.Boolean
+	case kind.Bytes:
+		return arrow.BinaryTypes.Binary
+	case kind.Decimal:
+		precision, scale := DecimalSize(fm)
+		return &arrow.Decimal128Type{Precision: precision, Scale: scale}
+	case kind.Datetime:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	case kind.Date:
+		return arrow.FixedWidthTypes.Date32
+	case kind.Time:
+		return arrow.FixedWidthTypes.Time64us
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// DecimalSize returns the precision and scale of the decimal field fm.
+// If the precision and scale aren't reported, or don't fit a decimal128,
+// DefaultDecimalPrecision and DefaultDecimalScale are returned.
+func DecimalSize(fm *record.FieldMeta) (precision, scale int32) {
+	p, s, ok := fm.DecimalSize()
+	if !ok || p < 1 || p > DefaultDecimalPrecision || s < 0 || s > p {
+		return DefaultDecimalPrecision, DefaultDecimalScale
+	}
+	return int32(p), int32(s) //nolint:gosec
+}
+
+// Builder builds Arrow record batches from records. The schema of the
+// batches is that returned by NewSchema. Builder is not safe for
+// concurrent use.
+type Builder struct {
+	rb      *array.RecordBuilder
+	recMeta record.Meta
+	n       int
+}
+
+// NewBuilder returns a new Builder for records described by recMeta.
+// The caller must invoke Builder.Release when done.
+func NewBuilder(recMeta record.Meta) *Builder {
+	return &Builder{
+		rb:      array.NewRecordBuilder(memory.DefaultAllocator, NewSchema(recMeta)),
+		recMeta: recMeta,
+	}
+}
+
+// Schema returns the schema of the record batches built by b.
+func (b *Builder) Schema() *arrow.Schema {
+	return b.rb.Schema()
+}
+
+// Len returns the number of records appended since the last
+// invocation of Builder.NewRecordBatch.
+func (b *Builder) Len() int {
+	return b.n
+}
+
+// Append appends rec to the record batch being built. If an error is
+// returned, the builder is in an inconsistent state, and must not be
+// used further.
+func (b *Builder) Append(rec record.Record) error {
+	for i, val := range rec {
+		if err := b.appendValue(i, val); err != nil {
+			return err
+		}
+	}
+	b.n++
+	return nil
+}
+
+// NewRecordBatch returns a record batch of the records appended since
+// the previous invocation, and resets the builder. The caller must
+// release the returned batch.
+func (b *Builder) NewRecordBatch() arrow.RecordBatch {
+	b.n = 0
+	return b.rb.NewRecordBatch()
+}
+
+// Release releases the builder's resources.
+func (b *Builder) Release() {
+	b.rb.Release()
+}
+
+// appendValue appends val, the value of column i, to the column's
+// builder.
+func (b *Builder) appendValue(i int, val any) error { //nolint:gocognit
+	fb := b.rb.Field(i)
+	if val == nil {
+		fb.AppendNull()
+		return nil
+	}
+
+	switch fb := fb.(type) {
+	case *array.Int64Builder:
+		if v, ok := val.(int64); ok {
+			fb.Append(v)
+			return nil
+		}
+	case *array.Float64Builder:
+		switch v := val.(type) {
+		case float64:
+			fb.Append(v)
+			return nil
+		case decimal.Decimal:
+			fb.Append(v.InexactFloat64())
+			return nil
+		}
+	case *array.BooleanBuilder:
+		if v, ok := val.(bool); ok {
+			fb.Append(v)
+			return nil
+		}
+	case *array.BinaryBuilder:
+		switch v := val.(type) {
+		case []byte:
+			fb.Append(v)
+			return nil
+		case string:
+			fb.AppendString(v)
+			return nil
+		}
+	case *array.Decimal128Builder:
+		num, err := b.decimalValue(i, fb.Type().(*arrow.Decimal128Type), val)
+		if err != nil {
+			return err
+		}
+		fb.Append(num)
+		return nil
+	case *array.TimestampBuilder:
+		if v, ok := val.(time.Time); ok {
+			fb.Append(arrow.Timestamp(v.UnixMicro()))
+			return nil
+		}
+	case *array.Date32Builder:
+		if v, ok := val.(time.Time); ok {
+			// The date is the calendar date of v, in v's location.
+			y, m, d := v.Date()
+			fb.Append(arrow.Date32FromTime(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)))
+			return nil
+		}
+	case *array.Time64Builder:
+		switch v := val.(type) {
+		case time.Time:
+			fb.Append(arrow.Time64(sinceMidnight(v) / time.Microsecond))
+			return nil
+		case string:
+			// Some database drivers supply kind.Time values as string.
+			t, err := time.Parse(time.TimeOnly, v)
+			if err != nil {
+				return errz.Wrapf(err, "column {%s}: invalid time", b.recMeta[i].MungedName())
+			}
+			fb.Append(arrow.Time64(sinceMidnight(t) / time.Microsecond))
+			return nil
+		}
+	case *array.StringBuilder:
+		switch v := val.(type) {
+		case string:
+			fb.Append(v)
+		case []byte:
+			fb.BinaryBuilder.Append(v)
+		case time.Time:
+			fb.Append(v.Format(time.RFC3339Nano))
+		default:
+			fb.Append(fmt.Sprintf("%v", v))
+		}
+		return nil
+	}
+
+	return b.errUnexpected(i, val)
+}
+
+// decimalValue returns val, the value of column i, as a value of the
+// decimal type dt. The value is rounded to the type's scale. An error
+// is returned if the value exceeds the type's precision.
+func (b *Builder) decimalValue(i int, dt *arrow.Decimal128Type, val any) (decimal128.Num, error) {
+	var dec decimal.Decimal
+	switch v := val.(type) {
+	case decimal.Decimal:
+		dec = v
+	case int64:
+		dec = decimal.NewFromInt(v)
+	case float64:
+		dec = decimal.NewFromFloat(v)
+	case string:
+		var err error
+		if dec, err = decimal.NewFromString(v); err != nil {
+			return decimal128.Num{}, errz.Wrapf(err, "column {%s}: invalid decimal", b.recMeta[i].MungedName())
+		}
+	default:
+		return decimal128.Num{}, b.errUnexpected(i, val)
+	}
+
+	unscaled := dec.Round(dt.Scale).Shift(dt.Scale).BigInt()
+	if unscaled.CmpAbs(maxUnscaled(dt.Precision)) > 0 {
+		return decimal128.Num{}, errz.Errorf("column {%s}: value %s exceeds %s",
+			b.recMeta[i].MungedName(), dec, dt)
+	}
+	return decimal128.FromBigInt(unscaled), nil
+}
+
+// maxUnscaled returns the largest unscaled value of a decimal with
+// the given precision, that is, 10^precision - 1.
+func maxUnscaled(precision int32) *big.Int {
+	n := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	return n.Sub(n, big.NewInt(1))
+}
+
+func (b *Builder) errUnexpected(i int, val any) error {
+	return errz.Errorf("column {%s}: unexpected %T value for kind %s",
+		b.recMeta[i].MungedName(), val, b.recMeta[i].Kind())
+}
+
+// sinceMidnight returns the time of day of t, as a duration.
+func sinceMidnight(t time.Time) time.Duration {
+	h, m, s := t.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+		time.Duration(s)*time.Second + time.Duration(t.Nanosecond())
+}
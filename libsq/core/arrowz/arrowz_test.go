@@ -0,0 +1,106 @@
+package arrowz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/libsq/core/arrowz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/testh"
+)
+
+func TestBuilder_Value(t *testing.T) {
+	colNames := []string{
+		"col_int", "col_float", "col_bool", "col_bytes", "col_decimal",
+		"col_datetime", "col_date", "col_time", "col_text", "col_null",
+	}
+	colKinds := []kind.Kind{
+		kind.Int, kind.Float, kind.Bool, kind.Bytes, kind.Decimal,
+		kind.Datetime, kind.Date, kind.Time, kind.Text, kind.Null,
+	}
+	recMeta := testh.NewRecordMeta(colNames, colKinds)
+
+	dt := time.Date(2021, 3, 4, 5, 6, 7, 123456000, time.UTC)
+	recs := []record.Record{
+		{
+			int64(7), float64(1.5), true, []byte("hello"), decimal.RequireFromString("-12.345"),
+			dt, dt, "05:06:07", "huzzah", nil,
+		},
+		make(record.Record, len(colNames)), // All null.
+	}
+
+	b := arrowz.NewBuilder(recMeta)
+	defer b.Release()
+	for _, rec := range recs {
+		require.NoError(t, b.Append(rec))
+	}
+	require.Equal(t, len(recs), b.Len())
+
+	batch := b.NewRecordBatch()
+	defer batch.Release()
+	require.Equal(t, 0, b.Len())
+	require.Equal(t, int64(len(recs)), batch.NumRows())
+
+	wantKinds := []kind.Kind{
+		kind.Int, kind.Float, kind.Bool, kind.Bytes, kind.Decimal,
+		kind.Datetime, kind.Date, kind.Time, kind.Text, kind.Text,
+	}
+	for i, f := range batch.Schema().Fields() {
+		require.Equal(t, colNames[i], f.Name)
+		require.True(t, f.Nullable)
+		gotKind, err := arrowz.Kind(f.Type)
+		require.NoError(t, err)
+		require.Equal(t, wantKinds[i], gotKind, f.Name)
+	}
+
+	want := []any{
+		int64(7), float64(1.5), true, []byte("hello"), decimal.RequireFromString("-12.345"),
+		dt.Truncate(time.Microsecond), "2021-03-04", "05:06:07", "huzzah", nil,
+	}
+	for i := range colNames {
+		got, err := arrowz.Value(batch.Column(i), 1)
+		require.NoError(t, err)
+		require.Nil(t, got, colNames[i])
+
+		got, err = arrowz.Value(batch.Column(i), 0)
+		require.NoError(t, err)
+		if wantDec, ok := want[i].(decimal.Decimal); ok {
+			require.True(t, wantDec.Equal(got.(decimal.Decimal)), got)
+			continue
+		}
+		require.Equal(t, want[i], got, colNames[i])
+	}
+}
+
+func TestBuilder_errors(t *testing.T) {
+	testCases := []struct {
+		name string
+		knd  kind.Kind
+		val  any
+	}{
+		{name: "int_string", knd: kind.Int, val: "7"},
+		{name: "decimal_invalid", knd: kind.Decimal, val: "not a number"},
+		{name: "decimal_overflow", knd: kind.Decimal, val: decimal.RequireFromString("1e33")},
+		{name: "time_invalid", knd: kind.Time, val: "25:00"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := arrowz.NewBuilder(testh.NewRecordMeta([]string{"a"}, []kind.Kind{tc.knd}))
+			defer b.Release()
+			require.Error(t, b.Append(record.Record{tc.val}))
+		})
+	}
+}
+
+func TestKind_unsupported(t *testing.T) {
+	_, err := arrowz.Kind(arrow.ListOf(arrow.PrimitiveTypes.Int64))
+	require.Error(t, err)
+	_, err = arrowz.Kind(arrow.StructOf(arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64}))
+	require.Error(t, err)
+}
@@ -0,0 +1,196 @@
+package arrowz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+
+	"github.com/neilotoole/sq/libsq"
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/lg"
+	"github.com/neilotoole/sq/libsq/core/lg/lga"
+	"github.com/neilotoole/sq/libsq/core/record"
+	"github.com/neilotoole/sq/libsq/core/schema"
+	"github.com/neilotoole/sq/libsq/core/tuning"
+	"github.com/neilotoole/sq/libsq/driver"
+	"github.com/neilotoole/sq/libsq/source"
+)
+
+// BatchIterator iterates over Arrow record batches. The batch returned by
+// RecordBatch is valid until the next invocation of Next. It's a subset of
+// array.RecordReader, and thus is implemented by ipc.Reader and
+// pqarrow.RecordReader.
+type BatchIterator interface {
+	// Schema returns the schema of the record batches.
+	Schema() *arrow.Schema
+
+	// Next advances to the next record batch, returning false when
+	// there are no more batches, or on error.
+	Next() bool
+
+	// RecordBatch returns the current record batch.
+	RecordBatch() arrow.RecordBatch
+
+	// Err returns the error, if any, that stopped Next.
+	Err() error
+}
+
+// Ingest loads the record batches of it into the monotable of destGrip.
+// The table has a column for each field of the batch schema. An error is
+// returned if a field's type can't be ingested: for example, a nested
+// or repeated field.
+func Ingest(ctx context.Context, destGrip driver.Grip, it BatchIterator) error {
+	log := lg.FromContext(ctx)
+	start := time.Now()
+
+	tblDef, err := buildTable(ctx, it.Schema())
+	if err != nil {
+		return err
+	}
+
+	db, err := destGrip.DB(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = destGrip.SQLDriver().CreateTable(ctx, db, tblDef); err != nil {
+		return errz.Wrap(err, "failed to create dest scratch table")
+	}
+
+	recMeta, err := getIngestRecMeta(ctx, destGrip, tblDef)
+	if err != nil {
+		return err
+	}
+
+	insertWriter := libsq.NewDBWriter(
+		libsq.MsgIngestRecords,
+		destGrip,
+		tblDef.Name,
+		tuning.OptRecBufSize.Get(destGrip.Source().Options),
+	)
+
+	if err = execInsert(ctx, insertWriter, recMeta, tblDef, it); err != nil {
+		return err
+	}
+
+	inserted, err := insertWriter.Wait()
+	if err != nil {
+		return err
+	}
+
+	log.Info(
+		"Ingested rows",
+		lga.Count, inserted,
+		lga.Elapsed, time.Since(start).Round(time.Millisecond),
+		lga.Target, source.Target(destGrip.Source(), tblDef.Name),
+	)
+	return nil
+}
+
+// buildTable returns the monotable definition for the Arrow schema sch,
+// with a column for each field.
+func buildTable(ctx context.Context, sch *arrow.Schema) (*schema.Table, error) {
+	tblDef := &schema.Table{Name: source.MonotableName}
+	tblDef.Cols = make([]*schema.Column, sch.NumFields())
+	names := make([]string, sch.NumFields())
+	for i, f := range sch.Fields() {
+		names[i] = f.Name
+		knd, err := Kind(f.Type)
+		if err != nil {
+			return nil, errz.Wrapf(err, "field {%s}", f.Name)
+		}
+		tblDef.Cols[i] = &schema.Column{Table: tblDef, Kind: knd}
+	}
+
+	names, err := driver.MungeIngestColNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, col := range tblDef.Cols {
+		col.Name = names[i]
+	}
+
+	return tblDef, nil
+}
+
+// execInsert reads the record batches from it, writing their rows via
+// recw. The caller should wait on recw to complete.
+func execInsert(ctx context.Context, recw libsq.RecordWriter, recMeta record.Meta,
+	tblDef *schema.Table, it BatchIterator,
+) error {
+	ctx, cancelFn := context.WithCancel(ctx)
+	// We don't do "defer cancelFn" here. The cancelFn is passed
+	// to recw.
+
+	recordCh, errCh, err := recw.Open(ctx, cancelFn, recMeta)
+	if err != nil {
+		return err
+	}
+	defer close(recordCh)
+
+	for it.Next() {
+		batch := it.RecordBatch()
+		for row := range int(batch.NumRows()) {
+			var rec []any
+			if rec, err = batchRecord(tblDef, batch, row); err != nil {
+				cancelFn()
+				return err
+			}
+
+			select {
+			case err = <-errCh:
+				cancelFn()
+				return err
+			case <-ctx.Done():
+				cancelFn()
+				return ctx.Err()
+			case recordCh <- rec:
+			}
+		}
+	}
+
+	if err = it.Err(); err != nil && !errors.Is(err, io.EOF) {
+		cancelFn()
+		return errz.Wrap(err, "read record batch")
+	}
+
+	return nil
+}
+
+// batchRecord returns the record for the given row of batch.
+func batchRecord(tblDef *schema.Table, batch arrow.RecordBatch, row int) ([]any, error) {
+	rec := make([]any, len(tblDef.Cols))
+	for i, col := range tblDef.Cols {
+		var err error
+		if rec[i], err = Value(batch.Column(i), row); err != nil {
+			return nil, errz.Wrapf(err, "column {%s}", col.Name)
+		}
+	}
+	return rec, nil
+}
+
+// getIngestRecMeta returns record.Meta to use with RecordWriter.Open.
+func getIngestRecMeta(ctx context.Context, destGrip driver.Grip, tblDef *schema.Table) (record.Meta, error) {
+	db, err := destGrip.DB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	drvr := destGrip.SQLDriver()
+
+	colTypes, err := drvr.TableColumnTypes(ctx, db, tblDef.Name, tblDef.ColNames())
+	if err != nil {
+		return nil, err
+	}
+
+	destMeta, _, err := drvr.RecordMeta(ctx, colTypes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return destMeta, nil
+}
@@ -0,0 +1,158 @@
+package arrowz
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/shopspring/decimal"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+	"github.com/neilotoole/sq/libsq/core/kind"
+)
+
+// Kind returns the kind.Kind of values of Arrow data type dt, as returned
+// by Value. An error is returned if dt is a type that can't be ingested,
+// such as a nested type (e.g. list or struct), or an interval.
+func Kind(dt arrow.DataType) (kind.Kind, error) {
+	switch dt.ID() { //nolint:exhaustive
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return kind.Int, nil
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return kind.Float, nil
+	case arrow.BOOL:
+		return kind.Bool, nil
+	case arrow.BINARY, arrow.LARGE_BINARY, arrow.BINARY_VIEW, arrow.FIXED_SIZE_BINARY:
+		return kind.Bytes, nil
+	case arrow.STRING, arrow.LARGE_STRING, arrow.STRING_VIEW:
+		return kind.Text, nil
+	case arrow.NULL:
+		// All values are NULL.
+		return kind.Text, nil
+	case arrow.DECIMAL32, arrow.DECIMAL64, arrow.DECIMAL128, arrow.DECIMAL256:
+		return kind.Decimal, nil
+	case arrow.DATE32, arrow.DATE64:
+		return kind.Date, nil
+	case arrow.TIME32, arrow.TIME64:
+		return kind.Time, nil
+	case arrow.TIMESTAMP:
+		return kind.Datetime, nil
+	case arrow.DICTIONARY:
+		return Kind(dt.(*arrow.DictionaryType).ValueType)
+	case arrow.EXTENSION:
+		return Kind(dt.(arrow.ExtensionType).StorageType())
+	default:
+		return kind.Unknown, errz.Errorf("unsupported type {%s}", dt)
+	}
+}
+
+// Value returns the value at index i of arr, or nil if the value is null.
+// The Go type of the value depends upon the kind of the array's type, as
+// returned by Kind:
+//
+//	kind.Int       int64
+//	kind.Float     float64
+//	kind.Bool      bool
+//	kind.Bytes     []byte
+//	kind.Text      string
+//	kind.Decimal   decimal.Decimal
+//	kind.Datetime  time.Time, in UTC
+//	kind.Date      string, formatted as time.DateOnly
+//	kind.Time      string, formatted as time.TimeOnly
+//
+// The returned value doesn't reference the array's memory, and thus
+// remains valid after the array is released. An error is returned if
+// the type isn't supported, or if a uint64 value overflows int64.
+func Value(arr arrow.Array, i int) (any, error) { //nolint:gocyclo
+	if arr.IsNull(i) {
+		return nil, nil //nolint:nilnil
+	}
+
+	switch arr := arr.(type) {
+	case *array.Null:
+		return nil, nil //nolint:nilnil
+	case *array.Int8:
+		return int64(arr.Value(i)), nil
+	case *array.Int16:
+		return int64(arr.Value(i)), nil
+	case *array.Int32:
+		return int64(arr.Value(i)), nil
+	case *array.Int64:
+		return arr.Value(i), nil
+	case *array.Uint8:
+		return int64(arr.Value(i)), nil
+	case *array.Uint16:
+		return int64(arr.Value(i)), nil
+	case *array.Uint32:
+		return int64(arr.Value(i)), nil
+	case *array.Uint64:
+		n := arr.Value(i)
+		if n > math.MaxInt64 {
+			return nil, errz.Errorf("value %d overflows int64", n)
+		}
+		return int64(n), nil
+	case *array.Float16:
+		return float64(arr.Value(i).Float32()), nil
+	case *array.Float32:
+		return float64(arr.Value(i)), nil
+	case *array.Float64:
+		return arr.Value(i), nil
+	case *array.Boolean:
+		return arr.Value(i), nil
+	case *array.Binary:
+		return bytes.Clone(arr.Value(i)), nil
+	case *array.LargeBinary:
+		return bytes.Clone(arr.Value(i)), nil
+	case *array.BinaryView:
+		return bytes.Clone(arr.Value(i)), nil
+	case *array.FixedSizeBinary:
+		return bytes.Clone(arr.Value(i)), nil
+	case *array.String:
+		return strings.Clone(arr.Value(i)), nil
+	case *array.LargeString:
+		return strings.Clone(arr.Value(i)), nil
+	case *array.StringView:
+		return strings.Clone(arr.Value(i)), nil
+	case *array.Decimal32:
+		return decimalValue(arr.Value(i), arr.DataType())
+	case *array.Decimal64:
+		return decimalValue(arr.Value(i), arr.DataType())
+	case *array.Decimal128:
+		return decimalValue(arr.Value(i), arr.DataType())
+	case *array.Decimal256:
+		return decimalValue(arr.Value(i), arr.DataType())
+	case *array.Date32:
+		return arr.Value(i).ToTime().Format(time.DateOnly), nil
+	case *array.Date64:
+		return arr.Value(i).ToTime().Format(time.DateOnly), nil
+	case *array.Time32:
+		unit := arr.DataType().(*arrow.Time32Type).Unit
+		return arr.Value(i).ToTime(unit).Format(time.TimeOnly), nil
+	case *array.Time64:
+		unit := arr.DataType().(*arrow.Time64Type).Unit
+		return arr.Value(i).ToTime(unit).Format(time.TimeOnly), nil
+	case *array.Timestamp:
+		unit := arr.DataType().(*arrow.TimestampType).Unit
+		return arr.Value(i).ToTime(unit).UTC(), nil
+	case *array.Dictionary:
+		return Value(arr.Dictionary(), arr.GetValueIndex(i))
+	case array.ExtensionArray:
+		return Value(arr.Storage(), i)
+	default:
+		return nil, errz.Errorf("unsupported type {%s}", arr.DataType())
+	}
+}
+
+// decimalValue returns n, a value of the decimal type dt, as
+// decimal.Decimal.
+func decimalValue[T interface{ ToString(scale int32) string }](n T, dt arrow.DataType) (any, error) {
+	dec, err := decimal.NewFromString(n.ToString(dt.(arrow.DecimalType).GetScale()))
+	if err != nil {
+		return nil, errz.Err(err)
+	}
+	return dec, nil
+}
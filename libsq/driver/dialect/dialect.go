@@ -111,10 +111,14 @@ func (d Dialect) String() string {
 // defaultOps is a map of SLQ operator (e.g. "==" or "!=") to
 // its default SQL rendering.
 var defaultOps = map[string]string{
-	`==`: `=`,
-	`&&`: `AND`,
-	`||`: `OR`,
-	`!=`: `!=`,
+	`==`:          `=`,
+	`&&`:          `AND`,
+	`||`:          `OR`,
+	`!=`:          `!=`,
+	`in`:          `IN`,
+	`not in`:      `NOT IN`,
+	`is null`:     `IS NULL`,
+	`is not null`: `IS NOT NULL`,
 }
 
 // DefaultOps returns a default map of SLQ operator (e.g. "==" or "!=") to
@@ -598,7 +598,13 @@ var coreDrivers = []drivertype.Type{
 	drivertype.DuckDB,
 	drivertype.CSV,
 	drivertype.TSV,
+	drivertype.FixedWidth,
 	drivertype.XLSX,
+	drivertype.ODS,
+	drivertype.Parquet,
+	drivertype.Arrow,
+	drivertype.Avro,
+	drivertype.XML,
 }
 
 // sqlDrivers is a slice of the SQL driver types.
@@ -616,7 +622,13 @@ var sqlDrivers = []drivertype.Type{
 var docDrivers = []drivertype.Type{
 	drivertype.CSV,
 	drivertype.TSV,
+	drivertype.FixedWidth,
 	drivertype.XLSX,
+	drivertype.ODS,
+	drivertype.Parquet,
+	drivertype.Arrow,
+	drivertype.Avro,
+	drivertype.XML,
 }
 
 func TestRegistry_DriversMetadata_All(t *testing.T) {
@@ -0,0 +1,160 @@
+package files
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+
+	"github.com/neilotoole/sq/libsq/core/errz"
+)
+
+// Compression is a compression format of a document source. Files
+// transparently decompresses a compressed source when reading it: see
+// Files.NewReader.
+type Compression string
+
+// Compression formats.
+const (
+	CompressionNone  Compression = ""
+	CompressionGzip  Compression = "gzip"
+	CompressionZstd  Compression = "zstd"
+	CompressionBzip2 Compression = "bzip2"
+	CompressionXZ    Compression = "xz"
+)
+
+// compressionMagicLen is the number of bytes required by DetectCompression
+// to detect every supported compression format.
+const compressionMagicLen = 6
+
+// DetectCompression returns the compression format indicated by the magic
+// number at the start of b, or CompressionNone.
+func DetectCompression(b []byte) Compression {
+	switch {
+	case bytes.HasPrefix(b, []byte{0x1F, 0x8B}):
+		return CompressionGzip
+	case bytes.HasPrefix(b, []byte{0x28, 0xB5, 0x2F, 0xFD}):
+		return CompressionZstd
+	case len(b) >= 4 && string(b[:3]) == "BZh" && b[3] >= '1' && b[3] <= '9':
+		return CompressionBzip2
+	case bytes.HasPrefix(b, []byte("\xFD7zXZ\x00")):
+		return CompressionXZ
+	default:
+		return CompressionNone
+	}
+}
+
+// CompressionFromExt returns the compression format indicated by file
+// extension ext, e.g. ".gz". If ext isn't a compression extension,
+// CompressionNone and false are returned.
+func CompressionFromExt(ext string) (Compression, bool) {
+	switch strings.ToLower(ext) {
+	case ".gz", ".gzip":
+		return CompressionGzip, true
+	case ".zst", ".zstd":
+		return CompressionZstd, true
+	case ".bz2":
+		return CompressionBzip2, true
+	case ".xz":
+		return CompressionXZ, true
+	default:
+		return CompressionNone, false
+	}
+}
+
+// NewDecompressReader returns a reader that decompresses rc, if rc's
+// content starts with the magic number of a supported compression format.
+// Otherwise, the returned reader returns rc's content unchanged. The
+// format is detected by magic number rather than by file extension, so
+// that content that was decompressed in transit (e.g. via HTTP
+// Content-Encoding) is still read correctly. Closing the returned reader
+// closes rc.
+func NewDecompressReader(rc io.ReadCloser) (io.ReadCloser, error) {
+	br := bufio.NewReader(rc)
+	head, err := br.Peek(compressionMagicLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errz.Combine(errz.Err(err), rc.Close())
+	}
+
+	dr := &decompressReader{rc: rc}
+	switch DetectCompression(head) {
+	case CompressionNone:
+		// The peeked bytes are buffered in br, so we read via br.
+		dr.r = br
+	case CompressionGzip:
+		var gzr *gzip.Reader
+		if gzr, err = gzip.NewReader(br); err != nil {
+			return nil, errz.Combine(errz.Wrap(err, "gzip"), rc.Close())
+		}
+		dr.r, dr.closeFn = gzr, gzr.Close
+	case CompressionZstd:
+		var zr *zstd.Decoder
+		if zr, err = zstd.NewReader(br, zstd.WithDecoderConcurrency(1)); err != nil {
+			return nil, errz.Combine(errz.Wrap(err, "zstd"), rc.Close())
+		}
+		dr.r = zr
+		dr.closeFn = func() error {
+			zr.Close()
+			return nil
+		}
+	case CompressionBzip2:
+		dr.r = bzip2.NewReader(br)
+	case CompressionXZ:
+		if dr.r, err = xz.NewReader(br); err != nil {
+			return nil, errz.Combine(errz.Wrap(err, "xz"), rc.Close())
+		}
+	}
+
+	return dr, nil
+}
+
+// NewCompressWriter returns a writer that compresses to w using format c,
+// which must be CompressionGzip or CompressionZstd. The caller must close
+// the returned writer to flush the compressed data; closing it doesn't
+// close w.
+func NewCompressWriter(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errz.Wrap(err, "zstd")
+		}
+		return zw, nil
+	case CompressionNone:
+		return nil, errz.New("no compression format specified")
+	default:
+		return nil, errz.Errorf("compression format not supported for writing: %s", c)
+	}
+}
+
+var _ io.ReadCloser = (*decompressReader)(nil)
+
+// decompressReader is the io.ReadCloser returned by NewDecompressReader.
+type decompressReader struct {
+	r       io.Reader
+	rc      io.ReadCloser
+	closeFn func() error
+}
+
+// Read implements io.Reader.
+func (dr *decompressReader) Read(p []byte) (int, error) {
+	return dr.r.Read(p)
+}
+
+// Close implements io.Closer. It closes the decompressor, if any, and
+// the underlying reader.
+func (dr *decompressReader) Close() error {
+	var err error
+	if dr.closeFn != nil {
+		err = dr.closeFn()
+	}
+	return errz.Combine(err, dr.rc.Close())
+}
@@ -0,0 +1,229 @@
+package files_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/libsq/core/stringz"
+	"github.com/neilotoole/sq/libsq/files"
+	"github.com/neilotoole/sq/libsq/source"
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/proj"
+	"github.com/neilotoole/sq/testh/sakila"
+	"github.com/neilotoole/sq/testh/tu"
+)
+
+// compressedActorFiles are compressed copies of sakila.PathCSVActor.
+var compressedActorFiles = []string{
+	filepath.Join("testdata", "actor.csv.gz"),
+	filepath.Join("testdata", "actor.csv.zst"),
+	filepath.Join("testdata", "actor.csv.bz2"),
+	filepath.Join("testdata", "actor.csv.xz"),
+}
+
+func TestDetectCompression(t *testing.T) {
+	testCases := []struct {
+		head string
+		want files.Compression
+	}{
+		{head: "\x1f\x8b\x08\x00", want: files.CompressionGzip},
+		{head: "\x28\xb5\x2f\xfd\x24", want: files.CompressionZstd},
+		{head: "BZh91AY&SY", want: files.CompressionBzip2},
+		{head: "BZh0", want: files.CompressionNone},
+		{head: "\xfd7zXZ\x00\x00\x04", want: files.CompressionXZ},
+		{head: "\xfd7zXZ", want: files.CompressionNone},
+		{head: "actor_id,first_name", want: files.CompressionNone},
+		{head: "", want: files.CompressionNone},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tu.Name(tc.head), func(t *testing.T) {
+			require.Equal(t, tc.want, files.DetectCompression([]byte(tc.head)))
+		})
+	}
+}
+
+func TestCompressionFromExt(t *testing.T) {
+	testCases := map[string]files.Compression{
+		".gz":   files.CompressionGzip,
+		".GZIP": files.CompressionGzip,
+		".zst":  files.CompressionZstd,
+		".zstd": files.CompressionZstd,
+		".bz2":  files.CompressionBzip2,
+		".xz":   files.CompressionXZ,
+		".csv":  files.CompressionNone,
+		"":      files.CompressionNone,
+	}
+
+	for ext, want := range testCases {
+		got, ok := files.CompressionFromExt(ext)
+		require.Equal(t, want, got, ext)
+		require.Equal(t, want != files.CompressionNone, ok, ext)
+	}
+}
+
+func TestNewDecompressReader(t *testing.T) {
+	want := proj.ReadFile(sakila.PathCSVActor)
+
+	for _, fpath := range compressedActorFiles {
+		t.Run(filepath.Ext(fpath), func(t *testing.T) {
+			f, err := os.Open(fpath)
+			require.NoError(t, err)
+			r, err := files.NewDecompressReader(f)
+			require.NoError(t, err)
+			got, err := readAllAndClose(t, r)
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(got))
+		})
+	}
+
+	t.Run("uncompressed", func(t *testing.T) {
+		for _, s := range []string{"", "a", "a,b,c\n1,2,3\n"} {
+			r, err := files.NewDecompressReader(io.NopCloser(strings.NewReader(s)))
+			require.NoError(t, err)
+			got, err := readAllAndClose(t, r)
+			require.NoError(t, err)
+			require.Equal(t, s, string(got))
+		}
+	})
+
+	t.Run("corrupt", func(t *testing.T) {
+		r, err := files.NewDecompressReader(io.NopCloser(strings.NewReader("\x1f\x8bnot gzip")))
+		if err == nil {
+			_, err = readAllAndClose(t, r)
+		}
+		require.Error(t, err)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		for _, fpath := range compressedActorFiles {
+			data, err := os.ReadFile(fpath)
+			require.NoError(t, err)
+
+			r, err := files.NewDecompressReader(io.NopCloser(bytes.NewReader(data[:len(data)/2])))
+			if err == nil {
+				_, err = io.ReadAll(r)
+				_ = r.Close()
+			}
+			require.Error(t, err, fpath)
+		}
+	})
+}
+
+// TestFiles_Compressed verifies that compressed files are transparently
+// decompressed, and that their type is detected, whether the source is a
+// local file, stdin, or an HTTP download.
+func TestFiles_Compressed(t *testing.T) {
+	want := proj.ReadFile(sakila.PathCSVActor)
+
+	for _, fpath := range compressedActorFiles {
+		data, err := os.ReadFile(fpath)
+		require.NoError(t, err)
+
+		t.Run(filepath.Ext(fpath), func(t *testing.T) {
+			t.Run("file", func(t *testing.T) {
+				ctx, fs := newTestFiles(t)
+				t.Cleanup(func() { assert.NoError(t, fs.Close()) })
+				fs.AddDriverDetectors(testh.DriverDetectors()...)
+
+				// The file extension, e.g. ".csv.gz", indicates the type.
+				typ, err := fs.DetectType(ctx, "@h"+stringz.Uniq8(), proj.Abs("libsq/files/"+fpath))
+				require.NoError(t, err)
+				require.Equal(t, drivertype.CSV, typ)
+
+				// Without an extension, the type is detected from the
+				// decompressed content.
+				noExt := tu.WriteTemp(t, "actor_*", data, false)
+				typ, err = fs.DetectType(ctx, "@h"+stringz.Uniq8(), noExt)
+				require.NoError(t, err)
+				require.Equal(t, drivertype.CSV, typ)
+
+				src := &source.Source{Handle: "@h" + stringz.Uniq8(), Type: drivertype.CSV, Location: noExt}
+				r, err := fs.NewReader(ctx, src, false)
+				require.NoError(t, err)
+				got, err := readAllAndClose(t, r)
+				require.NoError(t, err)
+				require.Equal(t, string(want), string(got))
+			})
+
+			t.Run("stdin", func(t *testing.T) {
+				th := testh.New(t)
+				fs := th.Files()
+
+				f, err := os.Open(fpath)
+				require.NoError(t, err)
+				require.NoError(t, fs.AddStdin(th.Context, f)) // AddStdin closes f
+
+				typ, err := fs.DetectStdinType(th.Context)
+				require.NoError(t, err)
+				require.Equal(t, drivertype.CSV, typ)
+
+				src := &source.Source{Handle: source.StdinHandle, Location: source.StdinHandle}
+				r, err := fs.NewReader(th.Context, src, true)
+				require.NoError(t, err)
+				got, err := readAllAndClose(t, r)
+				require.NoError(t, err)
+				require.Equal(t, string(want), string(got))
+			})
+
+			t.Run("http", func(t *testing.T) {
+				srvr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.Header().Set("Content-Type", "application/octet-stream")
+					_, _ = w.Write(data)
+				}))
+				t.Cleanup(srvr.Close)
+
+				ctx, fs := newTestFiles(t)
+				t.Cleanup(func() { assert.NoError(t, fs.Close()) })
+				src := &source.Source{Handle: "@remote", Type: drivertype.CSV, Location: srvr.URL}
+
+				// The first read streams the download; the second reads
+				// the downloaded file.
+				for i := 0; i < 2; i++ {
+					r, err := fs.NewReader(ctx, src, false)
+					require.NoError(t, err)
+					got, err := readAllAndClose(t, r)
+					require.NoError(t, err)
+					require.Equal(t, string(want), string(got))
+				}
+			})
+		})
+	}
+}
+
+func TestNewCompressWriter(t *testing.T) {
+	want := proj.ReadFile(sakila.PathCSVActor)
+
+	for _, c := range []files.Compression{files.CompressionGzip, files.CompressionZstd} {
+		t.Run(string(c), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w, err := files.NewCompressWriter(buf, c)
+			require.NoError(t, err)
+			_, err = w.Write(want)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+			require.Equal(t, c, files.DetectCompression(buf.Bytes()))
+
+			r, err := files.NewDecompressReader(io.NopCloser(buf))
+			require.NoError(t, err)
+			got, err := readAllAndClose(t, r)
+			require.NoError(t, err)
+			require.Equal(t, string(want), string(got))
+		})
+	}
+
+	for _, c := range []files.Compression{files.CompressionNone, files.CompressionBzip2, files.CompressionXZ} {
+		_, err := files.NewCompressWriter(io.Discard, c)
+		require.Error(t, err, c)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"mime"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -53,14 +54,21 @@ func (fs *Files) DetectType(ctx context.Context, handle, loc string) (drivertype
 		return fields.DriverType, nil
 	}
 
-	if fields.Ext != "" {
+	ext := fields.Ext
+	if _, ok := CompressionFromExt(ext); ok {
+		// A compressed file, e.g. "actor.csv.gz": the content is decompressed
+		// when read, so the inner extension indicates the driver type.
+		ext = filepath.Ext(fields.Name)
+	}
+
+	if ext != "" {
 		// Check DuckDB extensions before falling through to the MIME lookup,
 		// since .duckdb and .ddb have no registered MIME type.
-		if typ, ok := driverFromFileExt(fields.Ext); ok {
+		if typ, ok := driverFromFileExt(ext); ok {
 			return typ, nil
 		}
 
-		mtype := mime.TypeByExtension(fields.Ext)
+		mtype := mime.TypeByExtension(ext)
 		if mtype == "" {
 			log.Debug("unknown mime type", lga.Type, mtype)
 		} else {
@@ -125,7 +133,11 @@ func (fs *Files) detectType(ctx context.Context, handle, loc string) (typ driver
 	var newRdrFn NewReaderFunc
 	if location.TypeOf(loc) == location.TypeFile {
 		newRdrFn = func(_ context.Context) (io.ReadCloser, error) {
-			return errz.Return(os.Open(loc))
+			f, err := os.Open(loc)
+			if err != nil {
+				return nil, errz.Err(err)
+			}
+			return NewDecompressReader(f)
 		}
 	} else {
 		newRdrFn = func(ctx context.Context) (io.ReadCloser, error) {
@@ -224,6 +236,22 @@ func IsDuckDB(b []byte) bool {
 	return len(b) >= 12 && string(b[8:12]) == "DUCK"
 }
 
+// typeAvro is the filetype.Type registered for Avro object container files.
+var typeAvro = filetype.AddType("avro", "application/avro")
+
+// Register the Avro magic-byte matcher with the h2non/filetype library.
+// An Avro object container file starts with the bytes "Obj" followed by
+// the format version 1.
+//
+//nolint:gochecknoglobals
+var _ = filetype.AddMatcher(typeAvro, IsAvro)
+
+// IsAvro reports whether b is the start of an Avro object container file.
+// The file starts with the magic bytes "Obj\x01".
+func IsAvro(b []byte) bool {
+	return len(b) >= 4 && string(b[:4]) == "Obj\x01"
+}
+
 // DetectMagicNumber is a TypeDetectFunc that detects the "magic number"
 // from the start of files.
 func DetectMagicNumber(ctx context.Context, newRdrFn NewReaderFunc,
@@ -264,16 +292,34 @@ func DetectMagicNumber(ctx context.Context, newRdrFn NewReaderFunc,
 		return drivertype.SQLite, 1.0, nil
 	case typeDuckDB:
 		return drivertype.DuckDB, 1.0, nil
+	case typeAvro:
+		return drivertype.Avro, 1.0, nil
 	}
 }
 
 // driverFromFileExt returns the driver type for file extensions that have no
 // registered MIME type (and thus cannot be detected via driverFromMediaType).
-// Currently this covers the DuckDB extensions .duckdb and .ddb.
+// Currently this covers the DuckDB extensions .duckdb and .ddb, the
+// Parquet extension .parquet, the Arrow extensions .arrow, .arrows
+// and .feather, the Avro extension .avro, the YAML extensions .yaml and
+// .yml, and the TOML extension .toml. Note that YAML and TOML are only
+// detected via file extension, because their content (e.g. plain text,
+// or JSON, which is valid YAML) can be ambiguous. For a compressed file,
+// such as "data.parquet.zst", DetectType passes the inner extension.
 func driverFromFileExt(ext string) (typ drivertype.Type, ok bool) {
 	switch strings.ToLower(ext) {
 	case ".duckdb", ".ddb":
 		return drivertype.DuckDB, true
+	case ".parquet":
+		return drivertype.Parquet, true
+	case ".arrow", ".arrows", ".feather":
+		return drivertype.Arrow, true
+	case ".avro":
+		return drivertype.Avro, true
+	case ".yaml", ".yml":
+		return drivertype.YAML, true
+	case ".toml":
+		return drivertype.TOML, true
 	}
 	return drivertype.None, false
 }
@@ -282,7 +328,9 @@ func driverFromFileExt(ext string) (typ drivertype.Type, ok bool) {
 // For example:
 //
 //	xlsx		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	ods			application/vnd.oasis.opendocument.spreadsheet
 //	csv			text/csv
+//	xml			text/xml
 //
 // Note that we don't rely on this function for types such
 // as application/json, because JSON can map to multiple
@@ -291,10 +339,14 @@ func driverFromMediaType(mediatype string) (typ drivertype.Type, ok bool) {
 	switch {
 	case strings.Contains(mediatype, `application/vnd.openxmlformats-officedocument.spreadsheetml.sheet`):
 		return drivertype.XLSX, true
+	case strings.Contains(mediatype, `application/vnd.oasis.opendocument.spreadsheet`):
+		return drivertype.ODS, true
 	case strings.Contains(mediatype, `text/csv`):
 		return drivertype.CSV, true
 	case strings.Contains(mediatype, `text/tab-separated-values`):
 		return drivertype.TSV, true
+	case strings.Contains(mediatype, `text/xml`), strings.Contains(mediatype, `application/xml`):
+		return drivertype.XML, true
 	}
 
 	return drivertype.None, false
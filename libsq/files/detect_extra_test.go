@@ -93,6 +93,34 @@ func TestFiles_DetectType_DuckDBExt(t *testing.T) {
 	}
 }
 
+// TestFiles_DetectType_ArrowExt verifies driverFromFileExt via DetectType
+// for the Arrow extensions .arrow, .arrows and .feather, without opening files.
+func TestFiles_DetectType_ArrowExt(t *testing.T) {
+	for _, loc := range []string{"/no/such/x.arrow", "/no/such/x.arrows", "/no/such/x.FEATHER"} {
+		t.Run(loc, func(t *testing.T) {
+			ctx, fs := newTestFiles(t)
+			t.Cleanup(func() { assert.NoError(t, fs.Close()) })
+			typ, err := fs.DetectType(ctx, "@h"+stringz.Uniq8(), loc)
+			require.NoError(t, err)
+			require.Equal(t, drivertype.Arrow, typ)
+		})
+	}
+}
+
+// TestFiles_DetectType_AvroExt verifies driverFromFileExt via DetectType
+// for the Avro extension .avro, without opening files.
+func TestFiles_DetectType_AvroExt(t *testing.T) {
+	for _, loc := range []string{"/no/such/x.avro", "/no/such/x.AVRO"} {
+		t.Run(loc, func(t *testing.T) {
+			ctx, fs := newTestFiles(t)
+			t.Cleanup(func() { assert.NoError(t, fs.Close()) })
+			typ, err := fs.DetectType(ctx, "@h"+stringz.Uniq8(), loc)
+			require.NoError(t, err)
+			require.Equal(t, drivertype.Avro, typ)
+		})
+	}
+}
+
 // TestFiles_DetectType_NoDetectors verifies that DetectType returns an error
 // when no detectors are registered and the type can't be determined by
 // extension/MIME.
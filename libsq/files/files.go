@@ -260,11 +260,23 @@ func (fs *Files) NewReader(ctx context.Context, src *source.Source, ingesting bo
 // newReader returns a new io.ReadCloser for src.Location. If finalRdr is
 // true, and src is using a streamcache.Stream, that cache is sealed after
 // the reader is created: newReader must not be called again for src in the
-// lifetime of this Files instance.
+// lifetime of this Files instance. If the content is compressed (e.g.
+// gzip), the returned reader decompresses it: see NewDecompressReader.
 func (fs *Files) newReader(ctx context.Context, src *source.Source, finalRdr bool) (io.ReadCloser, error) {
 	log := lg.FromContext(ctx).With(lga.Src, src)
 	lg.Depth(log, slog.LevelDebug, 2, "Invoked Files.NewReader", "final_reader", finalRdr)
 
+	rc, err := fs.newRawReader(ctx, src, finalRdr)
+	if err != nil {
+		return nil, err
+	}
+	return NewDecompressReader(rc)
+}
+
+// newRawReader is like newReader, but the returned reader doesn't
+// decompress the content.
+func (fs *Files) newRawReader(ctx context.Context, src *source.Source, finalRdr bool) (io.ReadCloser, error) {
+	log := lg.FromContext(ctx).With(lga.Src, src)
 	loc := src.Location
 	switch location.TypeOf(loc) {
 	case location.TypeUnknown:
@@ -37,6 +37,7 @@ func TestFiles_DetectType(t *testing.T) {
 		{loc: proj.Abs(testsrc.PathXLSXTestHeader), wantType: drivertype.XLSX},
 		{loc: proj.Abs("drivers/xlsx/testdata/test_header_xlsx"), wantType: drivertype.XLSX},
 		{loc: proj.Abs("drivers/xlsx/testdata/test_noheader.xlsx"), wantType: drivertype.XLSX},
+		{loc: proj.Abs("drivers/ods/testdata/actor.ods"), wantType: drivertype.ODS},
 		{loc: proj.Abs("drivers/csv/testdata/person.csv"), wantType: drivertype.CSV},
 		{loc: proj.Abs("drivers/csv/testdata/person_noheader.csv"), wantType: drivertype.CSV},
 		{loc: proj.Abs("drivers/csv/testdata/person_csv"), wantType: drivertype.CSV},
@@ -47,6 +48,8 @@ func TestFiles_DetectType(t *testing.T) {
 		{loc: proj.Abs("drivers/json/testdata/actor.json"), wantType: drivertype.JSON},
 		{loc: proj.Abs("drivers/json/testdata/actor.jsona"), wantType: drivertype.JSONA},
 		{loc: proj.Abs("drivers/json/testdata/actor.jsonl"), wantType: drivertype.JSONL},
+		{loc: proj.Abs("drivers/json/testdata/flags.yaml"), wantType: drivertype.YAML},
+		{loc: proj.Abs("drivers/json/testdata/inventory.toml"), wantType: drivertype.TOML},
 		{loc: proj.Abs("README.md"), wantType: drivertype.None, wantErr: true},
 	}
 
@@ -90,6 +93,7 @@ func TestFiles_DriverType(t *testing.T) {
 		{loc: proj.Abs(testsrc.PathXLSXTestHeader), wantType: drivertype.XLSX},
 		{loc: proj.Abs("drivers/xlsx/testdata/test_header_xlsx"), wantType: drivertype.XLSX},
 		{loc: sakila.ExcelSubsetURL, wantType: drivertype.XLSX},
+		{loc: proj.Abs("drivers/ods/testdata/actor.ods"), wantType: drivertype.ODS},
 		{loc: proj.Abs(sakila.PathCSVActor), wantType: drivertype.CSV},
 		{loc: proj.Abs("drivers/csv/testdata/person_csv"), wantType: drivertype.CSV},
 		{loc: sakila.ActorCSVURL, wantType: drivertype.CSV},
@@ -98,6 +102,9 @@ func TestFiles_DriverType(t *testing.T) {
 		{loc: proj.Abs("drivers/json/testdata/actor.json"), wantType: drivertype.JSON},
 		{loc: proj.Abs("drivers/json/testdata/actor.jsona"), wantType: drivertype.JSONA},
 		{loc: proj.Abs("drivers/json/testdata/actor.jsonl"), wantType: drivertype.JSONL},
+		{loc: proj.Abs("drivers/json/testdata/flags.yaml"), wantType: drivertype.YAML},
+		{loc: proj.Abs("drivers/json/testdata/inventory.toml"), wantType: drivertype.TOML},
+		{loc: proj.Abs("drivers/xml/testdata/actor.xml"), wantType: drivertype.XML},
 	}
 
 	for _, tc := range testCases {
@@ -137,6 +144,8 @@ func TestDetectMagicNumber(t *testing.T) {
 		{loc: proj.Abs(sakila.PathSL3), wantType: drivertype.SQLite, wantScore: 1.0},
 		{loc: proj.Abs("drivers/sqlite3/testdata/sakila_db"), wantType: drivertype.SQLite, wantScore: 1.0},
 		{loc: proj.Abs(sakila.PathDuck), wantType: drivertype.DuckDB, wantScore: 1.0},
+		{loc: proj.Abs("drivers/avro/testdata/actor.avro"), wantType: drivertype.Avro, wantScore: 1.0},
+		{loc: proj.Abs("drivers/avro/testdata/types.avro"), wantType: drivertype.Avro, wantScore: 1.0},
 	}
 
 	for _, tc := range testCases {
@@ -173,6 +182,16 @@ func TestIsDuckDB(t *testing.T) {
 	require.False(t, files.IsDuckDB(make([]byte, 11)))
 }
 
+func TestIsAvro(t *testing.T) {
+	require.True(t, files.IsAvro([]byte("Obj\x01\x04\x16avro.schema")))
+
+	// Other versions of the format, and short buffers, should not match.
+	require.False(t, files.IsAvro([]byte("Obj\x02")))
+	require.False(t, files.IsAvro([]byte("Obj")))
+	require.False(t, files.IsAvro(nil))
+	require.False(t, files.IsAvro([]byte("SQLite format 3\x00")))
+}
+
 func TestFiles_NewReader(t *testing.T) {
 	ctx := lg.NewContext(context.Background(), lgt.New(t))
 	fpath := sakila.PathCSVActor
@@ -221,6 +240,7 @@ func TestFiles_Stdin(t *testing.T) {
 		{fpath: proj.Abs(sakila.PathCSVActor), wantType: drivertype.CSV},
 		{fpath: proj.Abs(sakila.PathTSVActor), wantType: drivertype.TSV},
 		{fpath: proj.Abs(sakila.PathXLSX), wantType: drivertype.XLSX},
+		{fpath: proj.Abs("drivers/ods/testdata/actor.ods"), wantType: drivertype.ODS},
 		{fpath: proj.Abs(sakila.PathDuck), wantType: drivertype.DuckDB},
 	}
 
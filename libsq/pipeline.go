@@ -457,12 +457,146 @@ func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromCla
 		DBSemver: dbSemverOf(ctx, joinGrip),
 	}
 
-	leftHandle := jc.leftTbl.Handle()
-	// TODO: verify not empty
+	if err = p.prepareScratchCopies(ctx, joinGrip, jc.tables(), jc.leftTbl.Handle()); err != nil {
+		return "", nil, err
+	}
+
+	fromClause, err = rndr.Join(p.rc, jc.leftTbl, jc.joins)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fromClause, joinGrip, nil
+}
+
+// prepareFromNested builds the FROM clause of qm, where qm has nested
+// queries: lets, set operations, a derived table, or subqueries. If the tables
+// of qm and its nested queries all live in the same source, the query is
+// executed natively against that source. Otherwise, the tables are copied
+// into the join scratch DB, just as for a cross-source join, and the query
+// is executed against the scratch DB.
+//
+// On return, pipeline.rc will be set, with frags as its fragments, and
+// frags.From will have been rendered. Note that rendering a derived table
+// may add to frags, e.g. its PreExecStmts.
+func (p *pipeline) prepareFromNested(ctx context.Context, qm *queryModel, frags *render.Fragments) (
+	fromGrip driver.Grip, err error,
+) {
+	tbls := qm.allTables()
+	handles := make([]string, 0, len(tbls))
+	for _, tbl := range tbls {
+		handles = append(handles, tbl.Handle())
+	}
+	handles = lo.Without(lo.Uniq(handles), "")
+	if len(handles) == 0 {
+		return nil, errz.New("query does not specify source, and no active source")
+	}
+
+	srcs := make([]*source.Source, 0, len(handles))
+	for _, handle := range handles {
+		var src *source.Source
+		if src, err = p.qc.Collection.Get(handle); err != nil {
+			return nil, err
+		}
+		srcs = append(srcs, src)
+	}
+
+	if len(srcs) == 1 {
+		fromGrip, err = p.qc.Grips.Open(ctx, srcs[0], p.qc.openModeFor(srcs[0].Handle))
+	} else {
+		fromGrip, err = p.qc.Grips.OpenJoin(ctx, srcs...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	p.rc = &render.Context{
+		Renderer:    fromGrip.SQLDriver().Renderer(),
+		Args:        p.qc.Args,
+		Dialect:     fromGrip.SQLDriver().Dialect(),
+		DBSemver:    dbSemverOf(ctx, fromGrip),
+		Fragments:   frags,
+		SubquerySQL: p.subquerySQLFunc(qm),
+		LetSQL:      p.letSQLFunc(qm),
+	}
+
+	if len(srcs) > 1 {
+		if err = p.prepareScratchCopies(ctx, fromGrip, tbls, handles[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	if frags.From, err = renderFrom(p.rc, qm); err != nil {
+		return nil, err
+	}
 
-	tbls := jc.tables()
+	return fromGrip, nil
+}
+
+// subquerySQLFunc returns a function for use as render.Context.SubquerySQL,
+// which renders the subqueries of qm, recursively.
+func (p *pipeline) subquerySQLFunc(qm *queryModel) func(sub *ast.SubqueryNode) (string, error) {
+	models := map[*ast.SubqueryNode]*queryModel{}
+	for _, sub := range qm.subqueries() {
+		models[sub.Node] = sub.Query
+	}
+
+	return func(sub *ast.SubqueryNode) (string, error) {
+		subQM, ok := models[sub]
+		if !ok {
+			return "", errz.Errorf("no model for subquery: %s", sub.Text())
+		}
+		return p.renderNested(subQM)
+	}
+}
+
+// letSQLFunc returns a function for use as render.Context.LetSQL, which
+// renders the queries of qm's lets.
+func (p *pipeline) letSQLFunc(qm *queryModel) func(let *ast.LetNode) (string, error) {
+	models := map[*ast.LetNode]*queryModel{}
+	for _, let := range qm.Lets {
+		models[let.Node] = let.Query
+	}
+
+	return func(let *ast.LetNode) (string, error) {
+		letQM, ok := models[let]
+		if !ok {
+			return "", errz.Errorf("no model for let: %s", let.Name())
+		}
+		return p.renderNested(letQM)
+	}
+}
+
+// renderFrom renders the FROM clause of qm, which may be a join,
+// a derived table, or empty if qm has no table.
+func renderFrom(rc *render.Context, qm *queryModel) (string, error) {
+	switch {
+	case qm.DerivedTable != nil:
+		s, err := rc.Renderer.Subquery(rc, qm.DerivedTable.Node)
+		if err != nil {
+			return "", err
+		}
+		return "FROM " + s, nil
+	case qm.Table == nil:
+		return "", nil
+	case len(qm.Joins) > 0:
+		return rc.Renderer.Join(rc, qm.Table, qm.Joins)
+	default:
+		return rc.Renderer.FromTable(rc, qm.Table)
+	}
+}
 
-	// Every cross-source join participant is copied into the join scratch
+// prepareScratchCopies adds a task to p.tasks for each of tbls, to copy
+// the table from its source into the scratch DB joinGrip. This is the
+// mechanism underlying cross-source joins and set operations. A table
+// without a handle is copied from the source with defaultHandle.
+//
+// On return, each of tbls will have been modified to refer to its
+// scratch DB copy.
+func (p *pipeline) prepareScratchCopies(ctx context.Context, joinGrip driver.Grip,
+	tbls []*ast.TblSelectorNode, defaultHandle string,
+) error {
+	// Every cross-source participant is copied into the join scratch
 	// DB under a destination name taken from its user alias (if any) or
 	// its bare table name. When two participants resolve to the same
 	// destination, the second CREATE TABLE collides and the rendered
@@ -492,13 +626,11 @@ func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromCla
 		}
 		key := strings.ToLower(tbl.Alias())
 		if used[key] {
-			return "", nil, errz.Errorf("cross-source join: duplicate table alias %q", tbl.Alias())
+			return errz.Errorf("cross-source query: duplicate table alias %q", tbl.Alias())
 		}
 		used[key] = true
 	}
-	// keepsBare keys nodes by pointer identity; relies on jc.tables()
-	// returning the same *ast.TblSelectorNode instances across the loops
-	// below.
+	// keepsBare keys nodes by pointer identity.
 	keepsBare := map[*ast.TblSelectorNode]bool{}
 	for _, tbl := range tbls {
 		if tbl.Alias() != "" {
@@ -526,22 +658,22 @@ func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromCla
 		}
 		used[strings.ToLower(candidate)] = true
 		tbl.SetAlias(candidate)
-		log.Debug("Synthesized cross-source join alias to avoid table-name collision",
+		log.Debug("Synthesized cross-source alias to avoid table-name collision",
 			lga.Handle, tbl.Handle(), lga.From, name, lga.To, candidate)
 	}
 
 	for _, tbl := range tbls {
 		handle := tbl.Handle()
 		if handle == "" {
-			handle = leftHandle
+			handle = defaultHandle
 		}
-		var src *source.Source
-		if src, err = p.qc.Collection.Get(handle); err != nil {
-			return "", nil, err
+		src, err := p.qc.Collection.Get(handle)
+		if err != nil {
+			return err
 		}
-		var db driver.Grip
-		if db, err = p.qc.Grips.Open(ctx, src, p.qc.openModeFor(src.Handle)); err != nil {
-			return "", nil, err
+		db, err := p.qc.Grips.Open(ctx, src, p.qc.openModeFor(src.Handle))
+		if err != nil {
+			return err
 		}
 
 		task := &joinCopyTask{
@@ -558,7 +690,7 @@ func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromCla
 		// source-side qualifiers and would render as
 		// "catalog"."schema"."table" against the scratch DB, which
 		// only knows the bare table name we copied into it. Normalize
-		// the AST table to scratch-local form before rndr.Join below.
+		// the AST table to scratch-local form before rendering.
 		// The copy task above already captured the source-qualified
 		// fromTbl, so this doesn't affect the source-side fetch.
 		if t := tbl.Table(); t.Catalog != "" || t.Schema != "" {
@@ -575,12 +707,7 @@ func (p *pipeline) joinCrossSource(ctx context.Context, jc *joinClause) (fromCla
 	// errgroup limit.
 	p.tasksSingleWriter = joinGrip.SQLDriver().Dialect().SingleWriter
 
-	fromClause, err = rndr.Join(p.rc, jc.leftTbl, jc.joins)
-	if err != nil {
-		return "", nil, err
-	}
-
-	return fromClause, joinGrip, nil
+	return nil
 }
 
 // tasker is the interface for executing a DB task.
@@ -2,7 +2,9 @@ package libsq
 
 import (
 	"context"
+	"strings"
 
+	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/ast/render"
 )
 
@@ -18,6 +20,10 @@ func (p *pipeline) prepare(ctx context.Context, qm *queryModel) error {
 
 	// After this switch, p.rc will be set.
 	switch {
+	case len(qm.nested()) > 0:
+		if p.targetGrip, err = p.prepareFromNested(ctx, qm, frags); err != nil {
+			return err
+		}
 	case qm.Table == nil:
 		if err = p.prepareNoTable(ctx, qm); err != nil {
 			return err
@@ -34,6 +40,47 @@ func (p *pipeline) prepare(ctx context.Context, qm *queryModel) error {
 	}
 
 	p.rc.Fragments = frags
+	if err = p.renderFragments(qm, frags); err != nil {
+		return err
+	}
+
+	if len(qm.SetOps) > 0 {
+		if frags.SetOps, err = p.renderSetOps(qm); err != nil {
+			return err
+		}
+	}
+
+	rndr := p.rc.Renderer
+	if len(qm.Lets) > 0 {
+		lets := make([]*ast.LetNode, len(qm.Lets))
+		for i, let := range qm.Lets {
+			lets[i] = let.Node
+		}
+		if frags.With, err = rndr.With(p.rc, lets); err != nil {
+			return err
+		}
+	}
+
+	for _, fn := range rndr.PreRender {
+		if err = fn(p.rc, frags); err != nil {
+			return err
+		}
+	}
+
+	if p.targetSQL, err = rndr.Render(p.rc, frags); err != nil {
+		return err
+	}
+
+	p.qc.PreExecStmts = append(p.qc.PreExecStmts, frags.PreExecStmts...)
+	p.qc.PostExecStmts = append(p.qc.PostExecStmts, frags.PostExecStmts...)
+
+	return nil
+}
+
+// renderFragments renders the clauses of qm into frags. The FROM
+// clause (frags.From) must already be set.
+func (p *pipeline) renderFragments(qm *queryModel, frags *render.Fragments) error {
+	var err error
 	rndr := p.rc.Renderer
 	if frags.Columns, err = rndr.SelectCols(p.rc, qm.Cols); err != nil {
 		return err
@@ -75,18 +122,121 @@ func (p *pipeline) prepare(ctx context.Context, qm *queryModel) error {
 		}
 	}
 
-	for _, fn := range rndr.PreRender {
-		if err = fn(p.rc, frags); err != nil {
-			return err
+	return nil
+}
+
+// renderSetOps renders qm's set operations, and their right operands,
+// for use as render.Fragments.SetOps. For example:
+//
+//	UNION ALL SELECT "first_name" FROM "actor"
+//
+// Each operand is rendered as a complete query (including any PreRender
+// hooks), but its pre/post exec statements are accumulated into the
+// enclosing query's fragments.
+func (p *pipeline) renderSetOps(qm *queryModel) (string, error) {
+	var (
+		rndr  = p.rc.Renderer
+		frags = p.rc.Fragments
+		// The kinds of the result columns are determined by the left
+		// operand; don't let the right operands clobber them.
+		resultKinds = p.rc.ResultColumnKinds
+		sb          strings.Builder
+	)
+
+	defer func() {
+		p.rc.Fragments = frags
+		p.rc.ResultColumnKinds = resultKinds
+	}()
+
+	for i, setOp := range qm.SetOps {
+		keyword, err := rndr.SetOp(p.rc, setOp.Node)
+		if err != nil {
+			return "", err
+		}
+
+		opFrags := &render.Fragments{}
+		if opFrags.From, err = renderFrom(p.rc, setOp.Operand); err != nil {
+			return "", err
+		}
+
+		p.rc.Fragments = opFrags
+		if err = p.renderFragments(setOp.Operand, opFrags); err != nil {
+			return "", err
 		}
+
+		for _, fn := range rndr.PreRender {
+			if err = fn(p.rc, opFrags); err != nil {
+				return "", err
+			}
+		}
+
+		var operandSQL string
+		if operandSQL, err = rndr.Render(p.rc, opFrags); err != nil {
+			return "", err
+		}
+
+		frags.PreExecStmts = append(frags.PreExecStmts, opFrags.PreExecStmts...)
+		frags.PostExecStmts = append(frags.PostExecStmts, opFrags.PostExecStmts...)
+
+		if i > 0 {
+			sb.WriteRune(' ')
+		}
+		sb.WriteString(keyword)
+		sb.WriteRune(' ')
+		sb.WriteString(operandSQL)
 	}
 
-	if p.targetSQL, err = rndr.Render(p.rc, frags); err != nil {
-		return err
+	return sb.String(), nil
+}
+
+// renderNested renders qm, which is nested in the query being prepared
+// (e.g. a subquery), into a complete SQL statement. Like the right operand
+// of a set operation, qm's pre/post exec statements are accumulated into
+// the enclosing query's fragments.
+func (p *pipeline) renderNested(qm *queryModel) (string, error) {
+	var (
+		rndr  = p.rc.Renderer
+		frags = p.rc.Fragments
+		// The kinds of the result columns are determined by the
+		// enclosing query; don't let qm clobber them.
+		resultKinds = p.rc.ResultColumnKinds
+		err         error
+	)
+
+	defer func() {
+		p.rc.Fragments = frags
+		p.rc.ResultColumnKinds = resultKinds
+	}()
+
+	nestedFrags := &render.Fragments{}
+	if nestedFrags.From, err = renderFrom(p.rc, qm); err != nil {
+		return "", err
 	}
 
-	p.qc.PreExecStmts = append(p.qc.PreExecStmts, frags.PreExecStmts...)
-	p.qc.PostExecStmts = append(p.qc.PostExecStmts, frags.PostExecStmts...)
+	p.rc.Fragments = nestedFrags
+	p.rc.ResultColumnKinds = nil
+	if err = p.renderFragments(qm, nestedFrags); err != nil {
+		return "", err
+	}
 
-	return nil
+	if len(qm.SetOps) > 0 {
+		if nestedFrags.SetOps, err = p.renderSetOps(qm); err != nil {
+			return "", err
+		}
+	}
+
+	for _, fn := range rndr.PreRender {
+		if err = fn(p.rc, nestedFrags); err != nil {
+			return "", err
+		}
+	}
+
+	sql, err := rndr.Render(p.rc, nestedFrags)
+	if err != nil {
+		return "", err
+	}
+
+	frags.PreExecStmts = append(frags.PreExecStmts, nestedFrags.PreExecStmts...)
+	frags.PostExecStmts = append(frags.PostExecStmts, nestedFrags.PostExecStmts...)
+	return sql, nil
 }
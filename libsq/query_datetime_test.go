@@ -5,6 +5,7 @@ import (
 
 	_ "github.com/mattn/go-sqlite3"
 
+	"github.com/neilotoole/sq/libsq/core/kind"
 	"github.com/neilotoole/sq/libsq/source/drivertype"
 	"github.com/neilotoole/sq/testh/sakila"
 )
@@ -39,6 +40,123 @@ func TestQuery_datetime(t *testing.T) {
 			onlyFor:      []drivertype.Type{drivertype.MySQL},
 			wantRecCount: sakila.TblPaymentCount,
 		},
+		{
+			name:    "datetime/now",
+			in:      `@sakila | .actor | where(.actor_id == 1) | now():ts`,
+			wantSQL: `SELECT datetime('now') AS "ts" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.Pg:         `SELECT CURRENT_TIMESTAMP AS "ts" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.DuckDB:     `SELECT CURRENT_TIMESTAMP AS "ts" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.MSSQL:      `SELECT CURRENT_TIMESTAMP AS "ts" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.Oracle:     `SELECT CURRENT_TIMESTAMP AS "TS" FROM "ACTOR" WHERE "ACTOR_ID" = 1`,
+				drivertype.MySQL:      "SELECT CURRENT_TIMESTAMP AS `ts` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT now() AS `ts` FROM `actor` WHERE `actor_id` = 1",
+			},
+			wantRecCount: 1,
+			sinkFns:      []SinkTestFunc{assertSinkColKind(0, kind.Datetime)},
+		},
+		{
+			name: "datetime/date_trunc/group_by",
+			in: `@sakila | .payment | date_trunc("month", .payment_date):month, count` +
+				` | group_by(date_trunc("month", .payment_date))`,
+			wantSQL: `SELECT datetime("payment_date", 'start of month') AS "month", count(*) AS "count" FROM "payment"` +
+				` GROUP BY datetime("payment_date", 'start of month')`,
+			override: driverMap{
+				drivertype.Pg: `SELECT date_trunc('month', "payment_date") AS "month", count(*) AS "count" FROM "payment"` +
+					` GROUP BY date_trunc('month', "payment_date")`,
+				drivertype.DuckDB: `SELECT date_trunc('month', "payment_date") AS "month", count(*) AS "count" FROM "payment"` +
+					` GROUP BY date_trunc('month', "payment_date")`,
+				drivertype.MSSQL: `SELECT DATEADD(month, DATEDIFF(month, 0, "payment_date"), 0) AS "month", count(*) AS "count"` +
+					` FROM "payment" GROUP BY DATEADD(month, DATEDIFF(month, 0, "payment_date"), 0)`,
+				drivertype.Oracle: `SELECT TRUNC("PAYMENT_DATE", 'MM') AS "MONTH", count(*) AS "COUNT" FROM "PAYMENT"` +
+					` GROUP BY TRUNC("PAYMENT_DATE", 'MM')`,
+				drivertype.MySQL: "SELECT CAST(DATE_FORMAT(`payment_date`, '%Y-%m-01 00:00:00') AS DATETIME) AS `month`," +
+					" count(*) AS `count` FROM `payment` GROUP BY CAST(DATE_FORMAT(`payment_date`, '%Y-%m-01 00:00:00') AS DATETIME)",
+				drivertype.ClickHouse: "SELECT date_trunc('month', `payment_date`) AS `month`, count(*) AS `count` FROM `payment`" +
+					" GROUP BY date_trunc('month', `payment_date`)",
+			},
+			// 2005-05, 2005-06, 2005-07, 2005-08, 2006-02
+			wantRecCount: 5,
+		},
+		{
+			name: "datetime/date_trunc/week",
+			in:   `@sakila | .payment | where(.payment_id == 2) | date_trunc("week", .payment_date):wk`,
+			wantSQL: `SELECT datetime("payment_date", 'start of day', '-' || ((CAST(strftime('%w', "payment_date") AS INTEGER) + 6) % 7)` +
+				` || ' days') AS "wk" FROM "payment" WHERE "payment_id" = 2`,
+			override: driverMap{
+				drivertype.Pg:     `SELECT date_trunc('week', "payment_date") AS "wk" FROM "payment" WHERE "payment_id" = 2`,
+				drivertype.DuckDB: `SELECT date_trunc('week', "payment_date") AS "wk" FROM "payment" WHERE "payment_id" = 2`,
+				drivertype.MSSQL: `SELECT DATEADD(day, DATEDIFF(day, 0, "payment_date") / 7 * 7, 0) AS "wk" FROM "payment"` +
+					` WHERE "payment_id" = 2`,
+				drivertype.Oracle: `SELECT TRUNC("PAYMENT_DATE", 'IW') AS "WK" FROM "PAYMENT" WHERE "PAYMENT_ID" = 2`,
+				drivertype.MySQL: "SELECT CAST(DATE(`payment_date`) - INTERVAL WEEKDAY(`payment_date`) DAY AS DATETIME) AS `wk`" +
+					" FROM `payment` WHERE `payment_id` = 2",
+				drivertype.ClickHouse: "SELECT date_trunc('week', `payment_date`) AS `wk` FROM `payment` WHERE `payment_id` = 2",
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "datetime/date_part/where",
+			in:      `@sakila | .payment | where(date_part("year", .payment_date) == 2006)`,
+			wantSQL: `SELECT * FROM "payment" WHERE CAST(strftime('%Y', "payment_date") AS INTEGER) = 2006`,
+			override: driverMap{
+				drivertype.Pg:         `SELECT * FROM "payment" WHERE CAST(EXTRACT(YEAR FROM "payment_date") AS BIGINT) = 2006`,
+				drivertype.DuckDB:     `SELECT * FROM "payment" WHERE CAST(EXTRACT(YEAR FROM "payment_date") AS BIGINT) = 2006`,
+				drivertype.MSSQL:      `SELECT * FROM "payment" WHERE DATEPART(year, "payment_date") = 2006`,
+				drivertype.Oracle:     `SELECT * FROM "PAYMENT" WHERE TO_NUMBER(TO_CHAR("PAYMENT_DATE", 'YYYY')) = 2006`,
+				drivertype.MySQL:      "SELECT * FROM `payment` WHERE EXTRACT(YEAR FROM `payment_date`) = 2006",
+				drivertype.ClickHouse: "SELECT * FROM `payment` WHERE toYear(`payment_date`) = 2006",
+			},
+			wantRecCount: 182,
+		},
+		{
+			name:    "datetime/date_part/dow",
+			in:      `@sakila | .payment | where(.payment_id == 2) | date_part("dow", .payment_date):dow`,
+			wantSQL: `SELECT CAST(strftime('%w', "payment_date") AS INTEGER) AS "dow" FROM "payment" WHERE "payment_id" = 2`,
+			override: driverMap{
+				drivertype.Pg:     `SELECT CAST(EXTRACT(DOW FROM "payment_date") AS BIGINT) AS "dow" FROM "payment" WHERE "payment_id" = 2`,
+				drivertype.DuckDB: `SELECT CAST(EXTRACT(DOW FROM "payment_date") AS BIGINT) AS "dow" FROM "payment" WHERE "payment_id" = 2`,
+				drivertype.MSSQL: `SELECT ((DATEPART(weekday, "payment_date") + @@DATEFIRST - 1) % 7) AS "dow" FROM "payment"` +
+					` WHERE "payment_id" = 2`,
+				drivertype.Oracle: `SELECT MOD(TO_NUMBER(TO_CHAR("PAYMENT_DATE", 'J')) + 1, 7) AS "DOW" FROM "PAYMENT"` +
+					` WHERE "PAYMENT_ID" = 2`,
+				drivertype.MySQL:      "SELECT (DAYOFWEEK(`payment_date`) - 1) AS `dow` FROM `payment` WHERE `payment_id` = 2",
+				drivertype.ClickHouse: "SELECT (toDayOfWeek(`payment_date`) % 7) AS `dow` FROM `payment` WHERE `payment_id` = 2",
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkColKind(0, kind.Int),
+			},
+		},
+		{
+			name:    "datetime/date_add/where",
+			in:      `@sakila | .rental | where(.return_date > date_add(.rental_date, 7, "day"))`,
+			wantSQL: `SELECT * FROM "rental" WHERE "return_date" > datetime("rental_date", 7 || ' days')`,
+			override: driverMap{
+				drivertype.Pg:         `SELECT * FROM "rental" WHERE "return_date" > ("rental_date" + INTERVAL '1 day' * 7)`,
+				drivertype.DuckDB:     `SELECT * FROM "rental" WHERE "return_date" > ("rental_date" + INTERVAL '1 day' * 7)`,
+				drivertype.MSSQL:      `SELECT * FROM "rental" WHERE "return_date" > DATEADD(day, 7, "rental_date")`,
+				drivertype.Oracle:     `SELECT * FROM "RENTAL" WHERE "RETURN_DATE" > ("RENTAL_DATE" + NUMTODSINTERVAL(7, 'DAY'))`,
+				drivertype.MySQL:      "SELECT * FROM `rental` WHERE `return_date` > DATE_ADD(`rental_date`, INTERVAL 7 DAY)",
+				drivertype.ClickHouse: "SELECT * FROM `rental` WHERE `return_date` > addDays(`rental_date`, 7)",
+			},
+			wantRecCount: 4497,
+		},
+		{
+			name:    "datetime/error/invalid_unit",
+			in:      `@sakila | .payment | date_trunc("fortnight", .payment_date)`,
+			wantErr: true,
+		},
+		{
+			name:    "datetime/error/unit_not_literal",
+			in:      `@sakila | .payment | date_part(.payment_id, .payment_date)`,
+			wantErr: true,
+		},
+		{
+			name:    "datetime/error/date_add_missing_unit",
+			in:      `@sakila | .payment | date_add(.payment_date, 7)`,
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
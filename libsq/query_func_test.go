@@ -465,3 +465,217 @@ func TestQuery_func_rownum(t *testing.T) {
 		})
 	}
 }
+
+//nolint:exhaustive,lll
+func TestQuery_func_case(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:    "column/else",
+			in:      `@sakila | .actor | .actor_id, case(.actor_id < 100, "low", "high"):bucket`,
+			wantSQL: `SELECT "actor_id", CASE WHEN "actor_id" < 100 THEN 'low' ELSE 'high' END AS "bucket" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, CASE WHEN `actor_id` < 100 THEN 'low' ELSE 'high' END AS `bucket` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `actor_id`, CASE WHEN `actor_id` < 100 THEN 'low' ELSE 'high' END AS `bucket` FROM `actor`",
+			},
+			wantRecCount: 200,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(1, "bucket"),
+				assertSinkCellValue(0, 1, "low"),
+				assertSinkCellValue(199, 1, "high"),
+			},
+		},
+		{
+			name:    "column/multi_when_no_else",
+			in:      `@sakila | .actor | .actor_id, case(.actor_id < 10, "a", .actor_id < 20, "b"):bucket`,
+			wantSQL: `SELECT "actor_id", CASE WHEN "actor_id" < 10 THEN 'a' WHEN "actor_id" < 20 THEN 'b' END AS "bucket" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, CASE WHEN `actor_id` < 10 THEN 'a' WHEN `actor_id` < 20 THEN 'b' END AS `bucket` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `actor_id`, CASE WHEN `actor_id` < 10 THEN 'a' WHEN `actor_id` < 20 THEN 'b' END AS `bucket` FROM `actor`",
+			},
+			wantRecCount: 200,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 1, "a"),
+				assertSinkCellValue(10, 1, "b"),
+				assertSinkCellValue(199, 1, nil),
+			},
+		},
+		{
+			name:    "where",
+			in:      `@sakila | .actor | where(case(.first_name == "TOM", 1, 0) == 1)`,
+			wantSQL: `SELECT * FROM "actor" WHERE CASE WHEN "first_name" = 'TOM' THEN 1 ELSE 0 END = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE CASE WHEN `first_name` = 'TOM' THEN 1 ELSE 0 END = 1",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE CASE WHEN `first_name` = 'TOM' THEN 1 ELSE 0 END = 1",
+			},
+			wantRecCount: 2,
+		},
+		{
+			name:    "order_by",
+			in:      `@sakila | .actor | order_by(case(.first_name == "TOM", 0, 1), .actor_id-)`,
+			wantSQL: `SELECT * FROM "actor" ORDER BY CASE WHEN "first_name" = 'TOM' THEN 0 ELSE 1 END, "actor_id" DESC`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` ORDER BY CASE WHEN `first_name` = 'TOM' THEN 0 ELSE 1 END, `actor_id` DESC",
+				drivertype.ClickHouse: "SELECT * FROM `actor` ORDER BY CASE WHEN `first_name` = 'TOM' THEN 0 ELSE 1 END, `actor_id` DESC",
+			},
+			wantRecCount: 200,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 1, "TOM"),
+				assertSinkCellValue(1, 1, "TOM"),
+			},
+		},
+		{
+			name:    "order_by/desc",
+			in:      `@sakila | .actor | order_by(case(.first_name == "TOM", 0, 1)-)`,
+			wantSQL: `SELECT * FROM "actor" ORDER BY CASE WHEN "first_name" = 'TOM' THEN 0 ELSE 1 END DESC`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` ORDER BY CASE WHEN `first_name` = 'TOM' THEN 0 ELSE 1 END DESC",
+				drivertype.ClickHouse: "SELECT * FROM `actor` ORDER BY CASE WHEN `first_name` = 'TOM' THEN 0 ELSE 1 END DESC",
+			},
+			wantRecCount: 200,
+		},
+		{
+			name:    "group_by",
+			in:      `@sakila | .actor | case(.actor_id < 100, "low", "high"):bucket, count | group_by(case(.actor_id < 100, "low", "high"))`,
+			wantSQL: `SELECT CASE WHEN "actor_id" < 100 THEN 'low' ELSE 'high' END AS "bucket", count(*) AS "count" FROM "actor" GROUP BY CASE WHEN "actor_id" < 100 THEN 'low' ELSE 'high' END`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT CASE WHEN `actor_id` < 100 THEN 'low' ELSE 'high' END AS `bucket`, count(*) AS `count` FROM `actor` GROUP BY CASE WHEN `actor_id` < 100 THEN 'low' ELSE 'high' END",
+				drivertype.ClickHouse: "SELECT CASE WHEN `actor_id` < 100 THEN 'low' ELSE 'high' END AS `bucket`, count(*) AS `count` FROM `actor` GROUP BY CASE WHEN `actor_id` < 100 THEN 'low' ELSE 'high' END",
+			},
+			wantRecCount: 2,
+		},
+		{
+			name:    "args",
+			in:      `@sakila | .actor | .actor_id, case(.first_name == $name, $yes, "no"):is_tom`,
+			args:    map[string]string{"name": "TOM", "yes": "yes"},
+			wantSQL: `SELECT "actor_id", CASE WHEN "first_name" = 'TOM' THEN 'yes' ELSE 'no' END AS "is_tom" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, CASE WHEN `first_name` = 'TOM' THEN 'yes' ELSE 'no' END AS `is_tom` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `actor_id`, CASE WHEN `first_name` = 'TOM' THEN 'yes' ELSE 'no' END AS `is_tom` FROM `actor`",
+			},
+			wantRecCount: 200,
+		},
+		{
+			name:    "error/too_few_args",
+			in:      `@sakila | .actor | case(.actor_id < 100)`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
+
+//nolint:exhaustive
+func TestQuery_func_cast(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:    "where/text",
+			in:      `@sakila | .actor | where(cast(.actor_id, text) == "1")`,
+			wantSQL: `SELECT * FROM "actor" WHERE CAST("actor_id" AS TEXT) = '1'`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE CAST(`actor_id` AS CHAR) = '1'",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE CAST(`actor_id` AS Nullable(String)) = '1'",
+				drivertype.DuckDB:     `SELECT * FROM "actor" WHERE CAST("actor_id" AS VARCHAR) = '1'`,
+				drivertype.MSSQL:      `SELECT * FROM "actor" WHERE CAST("actor_id" AS NVARCHAR(MAX)) = '1'`,
+				drivertype.Oracle:     `SELECT * FROM "ACTOR" WHERE CAST("ACTOR_ID" AS VARCHAR2(4000)) = '1'`,
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "column/text",
+			in:      `@sakila | .actor | where(.actor_id == 1) | cast(.actor_id, text):id`,
+			wantSQL: `SELECT CAST("actor_id" AS TEXT) AS "id" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT CAST(`actor_id` AS CHAR) AS `id` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT CAST(`actor_id` AS Nullable(String)) AS `id` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.DuckDB:     `SELECT CAST("actor_id" AS VARCHAR) AS "id" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.MSSQL:      `SELECT CAST("actor_id" AS NVARCHAR(MAX)) AS "id" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.Oracle:     `SELECT CAST("ACTOR_ID" AS VARCHAR2(4000)) AS "ID" FROM "ACTOR" WHERE "ACTOR_ID" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(0, "id"),
+				assertSinkColKind(0, kind.Text),
+				assertSinkCellValue(0, 0, "1"),
+			},
+		},
+		{
+			name:    "column/float",
+			in:      `@sakila | .actor | where(.actor_id == 1) | cast(.actor_id, float):id`,
+			wantSQL: `SELECT CAST("actor_id" AS REAL) AS "id" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT CAST(`actor_id` AS DOUBLE) AS `id` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT CAST(`actor_id` AS Nullable(Float64)) AS `id` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.DuckDB:     `SELECT CAST("actor_id" AS DOUBLE) AS "id" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.Pg:         `SELECT CAST("actor_id" AS DOUBLE PRECISION) AS "id" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.MSSQL:      `SELECT CAST("actor_id" AS FLOAT) AS "id" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.Oracle:     `SELECT CAST("ACTOR_ID" AS BINARY_DOUBLE) AS "ID" FROM "ACTOR" WHERE "ACTOR_ID" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkColKind(0, kind.Float),
+				assertSinkCellValue(0, 0, float64(1)),
+			},
+		},
+		{
+			name:    "column/date",
+			in:      `@sakila | .payment | where(.payment_id == 1) | cast(.payment_date, date):day`,
+			wantSQL: `SELECT date("payment_date") AS "day" FROM "payment" WHERE "payment_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT CAST(`payment_date` AS DATE) AS `day` FROM `payment` WHERE `payment_id` = 1",
+				drivertype.ClickHouse: "SELECT CAST(`payment_date` AS Nullable(Date)) AS `day` FROM `payment` WHERE `payment_id` = 1",
+				drivertype.DuckDB:     `SELECT CAST("payment_date" AS DATE) AS "day" FROM "payment" WHERE "payment_id" = 1`,
+				drivertype.Pg:         `SELECT CAST("payment_date" AS DATE) AS "day" FROM "payment" WHERE "payment_id" = 1`,
+				drivertype.MSSQL:      `SELECT CAST("payment_date" AS DATE) AS "day" FROM "payment" WHERE "payment_id" = 1`,
+				drivertype.Oracle:     `SELECT CAST("PAYMENT_DATE" AS DATE) AS "DAY" FROM "PAYMENT" WHERE "PAYMENT_ID" = 1`,
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "column/bool",
+			in:      `@sakila | .actor | where(.actor_id == 1) | cast(.actor_id, bool):b`,
+			wantSQL: `SELECT ("actor_id" <> 0) AS "b" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT CAST(`actor_id` AS SIGNED) AS `b` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT CAST(`actor_id` AS Nullable(Bool)) AS `b` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.DuckDB:     `SELECT CAST("actor_id" AS BOOLEAN) AS "b" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.Pg:         `SELECT CAST("actor_id" AS BOOLEAN) AS "b" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.MSSQL:      `SELECT CAST("actor_id" AS BIT) AS "b" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.Oracle:     `SELECT CAST("ACTOR_ID" AS NUMBER(1,0)) AS "B" FROM "ACTOR" WHERE "ACTOR_ID" = 1`,
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "expr",
+			in:      `@sakila | .actor | where(cast(.actor_id * 2, text) == "4")`,
+			wantSQL: `SELECT * FROM "actor" WHERE CAST("actor_id"*2 AS TEXT) = '4'`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE CAST(`actor_id`*2 AS CHAR) = '4'",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE CAST(`actor_id`*2 AS Nullable(String)) = '4'",
+				drivertype.DuckDB:     `SELECT * FROM "actor" WHERE CAST("actor_id"*2 AS VARCHAR) = '4'`,
+				drivertype.MSSQL:      `SELECT * FROM "actor" WHERE CAST("actor_id"*2 AS NVARCHAR(MAX)) = '4'`,
+				drivertype.Oracle:     `SELECT * FROM "ACTOR" WHERE CAST("ACTOR_ID"*2 AS VARCHAR2(4000)) = '4'`,
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "error/unknown_kind",
+			in:      `@sakila | .actor | cast(.actor_id, huge)`,
+			wantErr: true,
+		},
+		{
+			name:    "error/no_kind",
+			in:      `@sakila | .actor | cast(.actor_id)`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package libsq_test
+
+import (
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_let(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:    "count",
+			in:      `let big = @sakila.payment | where(.amount > 10); .big | count`,
+			wantSQL: `WITH "big" AS (SELECT * FROM "payment" WHERE "amount" > 10) SELECT count(*) AS "count" FROM "big"`,
+			override: driverMap{
+				drivertype.MySQL:      "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10) SELECT count(*) AS `count` FROM `big`",
+				drivertype.ClickHouse: "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10) SELECT count(*) AS `count` FROM `big`",
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "cols",
+			in:      `let big = @sakila.payment | where(.amount > 10); .big | .customer_id, .amount`,
+			wantSQL: `WITH "big" AS (SELECT * FROM "payment" WHERE "amount" > 10) SELECT "customer_id", "amount" FROM "big"`,
+			override: driverMap{
+				drivertype.MySQL:      "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10) SELECT `customer_id`, `amount` FROM `big`",
+				drivertype.ClickHouse: "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10) SELECT `customer_id`, `amount` FROM `big`",
+			},
+			wantRecCount: 114,
+		},
+		{
+			name:    "chained",
+			in:      `let big = @sakila.payment | where(.amount > 10); let bigger = .big | where(.amount > 11); .bigger | .payment_id`,
+			wantSQL: `WITH "big" AS (SELECT * FROM "payment" WHERE "amount" > 10), "bigger" AS (SELECT * FROM "big" WHERE "amount" > 11) SELECT "payment_id" FROM "bigger"`,
+			override: driverMap{
+				drivertype.MySQL:      "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10), `bigger` AS (SELECT * FROM `big` WHERE `amount` > 11) SELECT `payment_id` FROM `bigger`",
+				drivertype.ClickHouse: "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10), `bigger` AS (SELECT * FROM `big` WHERE `amount` > 11) SELECT `payment_id` FROM `bigger`",
+			},
+			wantRecCount: 10,
+		},
+		{
+			name:    "subquery",
+			in:      `let big = @sakila.payment | where(.amount > 10); .customer | where(.customer_id in (.big | .customer_id)) | count`,
+			wantSQL: `WITH "big" AS (SELECT * FROM "payment" WHERE "amount" > 10) SELECT count(*) AS "count" FROM "customer" WHERE "customer_id" IN (SELECT "customer_id" FROM "big")`,
+			override: driverMap{
+				drivertype.MySQL:      "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10) SELECT count(*) AS `count` FROM `customer` WHERE `customer_id` IN (SELECT `customer_id` FROM `big`)",
+				drivertype.ClickHouse: "WITH `big` AS (SELECT * FROM `payment` WHERE `amount` > 10) SELECT count(*) AS `count` FROM `customer` WHERE `customer_id` IN (SELECT `customer_id` FROM `big`)",
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:            "error/trailing_let",
+			in:              `let big = @sakila.payment | where(.amount > 10)`,
+			wantErrContains: "must be followed by a query",
+		},
+		{
+			name:            "error/duplicate_name",
+			in:              `let big = @sakila.payment; let big = .actor; .big`,
+			wantErrContains: "duplicate let name",
+		},
+		{
+			name:            "error/table_name",
+			in:              `let actor = @sakila.actor | where(.actor_id < 3); .actor`,
+			wantErrContains: "a let can't have the same name as a table",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
@@ -2,6 +2,10 @@ package libsq
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/samber/lo"
 
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/errz"
@@ -20,6 +24,101 @@ type queryModel struct {
 	Distinct *ast.UniqueNode
 	Joins    []*ast.JoinNode
 	Cols     []ast.ResultColumn
+	SetOps   []*setOpModel
+
+	// DerivedTable is the query's derived table, which takes the place of
+	// Table, e.g. "(.payment | .amount):t | where(.amount > 10)".
+	DerivedTable *subqueryModel
+
+	// Subqueries are the models of the subqueries nested in the query's
+	// expressions, e.g. in where(). It doesn't include the subqueries of
+	// those subqueries, nor DerivedTable.
+	Subqueries []*subqueryModel
+
+	// Lets are the models of the let statements that precede the query,
+	// which are rendered as common table expressions. Only the top-level
+	// query has lets.
+	Lets []*letModel
+}
+
+// letModel models a let statement, i.e. an ast.LetNode.
+type letModel struct {
+	Node  *ast.LetNode
+	Query *queryModel
+}
+
+// subqueryModel models a nested query, i.e. an ast.SubqueryNode.
+type subqueryModel struct {
+	Node  *ast.SubqueryNode
+	Query *queryModel
+}
+
+// setOpModel models a set operation (e.g. UNION ALL) and its
+// right operand.
+type setOpModel struct {
+	Node    *ast.SetOpNode
+	Operand *queryModel
+}
+
+// tables returns the table selectors of qm's FROM clause: the
+// table, and any joined tables.
+func (qm *queryModel) tables() []*ast.TblSelectorNode {
+	tbls := make([]*ast.TblSelectorNode, 0, len(qm.Joins)+1)
+	if qm.Table != nil {
+		tbls = append(tbls, qm.Table)
+	}
+	for _, join := range qm.Joins {
+		tbls = append(tbls, join.Table())
+	}
+	return tbls
+}
+
+// nested returns the models of the queries nested in qm: the queries of
+// its lets, the right operands of its set operations, its derived table,
+// and its subqueries. Queries nested in those queries are not included.
+func (qm *queryModel) nested() []*queryModel {
+	models := make([]*queryModel, 0, len(qm.Lets)+len(qm.SetOps)+len(qm.Subqueries)+1)
+	for _, let := range qm.Lets {
+		models = append(models, let.Query)
+	}
+	for _, setOp := range qm.SetOps {
+		models = append(models, setOp.Operand)
+	}
+	if qm.DerivedTable != nil {
+		models = append(models, qm.DerivedTable.Query)
+	}
+	for _, sub := range qm.Subqueries {
+		models = append(models, sub.Query)
+	}
+	return models
+}
+
+// allTables returns the table selectors of qm's FROM clause, and of
+// the queries nested in qm, recursively. A table selector that references
+// a let (see ast.TblSelectorNode.Let) is not included: it doesn't refer
+// to a table of a source.
+func (qm *queryModel) allTables() []*ast.TblSelectorNode {
+	tbls := lo.Filter(qm.tables(), func(tbl *ast.TblSelectorNode, _ int) bool {
+		return tbl.Let() == nil
+	})
+	for _, nested := range qm.nested() {
+		tbls = append(tbls, nested.allTables()...)
+	}
+	return tbls
+}
+
+// subqueries returns the subquery models of qm, including its derived
+// table, and those of the queries nested in qm, recursively.
+func (qm *queryModel) subqueries() []*subqueryModel {
+	var subs []*subqueryModel
+	if qm.DerivedTable != nil {
+		subs = append(subs, qm.DerivedTable)
+	}
+	subs = append(subs, qm.Subqueries...)
+	for _, nested := range qm.nested() {
+		subs = append(subs, nested.subqueries()...)
+	}
+	return subs
 }
 
 func (qm *queryModel) String() string {
@@ -53,6 +152,10 @@ func buildQueryModel(qc *QueryContext, a *ast.AST) (*queryModel, error) {
 		}
 	}
 
+	if qm.DerivedTable, err = buildDerivedTableModel(qc, qm, insp); err != nil {
+		return nil, err
+	}
+
 	if len(qm.Joins) > 0 && qm.Table == nil {
 		return nil, errz.Errorf("invalid query: join doesn't have a preceding table selector")
 	}
@@ -105,13 +208,231 @@ func buildQueryModel(qc *QueryContext, a *ast.AST) (*queryModel, error) {
 		return nil, err
 	}
 
+	if qm.SetOps, err = buildSetOpModels(qc, qm, insp); err != nil {
+		return nil, err
+	}
+
+	if qm.Subqueries, err = buildSubqueryModels(qc, insp); err != nil {
+		return nil, err
+	}
+
+	if qm.Lets, err = buildLetModels(qc, a); err != nil {
+		return nil, err
+	}
+	if err = verifyLetNames(qm); err != nil {
+		return nil, err
+	}
+
 	return qm, nil
 }
 
+// buildLetModels builds the models of the let statements of a, if any.
+func buildLetModels(qc *QueryContext, a *ast.AST) ([]*letModel, error) {
+	lets := a.Lets()
+	if len(lets) == 0 {
+		return nil, nil
+	}
+
+	models := make([]*letModel, len(lets))
+	for i, let := range lets {
+		query, err := buildQueryModel(qc, let.Query())
+		if err != nil {
+			return nil, err
+		}
+
+		if query.Table == nil && query.DerivedTable == nil {
+			return nil, errz.Errorf("invalid let %s: doesn't have a table selector: %s",
+				let.Name(), let.Query().Text())
+		}
+
+		models[i] = &letModel{Node: let, Query: query}
+	}
+
+	return models, nil
+}
+
+// buildDerivedTableModel builds the model of qm's derived table, if any.
+// A table in the derived table's query that doesn't specify a handle
+// inherits the handle that precedes the derived table, if any, so that
+// "@sakila | (.payment | .amount):t" stays within @sakila.
+func buildDerivedTableModel(qc *QueryContext, qm *queryModel, insp *ast.Inspector) (*subqueryModel, error) {
+	node := insp.FindDerivedTable()
+	if node == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	if qm.Table != nil {
+		return nil, errz.Errorf("invalid query: derived table %s can't be combined with table %s",
+			node.Text(), qm.Table.Text())
+	}
+
+	if err := inheritHandle(node.Query(), insp.FindFirstHandle()); err != nil {
+		return nil, err
+	}
+
+	query, err := buildQueryModel(qc, node.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Table == nil && query.DerivedTable == nil {
+		return nil, errz.Errorf("invalid derived table: doesn't have a table selector: %s", node.Text())
+	}
+
+	return &subqueryModel{Node: node, Query: query}, nil
+}
+
+// buildSubqueryModels builds the models of the subqueries nested in the
+// expressions of the query. A table in a subquery that doesn't specify a
+// handle inherits the handle of the enclosing query, so that a correlated
+// subquery, such as in:
+//
+//	@sakila | .film:f | where(.length > (.film | where(.rating == .f.rating) | avg(.length)))
+//
+// refers to the same source as the enclosing query.
+func buildSubqueryModels(qc *QueryContext, insp *ast.Inspector) ([]*subqueryModel, error) {
+	nodes, err := insp.FindSubqueries()
+	if err != nil || len(nodes) == 0 {
+		return nil, err
+	}
+
+	handle := insp.FindFirstHandle()
+	models := make([]*subqueryModel, len(nodes))
+	for i, node := range nodes {
+		if err = inheritHandle(node.Query(), handle); err != nil {
+			return nil, err
+		}
+
+		query, err := buildQueryModel(qc, node.Query())
+		if err != nil {
+			return nil, err
+		}
+
+		if query.Table == nil && query.DerivedTable == nil {
+			return nil, errz.Errorf("invalid subquery: doesn't have a table selector: %s", node.Text())
+		}
+
+		models[i] = &subqueryModel{Node: node, Query: query}
+	}
+
+	return models, nil
+}
+
+// verifyLetNames returns an error if the name of one of qm's lets is the
+// same as the name of a table referenced by qm. In SQL, a common table
+// expression hides the table of the same name, so "@sakila.actor" would
+// otherwise silently reference the let named "actor". Names are compared
+// case-insensitively, as most DBs fold unquoted identifiers.
+func verifyLetNames(qm *queryModel) error {
+	if len(qm.Lets) == 0 {
+		return nil
+	}
+
+	tbls := qm.allTables()
+	for _, let := range qm.Lets {
+		for _, tbl := range tbls {
+			if strings.EqualFold(let.Node.Name(), tbl.Table().Table) {
+				return errz.Errorf("invalid let %s: the query also references table %s: "+
+					"a let can't have the same name as a table", let.Node.Name(), tbl.Table().Table)
+			}
+		}
+	}
+	return nil
+}
+
+// inheritHandle sets handle on each top-level table selector of a that
+// doesn't already specify a handle. If handle is empty, this is a no-op.
+func inheritHandle(a *ast.AST, handle string) error {
+	if handle == "" {
+		return nil
+	}
+
+	insp := ast.NewInspector(a)
+	joins, err := insp.FindJoins()
+	if err != nil {
+		return err
+	}
+
+	tbls := []*ast.TblSelectorNode{insp.FindFirstTableSelector()}
+	for _, join := range joins {
+		tbls = append(tbls, join.Table())
+	}
+
+	for _, tbl := range tbls {
+		if tbl != nil && tbl.Handle() == "" && tbl.Let() == nil {
+			tbl.SetHandle(handle)
+		}
+	}
+	return nil
+}
+
+// buildSetOpModels builds the models of qm's set operations, if any. A
+// table in an operand that doesn't specify a handle inherits the handle
+// of qm's table, so that "@sakila | .actor | union(.actor)" stays within
+// @sakila.
+func buildSetOpModels(qc *QueryContext, qm *queryModel, insp *ast.Inspector) ([]*setOpModel, error) {
+	nodes, err := insp.FindSetOps()
+	if err != nil || len(nodes) == 0 {
+		return nil, err
+	}
+
+	if qm.Table == nil {
+		return nil, errz.Errorf("invalid query: %s() doesn't have a preceding table selector", nodes[0].Op())
+	}
+
+	// The segments following the first set operation apply to the combined
+	// result. Only further set operations, order_by(), and a row range are
+	// meaningful there: anything else would silently apply to the left
+	// operand only.
+	segs := qm.AST.Segments()
+	first := slices.Index(segs, nodes[0].Parent().(*ast.SegmentNode))
+	for _, seg := range segs[first+1:] {
+		for _, child := range seg.Children() {
+			switch child.(type) {
+			case *ast.SetOpNode, *ast.OrderByNode, *ast.RowRangeNode:
+			default:
+				return nil, errz.Errorf("invalid query: only order_by() or a row range can follow %s(): %s",
+					nodes[0].Op(), seg.Text())
+			}
+		}
+	}
+
+	models := make([]*setOpModel, len(nodes))
+	for i, node := range nodes {
+		if err = inheritHandle(node.Query(), qm.Table.Handle()); err != nil {
+			return nil, err
+		}
+
+		operand, err := buildQueryModel(qc, node.Query())
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case operand.Table == nil:
+			return nil, errz.Errorf("invalid %s(): operand doesn't have a table selector: %s",
+				node.Op(), node.Text())
+		case len(operand.SetOps) > 0:
+			return nil, errz.Errorf("invalid %s(): nested set operations are not supported: %s",
+				node.Op(), node.Text())
+		case operand.OrderBy != nil:
+			return nil, errz.Errorf("invalid %s(): operand can't have order_by(): "+
+				"instead, apply order_by() after %s()", node.Op(), node.Op())
+		case operand.Range != nil:
+			return nil, errz.Errorf("invalid %s(): operand can't have a row range: "+
+				"instead, apply the row range after %s()", node.Op(), node.Op())
+		}
+
+		models[i] = &setOpModel{Node: node, Operand: operand}
+	}
+
+	return models, nil
+}
+
 // specifyTableFully sets the handle, catalog and schema for tbl, if
-// possible. If tbl is nil, this is a no-op.
+// possible. If tbl is nil, or references a let, this is a no-op.
 func specifyTableFully(qc *QueryContext, tbl *ast.TblSelectorNode) error {
-	if tbl == nil {
+	if tbl == nil || tbl.Let() != nil {
 		return nil
 	}
 
@@ -0,0 +1,128 @@
+package libsq_test
+
+import (
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+// TestQuery_null tests the null predicates "is null" and "is not null",
+// the comparisons with null, and the coalesce and nullif functions.
+//
+//nolint:exhaustive,lll
+func TestQuery_null(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:    "is_null",
+			in:      `@sakila | .rental | where(.return_date is null)`,
+			wantSQL: `SELECT * FROM "rental" WHERE "return_date" IS NULL`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `rental` WHERE `return_date` IS NULL",
+				drivertype.ClickHouse: "SELECT * FROM `rental` WHERE `return_date` IS NULL",
+			},
+			wantRecCount: 183,
+		},
+		{
+			name:    "is_not_null",
+			in:      `@sakila | .rental | where(.return_date is not null)`,
+			wantSQL: `SELECT * FROM "rental" WHERE "return_date" IS NOT NULL`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `rental` WHERE `return_date` IS NOT NULL",
+				drivertype.ClickHouse: "SELECT * FROM `rental` WHERE `return_date` IS NOT NULL",
+			},
+			wantRecCount: 15861,
+		},
+		{
+			name:    "is_not_null/whitespace",
+			in:      `@sakila | .rental | where(.return_date   is   not   null)`,
+			wantSQL: `SELECT * FROM "rental" WHERE "return_date" IS NOT NULL`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `rental` WHERE `return_date` IS NOT NULL",
+				drivertype.ClickHouse: "SELECT * FROM `rental` WHERE `return_date` IS NOT NULL",
+			},
+			wantRecCount: 15861,
+		},
+		{
+			name:    "is_null/compound",
+			in:      `@sakila | .rental | where(.return_date is null && .staff_id == 1)`,
+			wantSQL: `SELECT * FROM "rental" WHERE "return_date" IS NULL AND "staff_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `rental` WHERE `return_date` IS NULL AND `staff_id` = 1",
+				drivertype.ClickHouse: "SELECT * FROM `rental` WHERE `return_date` IS NULL AND `staff_id` = 1",
+			},
+			wantRecCount: 85,
+		},
+		{
+			// A comparison with a null left-hand operand is rendered with the
+			// operands swapped, because IS requires NULL on the right.
+			name:    "eq_null/lhs",
+			in:      `@sakila | .rental | where(null == .return_date)`,
+			wantSQL: `SELECT * FROM "rental" WHERE "return_date" IS NULL`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `rental` WHERE `return_date` IS NULL",
+				drivertype.ClickHouse: "SELECT * FROM `rental` WHERE `return_date` IS NULL",
+			},
+			wantRecCount: 183,
+		},
+		{
+			name:    "ne_null/lhs",
+			in:      `@sakila | .rental | where(null != .return_date)`,
+			wantSQL: `SELECT * FROM "rental" WHERE "return_date" IS NOT NULL`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `rental` WHERE `return_date` IS NOT NULL",
+				drivertype.ClickHouse: "SELECT * FROM `rental` WHERE `return_date` IS NOT NULL",
+			},
+			wantRecCount: 15861,
+		},
+		{
+			name:    "coalesce",
+			in:      `@sakila | .actor | where(.actor_id == 1) | coalesce(null, .first_name, "x"):name`,
+			wantSQL: `SELECT coalesce(NULL, "first_name", 'x') AS "name" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT coalesce(NULL, `first_name`, 'x') AS `name` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT coalesce(NULL, `first_name`, 'x') AS `name` FROM `actor` WHERE `actor_id` = 1",
+			},
+			wantRecCount: 1,
+			sinkFns:      []SinkTestFunc{assertSinkColValue(0, "PENELOPE")},
+		},
+		{
+			name:    "nullif",
+			in:      `@sakila | .actor | where(.actor_id == 1) | nullif(.first_name, "PENELOPE"):name`,
+			wantSQL: `SELECT nullif("first_name", 'PENELOPE') AS "name" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT nullif(`first_name`, 'PENELOPE') AS `name` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT nullif(`first_name`, 'PENELOPE') AS `name` FROM `actor` WHERE `actor_id` = 1",
+			},
+			wantRecCount: 1,
+			sinkFns:      []SinkTestFunc{assertSinkColValue(0, nil)},
+		},
+		{
+			name:    "nullif/is_null",
+			in:      `@sakila | .actor | where(nullif(.first_name, "PENELOPE") is null)`,
+			wantSQL: `SELECT * FROM "actor" WHERE nullif("first_name", 'PENELOPE') IS NULL`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE nullif(`first_name`, 'PENELOPE') IS NULL",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE nullif(`first_name`, 'PENELOPE') IS NULL",
+			},
+			wantRecCount: 4,
+		},
+		{
+			name:            "error/coalesce_one_arg",
+			in:              `@sakila | .actor | coalesce(.first_name)`,
+			wantErrContains: "coalesce() requires at least 2 arguments",
+		},
+		{
+			name:            "error/nullif_three_args",
+			in:              `@sakila | .actor | nullif(.first_name, "a", "b")`,
+			wantErrContains: "nullif() requires exactly 2 argument(s)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
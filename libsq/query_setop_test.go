@@ -0,0 +1,169 @@
+package libsq_test
+
+import (
+	"fmt"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+	"github.com/neilotoole/sq/testh"
+	"github.com/neilotoole/sq/testh/sakila"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_setop(t *testing.T) {
+	// INTERSECT and EXCEPT require MySQL 8.0.31; that version check is
+	// unit-tested in drivers/mysql. ClickHouse's INTERSECT and EXCEPT
+	// don't deduplicate, so the record counts differ.
+	intersectExceptDrivers := []drivertype.Type{
+		drivertype.SQLite, drivertype.DuckDB, drivertype.Pg, drivertype.MSSQL, drivertype.Oracle,
+	}
+
+	testCases := []queryTestCase{
+		{
+			name:    "union",
+			in:      `@sakila | .actor | .first_name | union(.actor | .first_name)`,
+			wantSQL: `SELECT "first_name" FROM "actor" UNION SELECT "first_name" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `first_name` FROM `actor` UNION SELECT `first_name` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `first_name` FROM `actor` UNION DISTINCT SELECT `first_name` FROM `actor`",
+			},
+			wantRecCount: 128,
+		},
+		{
+			name:    "union_all",
+			in:      `@sakila | .actor | .first_name | union_all(.actor | .first_name)`,
+			wantSQL: `SELECT "first_name" FROM "actor" UNION ALL SELECT "first_name" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `first_name` FROM `actor` UNION ALL SELECT `first_name` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `first_name` FROM `actor` UNION ALL SELECT `first_name` FROM `actor`",
+			},
+			wantRecCount: 400,
+		},
+		{
+			name:    "union_all/chained",
+			in:      `@sakila | .actor | union_all(.actor) | union_all(.actor)`,
+			wantSQL: `SELECT * FROM "actor" UNION ALL SELECT * FROM "actor" UNION ALL SELECT * FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` UNION ALL SELECT * FROM `actor` UNION ALL SELECT * FROM `actor`",
+				drivertype.ClickHouse: "SELECT * FROM `actor` UNION ALL SELECT * FROM `actor` UNION ALL SELECT * FROM `actor`",
+			},
+			wantRecCount: 600,
+		},
+		{
+			name:    "union_all/where",
+			in:      `@sakila | .actor | where(.actor_id <= 10) | .actor_id | union_all(.actor | where(.actor_id > 190) | .actor_id)`,
+			wantSQL: `SELECT "actor_id" FROM "actor" WHERE "actor_id" <= 10 UNION ALL SELECT "actor_id" FROM "actor" WHERE "actor_id" > 190`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id` FROM `actor` WHERE `actor_id` <= 10 UNION ALL SELECT `actor_id` FROM `actor` WHERE `actor_id` > 190",
+				drivertype.ClickHouse: "SELECT `actor_id` FROM `actor` WHERE `actor_id` <= 10 UNION ALL SELECT `actor_id` FROM `actor` WHERE `actor_id` > 190",
+			},
+			wantRecCount: 20,
+		},
+		{
+			name:    "union/order_by_range",
+			in:      `@sakila | .actor | .first_name | union(.actor | .first_name) | order_by(.first_name) | .[0:3]`,
+			wantSQL: `SELECT "first_name" FROM "actor" UNION SELECT "first_name" FROM "actor" ORDER BY "first_name" LIMIT 3 OFFSET 0`,
+			override: driverMap{
+				drivertype.MySQL: "SELECT `first_name` FROM `actor` UNION SELECT `first_name` FROM `actor` ORDER BY `first_name` LIMIT 3 OFFSET 0",
+			},
+			onlyFor:      []drivertype.Type{drivertype.SQLite, drivertype.DuckDB, drivertype.Pg, drivertype.MySQL},
+			wantRecCount: 3,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "ADAM"),
+			},
+		},
+		{
+			name:         "intersect",
+			in:           `@sakila | .actor | where(.actor_id <= 100) | .first_name | intersect(.actor | where(.actor_id > 100) | .first_name)`,
+			wantSQL:      `SELECT "first_name" FROM "actor" WHERE "actor_id" <= 100 INTERSECT SELECT "first_name" FROM "actor" WHERE "actor_id" > 100`,
+			onlyFor:      intersectExceptDrivers,
+			wantRecCount: 30,
+		},
+		{
+			name:    "except",
+			in:      `@sakila | .actor | where(.actor_id <= 100) | .first_name | except(.actor | where(.actor_id > 100) | .first_name)`,
+			wantSQL: `SELECT "first_name" FROM "actor" WHERE "actor_id" <= 100 EXCEPT SELECT "first_name" FROM "actor" WHERE "actor_id" > 100`,
+			override: driverMap{
+				drivertype.Oracle: `SELECT "FIRST_NAME" FROM "ACTOR" WHERE "ACTOR_ID" <= 100 MINUS SELECT "FIRST_NAME" FROM "ACTOR" WHERE "ACTOR_ID" > 100`,
+			},
+			onlyFor:      intersectExceptDrivers,
+			wantRecCount: 50,
+		},
+		{
+			name:            "error/operand_order_by",
+			in:              `@sakila | .actor | union(.actor | order_by(.first_name))`,
+			wantErrContains: "operand can't have order_by()",
+		},
+		{
+			name:            "error/operand_range",
+			in:              `@sakila | .actor | union(.actor | .[0:3])`,
+			wantErrContains: "operand can't have a row range",
+		},
+		{
+			name:            "error/cols_after",
+			in:              `@sakila | .actor | union(.actor) | .first_name`,
+			wantErrContains: "only order_by() or a row range can follow union()",
+		},
+		{
+			name:            "error/nested",
+			in:              `@sakila | .actor | union(.actor | union(.actor))`,
+			wantErrContains: "nested set operations are not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
+
+// TestQuery_setop_cross_source tests set operations whose operands live
+// in different sources. The operands are copied into the join scratch
+// DB, and the query is executed there. SL3 and SL3Whitespace both
+// contain an "actor" table, which exercises the renaming of same-named
+// operand tables in the scratch DB.
+func TestQuery_setop_cross_source(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		query     string
+		wantCount int
+	}{
+		{
+			name: "union_all/csv",
+			query: fmt.Sprintf(`%s.actor | .first_name | union_all(%s.data | .first_name)`,
+				sakila.SL3, sakila.CSVActor),
+			wantCount: sakila.TblActorCount * 2,
+		},
+		{
+			name: "union/same_table_name",
+			query: fmt.Sprintf(`%s.actor | .first_name | union(%s.actor | .first_name)`,
+				sakila.SL3, sakila.SL3Whitespace),
+			wantCount: 128,
+		},
+		{
+			name: "union_all/three_sources",
+			query: fmt.Sprintf(
+				`%s.actor | .first_name | union_all(%s.data | .first_name) | union_all(%s.actor | .first_name)`,
+				sakila.SL3, sakila.CSVActor, sakila.SL3Whitespace),
+			wantCount: sakila.TblActorCount * 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			th := testh.New(t)
+			sink, err := th.QuerySLQ(tc.query, nil)
+			require.NoError(t, err)
+			require.Len(t, sink.Recs, tc.wantCount)
+			require.Equal(t, []string{"first_name"}, sink.RecMeta.MungedNames())
+		})
+	}
+}
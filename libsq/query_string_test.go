@@ -5,6 +5,7 @@ import (
 
 	_ "github.com/mattn/go-sqlite3"
 
+	"github.com/neilotoole/sq/libsq/core/kind"
 	"github.com/neilotoole/sq/libsq/source/drivertype"
 )
 
@@ -1186,3 +1187,172 @@ func TestQuery_string_ilike(t *testing.T) {
 		})
 	}
 }
+
+//nolint:exhaustive,lll
+func TestQuery_string_funcs(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:    "lower-upper",
+			in:      `@sakila | .actor | where(.actor_id == 1) | lower(.first_name):first, upper(.last_name):last`,
+			wantSQL: `SELECT lower("first_name") AS "first", upper("last_name") AS "last" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT lower(`first_name`) AS `first`, upper(`last_name`) AS `last` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT lowerUTF8(`first_name`) AS `first`, upperUTF8(`last_name`) AS `last` FROM `actor` WHERE `actor_id` = 1",
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "penelope"),
+				assertSinkCellValue(0, 1, "GUINESS"),
+			},
+		},
+		{
+			name:    "trim",
+			in:      `@sakila | .actor | where(.actor_id == 1) | trim(.first_name):first`,
+			wantSQL: `SELECT trim("first_name") AS "first" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT trim(`first_name`) AS `first` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT trim(`first_name`) AS `first` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.MSSQL:      `SELECT LTRIM(RTRIM("first_name")) AS "first" FROM "actor" WHERE "actor_id" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "PENELOPE"),
+			},
+		},
+		{
+			name:    "substr/start-len",
+			in:      `@sakila | .actor | where(.actor_id == 1) | substr(.last_name, 2, 3):sub`,
+			wantSQL: `SELECT substr("last_name", 2, 3) AS "sub" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT substr(`last_name`, 2, 3) AS `sub` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT substringUTF8(`last_name`, 2, 3) AS `sub` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.MSSQL:      `SELECT SUBSTRING("last_name", 2, 3) AS "sub" FROM "actor" WHERE "actor_id" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "UIN"),
+			},
+		},
+		{
+			name:    "substr/start",
+			in:      `@sakila | .actor | where(.actor_id == 1) | substr(.last_name, 3):sub`,
+			wantSQL: `SELECT substr("last_name", 3) AS "sub" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT substr(`last_name`, 3) AS `sub` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT substringUTF8(`last_name`, 3) AS `sub` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.MSSQL:      `SELECT SUBSTRING("last_name", 3, 2147483647) AS "sub" FROM "actor" WHERE "actor_id" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "INESS"),
+			},
+		},
+		{
+			name:    "replace",
+			in:      `@sakila | .actor | where(.actor_id == 1) | replace(.first_name, "E", "3"):first`,
+			wantSQL: `SELECT replace("first_name", 'E', '3') AS "first" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT replace(`first_name`, 'E', '3') AS `first` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT replaceAll(`first_name`, 'E', '3') AS `first` FROM `actor` WHERE `actor_id` = 1",
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "P3N3LOP3"),
+			},
+		},
+		{
+			name:    "length/where",
+			in:      `@sakila | .actor | where(length(.first_name) == 2)`,
+			wantSQL: `SELECT * FROM "actor" WHERE length("first_name") = 2`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE CHAR_LENGTH(`first_name`) = 2",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE lengthUTF8(`first_name`) = 2",
+				drivertype.MSSQL:      `SELECT * FROM "actor" WHERE (LEN(CAST("first_name" AS NVARCHAR(MAX)) + N'x') - 1) = 2`,
+			},
+			wantRecCount: 4,
+		},
+		{
+			name:    "length/column",
+			in:      `@sakila | .actor | where(.actor_id == 1) | length(.first_name):len`,
+			wantSQL: `SELECT length("first_name") AS "len" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT CHAR_LENGTH(`first_name`) AS `len` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT lengthUTF8(`first_name`) AS `len` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.MSSQL:      `SELECT (LEN(CAST("first_name" AS NVARCHAR(MAX)) + N'x') - 1) AS "len" FROM "actor" WHERE "actor_id" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkColKind(0, kind.Int),
+				assertSinkCellValue(0, 0, int64(8)),
+			},
+		},
+		{
+			// Trailing whitespace is counted on every DB. Note that SQL
+			// Server's LEN alone would ignore it, and return 1.
+			name:    "length/trailing-whitespace",
+			in:      `@sakila | .actor | where(.actor_id == 1) | length("a  "):len`,
+			wantSQL: `SELECT length('a  ') AS "len" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT CHAR_LENGTH('a  ') AS `len` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT lengthUTF8('a  ') AS `len` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.MSSQL:      `SELECT (LEN(CAST('a  ' AS NVARCHAR(MAX)) + N'x') - 1) AS "len" FROM "actor" WHERE "actor_id" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, int64(3)),
+			},
+		},
+		{
+			name:    "concat",
+			in:      `@sakila | .actor | where(.actor_id == 1) | concat(.first_name, " ", lower(.last_name)):name`,
+			wantSQL: `SELECT concat("first_name", ' ', lower("last_name")) AS "name" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.SQLite:     `SELECT (coalesce("first_name", '') || coalesce(' ', '') || coalesce(lower("last_name"), '')) AS "name" FROM "actor" WHERE "actor_id" = 1`,
+				drivertype.MySQL:      "SELECT CONCAT_WS('', `first_name`, ' ', lower(`last_name`)) AS `name` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.ClickHouse: "SELECT concat(coalesce(toString(`first_name`), ''), coalesce(toString(' '), ''), coalesce(toString(lowerUTF8(`last_name`)), '')) AS `name` FROM `actor` WHERE `actor_id` = 1",
+				drivertype.Oracle:     `SELECT ("FIRST_NAME" || ' ' || lower("LAST_NAME")) AS "NAME" FROM "ACTOR" WHERE "ACTOR_ID" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "PENELOPE guiness"),
+			},
+		},
+		{
+			// NULL args are treated as the empty string.
+			name:    "concat/null",
+			in:      `@sakila | .address | where(.address_id == 1) | concat(.address2, "x"):c`,
+			wantSQL: `SELECT concat("address2", 'x') AS "c" FROM "address" WHERE "address_id" = 1`,
+			override: driverMap{
+				drivertype.SQLite:     `SELECT (coalesce("address2", '') || coalesce('x', '')) AS "c" FROM "address" WHERE "address_id" = 1`,
+				drivertype.MySQL:      "SELECT CONCAT_WS('', `address2`, 'x') AS `c` FROM `address` WHERE `address_id` = 1",
+				drivertype.ClickHouse: "SELECT concat(coalesce(toString(`address2`), ''), coalesce(toString('x'), '')) AS `c` FROM `address` WHERE `address_id` = 1",
+				drivertype.Oracle:     `SELECT ("ADDRESS2" || 'x') AS "C" FROM "ADDRESS" WHERE "ADDRESS_ID" = 1`,
+			},
+			wantRecCount: 1,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 0, "x"),
+			},
+		},
+		{
+			name:            "error/concat-one-arg",
+			in:              `@sakila | .actor | concat(.first_name):name`,
+			wantErrContains: "concat() requires at least 2 arguments",
+		},
+		{
+			name:            "error/substr-one-arg",
+			in:              `@sakila | .actor | substr(.first_name):name`,
+			wantErrContains: "substr() requires 2 or 3 arguments",
+		},
+		{
+			name:            "error/lower-two-args",
+			in:              `@sakila | .actor | lower(.first_name, .last_name):name`,
+			wantErrContains: "lower() requires exactly 1 argument",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package libsq_test
+
+import (
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_subquery(t *testing.T) {
+	// ClickHouse doesn't support correlated subqueries.
+	correlatedDrivers := []drivertype.Type{
+		drivertype.SQLite, drivertype.DuckDB, drivertype.Pg, drivertype.MySQL, drivertype.MSSQL, drivertype.Oracle,
+	}
+
+	testCases := []queryTestCase{
+		{
+			name:    "in",
+			in:      `@sakila | .actor | where(.actor_id in (.film_actor | where(.film_id == 1) | .actor_id))`,
+			wantSQL: `SELECT * FROM "actor" WHERE "actor_id" IN (SELECT "actor_id" FROM "film_actor" WHERE "film_id" = 1)`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE `actor_id` IN (SELECT `actor_id` FROM `film_actor` WHERE `film_id` = 1)",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE `actor_id` IN (SELECT `actor_id` FROM `film_actor` WHERE `film_id` = 1)",
+			},
+			wantRecCount: 10,
+		},
+		{
+			name:    "not_in",
+			in:      `@sakila | .customer | where(.customer_id not in (.payment | where(.amount > 10) | .customer_id))`,
+			wantSQL: `SELECT * FROM "customer" WHERE "customer_id" NOT IN (SELECT "customer_id" FROM "payment" WHERE "amount" > 10)`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `customer` WHERE `customer_id` NOT IN (SELECT `customer_id` FROM `payment` WHERE `amount` > 10)",
+				drivertype.ClickHouse: "SELECT * FROM `customer` WHERE `customer_id` NOT IN (SELECT `customer_id` FROM `payment` WHERE `amount` > 10)",
+			},
+			wantRecCount: 492,
+		},
+		{
+			name:    "scalar",
+			in:      `@sakila | .film | where(.length == (.film | max(.length)))`,
+			wantSQL: `SELECT * FROM "film" WHERE "length" = (SELECT max("length") AS "max(.length)" FROM "film")`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `film` WHERE `length` = (SELECT max(`length`) AS `max(.length)` FROM `film`)",
+				drivertype.ClickHouse: "SELECT * FROM `film` WHERE `length` = (SELECT max(`length`) AS `max(.length)` FROM `film`)",
+			},
+			wantRecCount: 10,
+		},
+		{
+			name:    "correlated",
+			in:      `@sakila | .film:f | where(.length == (.film | where(.rating == .f.rating) | max(.length)))`,
+			wantSQL: `SELECT * FROM "film" AS "f" WHERE "length" = (SELECT max("length") AS "max(.length)" FROM "film" WHERE "rating" = "f"."rating")`,
+			override: driverMap{
+				drivertype.MySQL: "SELECT * FROM `film` AS `f` WHERE `length` = (SELECT max(`length`) AS `max(.length)` FROM `film` WHERE `rating` = `f`.`rating`)",
+			},
+			onlyFor:      correlatedDrivers,
+			wantRecCount: 14,
+		},
+		{
+			name:    "correlated/column",
+			in:      `@sakila | .actor | where(.actor_id == 1) | .first_name, (.film_actor | where(.actor_id == .actor.actor_id) | count):films`,
+			wantSQL: `SELECT "first_name", (SELECT count(*) AS "count" FROM "film_actor" WHERE "actor_id" = "actor"."actor_id") AS "films" FROM "actor" WHERE "actor_id" = 1`,
+			override: driverMap{
+				drivertype.MySQL: "SELECT `first_name`, (SELECT count(*) AS `count` FROM `film_actor` WHERE `actor_id` = `actor`.`actor_id`) AS `films` FROM `actor` WHERE `actor_id` = 1",
+			},
+			onlyFor:      correlatedDrivers,
+			wantRecCount: 1,
+		},
+		{
+			name:    "derived_table",
+			in:      `@sakila | (.payment | group_by(.customer_id) | .customer_id, sum(.amount):total):t | where(.total > 150)`,
+			wantSQL: `SELECT * FROM (SELECT "customer_id", sum("amount") AS "total" FROM "payment" GROUP BY "customer_id") AS "t" WHERE "total" > 150`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM (SELECT `customer_id`, sum(`amount`) AS `total` FROM `payment` GROUP BY `customer_id`) AS `t` WHERE `total` > 150",
+				drivertype.ClickHouse: "SELECT * FROM (SELECT `customer_id`, sum(`amount`) AS `total` FROM `payment` GROUP BY `customer_id`) AS `t` WHERE `total` > 150",
+				drivertype.Oracle:     `SELECT * FROM (SELECT "CUSTOMER_ID", sum("AMOUNT") AS "TOTAL" FROM "PAYMENT" GROUP BY "CUSTOMER_ID") "T" WHERE "TOTAL" > 150`,
+			},
+			wantRecCount: 46,
+		},
+		{
+			name:    "derived_table/nested_subquery",
+			in:      `@sakila | (.actor | where(.actor_id in (.film_actor | where(.film_id == 1) | .actor_id)) | .actor_id, .first_name):t | .first_name`,
+			wantSQL: `SELECT "first_name" FROM (SELECT "actor_id", "first_name" FROM "actor" WHERE "actor_id" IN (SELECT "actor_id" FROM "film_actor" WHERE "film_id" = 1)) AS "t"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `first_name` FROM (SELECT `actor_id`, `first_name` FROM `actor` WHERE `actor_id` IN (SELECT `actor_id` FROM `film_actor` WHERE `film_id` = 1)) AS `t`",
+				drivertype.ClickHouse: "SELECT `first_name` FROM (SELECT `actor_id`, `first_name` FROM `actor` WHERE `actor_id` IN (SELECT `actor_id` FROM `film_actor` WHERE `film_id` = 1)) AS `t`",
+				drivertype.Oracle:     `SELECT "FIRST_NAME" FROM (SELECT "ACTOR_ID", "FIRST_NAME" FROM "ACTOR" WHERE "ACTOR_ID" IN (SELECT "ACTOR_ID" FROM "FILM_ACTOR" WHERE "FILM_ID" = 1)) "T"`,
+			},
+			wantRecCount: 10,
+		},
+		{
+			// MySQL doesn't support LIMIT in an IN subquery, but it
+			// does in a derived table.
+			name:            "error/mysql_in_range",
+			in:              `@sakila | .actor | where(.actor_id in (.film_actor | .actor_id | .[0:3]))`,
+			onlyFor:         []drivertype.Type{drivertype.MySQL},
+			wantErrContains: "row range in operand of in is not supported",
+		},
+		{
+			name:            "error/derived_table_no_alias",
+			in:              `@sakila | (.payment | .customer_id, .amount)`,
+			wantErrContains: "derived table must have an alias",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
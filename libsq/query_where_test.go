@@ -71,6 +71,67 @@ func TestQuery_where(t *testing.T) {
 			},
 			wantRecCount: 101,
 		},
+		{
+			name:    "operator/in",
+			in:      `@sakila | .actor | where(.actor_id in (1, 2, 3))`,
+			wantSQL: `SELECT * FROM "actor" WHERE "actor_id" IN (1, 2, 3)`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE `actor_id` IN (1, 2, 3)",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE `actor_id` IN (1, 2, 3)",
+			},
+			wantRecCount: 3,
+		},
+		{
+			name:    "operator/in/single",
+			in:      `@sakila | .actor | where(.actor_id in (100))`,
+			wantSQL: `SELECT * FROM "actor" WHERE "actor_id" IN (100)`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE `actor_id` IN (100)",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE `actor_id` IN (100)",
+			},
+			wantRecCount: 1,
+		},
+		{
+			name:    "operator/in/string",
+			in:      `@sakila | .actor | where(.first_name in ("TOM", "NICK"))`,
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" IN ('TOM', 'NICK')`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE `first_name` IN ('TOM', 'NICK')",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE `first_name` IN ('TOM', 'NICK')",
+			},
+			wantRecCount: 5,
+		},
+		{
+			name:    "operator/not_in",
+			in:      `@sakila | .actor | where(.actor_id not in (1, 2, 3))`,
+			wantSQL: `SELECT * FROM "actor" WHERE "actor_id" NOT IN (1, 2, 3)`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE `actor_id` NOT IN (1, 2, 3)",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE `actor_id` NOT IN (1, 2, 3)",
+			},
+			wantRecCount: 197,
+		},
+		{
+			name:    "operator/in/args",
+			in:      `@sakila | .actor | where(.first_name in ($a, $b))`,
+			args:    map[string]string{"a": "TOM", "b": "NICK"},
+			wantSQL: `SELECT * FROM "actor" WHERE "first_name" IN ('TOM', 'NICK')`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE `first_name` IN ('TOM', 'NICK')",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE `first_name` IN ('TOM', 'NICK')",
+			},
+			wantRecCount: 5,
+		},
+		{
+			name:    "operator/in/compound",
+			in:      `@sakila | .actor | where(.actor_id in (1, 2, 3) && .first_name != "NICK")`,
+			wantSQL: `SELECT * FROM "actor" WHERE "actor_id" IN (1, 2, 3) AND "first_name" != 'NICK'`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT * FROM `actor` WHERE `actor_id` IN (1, 2, 3) AND `first_name` != 'NICK'",
+				drivertype.ClickHouse: "SELECT * FROM `actor` WHERE `actor_id` IN (1, 2, 3) AND `first_name` != 'NICK'",
+			},
+			wantRecCount: 2,
+		},
 		{
 			name:    "error/no-args",
 			in:      `@sakila | .actor | where()`,
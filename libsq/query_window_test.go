@@ -0,0 +1,105 @@
+package libsq_test
+
+import (
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/neilotoole/sq/libsq/source/drivertype"
+)
+
+//nolint:exhaustive,lll
+func TestQuery_window(t *testing.T) {
+	testCases := []queryTestCase{
+		{
+			name:    "rank",
+			in:      `@sakila | .actor | .actor_id, rank() over(order_by(.first_name)):rnk`,
+			wantSQL: `SELECT "actor_id", rank() OVER (ORDER BY "first_name") AS "rnk" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, rank() OVER (ORDER BY `first_name`) AS `rnk` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `actor_id`, rank() OVER (ORDER BY `first_name`) AS `rnk` FROM `actor`",
+			},
+			wantRecCount: 200,
+			sinkFns: []SinkTestFunc{
+				assertSinkColName(1, "rnk"),
+			},
+		},
+		{
+			name:    "dense_rank/desc",
+			in:      `@sakila | .actor | .actor_id, dense_rank() over(order_by(.first_name-, .last_name)):rnk`,
+			wantSQL: `SELECT "actor_id", dense_rank() OVER (ORDER BY "first_name" DESC, "last_name") AS "rnk" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, dense_rank() OVER (ORDER BY `first_name` DESC, `last_name`) AS `rnk` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `actor_id`, dense_rank() OVER (ORDER BY `first_name` DESC, `last_name`) AS `rnk` FROM `actor`",
+			},
+			wantRecCount: 200,
+		},
+		{
+			name:    "sum/partition_order",
+			in:      `@sakila | .payment | .customer_id, .amount, sum(.amount) over(partition_by(.customer_id), order_by(.payment_date)):running`,
+			wantSQL: `SELECT "customer_id", "amount", sum("amount") OVER (PARTITION BY "customer_id" ORDER BY "payment_date") AS "running" FROM "payment"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `customer_id`, `amount`, sum(`amount`) OVER (PARTITION BY `customer_id` ORDER BY `payment_date`) AS `running` FROM `payment`",
+				drivertype.ClickHouse: "SELECT `customer_id`, `amount`, sum(`amount`) OVER (PARTITION BY `customer_id` ORDER BY `payment_date`) AS `running` FROM `payment`",
+			},
+			wantRecCount: 16049,
+		},
+		{
+			name:    "sum/empty_over",
+			in:      `@sakila | .actor | .actor_id, sum(.actor_id) over():total`,
+			wantSQL: `SELECT "actor_id", sum("actor_id") OVER () AS "total" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, sum(`actor_id`) OVER () AS `total` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `actor_id`, sum(`actor_id`) OVER () AS `total` FROM `actor`",
+			},
+			wantRecCount: 200,
+		},
+		{
+			name:    "count/partition",
+			in:      `@sakila | .actor | .actor_id, count() over(partition_by(.last_name)):n`,
+			wantSQL: `SELECT "actor_id", count(*) OVER (PARTITION BY "last_name") AS "n" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, count(*) OVER (PARTITION BY `last_name`) AS `n` FROM `actor`",
+				drivertype.ClickHouse: "SELECT `actor_id`, count(*) OVER (PARTITION BY `last_name`) AS `n` FROM `actor`",
+			},
+			wantRecCount: 200,
+		},
+		{
+			name:    "lag",
+			in:      `@sakila | .actor | .actor_id, lag(.first_name) over(order_by(.actor_id)):prev`,
+			wantSQL: `SELECT "actor_id", lag("first_name") OVER (ORDER BY "actor_id") AS "prev" FROM "actor"`,
+			override: driverMap{
+				drivertype.MySQL: "SELECT `actor_id`, lag(`first_name`) OVER (ORDER BY `actor_id`) AS `prev` FROM `actor`",
+			},
+			// ClickHouse doesn't implement lag(); it has lagInFrame()
+			// instead, which has different frame semantics.
+			onlyFor:      []drivertype.Type{drivertype.SQLite, drivertype.DuckDB, drivertype.Pg, drivertype.MySQL, drivertype.MSSQL},
+			wantRecCount: 200,
+			sinkFns: []SinkTestFunc{
+				assertSinkCellValue(0, 1, nil),
+				assertSinkCellValue(1, 1, "PENELOPE"),
+			},
+		},
+		{
+			name:    "with_query_order_by",
+			in:      `@sakila | .actor | .actor_id, rank() over(order_by(.first_name)):rnk | order_by(.actor_id)`,
+			wantSQL: `SELECT "actor_id", rank() OVER (ORDER BY "first_name") AS "rnk" FROM "actor" ORDER BY "actor_id"`,
+			override: driverMap{
+				drivertype.MySQL:      "SELECT `actor_id`, rank() OVER (ORDER BY `first_name`) AS `rnk` FROM `actor` ORDER BY `actor_id`",
+				drivertype.ClickHouse: "SELECT `actor_id`, rank() OVER (ORDER BY `first_name`) AS `rnk` FROM `actor` ORDER BY `actor_id`",
+			},
+			wantRecCount: 200,
+		},
+		{
+			name:            "error/rank_without_over",
+			in:              `@sakila | .actor | rank()`,
+			wantErrContains: "must be followed by over",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			execQueryTestCase(t, tc)
+		})
+	}
+}
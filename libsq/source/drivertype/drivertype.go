@@ -47,6 +47,10 @@ const (
 	// TSV is for Tab-Separated Values.
 	TSV = Type("tsv")
 
+	// FixedWidth is for fixed-width text, in which each column occupies
+	// the same character positions of each line.
+	FixedWidth = Type("fixedwidth")
+
 	// JSON is for plain-old JSON.
 	JSON = Type("json")
 
@@ -56,6 +60,27 @@ const (
 	// JSONL is for JSON Lines, aka ndjson (newline-delimited).
 	JSONL = Type("jsonl")
 
+	// YAML is for YAML documents, including multi-document streams.
+	YAML = Type("yaml")
+
+	// TOML is for TOML documents.
+	TOML = Type("toml")
+
 	// XLSX is for Microsoft Excel spreadsheets.
 	XLSX = Type("xlsx")
+
+	// ODS is for OpenDocument Spreadsheet documents.
+	ODS = Type("ods")
+
+	// Parquet is for Apache Parquet files.
+	Parquet = Type("parquet")
+
+	// Arrow is for Apache Arrow IPC (and Feather) files.
+	Arrow = Type("arrow")
+
+	// Avro is for Apache Avro object container files.
+	Avro = Type("avro")
+
+	// XML is for generic XML documents.
+	XML = Type("xml")
 )
@@ -22,10 +22,18 @@ func TestType_String(t *testing.T) {
 		{drivertype.ClickHouse, "clickhouse"},
 		{drivertype.CSV, "csv"},
 		{drivertype.TSV, "tsv"},
+		{drivertype.FixedWidth, "fixedwidth"},
 		{drivertype.JSON, "json"},
 		{drivertype.JSONA, "jsona"},
 		{drivertype.JSONL, "jsonl"},
+		{drivertype.YAML, "yaml"},
+		{drivertype.TOML, "toml"},
 		{drivertype.XLSX, "xlsx"},
+		{drivertype.ODS, "ods"},
+		{drivertype.Parquet, "parquet"},
+		{drivertype.Arrow, "arrow"},
+		{drivertype.Avro, "avro"},
+		{drivertype.XML, "xml"},
 	}
 
 	for _, tc := range testCases {
@@ -48,10 +56,18 @@ func TestType_Constants(t *testing.T) {
 	require.Equal(t, drivertype.Type("clickhouse"), drivertype.ClickHouse)
 	require.Equal(t, drivertype.Type("csv"), drivertype.CSV)
 	require.Equal(t, drivertype.Type("tsv"), drivertype.TSV)
+	require.Equal(t, drivertype.Type("fixedwidth"), drivertype.FixedWidth)
 	require.Equal(t, drivertype.Type("json"), drivertype.JSON)
 	require.Equal(t, drivertype.Type("jsona"), drivertype.JSONA)
 	require.Equal(t, drivertype.Type("jsonl"), drivertype.JSONL)
+	require.Equal(t, drivertype.Type("yaml"), drivertype.YAML)
+	require.Equal(t, drivertype.Type("toml"), drivertype.TOML)
 	require.Equal(t, drivertype.Type("xlsx"), drivertype.XLSX)
+	require.Equal(t, drivertype.Type("ods"), drivertype.ODS)
+	require.Equal(t, drivertype.Type("parquet"), drivertype.Parquet)
+	require.Equal(t, drivertype.Type("arrow"), drivertype.Arrow)
+	require.Equal(t, drivertype.Type("avro"), drivertype.Avro)
+	require.Equal(t, drivertype.Type("xml"), drivertype.XML)
 }
 
 func TestType_Equality(t *testing.T) {
@@ -23,19 +23,25 @@ import (
 	"github.com/neilotoole/sq/cli/config/yamlstore"
 	"github.com/neilotoole/sq/cli/output"
 	"github.com/neilotoole/sq/cli/run"
+	"github.com/neilotoole/sq/drivers/arrow"
+	"github.com/neilotoole/sq/drivers/avro"
 	"github.com/neilotoole/sq/drivers/clickhouse"
 	"github.com/neilotoole/sq/drivers/csv"
 	"github.com/neilotoole/sq/drivers/duckdb"
 	"github.com/neilotoole/sq/drivers/json"
 	"github.com/neilotoole/sq/drivers/mysql"
+	"github.com/neilotoole/sq/drivers/ods"
 	"github.com/neilotoole/sq/drivers/oracle"
+	"github.com/neilotoole/sq/drivers/parquet"
 	"github.com/neilotoole/sq/drivers/postgres"
 	"github.com/neilotoole/sq/drivers/rqlite"
 	"github.com/neilotoole/sq/drivers/sqlite3"
 	"github.com/neilotoole/sq/drivers/sqlserver"
 	"github.com/neilotoole/sq/drivers/userdriver"
+	"github.com/neilotoole/sq/drivers/userdriver/jsonud"
 	"github.com/neilotoole/sq/drivers/userdriver/xmlud"
 	"github.com/neilotoole/sq/drivers/xlsx"
+	"github.com/neilotoole/sq/drivers/xml"
 	"github.com/neilotoole/sq/libsq"
 	"github.com/neilotoole/sq/libsq/ast"
 	"github.com/neilotoole/sq/libsq/core/cleanup"
@@ -207,12 +213,15 @@ func (h *Helper) init() {
 		csvp := &csv.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files}
 		h.registry.AddProvider(drivertype.CSV, csvp)
 		h.registry.AddProvider(drivertype.TSV, csvp)
+		h.registry.AddProvider(drivertype.FixedWidth, csvp)
 		h.files.AddDriverDetectors(csv.DetectCSV, csv.DetectTSV)
 
 		jsonp := &json.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files}
 		h.registry.AddProvider(drivertype.JSON, jsonp)
 		h.registry.AddProvider(drivertype.JSONA, jsonp)
 		h.registry.AddProvider(drivertype.JSONL, jsonp)
+		h.registry.AddProvider(drivertype.YAML, jsonp)
+		h.registry.AddProvider(drivertype.TOML, jsonp)
 		h.files.AddDriverDetectors(
 			json.DetectJSON(driver.OptIngestSampleSize.Get(nil)),
 			json.DetectJSONA(driver.OptIngestSampleSize.Get(nil)),
@@ -222,6 +231,20 @@ func (h *Helper) init() {
 		h.registry.AddProvider(drivertype.XLSX, &xlsx.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files})
 		h.files.AddDriverDetectors(xlsx.DetectXLSX)
 
+		h.registry.AddProvider(drivertype.ODS, &ods.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files})
+		h.files.AddDriverDetectors(ods.DetectODS)
+
+		h.registry.AddProvider(drivertype.Parquet, &parquet.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files})
+		h.files.AddDriverDetectors(parquet.DetectParquet)
+
+		h.registry.AddProvider(drivertype.Arrow, &arrow.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files})
+		h.files.AddDriverDetectors(arrow.DetectArrow)
+
+		h.registry.AddProvider(drivertype.Avro, &avro.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files})
+
+		h.registry.AddProvider(drivertype.XML, &xml.Provider{Log: h.Log(), Ingester: h.grips, Files: h.files})
+		h.files.AddDriverDetectors(xml.DetectXML)
+
 		h.addUserDrivers()
 
 		h.run = &run.Run{
@@ -816,11 +839,12 @@ func (h *Helper) Run() *run.Run {
 
 // addUserDrivers adds some user drivers to the registry.
 func (h *Helper) addUserDrivers() {
-	userDriverDefs := DriverDefsFrom(h.T, testsrc.PathDriverDefPpl, testsrc.PathDriverDefRSS)
+	userDriverDefs := DriverDefsFrom(h.T, testsrc.PathDriverDefPpl, testsrc.PathDriverDefRSS,
+		testsrc.PathDriverDefOrders)
 
-	// One day we may have more supported user driver genres.
 	userDriverImporters := map[string]userdriver.IngestFunc{
-		xmlud.Genre: xmlud.Ingest,
+		xmlud.Genre:  xmlud.Ingest,
+		jsonud.Genre: jsonud.Ingest,
 	}
 
 	for _, userDriverDef := range userDriverDefs {
@@ -970,6 +994,10 @@ func DriverDetectors() []files.TypeDetectFunc {
 	return []files.TypeDetectFunc{
 		files.DetectMagicNumber,
 		xlsx.DetectXLSX,
+		ods.DetectODS,
+		parquet.DetectParquet,
+		arrow.DetectArrow,
+		xml.DetectXML,
 		csv.DetectCSV,
 		csv.DetectTSV,
 		json.DetectJSON(1000),
@@ -14,6 +14,9 @@ const (
 	// RSSNYTLocalUD is the handle of a user-defined RSS source.
 	RSSNYTLocalUD = "@ud_rss_nytimes_local"
 
+	// OrdersUD is the handle of a user-defined JSON "orders" source.
+	OrdersUD = "@ud_orders"
+
 	// MiscDB is the handle of a SQLite DB with misc testing data.
 	MiscDB = "@miscdb"
 
@@ -39,5 +42,7 @@ const (
 	PathDriverDefPpl = "drivers/userdriver/xmlud/testdata/ppl.sq.yml"
 	PathDriverDefRSS = "drivers/userdriver/xmlud/testdata/rss.sq.yml"
 
+	PathDriverDefOrders = "drivers/userdriver/jsonud/testdata/orders.sq.yml"
+
 	PathXLSXTestHeader = "drivers/xlsx/testdata/test_header.xlsx"
 )